@@ -5,8 +5,9 @@ import (
 	"flag"
 	"fmt"
 	"github.com/go-kit/kit/log"
-	"github.com/jwenz723/mathserver/grpc_and_http/gokit/pkg/mathservice"
 	"github.com/jwenz723/mathserver/grpc_and_http/gokit/pkg/mathtransport"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
 	"google.golang.org/grpc"
 	"os"
 	"strconv"
@@ -21,6 +22,9 @@ func main() {
 		httpAddr = fs.String("http-addr", "", "HTTP address of addsvc")
 		method   = fs.String("method", "sum", "divide, min, max, multiply, pow, subtract, sum")
 	)
+	var p precision.Precision
+	fs.Var(&p.Mode, "precision", "Precision mode: default, float64, bigfloat or rational")
+	fs.UintVar(&p.Bits, "precision-bits", 0, "Mantissa bits used by the bigfloat precision mode, 0 uses the server default")
 	fs.Usage = usageFor(fs, os.Args[0]+" [flags] <a> <b>")
 	fs.Parse(os.Args[1:])
 	if len(fs.Args()) != 2 {
@@ -50,33 +54,38 @@ func main() {
 	}
 	checkErr(err)
 
+	ctx, res := precision.NewContext(context.Background(), p)
 	switch *method {
 	case "divide":
-		v, err = svc.Divide(context.Background(), a, b)
+		v, err = svc.Divide(ctx, a, b)
 		op = "/"
 	case "max":
-		v, err = svc.Max(context.Background(), a, b)
+		v, err = svc.Max(ctx, a, b)
 		op = "max"
 	case "min":
-		v, err = svc.Min(context.Background(), a, b)
+		v, err = svc.Min(ctx, a, b)
 		op = "min"
 	case "multiply":
-		v, err = svc.Multiply(context.Background(), a, b)
+		v, err = svc.Multiply(ctx, a, b)
 		op = "*"
 	case "pow":
-		v, err = svc.Pow(context.Background(), a, b)
+		v, err = svc.Pow(ctx, a, b)
 		op = "^"
 	case "subtract":
-		v, err = svc.Subtract(context.Background(), a, b)
+		v, err = svc.Subtract(ctx, a, b)
 		op = "-"
 	case "sum":
-		v, err = svc.Sum(context.Background(), a, b)
+		v, err = svc.Sum(ctx, a, b)
 		op = "+"
 	default:
 		fmt.Fprintf(os.Stderr, "error: invalid method %q\n", *method)
 		os.Exit(1)
 	}
 	checkErr(err)
+	if exact := res.String(); exact != "" {
+		fmt.Fprintf(os.Stdout, "%f %s %f = %s\n", a, op, b, exact)
+		return
+	}
 	fmt.Fprintf(os.Stdout, "%f %s %f = %f\n", a, op, b, v)
 }
 
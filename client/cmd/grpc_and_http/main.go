@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"github.com/jwenz723/mathserver/grpc_and_http/std/pkg/server"
 	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
 	"google.golang.org/grpc"
 	"net/http"
 	"os"
@@ -42,10 +44,17 @@ func (h httpMathServer) handleMathRequest(method string, ctx context.Context, a,
 	resp, err := http.Post(fmt.Sprintf("http://%s/%s", h.addr, method), "application/json", bytes.NewBuffer(j))
 	checkErr(err)
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		p := problem.Read(resp)
+		if p.Code == "" {
+			return 0, fmt.Errorf("%s (%s)", p.Error(), p.Title)
+		}
+		return 0, rpcstatus.Err(p.ErrorCode(), p.Detail)
+	}
 
 	var m server.MathOpResponse
 	err = json.NewDecoder(resp.Body).Decode(&m)
-	return m.V, err
+	return float64(m.V), err
 }
 
 func (h httpMathServer) Divide(ctx context.Context, a, b float64) (float64, error) {
@@ -85,7 +94,7 @@ func (g grpcMathServer) Divide(ctx context.Context, a, b float64) (float64, erro
 		A: a,
 		B: b,
 	})
-	return r.V, err
+	return replyValue(r, err)
 }
 
 func (g grpcMathServer) Max(ctx context.Context, a, b float64) (float64, error) {
@@ -93,7 +102,7 @@ func (g grpcMathServer) Max(ctx context.Context, a, b float64) (float64, error)
 		A: a,
 		B: b,
 	})
-	return r.V, err
+	return replyValue(r, err)
 }
 
 func (g grpcMathServer) Min(ctx context.Context, a, b float64) (float64, error) {
@@ -101,7 +110,7 @@ func (g grpcMathServer) Min(ctx context.Context, a, b float64) (float64, error)
 		A: a,
 		B: b,
 	})
-	return r.V, err
+	return replyValue(r, err)
 }
 
 func (g grpcMathServer) Multiply(ctx context.Context, a, b float64) (float64, error) {
@@ -109,7 +118,7 @@ func (g grpcMathServer) Multiply(ctx context.Context, a, b float64) (float64, er
 		A: a,
 		B: b,
 	})
-	return r.V, err
+	return replyValue(r, err)
 }
 
 func (g grpcMathServer) Pow(ctx context.Context, a, b float64) (float64, error) {
@@ -117,7 +126,7 @@ func (g grpcMathServer) Pow(ctx context.Context, a, b float64) (float64, error)
 		A: a,
 		B: b,
 	})
-	return r.V, err
+	return replyValue(r, err)
 }
 
 func (g grpcMathServer) Subtract(ctx context.Context, a, b float64) (float64, error) {
@@ -125,7 +134,7 @@ func (g grpcMathServer) Subtract(ctx context.Context, a, b float64) (float64, er
 		A: a,
 		B: b,
 	})
-	return r.V, err
+	return replyValue(r, err)
 }
 
 func (g grpcMathServer) Sum(ctx context.Context, a, b float64) (float64, error) {
@@ -133,7 +142,25 @@ func (g grpcMathServer) Sum(ctx context.Context, a, b float64) (float64, error)
 		A: a,
 		B: b,
 	})
-	return r.V, err
+	return replyValue(r, err)
+}
+
+// replyValue returns the value of the reply r, or the error reported by the
+// server either in the reply or, when the server reports errors using gRPC
+// status codes, as the status of the call. The errors of the service are
+// reconstructed, so that they match the errors of pkg/mathservice using
+// errors.Is.
+func replyValue(r *pb.MathOpReply, err error) (float64, error) {
+	if code, msg, ok := rpcstatus.Parse(err); ok {
+		return 0, rpcstatus.Err(code, msg)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if r.Code != pb.ErrorCode_NO_ERROR {
+		return r.V, rpcstatus.Err(r.Code, r.Err)
+	}
+	return r.V, nil
 }
 
 func main() {
@@ -215,9 +242,16 @@ func usageFor(fs *flag.FlagSet, short string) func() {
 	}
 }
 
+// checkErr exits if err isn't nil, naming the ErrorCode of the errors of the
+// service.
 func checkErr(err error) {
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "error: %v", err)
-		os.Exit(1)
+	if err == nil {
+		return
+	}
+	if code := rpcstatus.Code(err); code != pb.ErrorCode_UNKNOWN {
+		fmt.Fprintf(os.Stderr, "error: %v (%s)\n", err, code)
+	} else {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
 	}
+	os.Exit(1)
 }
@@ -5,6 +5,8 @@ import (
 	"flag"
 	"fmt"
 	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
 	"google.golang.org/grpc"
 	"os"
 	"strconv"
@@ -18,6 +20,9 @@ func main() {
 		grpcAddr = fs.String("grpc-addr", "", "gRPC address of addsvc")
 		method   = fs.String("method", "sum", "divide, min, max, multiply, pow, subtract, sum")
 	)
+	var p precision.Precision
+	fs.Var(&p.Mode, "precision", "Precision mode: default, float64, bigfloat or rational")
+	fs.UintVar(&p.Bits, "precision-bits", 0, "Mantissa bits used by the bigfloat precision mode, 0 uses the server default")
 	fs.Usage = usageFor(fs, os.Args[0]+" [flags] <a> <b>")
 	fs.Parse(os.Args[1:])
 	if len(fs.Args()) != 2 {
@@ -37,7 +42,7 @@ func main() {
 	defer conn.Close()
 
 	svc := pb.NewMathClient(conn)
-	req := pb.MathOpRequest{A: a, B: b}
+	req := pb.MathOpRequest{A: a, B: b, Precision: p.Proto()}
 
 	var (
 		op string
@@ -69,7 +74,16 @@ func main() {
 		fmt.Fprintf(os.Stderr, "error: invalid method %q\n", *method)
 		os.Exit(1)
 	}
+	if code, msg, ok := rpcstatus.Parse(err); ok {
+		fmt.Fprintf(os.Stderr, "error: %v (%s, fields %v)\n", rpcstatus.Err(code, msg), code, rpcstatus.Fields(err))
+		os.Exit(1)
+	}
 	checkErr(err)
+	checkErr(rpcstatus.Err(v.Code, v.Err))
+	if v.Exact != "" {
+		fmt.Fprintf(os.Stdout, "%f %s %f = %s\n", a, op, b, v.Exact)
+		return
+	}
 	fmt.Fprintf(os.Stdout, "%f %s %f = %f\n", a, op, b, v.V)
 }
 
@@ -88,9 +102,16 @@ func usageFor(fs *flag.FlagSet, short string) func() {
 	}
 }
 
+// checkErr exits if err isn't nil, naming the ErrorCode of the errors of the
+// service.
 func checkErr(err error) {
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "error: %v", err)
-		os.Exit(1)
+	if err == nil {
+		return
+	}
+	if code := rpcstatus.Code(err); code != pb.ErrorCode_UNKNOWN {
+		fmt.Fprintf(os.Stderr, "error: %v (%s)\n", err, code)
+	} else {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
 	}
+	os.Exit(1)
 }
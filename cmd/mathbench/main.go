@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jwenz723/mathserver/pkg/bench"
+	"github.com/jwenz723/mathserver/pkg/conformance"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/variants"
+)
+
+var methods = []string{"Divide", "Max", "Min", "Multiply", "Pow", "Subtract", "Sum",
+	"Mod", "IntDivide", "Remainder", "Gcd", "Lcm",
+	"Sqrt", "Abs", "Negate", "Exp", "Ln", "Log10", "Log2", "Floor", "Ceil", "Round", "Trunc",
+	"Sin", "Cos", "Tan", "Asin", "Acos", "Atan",
+	"Gamma", "LogGamma", "Beta", "Erf", "Erfc", "BesselJ", "BesselY",
+	"SumAll", "Product", "Mean", "Median", "Variance", "StdDev", "Evaluate"}
+
+func main() {
+	fs := flag.NewFlagSet("mathbench", flag.ExitOnError)
+	var (
+		only         = fs.String("variants", "", "Comma separated implementations to benchmark, e.g. grpc_only/std, all by default")
+		transports   = fs.String("transports", "grpc,http", "Comma separated transports to benchmark: grpc, http")
+		concurrency  = fs.String("concurrency", "1,8,32", "Comma separated numbers of concurrent calls, each is a separate run")
+		qps          = fs.Float64("qps", 0, "Maximum rate at which calls are started, 0 for no limit")
+		duration     = fs.Duration("duration", 5*time.Second, "Duration of each run")
+		requests     = fs.Int("requests", 0, "Number of calls made by each run, overrides -duration when set")
+		method       = fs.String("method", "divide", "Method to call: "+strings.ToLower(strings.Join(methods, ", ")))
+		a            = fs.Float64("a", 7, "First operand of binary methods, operand of unary methods")
+		b            = fs.Float64("b", 2, "Second operand of binary methods")
+		values       = fs.String("values", "1,2,3,4,5,6,7,8,9,10", "Comma separated values of list methods")
+		expression   = fs.String("expression", "(3+4)*2^5/7", "Expression passed to evaluate")
+		statusErrors = fs.Bool("grpc-status-errors", false, "Start the gRPC servers reporting errors as gRPC status codes")
+		format       = fs.String("format", bench.Text, "Output format: text, csv or json")
+		output       = fs.String("o", "", "File to write the results to, stdout by default")
+	)
+	var p precision.Precision
+	fs.Var(&p.Mode, "precision", "Precision mode requested by each call: default, float64, bigfloat or rational")
+	fs.Usage = usageFor(fs, os.Args[0]+" [flags]")
+	fs.Parse(os.Args[1:])
+
+	call := conformance.Case{A: *a, B: *b, Expression: *expression, Precision: p}
+	for _, m := range methods {
+		if strings.EqualFold(m, *method) {
+			call.Method = m
+		}
+	}
+	if call.Method == "" {
+		fmt.Fprintf(os.Stderr, "error: invalid method %q\n", *method)
+		os.Exit(1)
+	}
+	for _, s := range split(*values) {
+		v, err := strconv.ParseFloat(s, 64)
+		checkErr(err)
+		call.Values = append(call.Values, v)
+	}
+
+	cfg := bench.Config{QPS: *qps, Duration: *duration}
+	if *requests > 0 {
+		cfg = bench.Config{QPS: *qps, Requests: *requests}
+	}
+	var levels []int
+	for _, s := range split(*concurrency) {
+		n, err := strconv.Atoi(s)
+		checkErr(err)
+		levels = append(levels, n)
+	}
+
+	var results []bench.Result
+	for _, v := range variants.All(precision.Precision{Mode: precision.Float64}, mathservice.PassNonFinite) {
+		if *only != "" && !contains(split(*only), v.Name) {
+			continue
+		}
+		for _, transport := range split(*transports) {
+			if transport == bench.HTTP && v.HTTPHandler == nil {
+				continue
+			}
+			c, stop, err := bench.Serve(v, transport, *statusErrors)
+			checkErr(err)
+			// make sure the call works before measuring it
+			if _, err := c.Do(context.Background(), call); err != nil {
+				stop()
+				checkErr(fmt.Errorf("%s over %s: %v", v.Name, transport, err))
+			}
+			for _, n := range levels {
+				cfg.Concurrency = n
+				fmt.Fprintf(os.Stderr, "benchmarking %s over %s with %d concurrent calls\n", v.Name, transport, n)
+				r, err := bench.Run(context.Background(), c, call, cfg)
+				if err != nil {
+					stop()
+					checkErr(err)
+				}
+				r.Variant, r.Transport = v.Name, transport
+				results = append(results, r)
+			}
+			stop()
+		}
+	}
+	bench.Compare(results)
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		checkErr(err)
+		defer f.Close()
+		w = f
+	}
+	checkErr(bench.Write(w, *format, results))
+}
+
+func split(s string) []string {
+	var fields []string
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func usageFor(fs *flag.FlagSet, short string) func() {
+	return func() {
+		fmt.Fprintf(os.Stderr, "USAGE\n")
+		fmt.Fprintf(os.Stderr, "  %s\n", short)
+		fmt.Fprintf(os.Stderr, "\n")
+		fmt.Fprintf(os.Stderr, "FLAGS\n")
+		w := tabwriter.NewWriter(os.Stderr, 0, 2, 2, ' ', 0)
+		fs.VisitAll(func(f *flag.Flag) {
+			fmt.Fprintf(w, "\t-%s %s\t%s\n", f.Name, f.DefValue, f.Usage)
+		})
+		w.Flush()
+		fmt.Fprintf(os.Stderr, "\n")
+	}
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
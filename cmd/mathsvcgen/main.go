@@ -0,0 +1,402 @@
+// Command mathsvcgen generates the per-operation code of the Math service from
+// mathsvc.proto, so that adding an operation only takes a change to the proto
+// and its business logic in pkg/mathservice.
+//
+// The operations are the unary methods of the Math service that take a
+// MathOpRequest, a MathListRequest or a UnaryOpRequest and return a
+// MathOpReply. For each of them mathsvcgen emits the Service method, the
+// observability middleware, the go-kit endpoints and the gRPC and HTTP
+// transport glue. The other methods, such as Evaluate and Batch, are written
+// by hand.
+//
+// Given the -service flag mathsvcgen generates the per-method code of another
+// service of the proto instead, from its methods and the Service interface of
+// the package implementing it: the methods of an observability middleware
+// and of a gRPC server calling the service or go-kit handlers, for the type
+// named by -type. The streaming methods of a gRPC server are written by
+// hand, as are the constructors, the observeMethodExecution method of a
+// middleware and the reply methods of a server.
+//
+// mathsvcgen reads the file descriptor compiled into package pb, so pb must be
+// regenerated with pb/compile.sh first. It's run by go generate from the
+// package it generates code for:
+//
+//	//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind service -o service_gen.go
+//	//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -service Complex -type complexObservabilityMiddleware -o complex_gen.go
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+
+	_ "github.com/jwenz723/mathserver/pb"
+)
+
+const (
+	protoFile   = "mathsvc.proto"
+	pbPackage   = "github.com/jwenz723/mathserver/pb"
+	serviceName = "Math"
+)
+
+func main() {
+	fs := flag.NewFlagSet("mathsvcgen", flag.ExitOnError)
+	var o options
+	fs.StringVar(&o.kind, "kind", "", "Kind of code to generate: "+strings.Join(kinds(templates), ", ")+
+		", or for another service "+strings.Join(kinds(serviceTemplates), ", "))
+	fs.StringVar(&o.output, "o", "", "File to write the generated code to")
+	fs.StringVar(&o.pkg, "package", os.Getenv("GOPACKAGE"), "Package of the generated code, set by go generate")
+	fs.StringVar(&o.endpoints, "endpoints", "", "Import path of the mathendpoint package used by the go-kit transports")
+	fs.StringVar(&o.service, "service", serviceName, "Service of the proto to generate code for")
+	fs.StringVar(&o.typ, "type", "", "Type to generate the methods of, for a service other than "+serviceName)
+	fs.Parse(os.Args[1:])
+
+	if err := run(o); err != nil {
+		fmt.Fprintf(os.Stderr, "mathsvcgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// options are the flags of mathsvcgen.
+type options struct {
+	kind      string
+	output    string
+	pkg       string
+	endpoints string
+	service   string
+	typ       string
+}
+
+func run(o options) error {
+	if o.output == "" || o.pkg == "" {
+		return fmt.Errorf("-o and -package are required")
+	}
+
+	var (
+		t    *template.Template
+		data interface{}
+	)
+	if o.service == serviceName || o.service == "" {
+		var ok bool
+		if t, ok = templates[o.kind]; !ok {
+			return fmt.Errorf("unknown kind %q, want one of %s", o.kind, strings.Join(kinds(templates), ", "))
+		}
+		svc, err := loadService()
+		if err != nil {
+			return err
+		}
+		svc.Package = o.pkg
+		svc.Endpoints = o.endpoints
+		data = svc
+	} else {
+		var ok bool
+		if t, ok = serviceTemplates[o.kind]; !ok {
+			return fmt.Errorf("unknown kind %q for the %s service, want one of %s", o.kind, o.service, strings.Join(kinds(serviceTemplates), ", "))
+		}
+		if o.typ == "" {
+			return fmt.Errorf("-type is required for the %s service", o.service)
+		}
+		svc, err := loadOtherService(o.service)
+		if err != nil {
+			return err
+		}
+		svc.Package = o.pkg
+		svc.Type = o.typ
+		data = svc
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %v\n%s", o.output, err, buf.Bytes())
+	}
+	return ioutil.WriteFile(o.output, src, 0644)
+}
+
+func kinds(templates map[string]*template.Template) []string {
+	var k []string
+	for kind := range templates {
+		k = append(k, kind)
+	}
+	sort.Strings(k)
+	return k
+}
+
+// service describes the Math service to the templates.
+type service struct {
+	// Name is the fully qualified name of the service, e.g. pb.Math.
+	Name string
+	// Operations are the methods generated by mathsvcgen, in the order they
+	// are declared in the proto.
+	Operations []operation
+	// Package is the name of the package the code is generated for.
+	Package string
+	// Endpoints is the import path of the mathendpoint package.
+	Endpoints string
+}
+
+// operation is a method of the service taking a pair of operands, a list of
+// values or a single operand.
+type operation struct {
+	// Name is the name of the method, e.g. SumAll.
+	Name string
+	// Doc holds the lines of the comment of the method in the proto.
+	Doc []string
+	// Kind is binary, list or unary.
+	Kind string
+}
+
+// The kinds of operation, named after the functions of package compute that
+// perform them.
+const (
+	binary = "binary"
+	list   = "list"
+	unary  = "unary"
+)
+
+// requests maps the request message of each kind of operation to the kind.
+var requests = map[string]string{
+	"MathOpRequest":   binary,
+	"MathListRequest": list,
+	"UnaryOpRequest":  unary,
+}
+
+// Lower returns the name of o the way HTTP paths and batch items spell it,
+// e.g. sumall.
+func (o operation) Lower() string { return strings.ToLower(o.Name) }
+
+// Field returns the name of the unexported field holding the handler of o,
+// e.g. sumAll.
+func (o operation) Field() string { return strings.ToLower(o.Name[:1]) + o.Name[1:] }
+
+// Enum returns the name of the pb.ComputeRequest_Op value requesting o, e.g.
+// SUMALL.
+func (o operation) Enum() string { return strings.ToUpper(o.Name) }
+
+// Binary reports whether o takes a pair of operands, and so a division.
+func (o operation) Binary() bool { return o.Kind == binary }
+
+// Request returns the name of the request message of o, which is also the
+// name of the request types of the transports.
+func (o operation) Request() string {
+	for req, kind := range requests {
+		if kind == o.Kind {
+			return req
+		}
+	}
+	panic("unknown kind " + o.Kind)
+}
+
+// Params returns the parameters of the Service method of o, after ctx.
+func (o operation) Params() string {
+	return o.pick("a, b float64", "values []float64", "x float64")
+}
+
+// Args returns the arguments passing the parameters of the Service method of
+// o on to another call.
+func (o operation) Args() string { return o.pick("a, b", "values", "x") }
+
+// ReqArgs returns the arguments of the Service method of o taken from its
+// request, req.
+func (o operation) ReqArgs() string { return o.pick("req.A, req.B", "req.Values", "req.X") }
+
+// Fields returns the fields of the request of o set from the parameters of
+// its Service method.
+func (o operation) Fields() string { return o.pick("A: a, B: b", "Values: values", "X: x") }
+
+// ItemFields returns the fields of the request of o set from a batch item,
+// whose A is the operand of unary operations.
+func (o operation) ItemFields() string {
+	return o.pick("A: item.A, B: item.B", "Values: item.Values", "X: item.A")
+}
+
+// Observe returns the name of the observabilityMiddleware method observing a
+// call of o.
+func (o operation) Observe() string {
+	return o.pick("observeMethodExecution", "observeListMethodExecution", "observeUnaryMethodExecution")
+}
+
+// Handler returns the name of the httpServer method serving o.
+func (o operation) Handler() string {
+	return o.pick("mathOpHandlerFunc", "mathListHandlerFunc", "unaryOpHandlerFunc")
+}
+
+func (o operation) pick(b, l, u string) string {
+	switch o.Kind {
+	case list:
+		return l
+	case unary:
+		return u
+	}
+	return b
+}
+
+// loadService reads the Math service from the file descriptor registered by
+// package pb, taking the doc comments of its methods from the generated
+// MathServer interface since the registered descriptor doesn't keep them. The
+// other services declared by the proto, such as Complex, are written by hand.
+func loadService() (service, error) {
+	fd, err := fileDescriptor()
+	if err != nil {
+		return service{}, err
+	}
+	var sd *descriptor.ServiceDescriptorProto
+	for _, s := range fd.Service {
+		if s.GetName() == serviceName {
+			sd = s
+		}
+	}
+	if sd == nil {
+		return service{}, fmt.Errorf("%s doesn't declare the %s service", protoFile, serviceName)
+	}
+
+	docs, err := methodDocs(sd.GetName() + "Server")
+	if err != nil {
+		return service{}, err
+	}
+	ops, err := computeOps(fd)
+	if err != nil {
+		return service{}, err
+	}
+
+	svc := service{Name: fd.GetPackage() + "." + sd.GetName()}
+	prefix := "." + fd.GetPackage() + "."
+	for _, m := range sd.Method {
+		if m.GetClientStreaming() || m.GetServerStreaming() || m.GetOutputType() != prefix+"MathOpReply" {
+			continue
+		}
+		kind, ok := requests[strings.TrimPrefix(m.GetInputType(), prefix)]
+		if !ok {
+			continue
+		}
+		op := operation{Name: m.GetName(), Doc: docs[m.GetName()], Kind: kind}
+		if !ops[op.Enum()] {
+			return service{}, fmt.Errorf("%s: ComputeRequest.Op has no %s value for %s", protoFile, op.Enum(), op.Name)
+		}
+		svc.Operations = append(svc.Operations, op)
+	}
+	return svc, nil
+}
+
+// fileDescriptor returns the descriptor of mathsvc.proto registered by pb.
+func fileDescriptor() (*descriptor.FileDescriptorProto, error) {
+	gz := proto.FileDescriptor(protoFile)
+	if gz == nil {
+		return nil, fmt.Errorf("%s isn't registered by %s", protoFile, pbPackage)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var fd descriptor.FileDescriptorProto
+	if err := proto.Unmarshal(b, &fd); err != nil {
+		return nil, err
+	}
+	return &fd, nil
+}
+
+// computeOps returns the values of the ComputeRequest.Op enum, which must
+// have a value for each operation so that it can be performed by Compute
+// and Batch.
+func computeOps(fd *descriptor.FileDescriptorProto) (map[string]bool, error) {
+	for _, m := range fd.MessageType {
+		if m.GetName() != "ComputeRequest" {
+			continue
+		}
+		for _, e := range m.EnumType {
+			if e.GetName() != "Op" {
+				continue
+			}
+			ops := make(map[string]bool)
+			for _, v := range e.Value {
+				ops[v.GetName()] = true
+			}
+			return ops, nil
+		}
+	}
+	return nil, fmt.Errorf("%s doesn't declare the ComputeRequest.Op enum", protoFile)
+}
+
+// methodDocs returns the doc comment lines of the methods of the named
+// interface generated in package pb.
+func methodDocs(iface string) (map[string][]string, error) {
+	p, err := build.Import(pbPackage, ".", build.FindOnly)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, p.Dir, func(fi os.FileInfo) bool {
+		return filepath.Ext(fi.Name()) == ".go" && !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make(map[string][]string)
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			ast.Inspect(f, func(n ast.Node) bool {
+				ts, ok := n.(*ast.TypeSpec)
+				if !ok || ts.Name.Name != iface {
+					return true
+				}
+				it, ok := ts.Type.(*ast.InterfaceType)
+				if !ok {
+					return false
+				}
+				for _, m := range it.Methods.List {
+					if m.Doc == nil || len(m.Names) == 0 {
+						continue
+					}
+					docs[m.Names[0].Name] = strings.Split(strings.TrimSpace(m.Doc.Text()), "\n")
+				}
+				return false
+			})
+		}
+	}
+	if len(docs) == 0 {
+		return nil, fmt.Errorf("no documented %s interface found in %s", iface, p.Dir)
+	}
+	return docs, nil
+}
+
+var templates = map[string]*template.Template{
+	"service":    template.Must(template.New("service").Parse(header + serviceTemplate)),
+	"middleware": template.Must(template.New("middleware").Parse(header + middlewareTemplate)),
+	"nonfinite":  template.Must(template.New("nonfinite").Parse(header + nonFiniteTemplate)),
+	"endpoint":   template.Must(template.New("endpoint").Parse(header + endpointTemplate)),
+	"gokit-grpc": template.Must(template.New("gokit-grpc").Parse(header + gokitGRPCTemplate)),
+	"gokit-http": template.Must(template.New("gokit-http").Parse(header + gokitHTTPTemplate)),
+	"grpc":       template.Must(template.New("grpc").Parse(header + grpcTemplate)),
+	"http":       template.Must(template.New("http").Parse(header + httpTemplate)),
+	"compute":    template.Must(template.New("compute").Parse(header + computeTemplate)),
+}
+
+// serviceTemplates generate the code of the services other than Math.
+var serviceTemplates = map[string]*template.Template{
+	"middleware": template.Must(template.New("middleware").Parse(header + serviceMiddlewareTemplate)),
+	"grpc":       template.Must(template.New("grpc").Parse(header + serviceGRPCTemplate)),
+	"gokit-grpc": template.Must(template.New("gokit-grpc").Parse(header + serviceGokitGRPCTemplate)),
+}
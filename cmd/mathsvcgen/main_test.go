@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerated checks that the code generated for every go:generate
+// directive running mathsvcgen is up to date, so that no operation is
+// missing from a transport or server because go generate wasn't run.
+func TestGenerated(t *testing.T) {
+	const directive = "//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen "
+	dir, err := ioutil.TempDir("", "mathsvcgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	n := 0
+	err = filepath.Walk(filepath.Join("..", ".."), func(path string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() || filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			if !strings.HasPrefix(s.Text(), directive) {
+				continue
+			}
+			fs := flag.NewFlagSet("mathsvcgen", flag.ContinueOnError)
+			var o options
+			fs.StringVar(&o.kind, "kind", "", "")
+			fs.StringVar(&o.output, "o", "", "")
+			fs.StringVar(&o.endpoints, "endpoints", "", "")
+			fs.StringVar(&o.service, "service", serviceName, "")
+			fs.StringVar(&o.typ, "type", "", "")
+			if err := fs.Parse(strings.Fields(strings.TrimPrefix(s.Text(), directive))); err != nil {
+				t.Errorf("%s: %v", path, err)
+				continue
+			}
+			pf, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.PackageClauseOnly)
+			if err != nil {
+				return err
+			}
+			want := filepath.Join(filepath.Dir(path), o.output)
+			got := filepath.Join(dir, "gen.go")
+			o.output, o.pkg = got, pf.Name.Name
+			if err := run(o); err != nil {
+				t.Errorf("%s: %v", path, err)
+				continue
+			}
+			if !sameContents(t, got, want) {
+				t.Errorf("%s is out of date, run go generate in %s", want, filepath.Dir(path))
+			}
+			n++
+		}
+		return s.Err()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n == 0 {
+		t.Error("no go:generate directives found")
+	}
+}
+
+func sameContents(t *testing.T, a, b string) bool {
+	ca, err := ioutil.ReadFile(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cb, err := ioutil.ReadFile(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.Equal(ca, cb)
+}
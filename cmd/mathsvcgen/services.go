@@ -0,0 +1,592 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/golang/protobuf/protoc-gen-go/generator"
+)
+
+// servicePackages maps the services of mathsvc.proto other than Math to the
+// package implementing them, whose Service interface declares a method for
+// each method of the service.
+var servicePackages = map[string]string{
+	"Complex":       "github.com/jwenz723/mathserver/pkg/complexservice",
+	"LinearAlgebra": "github.com/jwenz723/mathserver/pkg/linalgservice",
+	"Polynomial":    "github.com/jwenz723/mathserver/pkg/polyservice",
+	"Statistics":    "github.com/jwenz723/mathserver/pkg/statsservice",
+	"Units":         "github.com/jwenz723/mathserver/pkg/unitservice",
+	"Finance":       "github.com/jwenz723/mathserver/pkg/financeservice",
+	"NumberTheory":  "github.com/jwenz723/mathserver/pkg/numtheoryservice",
+	"Combinatorics": "github.com/jwenz723/mathserver/pkg/combinatoricsservice",
+	"Calculus":      "github.com/jwenz723/mathserver/pkg/calculusservice",
+	"Symbolic":      "github.com/jwenz723/mathserver/pkg/symbolicservice",
+}
+
+// otherService describes a service other than Math to the templates, from
+// its methods in the proto and the Service interface of its package.
+type otherService struct {
+	// Name is the name of the service in the proto, e.g. LinearAlgebra.
+	Name string
+	// FullName is the fully qualified name of the service, e.g.
+	// pb.LinearAlgebra.
+	FullName string
+	// Import is the import path of the package implementing the service,
+	// named PackageName.
+	Import      string
+	PackageName string
+	// Imports are the import paths of the other packages named by the types
+	// of the Service methods, besides context.
+	Imports []string
+	// Methods are the methods of the service, in the order they are
+	// declared in the proto.
+	Methods []method
+	// Package is the name of the package the code is generated for.
+	Package string
+	// Type is the name of the type the methods are generated for.
+	Type string
+}
+
+// Unary returns the methods of s that aren't streaming.
+func (s otherService) Unary() []method {
+	var unary []method
+	for _, m := range s.Methods {
+		if !m.Streaming {
+			unary = append(unary, m)
+		}
+	}
+	return unary
+}
+
+// SignatureImports returns the import paths of the packages named by the
+// signatures of the Service methods of s, besides context.
+func (s otherService) SignatureImports() []string {
+	for _, m := range s.Methods {
+		if strings.Contains(m.Signature(), s.PackageName+".") {
+			return append([]string{s.Import}, s.Imports...)
+		}
+	}
+	return s.Imports
+}
+
+// UsesPackage reports whether the gRPC handlers of s convert a request with
+// a function of the package implementing s.
+func (s otherService) UsesPackage() bool {
+	for _, m := range s.Unary() {
+		for _, a := range strings.Split(m.Args, ", ") {
+			if !strings.HasPrefix(a, "req.") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// method is a method of a service other than Math.
+type method struct {
+	// Name is the name of the method, e.g. Solve.
+	Name string
+	// Doc holds the lines of the comment of the method in the proto.
+	Doc []string
+	// Input and Output are the names of the request and reply messages.
+	Input, Output string
+	// Streaming is set for the methods streaming their request or reply,
+	// whose handlers are written by hand.
+	Streaming bool
+	// Params are the parameters of the Service method, after ctx.
+	Params []param
+	// Result is the type of the value returned by the Service method along
+	// with an error, empty if it only returns an error.
+	Result string
+	// Args are the arguments of the Service method taken from a request
+	// message, req, empty for the streaming methods.
+	Args string
+}
+
+// param is a parameter of a Service method.
+type param struct {
+	Name string
+	Type string
+	// Func describes the parameters and results of a function parameter,
+	// which is nil for the others.
+	Func *ast.FuncType
+	// pkg is the name of the package declaring Func.
+	pkg string
+}
+
+// Field returns the name of the unexported field holding the handler of m,
+// e.g. findRoot, or npv for NPV.
+func (m method) Field() string { return lowerInitial(m.Name) }
+
+// Reply returns the name of the method replying with the Output of m, e.g.
+// vectorReply for a VectorReply.
+func (m method) Reply() string { return lowerInitial(m.Output) }
+
+// Signature returns the parameters and results of the Service method of m,
+// after ctx, with named results v and err.
+func (m method) Signature() string {
+	var params []string
+	for _, p := range m.Params {
+		params = append(params, p.Name+" "+p.Type)
+	}
+	results := "err error"
+	if m.Result != "" {
+		results = "v " + m.Result + ", " + results
+	}
+	return strings.Join(params, ", ") + ") (" + results
+}
+
+// Counters returns the names of the variables counting the calls of the
+// function parameters of m.
+func (m method) Counters() []string {
+	var counters []string
+	for _, p := range m.Params {
+		if p.Func != nil {
+			counters = append(counters, p.counter())
+		}
+	}
+	return counters
+}
+
+// KeyVals returns the keys and values logged for a call of m: its
+// parameters, a function being logged as the number of times it was called,
+// and its result as v.
+func (m method) KeyVals() string {
+	var kv []string
+	for _, p := range m.Params {
+		v := p.Name
+		if p.Func != nil {
+			v = p.counter()
+		}
+		kv = append(kv, strconv.Quote(p.Name), v)
+	}
+	if m.Result != "" {
+		kv = append(kv, `"v"`, "v")
+	}
+	return strings.Join(kv, ", ")
+}
+
+// NextArgs returns the arguments passing the parameters of m on to the next
+// Service, wrapping each function to count its calls.
+func (m method) NextArgs() string {
+	var args []string
+	for _, p := range m.Params {
+		if p.Func == nil {
+			args = append(args, p.Name)
+			continue
+		}
+		var params, names []string
+		for i, f := range p.Func.Params.List {
+			var fieldNames []string
+			for _, n := range f.Names {
+				fieldNames = append(fieldNames, n.Name)
+			}
+			if len(fieldNames) == 0 && len(p.Func.Params.List) == 1 {
+				fieldNames = []string{"x"}
+			} else if len(fieldNames) == 0 {
+				fieldNames = []string{"x" + strconv.Itoa(i)}
+			}
+			names = append(names, fieldNames...)
+			params = append(params, strings.Join(fieldNames, ", ")+" "+typeString(f.Type, p.pkg))
+		}
+		var results []string
+		if p.Func.Results != nil {
+			for _, f := range p.Func.Results.List {
+				results = append(results, typeString(f.Type, p.pkg))
+			}
+		}
+		call := p.Name + "(" + strings.Join(names, ", ") + ")"
+		if len(results) > 0 {
+			call = "return " + call
+		}
+		result := strings.Join(results, ", ")
+		if len(results) > 1 {
+			result = "(" + result + ")"
+		}
+		args = append(args, fmt.Sprintf("func(%s) %s {\n%s++\n%s\n}",
+			strings.Join(params, ", "), result, p.counter(), call))
+	}
+	return strings.Join(args, ", ")
+}
+
+func (p param) counter() string { return p.Name + "Calls" }
+
+// lowerInitial lower-cases the leading upper-case letters of name, except
+// the one starting the next word, e.g. NPV to npv and IsPrime to isPrime.
+func lowerInitial(name string) string {
+	r := []rune(name)
+	n := 0
+	for n < len(r) && unicode.IsUpper(r[n]) {
+		n++
+	}
+	if n > 1 && n < len(r) {
+		n--
+	}
+	for i := 0; i < n; i++ {
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r)
+}
+
+// loadOtherService reads the named service from the file descriptor
+// registered by package pb, and the signatures of its methods from the
+// Service interface of the package implementing it.
+func loadOtherService(name string) (otherService, error) {
+	path, ok := servicePackages[name]
+	if !ok {
+		var names []string
+		for n := range servicePackages {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return otherService{}, fmt.Errorf("unknown service %q, want %s or one of %s", name, serviceName, strings.Join(names, ", "))
+	}
+	fd, err := fileDescriptor()
+	if err != nil {
+		return otherService{}, err
+	}
+	var sd *descriptor.ServiceDescriptorProto
+	for _, s := range fd.Service {
+		if s.GetName() == name {
+			sd = s
+		}
+	}
+	if sd == nil {
+		return otherService{}, fmt.Errorf("%s doesn't declare the %s service", protoFile, name)
+	}
+	docs, err := methodDocs(name + "Server")
+	if err != nil {
+		return otherService{}, err
+	}
+	pkg, err := loadServicePackage(path)
+	if err != nil {
+		return otherService{}, err
+	}
+
+	svc := otherService{
+		Name:        name,
+		FullName:    fd.GetPackage() + "." + name,
+		Import:      path,
+		PackageName: pkg.name,
+		Imports:     pkg.imports,
+	}
+	prefix := "." + fd.GetPackage() + "."
+	for _, m := range sd.Method {
+		sig, ok := pkg.methods[m.GetName()]
+		if !ok {
+			return otherService{}, fmt.Errorf("%s.Service has no %s method", pkg.name, m.GetName())
+		}
+		sig.Name = m.GetName()
+		sig.Doc = docs[m.GetName()]
+		sig.Input = strings.TrimPrefix(m.GetInputType(), prefix)
+		sig.Output = strings.TrimPrefix(m.GetOutputType(), prefix)
+		sig.Streaming = m.GetClientStreaming() || m.GetServerStreaming()
+		if !sig.Streaming {
+			if sig.Args, err = pkg.args(sig, message(fd, sig.Input)); err != nil {
+				return otherService{}, fmt.Errorf("%s.%s: %v", name, m.GetName(), err)
+			}
+		}
+		svc.Methods = append(svc.Methods, sig)
+	}
+	return svc, nil
+}
+
+// message returns the descriptor of the named message of fd, or nil.
+func message(fd *descriptor.FileDescriptorProto, name string) *descriptor.DescriptorProto {
+	for _, m := range fd.MessageType {
+		if m.GetName() == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// servicePackage is what mathsvcgen needs from the package implementing a
+// service.
+type servicePackage struct {
+	// name is the name of the package, e.g. linalgservice.
+	name string
+	// imports are the import paths of the packages other than context named
+	// by the Service methods.
+	imports []string
+	// methods are the methods of the Service interface, by name.
+	methods map[string]method
+	// converters are the functions converting a message to a type of the
+	// package, e.g. FromProto for a Matrix, by message name.
+	converters map[string][]converter
+}
+
+// converter is a function of a service package converting a message to one
+// of its types.
+type converter struct {
+	name   string
+	result string
+}
+
+// loadServicePackage parses the package at path.
+func loadServicePackage(path string) (servicePackage, error) {
+	p, err := build.Import(path, ".", build.FindOnly)
+	if err != nil {
+		return servicePackage{}, err
+	}
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, p.Dir, func(fi os.FileInfo) bool {
+		return filepath.Ext(fi.Name()) == ".go" && !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return servicePackage{}, err
+	}
+	var sp servicePackage
+	for name, pkg := range pkgs {
+		sp = servicePackage{name: name, converters: make(map[string][]converter)}
+		for _, f := range pkg.Files {
+			for _, d := range f.Decls {
+				switch d := d.(type) {
+				case *ast.GenDecl:
+					if err := sp.addService(d, f); err != nil {
+						return servicePackage{}, err
+					}
+				case *ast.FuncDecl:
+					sp.addConverter(d)
+				}
+			}
+		}
+	}
+	if sp.methods == nil {
+		return servicePackage{}, fmt.Errorf("no Service interface found in %s", p.Dir)
+	}
+	return sp, nil
+}
+
+// addService records the methods of the Service interface if it's declared
+// by d, a declaration of f.
+func (sp *servicePackage) addService(d *ast.GenDecl, f *ast.File) error {
+	for _, s := range d.Specs {
+		ts, ok := s.(*ast.TypeSpec)
+		if !ok || ts.Name.Name != "Service" {
+			continue
+		}
+		it, ok := ts.Type.(*ast.InterfaceType)
+		if !ok {
+			return fmt.Errorf("%s.Service isn't an interface", sp.name)
+		}
+		imports := make(map[string]string)
+		for _, imp := range f.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			name := filepath.Base(path)
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			imports[name] = path
+		}
+		used := make(map[string]bool)
+		ast.Inspect(it, func(n ast.Node) bool {
+			if se, ok := n.(*ast.SelectorExpr); ok {
+				if id, ok := se.X.(*ast.Ident); ok && id.Name != "context" {
+					used[imports[id.Name]] = true
+				}
+			}
+			return true
+		})
+		for path := range used {
+			sp.imports = append(sp.imports, path)
+		}
+		sort.Strings(sp.imports)
+
+		sp.methods = make(map[string]method)
+		for _, m := range it.Methods.List {
+			ft, ok := m.Type.(*ast.FuncType)
+			if !ok || len(m.Names) == 0 {
+				continue
+			}
+			sig, err := sp.signature(m.Names[0].Name, ft)
+			if err != nil {
+				return err
+			}
+			sp.methods[m.Names[0].Name] = sig
+		}
+	}
+	return nil
+}
+
+// signature returns the method named name of type ft, which must take a
+// context then its operands and return an error, after its result if any.
+func (sp *servicePackage) signature(name string, ft *ast.FuncType) (method, error) {
+	var (
+		m      = method{Name: name}
+		params = ft.Params.List
+	)
+	if len(params) == 0 || typeString(params[0].Type, sp.name) != "context.Context" {
+		return method{}, fmt.Errorf("%s.Service.%s doesn't take a context first", sp.name, name)
+	}
+	if len(params[0].Names) > 1 {
+		return method{}, fmt.Errorf("%s.Service.%s takes an operand of type context.Context", sp.name, name)
+	}
+	for _, f := range params[1:] {
+		fn, _ := f.Type.(*ast.FuncType)
+		for _, n := range f.Names {
+			m.Params = append(m.Params, param{Name: n.Name, Type: typeString(f.Type, sp.name), Func: fn, pkg: sp.name})
+		}
+	}
+	var results []ast.Expr
+	if ft.Results != nil {
+		for _, f := range ft.Results.List {
+			results = append(results, f.Type)
+			for i := 1; i < len(f.Names); i++ {
+				results = append(results, f.Type)
+			}
+		}
+	}
+	switch {
+	case len(results) == 1 && typeString(results[0], sp.name) == "error":
+	case len(results) == 2 && typeString(results[1], sp.name) == "error":
+		m.Result = typeString(results[0], sp.name)
+	default:
+		return method{}, fmt.Errorf("%s.Service.%s doesn't return an error last", sp.name, name)
+	}
+	return m, nil
+}
+
+// addConverter records d if it converts a message to a type of the package,
+// like func FromProto(m *pb.Matrix) Matrix.
+func (sp *servicePackage) addConverter(d *ast.FuncDecl) {
+	ft := d.Type
+	if d.Recv != nil || !strings.HasSuffix(d.Name.Name, "FromProto") || !d.Name.IsExported() ||
+		len(ft.Params.List) != 1 || len(ft.Params.List[0].Names) > 1 || ft.Results == nil || len(ft.Results.List) != 1 {
+		return
+	}
+	in := typeString(ft.Params.List[0].Type, sp.name)
+	if !strings.HasPrefix(in, "*pb.") {
+		return
+	}
+	msg := strings.TrimPrefix(in, "*pb.")
+	sp.converters[msg] = append(sp.converters[msg], converter{
+		name:   sp.name + "." + d.Name.Name,
+		result: typeString(ft.Results.List[0].Type, sp.name),
+	})
+}
+
+// convert returns the function converting msg to typ, if any.
+func (sp *servicePackage) convert(msg, typ string) (string, bool) {
+	for _, c := range sp.converters[msg] {
+		if c.result == typ {
+			return c.name, true
+		}
+	}
+	return "", false
+}
+
+// args returns the arguments of the Service method m taken from its request
+// message in, req. A method taking a single operand converted from the
+// whole request, e.g. a numtheoryservice.Operand from an IntegerRequest,
+// is passed the conversion of req. Otherwise each parameter is taken from
+// the field of the same name, converted if its type differs.
+func (sp *servicePackage) args(m method, in *descriptor.DescriptorProto) (string, error) {
+	if in == nil {
+		return "", fmt.Errorf("%s isn't declared by %s", m.Input, protoFile)
+	}
+	if len(m.Params) == 1 {
+		if conv, ok := sp.convert(in.GetName(), m.Params[0].Type); ok {
+			return conv + "(req)", nil
+		}
+	}
+	fields := make(map[string]*descriptor.FieldDescriptorProto)
+	for _, f := range in.Field {
+		fields[generator.CamelCase(f.GetName())] = f
+	}
+	var args []string
+	for _, p := range m.Params {
+		name := generator.CamelCase(p.Name)
+		f, ok := fields[name]
+		if !ok {
+			return "", fmt.Errorf("%s has no field for %s", in.GetName(), p.Name)
+		}
+		typ, msg := fieldType(f)
+		switch {
+		case typ == p.Type:
+			args = append(args, "req."+name)
+		case msg != "":
+			conv, ok := sp.convert(msg, p.Type)
+			if !ok {
+				return "", fmt.Errorf("%s has no function converting a %s to a %s", sp.name, msg, p.Type)
+			}
+			args = append(args, conv+"(req."+name+")")
+		default:
+			return "", fmt.Errorf("%s.%s is a %s, not a %s", in.GetName(), f.GetName(), typ, p.Type)
+		}
+	}
+	return strings.Join(args, ", "), nil
+}
+
+// fieldType returns the Go type of the field f of a message, and the name of
+// its message type if it has one.
+func fieldType(f *descriptor.FieldDescriptorProto) (typ, msg string) {
+	switch f.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		typ = "float64"
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		typ = "string"
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		msg = f.GetTypeName()[strings.LastIndex(f.GetTypeName(), ".")+1:]
+		typ = "*pb." + msg
+	default:
+		typ = strings.ToLower(strings.TrimPrefix(f.GetType().String(), "TYPE_"))
+	}
+	if f.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		typ = "[]" + typ
+	}
+	return typ, msg
+}
+
+// typeString returns the source of the type expr, qualifying the types
+// declared by the package named pkg with its name.
+func typeString(expr ast.Expr, pkg string) string {
+	expr = qualify(expr, pkg)
+	var buf bytes.Buffer
+	printer.Fprint(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+// qualify returns a copy of expr with the exported identifiers of the
+// package named pkg qualified.
+func qualify(expr ast.Expr, pkg string) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.IsExported() {
+			return &ast.SelectorExpr{X: ast.NewIdent(pkg), Sel: ast.NewIdent(e.Name)}
+		}
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(e.X, pkg)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualify(e.Elt, pkg)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(e.Key, pkg), Value: qualify(e.Value, pkg)}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: qualifyFields(e.Params, pkg), Results: qualifyFields(e.Results, pkg)}
+	}
+	return expr
+}
+
+func qualifyFields(fl *ast.FieldList, pkg string) *ast.FieldList {
+	if fl == nil {
+		return nil
+	}
+	q := &ast.FieldList{}
+	for _, f := range fl.List {
+		q.List = append(q.List, &ast.Field{Names: f.Names, Type: qualify(f.Type, pkg)})
+	}
+	return q
+}
@@ -0,0 +1,354 @@
+package main
+
+const header = `// Code generated by mathsvcgen from mathsvc.proto. DO NOT EDIT.
+
+package {{.Package}}
+`
+
+// serviceTemplate generates the Service interface of pkg/mathservice.
+const serviceTemplate = `
+import "context"
+
+// Service describes a service that adds things together. Implementations may
+// be wrapped by a Middleware, e.g. to log and measure each call.
+type Service interface {
+{{- range .Operations}}
+	{{- range .Doc}}
+	// {{.}}
+	{{- end}}
+	{{.Name}}(ctx context.Context, {{.Params}}) (float64, error)
+{{- end}}
+}
+`
+
+// middlewareTemplate generates the methods of an observabilityMiddleware,
+// which observes each call with its observeMethodExecution,
+// observeListMethodExecution or observeUnaryMethodExecution method.
+const middlewareTemplate = `
+import (
+	"context"
+	"time"
+)
+{{range .Operations}}
+func (mw observabilityMiddleware) {{.Name}}(ctx context.Context, {{.Params}}) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "{{.Name}}"
+		mw.{{.Observe}}(ctx, m, {{.Args}}, v, begin, err)
+	}(time.Now())
+	return mw.next.{{.Name}}(ctx, {{.Args}})
+}
+{{end}}`
+
+// nonFiniteTemplate generates the methods of the nonFiniteMiddleware of
+// pkg/mathservice, which passes each result to its apply method.
+const nonFiniteTemplate = `
+import "context"
+{{range .Operations}}
+func (mw nonFiniteMiddleware) {{.Name}}(ctx context.Context, {{.Params}}) (float64, error) {
+	v, err := mw.next.{{.Name}}(ctx, {{.Args}})
+	return mw.apply(ctx, v, err)
+}
+{{end}}`
+
+// endpointTemplate generates the Operations of a go-kit mathendpoint package.
+const endpointTemplate = `
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+)
+
+// Operations collects the endpoints of the operations of the service, the
+// methods taking a pair of operands, a list of values or a single operand.
+// It's embedded in Set, which adds the endpoints of the other methods.
+type Operations struct {
+{{- range .Operations}}
+	{{.Name}}Endpoint endpoint.Endpoint
+{{- end}}
+}
+
+// NewOperations returns the Operations wrapping the provided service.
+func NewOperations(svc mathservice2.Service) Operations {
+	return Operations{
+{{- range .Operations}}
+		{{.Name}}Endpoint: Make{{.Name}}Endpoint(svc),
+{{- end}}
+	}
+}
+{{range .Operations}}
+// {{.Name}} implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) {{.Name}}(ctx context.Context, {{.Params}}) (float64, error) {
+	resp, err := o.{{.Name}}Endpoint(ctx, {{template "request" .}})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// Make{{.Name}}Endpoint constructs a {{.Name}} endpoint wrapping the service.
+func Make{{.Name}}Endpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.({{.Request}})
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		{{- if .Binary}}
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		{{- end}}
+		v, err := s.{{.Name}}(ctx, {{.ReqArgs}})
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+{{end}}
+// operation returns the endpoint performing item along with its request, or
+// a nil endpoint if item doesn't name one of the Operations.
+func (o Operations) operation(item BatchItem) (endpoint.Endpoint, interface{}) {
+	switch strings.ToLower(item.Op) {
+{{- range .Operations}}
+	case "{{.Lower}}":
+		return o.{{.Name}}Endpoint, {{.Request}}{ {{- .ItemFields}}, Precision: item.Precision{{if .Binary}}, Division: item.Division{{end}}}
+{{- end}}
+	}
+	return nil, nil
+}
+{{define "request"}}{{.Request}}{ {{- .Fields}}, Precision: requestedPrecision(ctx){{if .Binary}}, Division: mathservice2.DivisionFromContext(ctx){{end}}}{{end}}`
+
+// gokitGRPCTemplate generates the gRPC handlers and client endpoints of the
+// Operations of a go-kit mathtransport package.
+const gokitGRPCTemplate = `
+import (
+	"context"
+
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	mathendpoint2 "{{.Endpoints}}"
+	"github.com/jwenz723/mathserver/pb"
+	"google.golang.org/grpc"
+)
+
+// grpcOperations implements the operations of pb.MathServer, it's embedded in
+// grpcServer which implements the other methods.
+type grpcOperations struct {
+{{- range .Operations}}
+	{{.Field}} grpctransport.Handler
+{{- end}}
+}
+
+// newGRPCOperations makes the Operations of endpoints available over gRPC,
+// encoding their responses with encodeResponse.
+func newGRPCOperations(endpoints mathendpoint2.Operations, encodeResponse grpctransport.EncodeResponseFunc, options []grpctransport.ServerOption) grpcOperations {
+	return grpcOperations{
+{{- range .Operations}}
+		{{.Field}}: grpctransport.NewServer(
+			endpoints.{{.Name}}Endpoint,
+			decodeGRPC{{.Request}},
+			encodeResponse,
+			options...,
+		),
+{{- end}}
+	}
+}
+{{range .Operations}}
+func (s grpcOperations) {{.Name}}(ctx context.Context, req *pb.{{.Request}}) (*pb.MathOpReply, error) {
+	_, rep, err := s.{{.Field}}.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.MathOpReply), nil
+}
+{{end}}
+// newGRPCClientOperations returns the Operations calling the gRPC server at
+// the other end of conn.
+func newGRPCClientOperations(conn *grpc.ClientConn) mathendpoint2.Operations {
+	var o mathendpoint2.Operations
+{{- range .Operations}}
+	o.{{.Name}}Endpoint = decodeGRPCStatusMiddleware(grpctransport.NewClient(
+		conn,
+		"{{$.Name}}",
+		"{{.Name}}",
+		encodeGRPC{{.Request}},
+		decodeGRPCMathOpResponse,
+		pb.MathOpReply{},
+	).Endpoint())
+{{- end}}
+	return o
+}
+`
+
+// gokitHTTPTemplate generates the HTTP handlers and client endpoints of the
+// Operations of a go-kit mathtransport package.
+const gokitHTTPTemplate = `
+import (
+	"net/http"
+	"net/url"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+	mathendpoint2 "{{.Endpoints}}"
+)
+
+// handleOperations serves each of the Operations of endpoints on m, at its
+// lower-cased name, e.g. /divide.
+func handleOperations(m *http.ServeMux, endpoints mathendpoint2.Operations, options []httptransport.ServerOption) {
+{{- range .Operations}}
+	m.Handle("/{{.Lower}}", httptransport.NewServer(
+		endpoints.{{.Name}}Endpoint,
+		decodeHTTP{{.Request}},
+		encodeHTTPGenericResponse,
+		options...,
+	))
+{{- end}}
+}
+
+// newHTTPClientOperations returns the Operations calling the HTTP server at
+// base.
+func newHTTPClientOperations(base *url.URL) mathendpoint2.Operations {
+	var o mathendpoint2.Operations
+{{- range .Operations}}
+	o.{{.Name}}Endpoint = httptransport.NewClient(
+		"POST",
+		copyURL(base, "/{{.Lower}}"),
+		encodeHTTPGenericRequest,
+		decodeHTTPMathOpResponse,
+	).Endpoint()
+{{- end}}
+	return o
+}
+`
+
+// grpcTemplate generates the operations of the grpcServer of a server package
+// that calls the service directly, replying with its reply method.
+const grpcTemplate = `
+import (
+	"context"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+)
+{{range .Operations}}
+{{- range .Doc}}
+// {{.}}
+{{- end}}
+func (s *grpcServer) {{.Name}}(ctx context.Context, req *pb.{{.Request}}) (*pb.MathOpReply, error) {
+	ctx, res := precision.NewContext(ctx, precision.FromProto(req.Precision))
+	{{- if .Binary}}
+	ctx = mathservice.NewDivisionContext(ctx, mathservice.DivisionFromProto(req.Division))
+	{{- end}}
+	v, err := s.svc.{{.Name}}(ctx, {{.ReqArgs}})
+	return s.reply(v, res, err)
+}
+{{end}}`
+
+// httpTemplate generates the routes of the operations of the httpServer of a
+// server package, which are served by its mathOpHandlerFunc,
+// mathListHandlerFunc and unaryOpHandlerFunc methods.
+const httpTemplate = `
+// operationRoutes serves each of the operations of the service at its
+// lower-cased name, e.g. /divide.
+func (s *httpServer) operationRoutes() {
+{{- range .Operations}}
+	s.router.Methods("POST").Path("/{{.Lower}}").HandlerFunc(s.{{.Handler}}(s.svc.{{.Name}}))
+{{- end}}
+}
+`
+
+// computeTemplate generates the operations performed by package compute.
+const computeTemplate = `
+import (
+	"github.com/jwenz723/mathserver/pb"
+	"google.golang.org/grpc"
+)
+
+// mathOperation is operation for the methods of srv taking a MathOpRequest, a
+// MathListRequest or a UnaryOpRequest.
+func mathOperation(srv pb.MathServer, r *pb.ComputeRequest) (string, interface{}, grpc.UnaryHandler) {
+	switch r.Op {
+{{- range .Operations}}
+	case pb.ComputeRequest_{{.Enum}}:
+		return {{.Kind}}(r, "{{.Name}}", srv.{{.Name}})
+{{- end}}
+	}
+	return "", nil, nil
+}
+`
+
+// serviceMiddlewareTemplate generates the methods of the observability
+// middleware of a service other than Math, which observes each call with its
+// observeMethodExecution method, passing the operands and result of the call
+// as keyvals.
+const serviceMiddlewareTemplate = `
+import (
+	"context"
+	"time"
+{{- if .SignatureImports}}
+{{range .SignatureImports}}
+	"{{.}}"
+{{- end}}
+{{- end}}
+)
+{{range .Methods}}
+func (mw {{$.Type}}) {{.Name}}(ctx context.Context, {{.Signature}}) {
+	{{- range .Counters}}
+	var {{.}} int
+	{{- end}}
+	defer func(begin time.Time) {
+		mw.observeMethodExecution(ctx, "{{$.Name}}.{{.Name}}", begin, err, {{.KeyVals}})
+	}(time.Now())
+	return mw.next.{{.Name}}(ctx, {{.NextArgs}})
+}
+{{end}}`
+
+// serviceGRPCTemplate generates the unary methods of the gRPC server of a
+// service other than Math that calls the service directly, replying with the
+// method named after the reply message, e.g. vectorReply.
+const serviceGRPCTemplate = `
+import (
+	"context"
+
+	"github.com/jwenz723/mathserver/pb"
+	{{- if .UsesPackage}}
+	"{{.Import}}"
+	{{- end}}
+)
+{{range .Unary}}
+{{- range .Doc}}
+// {{.}}
+{{- end}}
+func (s *{{$.Type}}) {{.Name}}(ctx context.Context, req *pb.{{.Input}}) (*pb.{{.Output}}, error) {
+	{{- if .Result}}
+	v, err := s.svc.{{.Name}}(ctx, {{.Args}})
+	return s.{{.Reply}}(v, err)
+	{{- else}}
+	err := s.svc.{{.Name}}(ctx, {{.Args}})
+	return s.{{.Reply}}(err)
+	{{- end}}
+}
+{{end}}`
+
+// serviceGokitGRPCTemplate generates the gRPC server of a service other than
+// Math that serves each method with a go-kit handler. Its handlers are set by
+// a constructor written by hand, as are its streaming methods.
+const serviceGokitGRPCTemplate = `
+import (
+	"context"
+
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/jwenz723/mathserver/pb"
+)
+
+// {{.Type}} implements pb.{{.Name}}Server with a handler for each method.
+type {{.Type}} struct {
+{{- range .Methods}}
+	{{.Field}} grpctransport.Handler
+{{- end}}
+}
+{{range .Unary}}
+func (s *{{$.Type}}) {{.Name}}(ctx context.Context, req *pb.{{.Input}}) (*pb.{{.Output}}, error) {
+	_, rep, err := s.{{.Field}}.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.{{.Output}}), nil
+}
+{{end}}`
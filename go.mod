@@ -15,6 +15,6 @@ require (
 	go.uber.org/atomic v1.4.0 // indirect
 	go.uber.org/multierr v1.1.0 // indirect
 	go.uber.org/zap v1.10.0
-	google.golang.org/genproto v0.0.0-20190530194941-fb225487d101 // indirect
+	google.golang.org/genproto v0.0.0-20190530194941-fb225487d101
 	google.golang.org/grpc v1.23.0
 )
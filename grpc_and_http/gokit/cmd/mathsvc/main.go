@@ -6,10 +6,15 @@ import (
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/metrics/prometheus"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
-	mathendpoint2 "github.com/jwenz723/mathserver/grpc_and_http/gokit/pkg/mathendpoint"
-	mathservice2 "github.com/jwenz723/mathserver/grpc_and_http/gokit/pkg/mathservice"
 	mathtransport2 "github.com/jwenz723/mathserver/grpc_and_http/gokit/pkg/mathtransport"
 	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/gokit/mathservice"
+	mathservice3 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/statsservice"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
 	"github.com/oklog/oklog/pkg/group"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -28,9 +33,23 @@ func main() {
 		debugAddr      = fs.String("debug.addr", ":8080", "Debug and metrics listen address")
 		httpAddr       = fs.String("http-addr", ":8081", "HTTP listen address")
 		grpcAddr       = fs.String("grpc-addr", ":8082", "gRPC listen address")
+		statusErrors   = fs.Bool("grpc-status-errors", false, "Report service errors as gRPC status codes instead of in the reply")
+		unitsFile      = fs.String("units", "", "File defining units besides the built-in ones, one per line such as: kn = 1 nmi/h")
+		maxDigits      = fs.Int("max-digits", combinatoricsservice.DefaultMaxDigits, "Most decimal digits of the exact results of the Combinatorics service")
+		statsMaxValues = fs.Int("stats-max-values", statsservice.DefaultMaxValues, "Most values of each series a stream of the Statistics service may carry")
 	)
+	defaultPrecision := precision.Precision{Mode: precision.Float64}
+	fs.Var(&defaultPrecision.Mode, "precision", "Default precision mode: float64, bigfloat or rational")
+	fs.UintVar(&defaultPrecision.Bits, "precision-bits", precision.DefaultBits, "Default mantissa bits used by the bigfloat precision mode")
+	var nonFinite mathservice3.NonFinitePolicy
+	fs.Var(&nonFinite, "non-finite", "Policy for NaN and infinite results of the Math service: pass, reject or string")
 	fs.Usage = usageFor(fs, os.Args[0]+" [flags]")
 	fs.Parse(os.Args[1:])
+	if defaultPrecision.Bits > precision.MaxBits {
+		fmt.Fprintf(os.Stderr, "invalid value %d for flag -precision-bits: at most %d bits are supported\n", defaultPrecision.Bits, precision.MaxBits)
+		fs.Usage()
+		os.Exit(2)
+	}
 
 	var logger log.Logger
 	{
@@ -46,12 +65,65 @@ func main() {
 		Help:      "Request duration in seconds.",
 	}, []string{"method", "success"})
 
+	units := unitservice.NewRegistry()
+	if *unitsFile != "" {
+		if err := units.LoadFile(*unitsFile); err != nil {
+			logger.Log("during", "LoadUnits", "err", err)
+			os.Exit(1)
+		}
+	}
+
 	var (
-		service        = mathservice2.New(duration, logger)
-		endpoints      = mathendpoint2.New(service, logger)
-		httpHandler    = mathtransport2.NewHTTPHandler(endpoints, logger)
-		grpcServer     = mathtransport2.NewGRPCServer(endpoints, logger)
+		service     = mathservice2.New(duration, logger, defaultPrecision, nonFinite)
+		endpoints   = mathendpoint2.New(service, logger)
+		httpHandler = http.NewServeMux()
+		grpcServer  = mathtransport2.NewGRPCServer(endpoints, logger, *statusErrors)
+
+		complexEndpoints = mathendpoint2.NewComplex(mathservice2.NewComplex(duration, logger))
+		complexServer    = mathtransport2.NewComplexGRPCServer(complexEndpoints, logger, *statusErrors)
+
+		linalgEndpoints = mathendpoint2.NewLinearAlgebra(mathservice2.NewLinearAlgebra(duration, logger))
+		linalgServer    = mathtransport2.NewLinearAlgebraGRPCServer(linalgEndpoints, logger, *statusErrors)
+
+		statsEndpoints = mathendpoint2.NewStatistics(mathservice2.NewStatistics(duration, logger))
+		statsServer    = mathtransport2.NewStatisticsGRPCServer(statsEndpoints, logger, *statusErrors, *statsMaxValues)
+
+		polyEndpoints = mathendpoint2.NewPolynomial(mathservice2.NewPolynomial(duration, logger))
+		polyServer    = mathtransport2.NewPolynomialGRPCServer(polyEndpoints, logger, *statusErrors)
+
+		unitsEndpoints = mathendpoint2.NewUnits(mathservice2.NewUnits(duration, logger, units))
+		unitsServer    = mathtransport2.NewUnitsGRPCServer(unitsEndpoints, logger, *statusErrors)
+
+		financeEndpoints = mathendpoint2.NewFinance(mathservice2.NewFinance(duration, logger))
+		financeServer    = mathtransport2.NewFinanceGRPCServer(financeEndpoints, logger, *statusErrors)
+
+		ntEndpoints = mathendpoint2.NewNumberTheory(mathservice2.NewNumberTheory(duration, logger))
+		ntServer    = mathtransport2.NewNumberTheoryGRPCServer(ntEndpoints, logger, *statusErrors)
+
+		combEndpoints = mathendpoint2.NewCombinatorics(mathservice2.NewCombinatorics(duration, logger, *maxDigits))
+		combServer    = mathtransport2.NewCombinatoricsGRPCServer(combEndpoints, logger, *statusErrors)
+
+		calcEndpoints = mathendpoint2.NewCalculus(mathservice2.NewCalculus(duration, logger))
+		calcServer    = mathtransport2.NewCalculusGRPCServer(calcEndpoints, logger, *statusErrors)
+
+		symEndpoints = mathendpoint2.NewSymbolic(mathservice2.NewSymbolic(duration, logger))
+		symServer    = mathtransport2.NewSymbolicGRPCServer(symEndpoints, logger, *statusErrors)
 	)
+	// The Complex, LinearAlgebra, Polynomial, Units, Finance, NumberTheory,
+	// Combinatorics, Calculus and Symbolic services are served under
+	// /complex/, /linearalgebra/, /polynomial/, /units/, /finance/,
+	// /numbertheory/, /combinatorics/, /calculus/ and /symbolic/ next to the
+	// Math service.
+	httpHandler.Handle("/complex/", mathtransport2.NewComplexHTTPHandler(complexEndpoints, logger))
+	httpHandler.Handle("/linearalgebra/", mathtransport2.NewLinearAlgebraHTTPHandler(linalgEndpoints, logger))
+	httpHandler.Handle("/polynomial/", mathtransport2.NewPolynomialHTTPHandler(polyEndpoints, logger))
+	httpHandler.Handle("/units/", mathtransport2.NewUnitsHTTPHandler(unitsEndpoints, logger))
+	httpHandler.Handle("/finance/", mathtransport2.NewFinanceHTTPHandler(financeEndpoints, logger))
+	httpHandler.Handle("/numbertheory/", mathtransport2.NewNumberTheoryHTTPHandler(ntEndpoints, logger))
+	httpHandler.Handle("/combinatorics/", mathtransport2.NewCombinatoricsHTTPHandler(combEndpoints, logger))
+	httpHandler.Handle("/calculus/", mathtransport2.NewCalculusHTTPHandler(calcEndpoints, logger))
+	httpHandler.Handle("/symbolic/", mathtransport2.NewSymbolicHTTPHandler(symEndpoints, logger))
+	httpHandler.Handle("/", mathtransport2.NewHTTPHandler(endpoints, logger))
 
 	var g group.Group
 	{
@@ -95,6 +167,16 @@ func main() {
 			// the here demonstrated zipkin tracing middleware.
 			baseServer := grpc.NewServer(grpc.UnaryInterceptor(kitgrpc.Interceptor))
 			pb.RegisterMathServer(baseServer, grpcServer)
+			pb.RegisterComplexServer(baseServer, complexServer)
+			pb.RegisterLinearAlgebraServer(baseServer, linalgServer)
+			pb.RegisterPolynomialServer(baseServer, polyServer)
+			pb.RegisterStatisticsServer(baseServer, statsServer)
+			pb.RegisterUnitsServer(baseServer, unitsServer)
+			pb.RegisterFinanceServer(baseServer, financeServer)
+			pb.RegisterNumberTheoryServer(baseServer, ntServer)
+			pb.RegisterCombinatoricsServer(baseServer, combServer)
+			pb.RegisterCalculusServer(baseServer, calcServer)
+			pb.RegisterSymbolicServer(baseServer, symServer)
 			return baseServer.Serve(grpcListener)
 		}, func(error) {
 			grpcListener.Close()
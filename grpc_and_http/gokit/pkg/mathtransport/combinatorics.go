@@ -0,0 +1,191 @@
+package mathtransport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service Combinatorics -type combinatoricsGRPCServer -o combinatorics_gen.go
+
+// NewCombinatoricsGRPCServer makes a set of endpoints available as a gRPC
+// CombinatoricsServer, reporting errors like NewGRPCServer does.
+func NewCombinatoricsGRPCServer(endpoints mathendpoint2.CombinatoricsSet, logger log.Logger, statusErrors bool) pb.CombinatoricsServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeResponse := encodeGRPCCombinatoricsResponse
+	if statusErrors {
+		encodeResponse = encodeGRPCCombinatoricsStatusResponse
+	}
+
+	return &combinatoricsGRPCServer{
+		factorial:    grpctransport.NewServer(endpoints.FactorialEndpoint, decodeGRPCCombinatoricsRequest, encodeResponse, options...),
+		binomial:     grpctransport.NewServer(endpoints.BinomialEndpoint, decodeGRPCCombinatoricsRequest, encodeResponse, options...),
+		permutations: grpctransport.NewServer(endpoints.PermutationsEndpoint, decodeGRPCCombinatoricsRequest, encodeResponse, options...),
+	}
+}
+
+// NewCombinatoricsGRPCClient returns a combinatoricsservice.Service backed by
+// a gRPC server at the other end of the conn, see NewGRPCClient.
+func NewCombinatoricsGRPCClient(conn *grpc.ClientConn, logger log.Logger) combinatoricsservice.Service {
+	client := func(method string) endpoint.Endpoint {
+		return decodeGRPCStatusAs(grpctransport.NewClient(
+			conn,
+			"pb.Combinatorics",
+			method,
+			encodeGRPCCombinatoricsRequest,
+			decodeGRPCCombinatoricsResponse,
+			pb.IntegerReply{},
+		).Endpoint(), func(err error) interface{} {
+			return mathendpoint2.CombinatoricsResponse{Err: err}
+		})
+	}
+
+	return mathendpoint2.CombinatoricsSet{
+		FactorialEndpoint:    client("Factorial"),
+		BinomialEndpoint:     client("Binomial"),
+		PermutationsEndpoint: client("Permutations"),
+	}
+}
+
+// decodeGRPCCombinatoricsRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC Combinatorics request to user-domain Operands. Primarily useful in a server.
+func decodeGRPCCombinatoricsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return combinatoricsservice.OperandsFromProto(grpcReq.(*pb.CombinatoricsRequest)), nil
+}
+
+// encodeGRPCCombinatoricsRequest is a transport/grpc.EncodeRequestFunc that converts
+// user-domain Operands to a gRPC Combinatorics request. Primarily useful in a client.
+func encodeGRPCCombinatoricsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return request.(combinatoricsservice.Operands).Proto(), nil
+}
+
+// encodeGRPCCombinatoricsResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Combinatorics response to a gRPC Integer reply. Primarily useful in a server.
+func encodeGRPCCombinatoricsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.CombinatoricsResponse)
+	return &pb.IntegerReply{V: resp.V, Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCCombinatoricsStatusResponse is encodeGRPCMathOpStatusResponse for
+// the Combinatorics service. Primarily useful in a server.
+func encodeGRPCCombinatoricsStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.CombinatoricsResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCCombinatoricsResponse(ctx, response)
+}
+
+// decodeGRPCCombinatoricsResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Integer reply to a user-domain Combinatorics response. Primarily useful in a client.
+func decodeGRPCCombinatoricsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.IntegerReply)
+	return mathendpoint2.CombinatoricsResponse{V: reply.V, Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
+
+// NewCombinatoricsHTTPHandler returns an HTTP handler that makes a set of
+// endpoints available on the lower-cased names of the methods under
+// /combinatorics/, e.g. /combinatorics/binomial. The requests are the JSON
+// encodings of combinatoricsservice.Operands.
+func NewCombinatoricsHTTPHandler(endpoints mathendpoint2.CombinatoricsSet, logger log.Logger) http.Handler {
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(errorEncoder),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	}
+	handle := func(m *http.ServeMux, method string, e endpoint.Endpoint) {
+		m.Handle("/combinatorics/"+method, httptransport.NewServer(
+			e,
+			decodeHTTPCombinatoricsRequest,
+			encodeHTTPCombinatoricsResponse,
+			options...,
+		))
+	}
+
+	m := http.NewServeMux()
+	handle(m, "factorial", endpoints.FactorialEndpoint)
+	handle(m, "binomial", endpoints.BinomialEndpoint)
+	handle(m, "permutations", endpoints.PermutationsEndpoint)
+	return m
+}
+
+// NewCombinatoricsHTTPClient returns a combinatoricsservice.Service backed by
+// an HTTP server living at the remote instance, see NewHTTPClient.
+func NewCombinatoricsHTTPClient(instance string, logger log.Logger) (combinatoricsservice.Service, error) {
+	if !strings.HasPrefix(instance, "http") {
+		instance = "http://" + instance
+	}
+	u, err := url.Parse(instance)
+	if err != nil {
+		return nil, err
+	}
+	client := func(method string) endpoint.Endpoint {
+		return httptransport.NewClient(
+			"POST",
+			copyURL(u, "/combinatorics/"+method),
+			encodeHTTPGenericRequest,
+			decodeHTTPCombinatoricsResponse,
+		).Endpoint()
+	}
+
+	return mathendpoint2.CombinatoricsSet{
+		FactorialEndpoint:    client("factorial"),
+		BinomialEndpoint:     client("binomial"),
+		PermutationsEndpoint: client("permutations"),
+	}, nil
+}
+
+// combinatoricsResponse is the JSON encoding of a
+// mathendpoint.CombinatoricsResponse, e.g. {"v":"120"}.
+type combinatoricsResponse struct {
+	V string `json:"v"`
+}
+
+// decodeHTTPCombinatoricsRequest is a transport/http.DecodeRequestFunc that decodes
+// JSON-encoded Operands from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPCombinatoricsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req combinatoricsservice.Operands
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return req, nil
+}
+
+// encodeHTTPCombinatoricsResponse is a transport/http.EncodeResponseFunc that
+// encodes the response of a method of the Combinatorics service as JSON to
+// the response writer. Primarily useful in a server.
+func encodeHTTPCombinatoricsResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if resp := response.(mathendpoint2.CombinatoricsResponse); resp.Err == nil {
+		response = combinatoricsResponse{V: resp.V}
+	}
+	return encodeHTTPGenericResponse(ctx, w, response)
+}
+
+// decodeHTTPCombinatoricsResponse is a transport/http.DecodeResponseFunc that
+// decodes a JSON-encoded Combinatorics response from the HTTP response body,
+// see decodeHTTPMathOpResponse. Primarily useful in a client.
+func decodeHTTPCombinatoricsResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		return nil, errorDecoder(r)
+	}
+	var resp combinatoricsResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return mathendpoint2.CombinatoricsResponse{V: resp.V}, err
+}
@@ -0,0 +1,259 @@
+package mathtransport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/complexservice"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service Complex -type complexGRPCServer -o complex_gen.go
+
+// NewComplexGRPCServer makes a set of endpoints available as a gRPC
+// ComplexServer, reporting errors like NewGRPCServer does.
+func NewComplexGRPCServer(endpoints mathendpoint2.ComplexSet, logger log.Logger, statusErrors bool) pb.ComplexServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeComplexOpResponse, encodeMathOpResponse := encodeGRPCComplexOpResponse, encodeGRPCMathOpResponse
+	if statusErrors {
+		encodeComplexOpResponse, encodeMathOpResponse = encodeGRPCComplexOpStatusResponse, encodeGRPCMathOpStatusResponse
+	}
+	handler := func(e endpoint.Endpoint, encodeResponse grpctransport.EncodeResponseFunc) grpctransport.Handler {
+		return grpctransport.NewServer(e, decodeGRPCComplexOpRequest, encodeResponse, options...)
+	}
+
+	return &complexGRPCServer{
+		sum:      handler(endpoints.SumEndpoint, encodeComplexOpResponse),
+		subtract: handler(endpoints.SubtractEndpoint, encodeComplexOpResponse),
+		multiply: handler(endpoints.MultiplyEndpoint, encodeComplexOpResponse),
+		divide:   handler(endpoints.DivideEndpoint, encodeComplexOpResponse),
+		pow:      handler(endpoints.PowEndpoint, encodeComplexOpResponse),
+		abs:      handler(endpoints.AbsEndpoint, encodeMathOpResponse),
+		phase:    handler(endpoints.PhaseEndpoint, encodeMathOpResponse),
+		conj:     handler(endpoints.ConjEndpoint, encodeComplexOpResponse),
+		sqrt:     handler(endpoints.SqrtEndpoint, encodeComplexOpResponse),
+	}
+}
+
+// NewComplexGRPCClient returns a complexservice.Service backed by a gRPC
+// server at the other end of the conn, see NewGRPCClient.
+func NewComplexGRPCClient(conn *grpc.ClientConn, logger log.Logger) complexservice.Service {
+	client := func(method string, decodeResponse grpctransport.DecodeResponseFunc, reply interface{}) endpoint.Endpoint {
+		return grpctransport.NewClient(
+			conn,
+			"pb.Complex",
+			method,
+			encodeGRPCComplexOpRequest,
+			decodeResponse,
+			reply,
+		).Endpoint()
+	}
+	complexOp := func(method string) endpoint.Endpoint {
+		return decodeComplexGRPCStatusMiddleware(client(method, decodeGRPCComplexOpResponse, pb.ComplexOpReply{}))
+	}
+	realOp := func(method string) endpoint.Endpoint {
+		return decodeGRPCStatusMiddleware(client(method, decodeGRPCMathOpResponse, pb.MathOpReply{}))
+	}
+
+	return mathendpoint2.ComplexSet{
+		SumEndpoint:      complexOp("Sum"),
+		SubtractEndpoint: complexOp("Subtract"),
+		MultiplyEndpoint: complexOp("Multiply"),
+		DivideEndpoint:   complexOp("Divide"),
+		PowEndpoint:      complexOp("Pow"),
+		AbsEndpoint:      realOp("Abs"),
+		PhaseEndpoint:    realOp("Phase"),
+		ConjEndpoint:     complexOp("Conj"),
+		SqrtEndpoint:     complexOp("Sqrt"),
+	}
+}
+
+// decodeGRPCComplexOpRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC ComplexOp request to a user-domain ComplexOp request. Primarily useful in a server.
+func decodeGRPCComplexOpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ComplexOpRequest)
+	return mathendpoint2.ComplexOpRequest{A: complexservice.FromProto(req.A), B: complexservice.FromProto(req.B)}, nil
+}
+
+// encodeGRPCComplexOpRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain ComplexOp request to a gRPC ComplexOp request. Primarily useful in a client.
+func encodeGRPCComplexOpRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.ComplexOpRequest)
+	return &pb.ComplexOpRequest{A: complexservice.Proto(req.A), B: complexservice.Proto(req.B)}, nil
+}
+
+// encodeGRPCComplexOpResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain ComplexOp response to a gRPC ComplexOp reply. Primarily useful in a server.
+func encodeGRPCComplexOpResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.ComplexOpResponse)
+	return &pb.ComplexOpReply{V: complexservice.Proto(resp.V), Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCComplexOpStatusResponse is encodeGRPCMathOpStatusResponse for the
+// methods returning a ComplexOpReply. Primarily useful in a server.
+func encodeGRPCComplexOpStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.ComplexOpResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCComplexOpResponse(ctx, response)
+}
+
+// decodeGRPCComplexOpResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC ComplexOp reply to a user-domain ComplexOp response. Primarily useful in a client.
+func decodeGRPCComplexOpResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.ComplexOpReply)
+	return mathendpoint2.ComplexOpResponse{V: complexservice.FromProto(reply.V), Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
+
+// decodeComplexGRPCStatusMiddleware is decodeGRPCStatusMiddleware for the
+// methods returning a ComplexOpReply. Primarily useful in a client.
+func decodeComplexGRPCStatusMiddleware(next endpoint.Endpoint) endpoint.Endpoint {
+	return decodeGRPCStatusAs(next, func(err error) interface{} {
+		return mathendpoint2.ComplexOpResponse{Err: err}
+	})
+}
+
+// decodeGRPCStatusAs is decodeGRPCStatusMiddleware for the methods whose
+// response carrying err is returned by failed. Primarily useful in a client.
+func decodeGRPCStatusAs(next endpoint.Endpoint, failed func(err error) interface{}) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		response, err := next(ctx, request)
+		if code, msg, ok := rpcstatus.Parse(err); ok {
+			return failed(rpcstatus.Err(code, msg)), nil
+		}
+		return response, err
+	}
+}
+
+// NewComplexHTTPHandler returns an HTTP handler that makes a set of endpoints
+// available on the lower-cased names of the methods under /complex/, e.g.
+// /complex/divide. It's meant to be mounted next to the handler returned by
+// NewHTTPHandler.
+func NewComplexHTTPHandler(endpoints mathendpoint2.ComplexSet, logger log.Logger) http.Handler {
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(errorEncoder),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	}
+	handle := func(m *http.ServeMux, method string, e endpoint.Endpoint) {
+		m.Handle("/complex/"+method, httptransport.NewServer(
+			e,
+			decodeHTTPComplexOpRequest,
+			encodeHTTPComplexResponse,
+			options...,
+		))
+	}
+
+	m := http.NewServeMux()
+	handle(m, "sum", endpoints.SumEndpoint)
+	handle(m, "subtract", endpoints.SubtractEndpoint)
+	handle(m, "multiply", endpoints.MultiplyEndpoint)
+	handle(m, "divide", endpoints.DivideEndpoint)
+	handle(m, "pow", endpoints.PowEndpoint)
+	handle(m, "abs", endpoints.AbsEndpoint)
+	handle(m, "phase", endpoints.PhaseEndpoint)
+	handle(m, "conj", endpoints.ConjEndpoint)
+	handle(m, "sqrt", endpoints.SqrtEndpoint)
+	return m
+}
+
+// NewComplexHTTPClient returns a complexservice.Service backed by an HTTP
+// server living at the remote instance, see NewHTTPClient.
+func NewComplexHTTPClient(instance string, logger log.Logger) (complexservice.Service, error) {
+	if !strings.HasPrefix(instance, "http") {
+		instance = "http://" + instance
+	}
+	u, err := url.Parse(instance)
+	if err != nil {
+		return nil, err
+	}
+	client := func(method string, decodeResponse httptransport.DecodeResponseFunc) endpoint.Endpoint {
+		return httptransport.NewClient(
+			"POST",
+			copyURL(u, "/complex/"+method),
+			encodeHTTPComplexOpRequest,
+			decodeResponse,
+		).Endpoint()
+	}
+
+	return mathendpoint2.ComplexSet{
+		SumEndpoint:      client("sum", decodeHTTPComplexOpResponse),
+		SubtractEndpoint: client("subtract", decodeHTTPComplexOpResponse),
+		MultiplyEndpoint: client("multiply", decodeHTTPComplexOpResponse),
+		DivideEndpoint:   client("divide", decodeHTTPComplexOpResponse),
+		PowEndpoint:      client("pow", decodeHTTPComplexOpResponse),
+		AbsEndpoint:      client("abs", decodeHTTPMathOpResponse),
+		PhaseEndpoint:    client("phase", decodeHTTPMathOpResponse),
+		ConjEndpoint:     client("conj", decodeHTTPComplexOpResponse),
+		SqrtEndpoint:     client("sqrt", decodeHTTPComplexOpResponse),
+	}, nil
+}
+
+// complexOpRequest is the JSON encoding of a mathendpoint.ComplexOpRequest,
+// e.g. {"a":{"real":1,"imag":2},"b":{"real":0,"imag":1}}.
+type complexOpRequest struct {
+	A jsonfloat.Complex128 `json:"a"`
+	B jsonfloat.Complex128 `json:"b"`
+}
+
+// complexOpResponse is the JSON encoding of a mathendpoint.ComplexOpResponse.
+type complexOpResponse struct {
+	V jsonfloat.Complex128 `json:"v"`
+}
+
+// decodeHTTPComplexOpRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded ComplexOp request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPComplexOpRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req complexOpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return mathendpoint2.ComplexOpRequest{A: complex128(req.A), B: complex128(req.B)}, nil
+}
+
+// encodeHTTPComplexOpRequest is a transport/http.EncodeRequestFunc that
+// JSON-encodes a ComplexOp request to the request body. Primarily useful in a
+// client.
+func encodeHTTPComplexOpRequest(ctx context.Context, r *http.Request, request interface{}) error {
+	req := request.(mathendpoint2.ComplexOpRequest)
+	return encodeHTTPGenericRequest(ctx, r, complexOpRequest{A: jsonfloat.Complex128(req.A), B: jsonfloat.Complex128(req.B)})
+}
+
+// encodeHTTPComplexResponse is a transport/http.EncodeResponseFunc that
+// encodes the response of a method of the Complex service as JSON to the
+// response writer. Primarily useful in a server.
+func encodeHTTPComplexResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if resp, ok := response.(mathendpoint2.ComplexOpResponse); ok && resp.Err == nil {
+		response = complexOpResponse{V: jsonfloat.Complex128(resp.V)}
+	}
+	return encodeHTTPGenericResponse(ctx, w, response)
+}
+
+// decodeHTTPComplexOpResponse is a transport/http.DecodeResponseFunc that
+// decodes a JSON-encoded ComplexOp response from the HTTP response body, see
+// decodeHTTPMathOpResponse. Primarily useful in a client.
+func decodeHTTPComplexOpResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		return nil, errorDecoder(r)
+	}
+	var resp complexOpResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return mathendpoint2.ComplexOpResponse{V: complex128(resp.V)}, err
+}
@@ -0,0 +1,371 @@
+package mathtransport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/financeservice"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service Finance -type financeGRPCServer -o finance_gen.go
+
+// NewFinanceGRPCServer makes a set of endpoints available as a gRPC
+// FinanceServer, reporting errors like NewGRPCServer does. go-kit has no
+// streaming transport, so the stream of an amortization schedule is passed
+// to the endpoint along with the request, to send each row as it's computed.
+func NewFinanceGRPCServer(endpoints mathendpoint2.FinanceSet, logger log.Logger, statusErrors bool) pb.FinanceServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeDecimal, encodeAmortization := encodeGRPCDecimalResponse, encodeGRPCAmortizationResponse
+	if statusErrors {
+		encodeDecimal, encodeAmortization = encodeGRPCDecimalStatusResponse, encodeGRPCAmortizationStatusResponse
+	}
+	handler := func(e endpoint.Endpoint, decodeRequest grpctransport.DecodeRequestFunc) grpctransport.Handler {
+		return grpctransport.NewServer(e, decodeRequest, encodeDecimal, options...)
+	}
+
+	return &financeGRPCServer{
+		npv:              handler(endpoints.NPVEndpoint, decodeGRPCCashFlowRequest),
+		irr:              handler(endpoints.IRREndpoint, decodeGRPCCashFlowRequest),
+		pmt:              handler(endpoints.PMTEndpoint, decodeGRPCTimeValueRequest),
+		fv:               handler(endpoints.FVEndpoint, decodeGRPCTimeValueRequest),
+		pv:               handler(endpoints.PVEndpoint, decodeGRPCTimeValueRequest),
+		compoundInterest: handler(endpoints.CompoundInterestEndpoint, decodeGRPCCompoundInterestRequest),
+		amortizationSchedule: grpctransport.NewServer(
+			endpoints.AmortizationScheduleEndpoint,
+			decodeGRPCAmortizationRequest,
+			encodeAmortization,
+			options...,
+		),
+	}
+}
+
+func (s *financeGRPCServer) AmortizationSchedule(req *pb.AmortizationRequest, stream pb.Finance_AmortizationScheduleServer) error {
+	_, rep, err := s.amortizationSchedule.ServeGRPC(stream.Context(), amortizationStream{req, stream})
+	if err != nil {
+		return err
+	}
+	if row := rep.(*pb.AmortizationRow); row != nil {
+		return stream.Send(row)
+	}
+	return nil
+}
+
+// amortizationStream is what the AmortizationSchedule handler serves: the
+// request and the stream to send its rows to.
+type amortizationStream struct {
+	req    *pb.AmortizationRequest
+	stream pb.Finance_AmortizationScheduleServer
+}
+
+// NewFinanceGRPCClient returns a financeservice.Service backed by a gRPC
+// server at the other end of the conn, see NewGRPCClient.
+func NewFinanceGRPCClient(conn *grpc.ClientConn, logger log.Logger) financeservice.Service {
+	client := func(method string, encodeRequest grpctransport.EncodeRequestFunc) endpoint.Endpoint {
+		return decodeGRPCStatusAs(grpctransport.NewClient(
+			conn,
+			"pb.Finance",
+			method,
+			encodeRequest,
+			decodeGRPCDecimalResponse,
+			pb.DecimalReply{},
+		).Endpoint(), func(err error) interface{} {
+			return mathendpoint2.DecimalResponse{Err: err}
+		})
+	}
+	finance := pb.NewFinanceClient(conn)
+
+	return mathendpoint2.FinanceSet{
+		NPVEndpoint:              client("NPV", encodeGRPCCashFlowRequest),
+		IRREndpoint:              client("IRR", encodeGRPCCashFlowRequest),
+		PMTEndpoint:              client("PMT", encodeGRPCTimeValueRequest),
+		FVEndpoint:               client("FV", encodeGRPCTimeValueRequest),
+		PVEndpoint:               client("PV", encodeGRPCTimeValueRequest),
+		CompoundInterestEndpoint: client("CompoundInterest", encodeGRPCCompoundInterestRequest),
+		AmortizationScheduleEndpoint: decodeGRPCStatusAs(
+			func(ctx context.Context, request interface{}) (interface{}, error) {
+				req := request.(mathendpoint2.AmortizationRequest)
+				stream, err := finance.AmortizationSchedule(ctx, req.Loan.Proto())
+				if err != nil {
+					return nil, err
+				}
+				var resp mathendpoint2.AmortizationResponse
+				send := req.Send
+				if send == nil {
+					send = func(row financeservice.AmortizationRow) error {
+						resp.Rows = append(resp.Rows, row)
+						return nil
+					}
+				}
+				failed, err := financeservice.ReceiveSchedule(stream.Recv, send)
+				if err != nil {
+					return nil, err
+				}
+				if failed != nil {
+					resp.Err = rpcstatus.Err(failed.Code, failed.Err)
+				}
+				return resp, nil
+			},
+			func(err error) interface{} { return mathendpoint2.AmortizationResponse{Err: err} },
+		),
+	}
+}
+
+// decodeGRPCCashFlowRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC CashFlow request to a user-domain CashFlows. Primarily useful in a server.
+func decodeGRPCCashFlowRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return financeservice.CashFlowsFromProto(grpcReq.(*pb.CashFlowRequest)), nil
+}
+
+// decodeGRPCTimeValueRequest is decodeGRPCCashFlowRequest for PMT, FV and PV.
+func decodeGRPCTimeValueRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return financeservice.TimeValueFromProto(grpcReq.(*pb.TimeValueRequest)), nil
+}
+
+// decodeGRPCCompoundInterestRequest is decodeGRPCCashFlowRequest for
+// CompoundInterest.
+func decodeGRPCCompoundInterestRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return financeservice.CompoundingFromProto(grpcReq.(*pb.CompoundInterestRequest)), nil
+}
+
+// decodeGRPCAmortizationRequest is decodeGRPCCashFlowRequest for
+// AmortizationSchedule, whose rows are sent to the stream of the request.
+func decodeGRPCAmortizationRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	s := grpcReq.(amortizationStream)
+	return mathendpoint2.AmortizationRequest{
+		Loan: financeservice.LoanFromProto(s.req),
+		Send: func(row financeservice.AmortizationRow) error {
+			return s.stream.Send(row.Proto())
+		},
+	}, nil
+}
+
+// encodeGRPCCashFlowRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain CashFlows to a gRPC CashFlow request. Primarily useful in a client.
+func encodeGRPCCashFlowRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return request.(financeservice.CashFlows).Proto(), nil
+}
+
+// encodeGRPCTimeValueRequest is encodeGRPCCashFlowRequest for PMT, FV and PV.
+func encodeGRPCTimeValueRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return request.(financeservice.TimeValue).Proto(), nil
+}
+
+// encodeGRPCCompoundInterestRequest is encodeGRPCCashFlowRequest for
+// CompoundInterest.
+func encodeGRPCCompoundInterestRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return request.(financeservice.Compounding).Proto(), nil
+}
+
+// encodeGRPCDecimalResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Decimal response to a gRPC Decimal reply. Primarily useful in a server.
+func encodeGRPCDecimalResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.DecimalResponse)
+	return &pb.DecimalReply{V: string(resp.V), Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCDecimalStatusResponse is encodeGRPCMathOpStatusResponse for the
+// methods returning a DecimalReply. Primarily useful in a server.
+func encodeGRPCDecimalStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.DecimalResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCDecimalResponse(ctx, response)
+}
+
+// decodeGRPCDecimalResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Decimal reply to a user-domain Decimal response. Primarily useful in a client.
+func decodeGRPCDecimalResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.DecimalReply)
+	return mathendpoint2.DecimalResponse{V: financeservice.Decimal(reply.V), Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
+
+// encodeGRPCAmortizationResponse is a transport/grpc.EncodeResponseFunc that
+// converts a user-domain Amortization response to the last row to stream,
+// holding the error when it failed, or nil once every row has been sent.
+// Primarily useful in a server.
+func encodeGRPCAmortizationResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.AmortizationResponse)
+	var row *pb.AmortizationRow
+	if resp.Err != nil {
+		row = &pb.AmortizationRow{Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}
+	}
+	return row, nil
+}
+
+// encodeGRPCAmortizationStatusResponse is encodeGRPCMathOpStatusResponse for
+// AmortizationSchedule. Primarily useful in a server.
+func encodeGRPCAmortizationStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.AmortizationResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCAmortizationResponse(ctx, response)
+}
+
+// NewFinanceHTTPHandler returns an HTTP handler that makes a set of endpoints
+// available on the lower-cased names of the methods under /finance/, e.g.
+// /finance/npv. The requests are the JSON encodings of the financeservice
+// types, the whole schedule is returned at once.
+func NewFinanceHTTPHandler(endpoints mathendpoint2.FinanceSet, logger log.Logger) http.Handler {
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(errorEncoder),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	}
+	handle := func(m *http.ServeMux, method string, e endpoint.Endpoint, decodeRequest httptransport.DecodeRequestFunc) {
+		m.Handle("/finance/"+method, httptransport.NewServer(
+			e,
+			decodeRequest,
+			encodeHTTPFinanceResponse,
+			options...,
+		))
+	}
+
+	m := http.NewServeMux()
+	handle(m, "npv", endpoints.NPVEndpoint, decodeHTTPCashFlowRequest)
+	handle(m, "irr", endpoints.IRREndpoint, decodeHTTPCashFlowRequest)
+	handle(m, "pmt", endpoints.PMTEndpoint, decodeHTTPTimeValueRequest)
+	handle(m, "fv", endpoints.FVEndpoint, decodeHTTPTimeValueRequest)
+	handle(m, "pv", endpoints.PVEndpoint, decodeHTTPTimeValueRequest)
+	handle(m, "compoundinterest", endpoints.CompoundInterestEndpoint, decodeHTTPCompoundInterestRequest)
+	handle(m, "amortizationschedule", endpoints.AmortizationScheduleEndpoint, decodeHTTPAmortizationRequest)
+	return m
+}
+
+// NewFinanceHTTPClient returns a financeservice.Service backed by an HTTP
+// server living at the remote instance, see NewHTTPClient.
+func NewFinanceHTTPClient(instance string, logger log.Logger) (financeservice.Service, error) {
+	if !strings.HasPrefix(instance, "http") {
+		instance = "http://" + instance
+	}
+	u, err := url.Parse(instance)
+	if err != nil {
+		return nil, err
+	}
+	client := func(method string, decodeResponse httptransport.DecodeResponseFunc) endpoint.Endpoint {
+		return httptransport.NewClient(
+			"POST",
+			copyURL(u, "/finance/"+method),
+			encodeHTTPGenericRequest,
+			decodeResponse,
+		).Endpoint()
+	}
+
+	return mathendpoint2.FinanceSet{
+		NPVEndpoint:                  client("npv", decodeHTTPDecimalResponse),
+		IRREndpoint:                  client("irr", decodeHTTPDecimalResponse),
+		PMTEndpoint:                  client("pmt", decodeHTTPDecimalResponse),
+		FVEndpoint:                   client("fv", decodeHTTPDecimalResponse),
+		PVEndpoint:                   client("pv", decodeHTTPDecimalResponse),
+		CompoundInterestEndpoint:     client("compoundinterest", decodeHTTPDecimalResponse),
+		AmortizationScheduleEndpoint: client("amortizationschedule", decodeHTTPAmortizationResponse),
+	}, nil
+}
+
+// decimalResponse is the JSON encoding of a mathendpoint.DecimalResponse,
+// e.g. {"v":"-1199.10"}.
+type decimalResponse struct {
+	V financeservice.Decimal `json:"v"`
+}
+
+// amortizationResponse is the JSON encoding of a
+// mathendpoint.AmortizationResponse.
+type amortizationResponse struct {
+	V []financeservice.AmortizationRow `json:"v"`
+}
+
+// decodeHTTPCashFlowRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded CashFlows from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPCashFlowRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req financeservice.CashFlows
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return req, nil
+}
+
+// decodeHTTPTimeValueRequest is decodeHTTPCashFlowRequest for PMT, FV and PV.
+func decodeHTTPTimeValueRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req financeservice.TimeValue
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return req, nil
+}
+
+// decodeHTTPCompoundInterestRequest is decodeHTTPCashFlowRequest for
+// CompoundInterest.
+func decodeHTTPCompoundInterestRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req financeservice.Compounding
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return req, nil
+}
+
+// decodeHTTPAmortizationRequest is decodeHTTPCashFlowRequest for
+// AmortizationSchedule.
+func decodeHTTPAmortizationRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req financeservice.Loan
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return mathendpoint2.AmortizationRequest{Loan: req}, nil
+}
+
+// encodeHTTPFinanceResponse is a transport/http.EncodeResponseFunc that
+// encodes the response of a method of the Finance service as JSON to the
+// response writer. Primarily useful in a server.
+func encodeHTTPFinanceResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	switch resp := response.(type) {
+	case mathendpoint2.DecimalResponse:
+		if resp.Err == nil {
+			response = decimalResponse{V: resp.V}
+		}
+	case mathendpoint2.AmortizationResponse:
+		if resp.Err == nil {
+			response = amortizationResponse{V: resp.Rows}
+		}
+	}
+	return encodeHTTPGenericResponse(ctx, w, response)
+}
+
+// decodeHTTPDecimalResponse is a transport/http.DecodeResponseFunc that
+// decodes a JSON-encoded Decimal response from the HTTP response body, see
+// decodeHTTPMathOpResponse. Primarily useful in a client.
+func decodeHTTPDecimalResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		return nil, errorDecoder(r)
+	}
+	var resp decimalResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return mathendpoint2.DecimalResponse{V: resp.V}, err
+}
+
+// decodeHTTPAmortizationResponse is decodeHTTPDecimalResponse for
+// AmortizationSchedule.
+func decodeHTTPAmortizationResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		return nil, errorDecoder(r)
+	}
+	var resp amortizationResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return mathendpoint2.AmortizationResponse{Rows: resp.V}, err
+}
@@ -2,133 +2,83 @@ package mathtransport
 
 import (
 	"context"
-	"errors"
 	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/transport"
 	grpctransport "github.com/go-kit/kit/transport/grpc"
-	mathendpoint2 "github.com/jwenz723/mathserver/grpc_and_http/gokit/pkg/mathendpoint"
-	mathservice2 "github.com/jwenz723/mathserver/grpc_and_http/gokit/pkg/mathservice"
 	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/compute"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
 	"google.golang.org/grpc"
+	"strings"
 )
 
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -endpoints github.com/jwenz723/mathserver/pkg/gokit/mathendpoint -o grpc_gen.go
+
 type grpcServer struct {
-	divide grpctransport.Handler
-	max grpctransport.Handler
-	min grpctransport.Handler
-	multiply grpctransport.Handler
-	pow grpctransport.Handler
-	subtact grpctransport.Handler
-	sum    grpctransport.Handler
+	grpcOperations
+	evaluate grpctransport.Handler
+	batch    grpctransport.Handler
 }
 
-// NewGRPCServer makes a set of endpoints available as a gRPC MathServer.
-func NewGRPCServer(endpoints mathendpoint2.Set, logger log.Logger) pb.MathServer {
+// NewGRPCServer makes a set of endpoints available as a gRPC MathServer. When
+// statusErrors is set errors returned by the service fail the call with a
+// gRPC status, see package rpcstatus, rather than being returned in the
+// MathOpReply.
+func NewGRPCServer(endpoints mathendpoint2.Set, logger log.Logger, statusErrors bool) pb.MathServer {
 	options := []grpctransport.ServerOption{
 		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
 	}
+	encodeMathOpResponse, encodeEvaluateResponse := encodeGRPCMathOpResponse, encodeGRPCMathOpResponse
+	if statusErrors {
+		encodeMathOpResponse, encodeEvaluateResponse = encodeGRPCMathOpStatusResponse, encodeGRPCEvaluateStatusResponse
+	}
 
 	return &grpcServer{
-		divide: grpctransport.NewServer(
-			endpoints.DivideEndpoint,
-			decodeGRPCMathOpRequest,
-			encodeGRPCMathOpResponse,
-			options...,
-		),
-		max:      grpctransport.NewServer(
-			endpoints.MaxEndpoint,
-			decodeGRPCMathOpRequest,
-			encodeGRPCMathOpResponse,
-			options...,
-		),
-		min:      grpctransport.NewServer(
-			endpoints.MinEndpoint,
-			decodeGRPCMathOpRequest,
-			encodeGRPCMathOpResponse,
-			options...,
-		),
-		multiply: grpctransport.NewServer(
-			endpoints.MultiplyEndpoint,
-			decodeGRPCMathOpRequest,
-			encodeGRPCMathOpResponse,
-			options...,
-		),
-		pow:      grpctransport.NewServer(
-			endpoints.PowEndpoint,
-			decodeGRPCMathOpRequest,
-			encodeGRPCMathOpResponse,
+		grpcOperations: newGRPCOperations(endpoints.Operations, encodeMathOpResponse, options),
+		evaluate: grpctransport.NewServer(
+			endpoints.EvaluateEndpoint,
+			decodeGRPCEvaluateRequest,
+			encodeEvaluateResponse,
 			options...,
 		),
-		subtact:  grpctransport.NewServer(
-			endpoints.SubtractEndpoint,
-			decodeGRPCMathOpRequest,
-			encodeGRPCMathOpResponse,
+		// the errors of batch items are always returned in their results so
+		// that a failed item doesn't fail the whole batch
+		batch: grpctransport.NewServer(
+			endpoints.BatchEndpoint,
+			decodeGRPCBatchRequest,
+			encodeGRPCBatchResponse,
 			options...,
 		),
-		sum:      grpctransport.NewServer(
-			endpoints.SumEndpoint,
-			decodeGRPCMathOpRequest,
-			encodeGRPCMathOpResponse,
-			options...,
-		),
-	}
-}
-
-func (s *grpcServer) Divide(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	_, rep, err := s.divide.ServeGRPC(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-	return rep.(*pb.MathOpReply), nil
-}
-
-func (s *grpcServer) Max(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	_, rep, err := s.max.ServeGRPC(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-	return rep.(*pb.MathOpReply), nil
-}
-
-func (s *grpcServer) Min(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	_, rep, err := s.min.ServeGRPC(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-	return rep.(*pb.MathOpReply), nil
-}
-
-func (s *grpcServer) Multiply(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	_, rep, err := s.multiply.ServeGRPC(ctx, req)
-	if err != nil {
-		return nil, err
 	}
-	return rep.(*pb.MathOpReply), nil
 }
 
-func (s *grpcServer) Pow(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	_, rep, err := s.pow.ServeGRPC(ctx, req)
+func (s *grpcServer) Evaluate(ctx context.Context, req *pb.EvaluateRequest) (*pb.MathOpReply, error) {
+	_, rep, err := s.evaluate.ServeGRPC(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 	return rep.(*pb.MathOpReply), nil
 }
 
-func (s *grpcServer) Subtract(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	_, rep, err := s.subtact.ServeGRPC(ctx, req)
+// Batch performs several operations, the error of each is returned in its own
+// result.
+func (s *grpcServer) Batch(ctx context.Context, req *pb.BatchRequest) (*pb.BatchReply, error) {
+	_, rep, err := s.batch.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
 	}
-	return rep.(*pb.MathOpReply), nil
+	return rep.(*pb.BatchReply), nil
 }
 
-func (s *grpcServer) Sum(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	_, rep, err := s.sum.ServeGRPC(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-	return rep.(*pb.MathOpReply), nil
+// Compute performs each operation received on the stream through the same
+// handler as the equivalent unary call and streams the replies back as they
+// complete.
+func (s *grpcServer) Compute(stream pb.Math_ComputeServer) error {
+	return compute.Serve(stream, s, nil)
 }
 
 // NewGRPCClient returns an MathService backed by a gRPC server at the other end
@@ -136,81 +86,28 @@ func (s *grpcServer) Sum(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOp
 // eventually closing the underlying transport. We bake-in certain middlewares,
 // implementing the client library pattern.
 func NewGRPCClient(conn *grpc.ClientConn, logger log.Logger) mathservice2.Service {
-	var divideEndpoint endpoint.Endpoint
-	{
-		divideEndpoint = grpctransport.NewClient(
-			conn,
-			"pb.Math",
-			"Divide",
-			encodeGRPCMathOpRequest,
-			decodeGRPCMathOpResponse,
-			pb.MathOpReply{},
-		).Endpoint()
-	}
-	var maxEndpoint endpoint.Endpoint
-	{
-		maxEndpoint = grpctransport.NewClient(
-			conn,
-			"pb.Math",
-			"Max",
-			encodeGRPCMathOpRequest,
-			decodeGRPCMathOpResponse,
-			pb.MathOpReply{},
-		).Endpoint()
-	}
-	var minEndpoint endpoint.Endpoint
+	var evaluateEndpoint endpoint.Endpoint
 	{
-		minEndpoint = grpctransport.NewClient(
+		evaluateEndpoint = grpctransport.NewClient(
 			conn,
 			"pb.Math",
-			"Min",
-			encodeGRPCMathOpRequest,
+			"Evaluate",
+			encodeGRPCEvaluateRequest,
 			decodeGRPCMathOpResponse,
 			pb.MathOpReply{},
 		).Endpoint()
+		evaluateEndpoint = decodeGRPCStatusMiddleware(evaluateEndpoint)
 	}
-	var multiplyEndpoint endpoint.Endpoint
-	{
-		multiplyEndpoint = grpctransport.NewClient(
-			conn,
-			"pb.Math",
-			"Multiply",
-			encodeGRPCMathOpRequest,
-			decodeGRPCMathOpResponse,
-			pb.MathOpReply{},
-		).Endpoint()
-	}
-	var powEndpoint endpoint.Endpoint
-	{
-		powEndpoint = grpctransport.NewClient(
-			conn,
-			"pb.Math",
-			"Pow",
-			encodeGRPCMathOpRequest,
-			decodeGRPCMathOpResponse,
-			pb.MathOpReply{},
-		).Endpoint()
-	}
-	var subtractEndpoint endpoint.Endpoint
-	{
-		subtractEndpoint = grpctransport.NewClient(
-			conn,
-			"pb.Math",
-			"Subtract",
-			encodeGRPCMathOpRequest,
-			decodeGRPCMathOpResponse,
-			pb.MathOpReply{},
-		).Endpoint()
-	}
-	var sumEndpoint endpoint.Endpoint
+
+	var batchEndpoint endpoint.Endpoint
 	{
-		sumEndpoint = grpctransport.NewClient(
+		batchEndpoint = grpctransport.NewClient(
 			conn,
 			"pb.Math",
-			"Sum",
-			encodeGRPCMathOpRequest,
-			decodeGRPCMathOpResponse,
-			pb.MathOpReply{},
+			"Batch",
+			encodeGRPCBatchRequest,
+			decodeGRPCBatchResponse,
+			pb.BatchReply{},
 		).Endpoint()
 	}
 
@@ -218,13 +115,9 @@ func NewGRPCClient(conn *grpc.ClientConn, logger log.Logger) mathservice2.Servic
 	// endpoint.Set implementing the Service methods. That's just a simple bit
 	// of glue code.
 	return mathendpoint2.Set{
-		DivideEndpoint:   divideEndpoint,
-		MaxEndpoint:      maxEndpoint,
-		MinEndpoint:      minEndpoint,
-		MultiplyEndpoint: multiplyEndpoint,
-		PowEndpoint:      powEndpoint,
-		SubtractEndpoint: subtractEndpoint,
-		SumEndpoint:      sumEndpoint,
+		Operations:       newGRPCClientOperations(conn),
+		EvaluateEndpoint: evaluateEndpoint,
+		BatchEndpoint:    batchEndpoint,
 	}
 }
 
@@ -232,41 +125,178 @@ func NewGRPCClient(conn *grpc.ClientConn, logger log.Logger) mathservice2.Servic
 // gRPC sum request to a user-domain sum request. Primarily useful in a server.
 func decodeGRPCMathOpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*pb.MathOpRequest)
-	return mathendpoint2.MathOpRequest{A: req.A, B: req.B}, nil
+	return mathendpoint2.MathOpRequest{A: req.A, B: req.B, Precision: precision.FromProto(req.Precision), Division: mathservice2.DivisionFromProto(req.Division)}, nil
 }
 
 // decodeGRPCMathOpResponse is a transport/grpc.DecodeResponseFunc that converts a
 // gRPC MathOp reply to a user-domain MathOp response. Primarily useful in a client.
 func decodeGRPCMathOpResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
 	reply := grpcReply.(*pb.MathOpReply)
-	return mathendpoint2.MathOpResponse{V: reply.V, Err: str2err(reply.Err)}, nil
+	return mathendpoint2.MathOpResponse{V: reply.V, Exact: reply.Exact, Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
 }
 
 // encodeGRPCMathOpResponse is a transport/grpc.EncodeResponseFunc that converts a
 // user-domain MathOp response to a gRPC MathOp reply. Primarily useful in a server.
 func encodeGRPCMathOpResponse(_ context.Context, response interface{}) (interface{}, error) {
 	resp := response.(mathendpoint2.MathOpResponse)
-	return &pb.MathOpReply{V: resp.V, Err: err2str(resp.Err)}, nil
+	return &pb.MathOpReply{V: resp.V, Exact: resp.Exact, Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCMathOpStatusResponse is a transport/grpc.EncodeResponseFunc like
+// encodeGRPCMathOpResponse that fails the call with a status error when the
+// response carries an error. Primarily useful in a server.
+func encodeGRPCMathOpStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.MathOpResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCMathOpResponse(ctx, response)
+}
+
+// encodeGRPCEvaluateStatusResponse is encodeGRPCMathOpStatusResponse for
+// Evaluate, whose errors are always caused by the expression.
+func encodeGRPCEvaluateStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.MathOpResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err, "expression")
+	}
+	return encodeGRPCMathOpResponse(ctx, response)
+}
+
+// decodeGRPCStatusMiddleware converts the status errors returned by a server
+// that reports errors using gRPC status codes back into the errors returned
+// by the service. Primarily useful in a client.
+func decodeGRPCStatusMiddleware(next endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		response, err := next(ctx, request)
+		if code, msg, ok := rpcstatus.Parse(err); ok {
+			return mathendpoint2.MathOpResponse{Err: rpcstatus.Err(code, msg)}, nil
+		}
+		return response, err
+	}
 }
 
 // encodeGRPCMathOpRequest is a transport/grpc.EncodeRequestFunc that converts a
 // user-domain MathOp request to a gRPC MathOp request. Primarily useful in a client.
 func encodeGRPCMathOpRequest(_ context.Context, request interface{}) (interface{}, error) {
 	req := request.(mathendpoint2.MathOpRequest)
-	return &pb.MathOpRequest{A: req.A, B: req.B}, nil
+	return &pb.MathOpRequest{A: req.A, B: req.B, Precision: req.Precision.Proto(), Division: req.Division.Proto()}, nil
 }
 
-// These annoying helper functions are required to translate Go error types to
-// and from strings, which is the type we use in our IDLs to represent errors.
-// There is special casing to treat empty strings as nil errors.
+// decodeGRPCEvaluateRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC Evaluate request to a user-domain Evaluate request. Primarily useful in a server.
+func decodeGRPCEvaluateRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.EvaluateRequest)
+	return mathendpoint2.EvaluateRequest{Expression: req.Expression, Precision: precision.FromProto(req.Precision)}, nil
+}
+
+// encodeGRPCEvaluateRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain Evaluate request to a gRPC Evaluate request. Primarily useful in a client.
+func encodeGRPCEvaluateRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.EvaluateRequest)
+	return &pb.EvaluateRequest{Expression: req.Expression, Precision: req.Precision.Proto()}, nil
+}
+
+// decodeGRPCMathListRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC MathList request to a user-domain MathList request. Primarily useful in a server.
+func decodeGRPCMathListRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.MathListRequest)
+	return mathendpoint2.MathListRequest{Values: req.Values, Precision: precision.FromProto(req.Precision)}, nil
+}
+
+// encodeGRPCMathListRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain MathList request to a gRPC MathList request. Primarily useful in a client.
+func encodeGRPCMathListRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.MathListRequest)
+	return &pb.MathListRequest{Values: req.Values, Precision: req.Precision.Proto()}, nil
+}
+
+// decodeGRPCUnaryOpRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC UnaryOp request to a user-domain UnaryOp request. Primarily useful in a server.
+func decodeGRPCUnaryOpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.UnaryOpRequest)
+	return mathendpoint2.UnaryOpRequest{X: req.X, Precision: precision.FromProto(req.Precision)}, nil
+}
+
+// encodeGRPCUnaryOpRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain UnaryOp request to a gRPC UnaryOp request. Primarily useful in a client.
+func encodeGRPCUnaryOpRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.UnaryOpRequest)
+	return &pb.UnaryOpRequest{X: req.X, Precision: req.Precision.Proto()}, nil
+}
+
+// decodeGRPCBatchRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC Batch request to a user-domain Batch request. Primarily useful in a server.
+func decodeGRPCBatchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.BatchRequest)
+	items := make([]mathendpoint2.BatchItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = mathendpoint2.BatchItem{
+			ID:         item.Id,
+			Op:         strings.ToLower(item.Op.String()),
+			A:          item.A,
+			B:          item.B,
+			Values:     item.Values,
+			Expression: item.Expression,
+			Precision:  precision.FromProto(item.Precision),
+			Division:   mathservice2.DivisionFromProto(item.Division),
+		}
+	}
+	return mathendpoint2.BatchRequest{Items: items, Concurrency: int(req.Concurrency)}, nil
+}
+
+// encodeGRPCBatchResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Batch response to a gRPC Batch reply. Primarily useful in a server.
+func encodeGRPCBatchResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.BatchResponse)
+	reply := &pb.BatchReply{Results: make([]*pb.ComputeReply, len(resp.Results))}
+	for i, r := range resp.Results {
+		reply.Results[i] = &pb.ComputeReply{
+			Id:    r.ID,
+			Reply: &pb.MathOpReply{V: r.V, Exact: r.Exact, Err: err2str(r.Err), Code: rpcstatus.Code(r.Err)},
+		}
+	}
+	return reply, nil
+}
+
+// encodeGRPCBatchRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain Batch request to a gRPC Batch request. Operations that don't
+// exist are sent as UNKNOWN_OP. Primarily useful in a client.
+func encodeGRPCBatchRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.BatchRequest)
+	grpcReq := &pb.BatchRequest{Items: make([]*pb.ComputeRequest, len(req.Items)), Concurrency: uint32(req.Concurrency)}
+	for i, item := range req.Items {
+		grpcReq.Items[i] = &pb.ComputeRequest{
+			Id:         item.ID,
+			Op:         pb.ComputeRequest_Op(pb.ComputeRequest_Op_value[strings.ToUpper(item.Op)]),
+			A:          item.A,
+			B:          item.B,
+			Values:     item.Values,
+			Expression: item.Expression,
+			Precision:  item.Precision.Proto(),
+			Division:   item.Division.Proto(),
+		}
+	}
+	return grpcReq, nil
+}
 
-func str2err(s string) error {
-	if s == "" {
-		return nil
+// decodeGRPCBatchResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Batch reply to a user-domain Batch response. Primarily useful in a client.
+func decodeGRPCBatchResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.BatchReply)
+	results := make([]mathendpoint2.BatchResult, len(reply.Results))
+	for i, r := range reply.Results {
+		results[i] = mathendpoint2.BatchResult{
+			ID:             r.Id,
+			MathOpResponse: mathendpoint2.MathOpResponse{V: r.Reply.GetV(), Exact: r.Reply.GetExact(), Err: rpcstatus.Err(r.Reply.GetCode(), r.Reply.GetErr())},
+		}
 	}
-	return errors.New(s)
+	return mathendpoint2.BatchResponse{Results: results}, nil
 }
 
+// err2str is required to translate Go error types to strings, which is the
+// type we use in our IDLs to represent errors, see rpcstatus.Err for the
+// reverse. There is special casing to treat nil errors as empty strings.
 func err2str(err error) string {
 	if err == nil {
 		return ""
@@ -4,68 +4,44 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/transport"
 	httptransport "github.com/go-kit/kit/transport/http"
-	mathendpoint2 "github.com/jwenz723/mathserver/grpc_and_http/gokit/pkg/mathendpoint"
-	mathservice2 "github.com/jwenz723/mathserver/grpc_and_http/gokit/pkg/mathservice"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
 )
 
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-http -endpoints github.com/jwenz723/mathserver/pkg/gokit/mathendpoint -o http_gen.go
+
 // NewHTTPHandler returns an HTTP handler that makes a set of endpoints
 // available on predefined paths.
 func NewHTTPHandler(endpoints mathendpoint2.Set, logger log.Logger) http.Handler {
 	options := []httptransport.ServerOption{
 		httptransport.ServerErrorEncoder(errorEncoder),
 		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
 	}
 
 	m := http.NewServeMux()
-	m.Handle("/divide", httptransport.NewServer(
-		endpoints.DivideEndpoint,
-		decodeHTTPMathOpRequest,
-		encodeHTTPGenericResponse,
-		options...,
-	))
-	m.Handle("/max", httptransport.NewServer(
-		endpoints.MaxEndpoint,
-		decodeHTTPMathOpRequest,
-		encodeHTTPGenericResponse,
-		options...,
-	))
-	m.Handle("/min", httptransport.NewServer(
-		endpoints.MinEndpoint,
-		decodeHTTPMathOpRequest,
-		encodeHTTPGenericResponse,
-		options...,
-	))
-	m.Handle("/multiply", httptransport.NewServer(
-		endpoints.MultiplyEndpoint,
-		decodeHTTPMathOpRequest,
+	handleOperations(m, endpoints.Operations, options)
+	m.Handle("/evaluate", httptransport.NewServer(
+		endpoints.EvaluateEndpoint,
+		decodeHTTPEvaluateRequest,
 		encodeHTTPGenericResponse,
 		options...,
 	))
-	m.Handle("/pow", httptransport.NewServer(
-		endpoints.PowEndpoint,
-		decodeHTTPMathOpRequest,
-		encodeHTTPGenericResponse,
-		options...,
-	))
-	m.Handle("/subtract", httptransport.NewServer(
-		endpoints.SubtractEndpoint,
-		decodeHTTPMathOpRequest,
-		encodeHTTPGenericResponse,
-		options...,
-	))
-	m.Handle("/sum", httptransport.NewServer(
-		endpoints.SumEndpoint,
-		decodeHTTPMathOpRequest,
-		encodeHTTPGenericResponse,
+	m.Handle("/batch", httptransport.NewServer(
+		endpoints.BatchEndpoint,
+		decodeHTTPBatchRequest,
+		encodeHTTPBatchResponse,
 		options...,
 	))
 	return m
@@ -85,67 +61,22 @@ func NewHTTPClient(instance string, logger log.Logger) (mathservice2.Service, er
 		return nil, err
 	}
 
-	var divideEndpoint endpoint.Endpoint
+	var evaluateEndpoint endpoint.Endpoint
 	{
-		divideEndpoint = httptransport.NewClient(
+		evaluateEndpoint = httptransport.NewClient(
 			"POST",
-			copyURL(u, "/divide"),
+			copyURL(u, "/evaluate"),
 			encodeHTTPGenericRequest,
 			decodeHTTPMathOpResponse,
 		).Endpoint()
 	}
-	var maxEndpoint endpoint.Endpoint
+	var batchEndpoint endpoint.Endpoint
 	{
-		maxEndpoint = httptransport.NewClient(
+		batchEndpoint = httptransport.NewClient(
 			"POST",
-			copyURL(u, "/max"),
+			copyURL(u, "/batch"),
 			encodeHTTPGenericRequest,
-			decodeHTTPMathOpResponse,
-		).Endpoint()
-	}
-	var minEndpoint endpoint.Endpoint
-	{
-		minEndpoint = httptransport.NewClient(
-			"POST",
-			copyURL(u, "/min"),
-			encodeHTTPGenericRequest,
-			decodeHTTPMathOpResponse,
-		).Endpoint()
-	}
-	var multiplyEndpoint endpoint.Endpoint
-	{
-		multiplyEndpoint = httptransport.NewClient(
-			"POST",
-			copyURL(u, "/multiply"),
-			encodeHTTPGenericRequest,
-			decodeHTTPMathOpResponse,
-		).Endpoint()
-	}
-	var powEndpoint endpoint.Endpoint
-	{
-		powEndpoint = httptransport.NewClient(
-			"POST",
-			copyURL(u, "/pow"),
-			encodeHTTPGenericRequest,
-			decodeHTTPMathOpResponse,
-		).Endpoint()
-	}
-	var subtractEndpoint endpoint.Endpoint
-	{
-		subtractEndpoint = httptransport.NewClient(
-			"POST",
-			copyURL(u, "/subtract"),
-			encodeHTTPGenericRequest,
-			decodeHTTPMathOpResponse,
-		).Endpoint()
-	}
-	var sumEndpoint endpoint.Endpoint
-	{
-		sumEndpoint = httptransport.NewClient(
-			"POST",
-			copyURL(u, "/sum"),
-			encodeHTTPGenericRequest,
-			decodeHTTPMathOpResponse,
+			decodeHTTPBatchResponse,
 		).Endpoint()
 	}
 
@@ -153,13 +84,9 @@ func NewHTTPClient(instance string, logger log.Logger) (mathservice2.Service, er
 	// endpoint.Set implementing the Service methods. That's just a simple bit
 	// of glue code.
 	return mathendpoint2.Set{
-		DivideEndpoint:   divideEndpoint,
-		MaxEndpoint:      maxEndpoint,
-		MinEndpoint:      minEndpoint,
-		MultiplyEndpoint: multiplyEndpoint,
-		PowEndpoint:      powEndpoint,
-		SubtractEndpoint: subtractEndpoint,
-		SumEndpoint:      sumEndpoint,
+		Operations:       newHTTPClientOperations(u),
+		EvaluateEndpoint: evaluateEndpoint,
+		BatchEndpoint:    batchEndpoint,
 	}, nil
 }
 
@@ -169,29 +96,21 @@ func copyURL(base *url.URL, path string) *url.URL {
 	return &next
 }
 
-func errorEncoder(_ context.Context, err error, w http.ResponseWriter) {
-	w.WriteHeader(err2code(err))
-	json.NewEncoder(w).Encode(errorWrapper{Error: err.Error()})
-}
-
-func err2code(err error) int {
-	switch err {
-	case mathservice2.ErrDivideByZero, mathservice2.ErrNoMax, mathservice2.ErrNoMin:
-		return http.StatusBadRequest
-	}
-	return http.StatusInternalServerError
+// errorEncoder writes err as a problem details response, with a 400 status for
+// malformed requests, 422 for errors returned by the service and 500 otherwise.
+func errorEncoder(ctx context.Context, err error, w http.ResponseWriter) {
+	path, _ := ctx.Value(httptransport.ContextKeyRequestPath).(string)
+	problem.New(rpcstatus.Code(err), err, path).Write(w)
 }
 
+// errorDecoder reconstructs the error described by the problem details
+// response r.
 func errorDecoder(r *http.Response) error {
-	var w errorWrapper
-	if err := json.NewDecoder(r.Body).Decode(&w); err != nil {
-		return err
+	p := problem.Read(r)
+	if p.Code == "" {
+		return p
 	}
-	return errors.New(w.Error)
-}
-
-type errorWrapper struct {
-	Error string `json:"error"`
+	return rpcstatus.Err(p.ErrorCode(), p.Detail)
 }
 
 // decodeHTTPMathOpRequest is a transport/http.DecodeRequestFunc that decodes a
@@ -199,22 +118,121 @@ type errorWrapper struct {
 // server.
 func decodeHTTPMathOpRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	var req mathendpoint2.MathOpRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
-	return req, err
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return req, nil
+}
+
+// decodeHTTPMathListRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded MathList request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPMathListRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req mathendpoint2.MathListRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return req, nil
+}
+
+// decodeHTTPUnaryOpRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded UnaryOp request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPUnaryOpRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req mathendpoint2.UnaryOpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return req, nil
+}
+
+// decodeHTTPEvaluateRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded Evaluate request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPEvaluateRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req mathendpoint2.EvaluateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return req, nil
+}
+
+// decodeHTTPBatchRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded Batch request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPBatchRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req mathendpoint2.BatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return req, nil
+}
+
+// batchResult is the JSON encoding of a mathendpoint.BatchResult, whose error
+// is described by a problem details object.
+type batchResult struct {
+	ID    uint64            `json:"id"`
+	V     jsonfloat.Float64 `json:"v"`
+	Exact string            `json:"exact,omitempty"`
+	Error *problem.Problem  `json:"error,omitempty"`
+}
+
+// batchResponse is the JSON encoding of a mathendpoint.BatchResponse.
+type batchResponse struct {
+	Results []batchResult `json:"results"`
+}
+
+// encodeHTTPBatchResponse is a transport/http.EncodeResponseFunc that encodes
+// a Batch response as JSON to the response writer. The response succeeds even
+// when some of the items failed, their errors are described by the problem
+// in their result. Primarily useful in a server.
+func encodeHTTPBatchResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(mathendpoint2.BatchResponse)
+	path, _ := ctx.Value(httptransport.ContextKeyRequestPath).(string)
+	body := batchResponse{Results: make([]batchResult, len(resp.Results))}
+	for i, r := range resp.Results {
+		body.Results[i] = batchResult{ID: r.ID, V: jsonfloat.Float64(r.V), Exact: r.Exact}
+		if r.Err != nil {
+			body.Results[i].Error = problem.New(rpcstatus.Code(r.Err), r.Err, path)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(body)
+}
+
+// decodeHTTPBatchResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded Batch response from the HTTP response body, reconstructing
+// the error of each failed item. Primarily useful in a client.
+func decodeHTTPBatchResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		return nil, errorDecoder(r)
+	}
+	var body batchResponse
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	results := make([]mathendpoint2.BatchResult, len(body.Results))
+	for i, b := range body.Results {
+		results[i] = mathendpoint2.BatchResult{ID: b.ID, MathOpResponse: mathendpoint2.MathOpResponse{V: float64(b.V), Exact: b.Exact}}
+		if b.Error != nil {
+			results[i].Err = rpcstatus.Err(b.Error.ErrorCode(), b.Error.Detail)
+		}
+	}
+	return mathendpoint2.BatchResponse{Results: results}, nil
 }
 
 // decodeHTTPMathOpResponse is a transport/http.DecodeResponseFunc that decodes a
 // JSON-encoded MathOp response from the HTTP response body. If the response has a
 // non-200 status code, we will interpret that as an error and attempt to decode
-// the specific error message from the response body. Primarily useful in a
-// client.
+// the specific error from the problem details in the response body. Primarily
+// useful in a client.
 func decodeHTTPMathOpResponse(_ context.Context, r *http.Response) (interface{}, error) {
 	if r.StatusCode != http.StatusOK {
-		return nil, errors.New(r.Status)
+		return nil, errorDecoder(r)
 	}
-	var resp mathendpoint2.MathOpResponse
+	var resp mathOpResponse
 	err := json.NewDecoder(r.Body).Decode(&resp)
-	return resp, err
+	return mathendpoint2.MathOpResponse{V: float64(resp.V), Exact: resp.Exact}, err
 }
 
 // encodeHTTPGenericRequest is a transport/http.EncodeRequestFunc that
@@ -228,6 +246,13 @@ func encodeHTTPGenericRequest(_ context.Context, r *http.Request, request interf
 	return nil
 }
 
+// mathOpResponse is the JSON encoding of a mathendpoint.MathOpResponse, whose
+// value may be NaN or infinite.
+type mathOpResponse struct {
+	V     jsonfloat.Float64 `json:"v"`
+	Exact string            `json:"exact,omitempty"`
+}
+
 // encodeHTTPGenericResponse is a transport/http.EncodeResponseFunc that encodes
 // the response as JSON to the response writer. Primarily useful in a server.
 func encodeHTTPGenericResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
@@ -235,6 +260,9 @@ func encodeHTTPGenericResponse(ctx context.Context, w http.ResponseWriter, respo
 		errorEncoder(ctx, f.Failed(), w)
 		return nil
 	}
+	if resp, ok := response.(mathendpoint2.MathOpResponse); ok {
+		response = mathOpResponse{V: jsonfloat.Float64(resp.V), Exact: resp.Exact}
+	}
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	return json.NewEncoder(w).Encode(response)
 }
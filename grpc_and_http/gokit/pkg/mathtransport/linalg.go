@@ -0,0 +1,364 @@
+package mathtransport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/jwenz723/mathserver/pb"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+	"github.com/jwenz723/mathserver/pkg/linalgservice"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service LinearAlgebra -type linalgGRPCServer -o linalg_gen.go
+
+// NewLinearAlgebraGRPCServer makes a set of endpoints available as a gRPC
+// LinearAlgebraServer, reporting errors like NewGRPCServer does.
+func NewLinearAlgebraGRPCServer(endpoints mathendpoint2.LinearAlgebraSet, logger log.Logger, statusErrors bool) pb.LinearAlgebraServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeMathOpResponse, encodeVectorResponse, encodeMatrixResponse := encodeGRPCMathOpResponse, encodeGRPCVectorResponse, encodeGRPCMatrixResponse
+	if statusErrors {
+		encodeMathOpResponse, encodeVectorResponse, encodeMatrixResponse = encodeGRPCMathOpStatusResponse, encodeGRPCVectorStatusResponse, encodeGRPCMatrixStatusResponse
+	}
+	handler := func(e endpoint.Endpoint, decodeRequest grpctransport.DecodeRequestFunc, encodeResponse grpctransport.EncodeResponseFunc) grpctransport.Handler {
+		return grpctransport.NewServer(e, decodeRequest, encodeResponse, options...)
+	}
+
+	return &linalgGRPCServer{
+		dot:         handler(endpoints.DotEndpoint, decodeGRPCVectorOpRequest, encodeMathOpResponse),
+		cross:       handler(endpoints.CrossEndpoint, decodeGRPCVectorOpRequest, encodeVectorResponse),
+		norm:        handler(endpoints.NormEndpoint, decodeGRPCVectorOpRequest, encodeMathOpResponse),
+		add:         handler(endpoints.AddEndpoint, decodeGRPCMatrixOpRequest, encodeMatrixResponse),
+		multiply:    handler(endpoints.MultiplyEndpoint, decodeGRPCMatrixOpRequest, encodeMatrixResponse),
+		transpose:   handler(endpoints.TransposeEndpoint, decodeGRPCMatrixOpRequest, encodeMatrixResponse),
+		determinant: handler(endpoints.DeterminantEndpoint, decodeGRPCMatrixOpRequest, encodeMathOpResponse),
+		inverse:     handler(endpoints.InverseEndpoint, decodeGRPCMatrixOpRequest, encodeMatrixResponse),
+		solve:       handler(endpoints.SolveEndpoint, decodeGRPCSolveRequest, encodeVectorResponse),
+	}
+}
+
+// NewLinearAlgebraGRPCClient returns a linalgservice.Service backed by a gRPC
+// server at the other end of the conn, see NewGRPCClient.
+func NewLinearAlgebraGRPCClient(conn *grpc.ClientConn, logger log.Logger) linalgservice.Service {
+	client := func(method string, encodeRequest grpctransport.EncodeRequestFunc, decodeResponse grpctransport.DecodeResponseFunc, reply interface{}) endpoint.Endpoint {
+		return grpctransport.NewClient(
+			conn,
+			"pb.LinearAlgebra",
+			method,
+			encodeRequest,
+			decodeResponse,
+			reply,
+		).Endpoint()
+	}
+	number := func(method string, encodeRequest grpctransport.EncodeRequestFunc) endpoint.Endpoint {
+		return decodeGRPCStatusMiddleware(client(method, encodeRequest, decodeGRPCMathOpResponse, pb.MathOpReply{}))
+	}
+	vector := func(method string, encodeRequest grpctransport.EncodeRequestFunc) endpoint.Endpoint {
+		return decodeGRPCStatusAs(client(method, encodeRequest, decodeGRPCVectorResponse, pb.VectorReply{}), func(err error) interface{} {
+			return mathendpoint2.VectorResponse{Err: err}
+		})
+	}
+	matrix := func(method string) endpoint.Endpoint {
+		return decodeGRPCStatusAs(client(method, encodeGRPCMatrixOpRequest, decodeGRPCMatrixResponse, pb.MatrixReply{}), func(err error) interface{} {
+			return mathendpoint2.MatrixResponse{Err: err}
+		})
+	}
+
+	return mathendpoint2.LinearAlgebraSet{
+		DotEndpoint:         number("Dot", encodeGRPCVectorOpRequest),
+		CrossEndpoint:       vector("Cross", encodeGRPCVectorOpRequest),
+		NormEndpoint:        number("Norm", encodeGRPCVectorOpRequest),
+		AddEndpoint:         matrix("Add"),
+		MultiplyEndpoint:    matrix("Multiply"),
+		TransposeEndpoint:   matrix("Transpose"),
+		DeterminantEndpoint: number("Determinant", encodeGRPCMatrixOpRequest),
+		InverseEndpoint:     matrix("Inverse"),
+		SolveEndpoint:       vector("Solve", encodeGRPCSolveRequest),
+	}
+}
+
+// decodeGRPCVectorOpRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC VectorOp request to a user-domain VectorOp request. Primarily useful in a server.
+func decodeGRPCVectorOpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.VectorOpRequest)
+	return mathendpoint2.VectorOpRequest{A: req.A, B: req.B}, nil
+}
+
+// encodeGRPCVectorOpRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain VectorOp request to a gRPC VectorOp request. Primarily useful in a client.
+func encodeGRPCVectorOpRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.VectorOpRequest)
+	return &pb.VectorOpRequest{A: req.A, B: req.B}, nil
+}
+
+// decodeGRPCMatrixOpRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC MatrixOp request to a user-domain MatrixOp request. Primarily useful in a server.
+func decodeGRPCMatrixOpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.MatrixOpRequest)
+	return mathendpoint2.MatrixOpRequest{A: linalgservice.FromProto(req.A), B: linalgservice.FromProto(req.B)}, nil
+}
+
+// encodeGRPCMatrixOpRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain MatrixOp request to a gRPC MatrixOp request. Primarily useful in a client.
+func encodeGRPCMatrixOpRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.MatrixOpRequest)
+	return &pb.MatrixOpRequest{A: req.A.Proto(), B: req.B.Proto()}, nil
+}
+
+// decodeGRPCSolveRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC Solve request to a user-domain Solve request. Primarily useful in a server.
+func decodeGRPCSolveRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.SolveRequest)
+	return mathendpoint2.SolveRequest{A: linalgservice.FromProto(req.A), B: req.B}, nil
+}
+
+// encodeGRPCSolveRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain Solve request to a gRPC Solve request. Primarily useful in a client.
+func encodeGRPCSolveRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.SolveRequest)
+	return &pb.SolveRequest{A: req.A.Proto(), B: req.B}, nil
+}
+
+// encodeGRPCVectorResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Vector response to a gRPC Vector reply. Primarily useful in a server.
+func encodeGRPCVectorResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.VectorResponse)
+	return &pb.VectorReply{V: resp.V, Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCVectorStatusResponse is encodeGRPCMathOpStatusResponse for the
+// methods returning a VectorReply. Primarily useful in a server.
+func encodeGRPCVectorStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.VectorResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCVectorResponse(ctx, response)
+}
+
+// decodeGRPCVectorResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Vector reply to a user-domain Vector response. Primarily useful in a client.
+func decodeGRPCVectorResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.VectorReply)
+	return mathendpoint2.VectorResponse{V: reply.V, Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
+
+// encodeGRPCMatrixResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Matrix response to a gRPC Matrix reply. Primarily useful in a server.
+func encodeGRPCMatrixResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.MatrixResponse)
+	return &pb.MatrixReply{V: resp.V.Proto(), Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCMatrixStatusResponse is encodeGRPCMathOpStatusResponse for the
+// methods returning a MatrixReply. Primarily useful in a server.
+func encodeGRPCMatrixStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.MatrixResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCMatrixResponse(ctx, response)
+}
+
+// decodeGRPCMatrixResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Matrix reply to a user-domain Matrix response. Primarily useful in a client.
+func decodeGRPCMatrixResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.MatrixReply)
+	return mathendpoint2.MatrixResponse{V: linalgservice.FromProto(reply.V), Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
+
+// NewLinearAlgebraHTTPHandler returns an HTTP handler that makes a set of
+// endpoints available on the lower-cased names of the methods under
+// /linearalgebra/, e.g. /linearalgebra/solve. It's meant to be mounted next to
+// the handler returned by NewHTTPHandler.
+func NewLinearAlgebraHTTPHandler(endpoints mathendpoint2.LinearAlgebraSet, logger log.Logger) http.Handler {
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(errorEncoder),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	}
+	handle := func(m *http.ServeMux, method string, e endpoint.Endpoint, decodeRequest httptransport.DecodeRequestFunc) {
+		m.Handle("/linearalgebra/"+method, httptransport.NewServer(
+			e,
+			decodeRequest,
+			encodeHTTPLinearAlgebraResponse,
+			options...,
+		))
+	}
+
+	m := http.NewServeMux()
+	handle(m, "dot", endpoints.DotEndpoint, decodeHTTPVectorOpRequest)
+	handle(m, "cross", endpoints.CrossEndpoint, decodeHTTPVectorOpRequest)
+	handle(m, "norm", endpoints.NormEndpoint, decodeHTTPVectorOpRequest)
+	handle(m, "add", endpoints.AddEndpoint, decodeHTTPMatrixOpRequest)
+	handle(m, "multiply", endpoints.MultiplyEndpoint, decodeHTTPMatrixOpRequest)
+	handle(m, "transpose", endpoints.TransposeEndpoint, decodeHTTPMatrixOpRequest)
+	handle(m, "determinant", endpoints.DeterminantEndpoint, decodeHTTPMatrixOpRequest)
+	handle(m, "inverse", endpoints.InverseEndpoint, decodeHTTPMatrixOpRequest)
+	handle(m, "solve", endpoints.SolveEndpoint, decodeHTTPSolveRequest)
+	return m
+}
+
+// NewLinearAlgebraHTTPClient returns a linalgservice.Service backed by an
+// HTTP server living at the remote instance, see NewHTTPClient.
+func NewLinearAlgebraHTTPClient(instance string, logger log.Logger) (linalgservice.Service, error) {
+	if !strings.HasPrefix(instance, "http") {
+		instance = "http://" + instance
+	}
+	u, err := url.Parse(instance)
+	if err != nil {
+		return nil, err
+	}
+	client := func(method string, decodeResponse httptransport.DecodeResponseFunc) endpoint.Endpoint {
+		return httptransport.NewClient(
+			"POST",
+			copyURL(u, "/linearalgebra/"+method),
+			encodeHTTPLinearAlgebraRequest,
+			decodeResponse,
+		).Endpoint()
+	}
+
+	return mathendpoint2.LinearAlgebraSet{
+		DotEndpoint:         client("dot", decodeHTTPMathOpResponse),
+		CrossEndpoint:       client("cross", decodeHTTPVectorResponse),
+		NormEndpoint:        client("norm", decodeHTTPMathOpResponse),
+		AddEndpoint:         client("add", decodeHTTPMatrixResponse),
+		MultiplyEndpoint:    client("multiply", decodeHTTPMatrixResponse),
+		TransposeEndpoint:   client("transpose", decodeHTTPMatrixResponse),
+		DeterminantEndpoint: client("determinant", decodeHTTPMathOpResponse),
+		InverseEndpoint:     client("inverse", decodeHTTPMatrixResponse),
+		SolveEndpoint:       client("solve", decodeHTTPVectorResponse),
+	}, nil
+}
+
+// vectorOpRequest is the JSON encoding of a mathendpoint.VectorOpRequest, e.g.
+// {"a":[1,2,3],"b":[4,5,6]}.
+type vectorOpRequest struct {
+	A jsonfloat.Slice `json:"a"`
+	B jsonfloat.Slice `json:"b"`
+}
+
+// matrixOpRequest is the JSON encoding of a mathendpoint.MatrixOpRequest, e.g.
+// {"a":{"rows":2,"cols":2,"values":[1,2,3,4]}}.
+type matrixOpRequest struct {
+	A linalgservice.Matrix `json:"a"`
+	B linalgservice.Matrix `json:"b"`
+}
+
+// solveRequest is the JSON encoding of a mathendpoint.SolveRequest.
+type solveRequest struct {
+	A linalgservice.Matrix `json:"a"`
+	B jsonfloat.Slice      `json:"b"`
+}
+
+// vectorResponse is the JSON encoding of a mathendpoint.VectorResponse.
+type vectorResponse struct {
+	V jsonfloat.Slice `json:"v"`
+}
+
+// matrixResponse is the JSON encoding of a mathendpoint.MatrixResponse.
+type matrixResponse struct {
+	V linalgservice.Matrix `json:"v"`
+}
+
+// decodeHTTPVectorOpRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded VectorOp request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPVectorOpRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req vectorOpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return mathendpoint2.VectorOpRequest{A: req.A, B: req.B}, nil
+}
+
+// decodeHTTPMatrixOpRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded MatrixOp request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPMatrixOpRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req matrixOpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return mathendpoint2.MatrixOpRequest{A: req.A, B: req.B}, nil
+}
+
+// decodeHTTPSolveRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded Solve request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPSolveRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req solveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return mathendpoint2.SolveRequest{A: req.A, B: req.B}, nil
+}
+
+// encodeHTTPLinearAlgebraRequest is a transport/http.EncodeRequestFunc that
+// JSON-encodes a request of the LinearAlgebra service to the request body.
+// Primarily useful in a client.
+func encodeHTTPLinearAlgebraRequest(ctx context.Context, r *http.Request, request interface{}) error {
+	switch req := request.(type) {
+	case mathendpoint2.VectorOpRequest:
+		request = vectorOpRequest{A: req.A, B: req.B}
+	case mathendpoint2.MatrixOpRequest:
+		request = matrixOpRequest{A: req.A, B: req.B}
+	case mathendpoint2.SolveRequest:
+		request = solveRequest{A: req.A, B: req.B}
+	}
+	return encodeHTTPGenericRequest(ctx, r, request)
+}
+
+// encodeHTTPLinearAlgebraResponse is a transport/http.EncodeResponseFunc that
+// encodes the response of a method of the LinearAlgebra service as JSON to
+// the response writer. Primarily useful in a server.
+func encodeHTTPLinearAlgebraResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	switch resp := response.(type) {
+	case mathendpoint2.VectorResponse:
+		if resp.Err == nil {
+			response = vectorResponse{V: resp.V}
+		}
+	case mathendpoint2.MatrixResponse:
+		if resp.Err == nil {
+			response = matrixResponse{V: resp.V}
+		}
+	}
+	return encodeHTTPGenericResponse(ctx, w, response)
+}
+
+// decodeHTTPVectorResponse is a transport/http.DecodeResponseFunc that
+// decodes a JSON-encoded Vector response from the HTTP response body, see
+// decodeHTTPMathOpResponse. Primarily useful in a client.
+func decodeHTTPVectorResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		return nil, errorDecoder(r)
+	}
+	var resp vectorResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return mathendpoint2.VectorResponse{V: resp.V}, err
+}
+
+// decodeHTTPMatrixResponse is a transport/http.DecodeResponseFunc that
+// decodes a JSON-encoded Matrix response from the HTTP response body, see
+// decodeHTTPMathOpResponse. Primarily useful in a client.
+func decodeHTTPMatrixResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		return nil, errorDecoder(r)
+	}
+	var resp matrixResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return mathendpoint2.MatrixResponse{V: resp.V}, err
+}
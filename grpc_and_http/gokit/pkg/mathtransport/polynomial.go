@@ -0,0 +1,368 @@
+package mathtransport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/complexservice"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+	"github.com/jwenz723/mathserver/pkg/polyservice"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service Polynomial -type polyGRPCServer -o polynomial_gen.go
+
+// NewPolynomialGRPCServer makes a set of endpoints available as a gRPC
+// PolynomialServer, reporting errors like NewGRPCServer does.
+func NewPolynomialGRPCServer(endpoints mathendpoint2.PolynomialSet, logger log.Logger, statusErrors bool) pb.PolynomialServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeMathOpResponse, encodePolynomialResponse, encodeRootsResponse := encodeGRPCMathOpResponse, encodeGRPCPolynomialResponse, encodeGRPCRootsResponse
+	if statusErrors {
+		encodeMathOpResponse, encodePolynomialResponse, encodeRootsResponse = encodeGRPCMathOpStatusResponse, encodeGRPCPolynomialStatusResponse, encodeGRPCRootsStatusResponse
+	}
+	handler := func(e endpoint.Endpoint, decodeRequest grpctransport.DecodeRequestFunc, encodeResponse grpctransport.EncodeResponseFunc) grpctransport.Handler {
+		return grpctransport.NewServer(e, decodeRequest, encodeResponse, options...)
+	}
+
+	return &polyGRPCServer{
+		evaluate:   handler(endpoints.EvaluateEndpoint, decodeGRPCPolynomialEvaluateRequest, encodeMathOpResponse),
+		add:        handler(endpoints.AddEndpoint, decodeGRPCPolynomialOpRequest, encodePolynomialResponse),
+		multiply:   handler(endpoints.MultiplyEndpoint, decodeGRPCPolynomialOpRequest, encodePolynomialResponse),
+		derivative: handler(endpoints.DerivativeEndpoint, decodeGRPCPolynomialOpRequest, encodePolynomialResponse),
+		integral:   handler(endpoints.IntegralEndpoint, decodeGRPCPolynomialOpRequest, encodePolynomialResponse),
+		roots:      handler(endpoints.RootsEndpoint, decodeGRPCRootsRequest, encodeRootsResponse),
+	}
+}
+
+// NewPolynomialGRPCClient returns a polyservice.Service backed by a gRPC
+// server at the other end of the conn, see NewGRPCClient.
+func NewPolynomialGRPCClient(conn *grpc.ClientConn, logger log.Logger) polyservice.Service {
+	client := func(method string, encodeRequest grpctransport.EncodeRequestFunc, decodeResponse grpctransport.DecodeResponseFunc, reply interface{}) endpoint.Endpoint {
+		return grpctransport.NewClient(
+			conn,
+			"pb.Polynomial",
+			method,
+			encodeRequest,
+			decodeResponse,
+			reply,
+		).Endpoint()
+	}
+	polynomial := func(method string) endpoint.Endpoint {
+		return decodeGRPCStatusAs(client(method, encodeGRPCPolynomialOpRequest, decodeGRPCPolynomialResponse, pb.PolynomialReply{}), func(err error) interface{} {
+			return mathendpoint2.PolynomialResponse{Err: err}
+		})
+	}
+
+	return mathendpoint2.PolynomialSet{
+		EvaluateEndpoint:   decodeGRPCStatusMiddleware(client("Evaluate", encodeGRPCPolynomialEvaluateRequest, decodeGRPCMathOpResponse, pb.MathOpReply{})),
+		AddEndpoint:        polynomial("Add"),
+		MultiplyEndpoint:   polynomial("Multiply"),
+		DerivativeEndpoint: polynomial("Derivative"),
+		IntegralEndpoint:   polynomial("Integral"),
+		RootsEndpoint: decodeGRPCStatusAs(client("Roots", encodeGRPCRootsRequest, decodeGRPCRootsResponse, pb.RootsReply{}), func(err error) interface{} {
+			return mathendpoint2.RootsResponse{Err: err}
+		}),
+	}
+}
+
+// decodeGRPCPolynomialOpRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC PolynomialOp request to a user-domain PolynomialOp request. Primarily useful in a server.
+func decodeGRPCPolynomialOpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.PolynomialOpRequest)
+	return mathendpoint2.PolynomialOpRequest{A: req.A, B: req.B}, nil
+}
+
+// encodeGRPCPolynomialOpRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain PolynomialOp request to a gRPC PolynomialOp request. Primarily useful in a client.
+func encodeGRPCPolynomialOpRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.PolynomialOpRequest)
+	return &pb.PolynomialOpRequest{A: req.A, B: req.B}, nil
+}
+
+// decodeGRPCPolynomialEvaluateRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC PolynomialEvaluate request to a user-domain PolynomialEvaluate request. Primarily useful in a server.
+func decodeGRPCPolynomialEvaluateRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.PolynomialEvaluateRequest)
+	return mathendpoint2.PolynomialEvaluateRequest{A: req.A, X: req.X}, nil
+}
+
+// encodeGRPCPolynomialEvaluateRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain PolynomialEvaluate request to a gRPC PolynomialEvaluate request. Primarily useful in a client.
+func encodeGRPCPolynomialEvaluateRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.PolynomialEvaluateRequest)
+	return &pb.PolynomialEvaluateRequest{A: req.A, X: req.X}, nil
+}
+
+// decodeGRPCRootsRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC Roots request to a user-domain Roots request. Primarily useful in a server.
+func decodeGRPCRootsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.RootsRequest)
+	return mathendpoint2.RootsRequest{A: req.A, Tolerance: req.Tolerance}, nil
+}
+
+// encodeGRPCRootsRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain Roots request to a gRPC Roots request. Primarily useful in a client.
+func encodeGRPCRootsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.RootsRequest)
+	return &pb.RootsRequest{A: req.A, Tolerance: req.Tolerance}, nil
+}
+
+// encodeGRPCPolynomialResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Polynomial response to a gRPC Polynomial reply. Primarily useful in a server.
+func encodeGRPCPolynomialResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.PolynomialResponse)
+	return &pb.PolynomialReply{V: resp.V, Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCPolynomialStatusResponse is encodeGRPCMathOpStatusResponse for the
+// methods returning a PolynomialReply. Primarily useful in a server.
+func encodeGRPCPolynomialStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.PolynomialResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCPolynomialResponse(ctx, response)
+}
+
+// decodeGRPCPolynomialResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Polynomial reply to a user-domain Polynomial response. Primarily useful in a client.
+func decodeGRPCPolynomialResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.PolynomialReply)
+	return mathendpoint2.PolynomialResponse{V: reply.V, Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
+
+// encodeGRPCRootsResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Roots response to a gRPC Roots reply. Primarily useful in a server.
+func encodeGRPCRootsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.RootsResponse)
+	v := make([]*pb.ComplexNumber, len(resp.V))
+	for i, z := range resp.V {
+		v[i] = complexservice.Proto(z)
+	}
+	return &pb.RootsReply{V: v, Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCRootsStatusResponse is encodeGRPCMathOpStatusResponse for Roots.
+// Primarily useful in a server.
+func encodeGRPCRootsStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.RootsResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCRootsResponse(ctx, response)
+}
+
+// decodeGRPCRootsResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Roots reply to a user-domain Roots response. Primarily useful in a client.
+func decodeGRPCRootsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.RootsReply)
+	v := make([]complex128, len(reply.V))
+	for i, z := range reply.V {
+		v[i] = complexservice.FromProto(z)
+	}
+	return mathendpoint2.RootsResponse{V: v, Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
+
+// NewPolynomialHTTPHandler returns an HTTP handler that makes a set of
+// endpoints available on the lower-cased names of the methods under
+// /polynomial/, e.g. /polynomial/roots. It's meant to be mounted next to the
+// handler returned by NewHTTPHandler.
+func NewPolynomialHTTPHandler(endpoints mathendpoint2.PolynomialSet, logger log.Logger) http.Handler {
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(errorEncoder),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	}
+	handle := func(m *http.ServeMux, method string, e endpoint.Endpoint, decodeRequest httptransport.DecodeRequestFunc) {
+		m.Handle("/polynomial/"+method, httptransport.NewServer(
+			e,
+			decodeRequest,
+			encodeHTTPPolynomialResponse,
+			options...,
+		))
+	}
+
+	m := http.NewServeMux()
+	handle(m, "evaluate", endpoints.EvaluateEndpoint, decodeHTTPPolynomialEvaluateRequest)
+	handle(m, "add", endpoints.AddEndpoint, decodeHTTPPolynomialOpRequest)
+	handle(m, "multiply", endpoints.MultiplyEndpoint, decodeHTTPPolynomialOpRequest)
+	handle(m, "derivative", endpoints.DerivativeEndpoint, decodeHTTPPolynomialOpRequest)
+	handle(m, "integral", endpoints.IntegralEndpoint, decodeHTTPPolynomialOpRequest)
+	handle(m, "roots", endpoints.RootsEndpoint, decodeHTTPRootsRequest)
+	return m
+}
+
+// NewPolynomialHTTPClient returns a polyservice.Service backed by an HTTP
+// server living at the remote instance, see NewHTTPClient.
+func NewPolynomialHTTPClient(instance string, logger log.Logger) (polyservice.Service, error) {
+	if !strings.HasPrefix(instance, "http") {
+		instance = "http://" + instance
+	}
+	u, err := url.Parse(instance)
+	if err != nil {
+		return nil, err
+	}
+	client := func(method string, decodeResponse httptransport.DecodeResponseFunc) endpoint.Endpoint {
+		return httptransport.NewClient(
+			"POST",
+			copyURL(u, "/polynomial/"+method),
+			encodeHTTPPolynomialRequest,
+			decodeResponse,
+		).Endpoint()
+	}
+
+	return mathendpoint2.PolynomialSet{
+		EvaluateEndpoint:   client("evaluate", decodeHTTPMathOpResponse),
+		AddEndpoint:        client("add", decodeHTTPPolynomialResponse),
+		MultiplyEndpoint:   client("multiply", decodeHTTPPolynomialResponse),
+		DerivativeEndpoint: client("derivative", decodeHTTPPolynomialResponse),
+		IntegralEndpoint:   client("integral", decodeHTTPPolynomialResponse),
+		RootsEndpoint:      client("roots", decodeHTTPRootsResponse),
+	}, nil
+}
+
+// polynomialOpRequest is the JSON encoding of a
+// mathendpoint.PolynomialOpRequest, e.g. {"a":[1,0,2],"b":[0,1]}.
+type polynomialOpRequest struct {
+	A jsonfloat.Slice `json:"a"`
+	B jsonfloat.Slice `json:"b"`
+}
+
+// polynomialEvaluateRequest is the JSON encoding of a
+// mathendpoint.PolynomialEvaluateRequest, e.g. {"a":[1,0,2],"x":3}.
+type polynomialEvaluateRequest struct {
+	A jsonfloat.Slice   `json:"a"`
+	X jsonfloat.Float64 `json:"x"`
+}
+
+// rootsRequest is the JSON encoding of a mathendpoint.RootsRequest, e.g.
+// {"a":[-1,0,1],"tolerance":1e-9}.
+type rootsRequest struct {
+	A         jsonfloat.Slice   `json:"a"`
+	Tolerance jsonfloat.Float64 `json:"tolerance"`
+}
+
+// polynomialResponse is the JSON encoding of a
+// mathendpoint.PolynomialResponse.
+type polynomialResponse struct {
+	V jsonfloat.Slice `json:"v"`
+}
+
+// rootsResponse is the JSON encoding of a mathendpoint.RootsResponse, e.g.
+// {"v":[{"real":0,"imag":-1},{"real":0,"imag":1}]}.
+type rootsResponse struct {
+	V []jsonfloat.Complex128 `json:"v"`
+}
+
+// decodeHTTPPolynomialOpRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded PolynomialOp request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPPolynomialOpRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req polynomialOpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return mathendpoint2.PolynomialOpRequest{A: req.A, B: req.B}, nil
+}
+
+// decodeHTTPPolynomialEvaluateRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded PolynomialEvaluate request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPPolynomialEvaluateRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req polynomialEvaluateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return mathendpoint2.PolynomialEvaluateRequest{A: req.A, X: float64(req.X)}, nil
+}
+
+// decodeHTTPRootsRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded Roots request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPRootsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req rootsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return mathendpoint2.RootsRequest{A: req.A, Tolerance: float64(req.Tolerance)}, nil
+}
+
+// encodeHTTPPolynomialRequest is a transport/http.EncodeRequestFunc that
+// JSON-encodes a request of the Polynomial service to the request body.
+// Primarily useful in a client.
+func encodeHTTPPolynomialRequest(ctx context.Context, r *http.Request, request interface{}) error {
+	switch req := request.(type) {
+	case mathendpoint2.PolynomialOpRequest:
+		request = polynomialOpRequest{A: req.A, B: req.B}
+	case mathendpoint2.PolynomialEvaluateRequest:
+		request = polynomialEvaluateRequest{A: req.A, X: jsonfloat.Float64(req.X)}
+	case mathendpoint2.RootsRequest:
+		request = rootsRequest{A: req.A, Tolerance: jsonfloat.Float64(req.Tolerance)}
+	}
+	return encodeHTTPGenericRequest(ctx, r, request)
+}
+
+// encodeHTTPPolynomialResponse is a transport/http.EncodeResponseFunc that
+// encodes the response of a method of the Polynomial service as JSON to the
+// response writer. Primarily useful in a server.
+func encodeHTTPPolynomialResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	switch resp := response.(type) {
+	case mathendpoint2.PolynomialResponse:
+		if resp.Err == nil {
+			response = polynomialResponse{V: resp.V}
+		}
+	case mathendpoint2.RootsResponse:
+		if resp.Err == nil {
+			v := make([]jsonfloat.Complex128, len(resp.V))
+			for i, z := range resp.V {
+				v[i] = jsonfloat.Complex128(z)
+			}
+			response = rootsResponse{V: v}
+		}
+	}
+	return encodeHTTPGenericResponse(ctx, w, response)
+}
+
+// decodeHTTPPolynomialResponse is a transport/http.DecodeResponseFunc that
+// decodes a JSON-encoded Polynomial response from the HTTP response body, see
+// decodeHTTPMathOpResponse. Primarily useful in a client.
+func decodeHTTPPolynomialResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		return nil, errorDecoder(r)
+	}
+	var resp polynomialResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return mathendpoint2.PolynomialResponse{V: resp.V}, err
+}
+
+// decodeHTTPRootsResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded Roots response from the HTTP response body, see
+// decodeHTTPMathOpResponse. Primarily useful in a client.
+func decodeHTTPRootsResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		return nil, errorDecoder(r)
+	}
+	var resp rootsResponse
+	if err := json.NewDecoder(r.Body).Decode(&resp); err != nil {
+		return nil, err
+	}
+	v := make([]complex128, len(resp.V))
+	for i, z := range resp.V {
+		v[i] = complex128(z)
+	}
+	return mathendpoint2.RootsResponse{V: v}, nil
+}
@@ -0,0 +1,187 @@
+package mathtransport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/jwenz723/mathserver/pb"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"github.com/jwenz723/mathserver/pkg/symbolicservice"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service Symbolic -type symbolicGRPCServer -o symbolic_gen.go
+
+// NewSymbolicGRPCServer makes a set of endpoints available as a gRPC
+// SymbolicServer, reporting errors like NewGRPCServer does.
+func NewSymbolicGRPCServer(endpoints mathendpoint2.SymbolicSet, logger log.Logger, statusErrors bool) pb.SymbolicServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeResponse := encodeGRPCSymbolicResponse
+	if statusErrors {
+		encodeResponse = encodeGRPCSymbolicStatusResponse
+	}
+
+	return &symbolicGRPCServer{
+		format:        grpctransport.NewServer(endpoints.FormatEndpoint, decodeGRPCSymbolicRequest, encodeResponse, options...),
+		simplify:      grpctransport.NewServer(endpoints.SimplifyEndpoint, decodeGRPCSymbolicRequest, encodeResponse, options...),
+		differentiate: grpctransport.NewServer(endpoints.DifferentiateEndpoint, decodeGRPCSymbolicRequest, encodeResponse, options...),
+	}
+}
+
+// NewSymbolicGRPCClient returns a symbolicservice.Service backed by a gRPC
+// server at the other end of the conn, see NewGRPCClient.
+func NewSymbolicGRPCClient(conn *grpc.ClientConn, logger log.Logger) symbolicservice.Service {
+	client := func(method string) endpoint.Endpoint {
+		return decodeGRPCStatusAs(grpctransport.NewClient(
+			conn,
+			"pb.Symbolic",
+			method,
+			encodeGRPCSymbolicRequest,
+			decodeGRPCSymbolicResponse,
+			pb.SymbolicReply{},
+		).Endpoint(), func(err error) interface{} {
+			return mathendpoint2.SymbolicResponse{Err: err}
+		})
+	}
+
+	return mathendpoint2.SymbolicSet{
+		FormatEndpoint:        client("Format"),
+		SimplifyEndpoint:      client("Simplify"),
+		DifferentiateEndpoint: client("Differentiate"),
+	}
+}
+
+// decodeGRPCSymbolicRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC Symbolic request to a user-domain Request. Primarily useful in a server.
+func decodeGRPCSymbolicRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return symbolicservice.RequestFromProto(grpcReq.(*pb.SymbolicRequest)), nil
+}
+
+// encodeGRPCSymbolicRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain Request to a gRPC Symbolic request. Primarily useful in a client.
+func encodeGRPCSymbolicRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return request.(symbolicservice.Request).Proto(), nil
+}
+
+// encodeGRPCSymbolicResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Symbolic response to a gRPC Symbolic reply. Primarily useful in a server.
+func encodeGRPCSymbolicResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.SymbolicResponse)
+	reply := resp.Result.Proto()
+	reply.Err, reply.Code = err2str(resp.Err), rpcstatus.Code(resp.Err)
+	return reply, nil
+}
+
+// encodeGRPCSymbolicStatusResponse is encodeGRPCMathOpStatusResponse for the
+// Symbolic service. Primarily useful in a server.
+func encodeGRPCSymbolicStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.SymbolicResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCSymbolicResponse(ctx, response)
+}
+
+// decodeGRPCSymbolicResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Symbolic reply to a user-domain Symbolic response. Primarily useful in a client.
+func decodeGRPCSymbolicResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.SymbolicReply)
+	return mathendpoint2.SymbolicResponse{Result: symbolicservice.ResultFromProto(reply), Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
+
+// NewSymbolicHTTPHandler returns an HTTP handler that makes a set of endpoints
+// available on the lower-cased names of the methods under /symbolic/, e.g.
+// /symbolic/simplify. The requests are the JSON encodings of
+// symbolicservice.Request, the responses those of symbolicservice.Result.
+func NewSymbolicHTTPHandler(endpoints mathendpoint2.SymbolicSet, logger log.Logger) http.Handler {
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(errorEncoder),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	}
+	handle := func(m *http.ServeMux, method string, e endpoint.Endpoint) {
+		m.Handle("/symbolic/"+method, httptransport.NewServer(
+			e,
+			decodeHTTPSymbolicRequest,
+			encodeHTTPSymbolicResponse,
+			options...,
+		))
+	}
+
+	m := http.NewServeMux()
+	handle(m, "format", endpoints.FormatEndpoint)
+	handle(m, "simplify", endpoints.SimplifyEndpoint)
+	handle(m, "differentiate", endpoints.DifferentiateEndpoint)
+	return m
+}
+
+// NewSymbolicHTTPClient returns a symbolicservice.Service backed by an HTTP
+// server living at the remote instance, see NewHTTPClient.
+func NewSymbolicHTTPClient(instance string, logger log.Logger) (symbolicservice.Service, error) {
+	if !strings.HasPrefix(instance, "http") {
+		instance = "http://" + instance
+	}
+	u, err := url.Parse(instance)
+	if err != nil {
+		return nil, err
+	}
+	client := func(method string) endpoint.Endpoint {
+		return httptransport.NewClient(
+			"POST",
+			copyURL(u, "/symbolic/"+method),
+			encodeHTTPGenericRequest,
+			decodeHTTPSymbolicResponse,
+		).Endpoint()
+	}
+
+	return mathendpoint2.SymbolicSet{
+		FormatEndpoint:        client("format"),
+		SimplifyEndpoint:      client("simplify"),
+		DifferentiateEndpoint: client("differentiate"),
+	}, nil
+}
+
+// decodeHTTPSymbolicRequest is a transport/http.DecodeRequestFunc that decodes
+// a JSON-encoded Request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPSymbolicRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req symbolicservice.Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return req, nil
+}
+
+// encodeHTTPSymbolicResponse is a transport/http.EncodeResponseFunc that
+// encodes the response of a method of the Symbolic service as JSON to the
+// response writer. Primarily useful in a server.
+func encodeHTTPSymbolicResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if resp := response.(mathendpoint2.SymbolicResponse); resp.Err == nil {
+		response = resp.Result
+	}
+	return encodeHTTPGenericResponse(ctx, w, response)
+}
+
+// decodeHTTPSymbolicResponse is a transport/http.DecodeResponseFunc that
+// decodes a JSON-encoded Symbolic response from the HTTP response body, see
+// decodeHTTPMathOpResponse. Primarily useful in a client.
+func decodeHTTPSymbolicResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		return nil, errorDecoder(r)
+	}
+	var resp symbolicservice.Result
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return mathendpoint2.SymbolicResponse{Result: resp}, err
+}
@@ -0,0 +1,290 @@
+package mathtransport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/jwenz723/mathserver/pb"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service Units -type unitsGRPCServer -o units_gen.go
+
+// NewUnitsGRPCServer makes a set of endpoints available as a gRPC
+// UnitsServer, reporting errors like NewGRPCServer does.
+func NewUnitsGRPCServer(endpoints mathendpoint2.UnitsSet, logger log.Logger, statusErrors bool) pb.UnitsServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeResponse := encodeGRPCQuantityResponse
+	if statusErrors {
+		encodeResponse = encodeGRPCQuantityStatusResponse
+	}
+	handler := func(e endpoint.Endpoint, decodeRequest grpctransport.DecodeRequestFunc) grpctransport.Handler {
+		return grpctransport.NewServer(e, decodeRequest, encodeResponse, options...)
+	}
+
+	return &unitsGRPCServer{
+		sum:      handler(endpoints.SumEndpoint, decodeGRPCQuantityOpRequest),
+		subtract: handler(endpoints.SubtractEndpoint, decodeGRPCQuantityOpRequest),
+		multiply: handler(endpoints.MultiplyEndpoint, decodeGRPCQuantityOpRequest),
+		divide:   handler(endpoints.DivideEndpoint, decodeGRPCQuantityOpRequest),
+		pow:      handler(endpoints.PowEndpoint, decodeGRPCQuantityPowRequest),
+		convert:  handler(endpoints.ConvertEndpoint, decodeGRPCConvertRequest),
+	}
+}
+
+// NewUnitsGRPCClient returns a unitservice.Service backed by a gRPC server at
+// the other end of the conn, see NewGRPCClient.
+func NewUnitsGRPCClient(conn *grpc.ClientConn, logger log.Logger) unitservice.Service {
+	client := func(method string, encodeRequest grpctransport.EncodeRequestFunc) endpoint.Endpoint {
+		return decodeGRPCStatusAs(grpctransport.NewClient(
+			conn,
+			"pb.Units",
+			method,
+			encodeRequest,
+			decodeGRPCQuantityResponse,
+			pb.QuantityReply{},
+		).Endpoint(), func(err error) interface{} {
+			return mathendpoint2.QuantityResponse{Err: err}
+		})
+	}
+
+	return mathendpoint2.UnitsSet{
+		SumEndpoint:      client("Sum", encodeGRPCQuantityOpRequest),
+		SubtractEndpoint: client("Subtract", encodeGRPCQuantityOpRequest),
+		MultiplyEndpoint: client("Multiply", encodeGRPCQuantityOpRequest),
+		DivideEndpoint:   client("Divide", encodeGRPCQuantityOpRequest),
+		PowEndpoint:      client("Pow", encodeGRPCQuantityPowRequest),
+		ConvertEndpoint:  client("Convert", encodeGRPCConvertRequest),
+	}
+}
+
+// decodeGRPCQuantityOpRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC QuantityOp request to a user-domain QuantityOp request. Primarily useful in a server.
+func decodeGRPCQuantityOpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.QuantityOpRequest)
+	return mathendpoint2.QuantityOpRequest{A: unitservice.FromProto(req.A), B: unitservice.FromProto(req.B)}, nil
+}
+
+// decodeGRPCQuantityPowRequest is decodeGRPCQuantityOpRequest for Pow.
+func decodeGRPCQuantityPowRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.QuantityPowRequest)
+	return mathendpoint2.QuantityPowRequest{A: unitservice.FromProto(req.A), B: req.B}, nil
+}
+
+// decodeGRPCConvertRequest is decodeGRPCQuantityOpRequest for Convert.
+func decodeGRPCConvertRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ConvertRequest)
+	return mathendpoint2.ConvertRequest{A: unitservice.FromProto(req.A), Unit: req.Unit}, nil
+}
+
+// encodeGRPCQuantityOpRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain QuantityOp request to a gRPC QuantityOp request. Primarily useful in a client.
+func encodeGRPCQuantityOpRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.QuantityOpRequest)
+	return &pb.QuantityOpRequest{A: req.A.Proto(), B: req.B.Proto()}, nil
+}
+
+// encodeGRPCQuantityPowRequest is encodeGRPCQuantityOpRequest for Pow.
+func encodeGRPCQuantityPowRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.QuantityPowRequest)
+	return &pb.QuantityPowRequest{A: req.A.Proto(), B: req.B}, nil
+}
+
+// encodeGRPCConvertRequest is encodeGRPCQuantityOpRequest for Convert.
+func encodeGRPCConvertRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.ConvertRequest)
+	return &pb.ConvertRequest{A: req.A.Proto(), Unit: req.Unit}, nil
+}
+
+// encodeGRPCQuantityResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Quantity response to a gRPC Quantity reply. Primarily useful in a server.
+func encodeGRPCQuantityResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.QuantityResponse)
+	return &pb.QuantityReply{V: resp.V.Proto(), Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCQuantityStatusResponse is encodeGRPCMathOpStatusResponse for the
+// methods of the Units service. Primarily useful in a server.
+func encodeGRPCQuantityStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.QuantityResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCQuantityResponse(ctx, response)
+}
+
+// decodeGRPCQuantityResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Quantity reply to a user-domain Quantity response. Primarily useful in a client.
+func decodeGRPCQuantityResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.QuantityReply)
+	return mathendpoint2.QuantityResponse{V: unitservice.FromProto(reply.V), Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
+
+// NewUnitsHTTPHandler returns an HTTP handler that makes a set of endpoints
+// available on the lower-cased names of the methods under /units/, e.g.
+// /units/convert. It's meant to be mounted next to the handler returned by
+// NewHTTPHandler.
+func NewUnitsHTTPHandler(endpoints mathendpoint2.UnitsSet, logger log.Logger) http.Handler {
+	options := []httptransport.ServerOption{
+		httptransport.ServerErrorEncoder(errorEncoder),
+		httptransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		httptransport.ServerBefore(httptransport.PopulateRequestContext),
+	}
+	handle := func(m *http.ServeMux, method string, e endpoint.Endpoint, decodeRequest httptransport.DecodeRequestFunc) {
+		m.Handle("/units/"+method, httptransport.NewServer(
+			e,
+			decodeRequest,
+			encodeHTTPUnitsResponse,
+			options...,
+		))
+	}
+
+	m := http.NewServeMux()
+	handle(m, "sum", endpoints.SumEndpoint, decodeHTTPQuantityOpRequest)
+	handle(m, "subtract", endpoints.SubtractEndpoint, decodeHTTPQuantityOpRequest)
+	handle(m, "multiply", endpoints.MultiplyEndpoint, decodeHTTPQuantityOpRequest)
+	handle(m, "divide", endpoints.DivideEndpoint, decodeHTTPQuantityOpRequest)
+	handle(m, "pow", endpoints.PowEndpoint, decodeHTTPQuantityPowRequest)
+	handle(m, "convert", endpoints.ConvertEndpoint, decodeHTTPConvertRequest)
+	return m
+}
+
+// NewUnitsHTTPClient returns a unitservice.Service backed by an HTTP server
+// living at the remote instance, see NewHTTPClient.
+func NewUnitsHTTPClient(instance string, logger log.Logger) (unitservice.Service, error) {
+	if !strings.HasPrefix(instance, "http") {
+		instance = "http://" + instance
+	}
+	u, err := url.Parse(instance)
+	if err != nil {
+		return nil, err
+	}
+	client := func(method string, encodeRequest httptransport.EncodeRequestFunc) endpoint.Endpoint {
+		return httptransport.NewClient(
+			"POST",
+			copyURL(u, "/units/"+method),
+			encodeRequest,
+			decodeHTTPQuantityResponse,
+		).Endpoint()
+	}
+
+	return mathendpoint2.UnitsSet{
+		SumEndpoint:      client("sum", encodeHTTPQuantityOpRequest),
+		SubtractEndpoint: client("subtract", encodeHTTPQuantityOpRequest),
+		MultiplyEndpoint: client("multiply", encodeHTTPQuantityOpRequest),
+		DivideEndpoint:   client("divide", encodeHTTPQuantityOpRequest),
+		PowEndpoint:      client("pow", encodeHTTPQuantityPowRequest),
+		ConvertEndpoint:  client("convert", encodeHTTPConvertRequest),
+	}, nil
+}
+
+// quantityOpRequest is the JSON encoding of a mathendpoint.QuantityOpRequest,
+// e.g. {"a":{"value":1,"unit":"km"},"b":{"value":500,"unit":"m"}}.
+type quantityOpRequest struct {
+	A unitservice.Quantity `json:"a"`
+	B unitservice.Quantity `json:"b"`
+}
+
+// quantityPowRequest is the JSON encoding of a mathendpoint.QuantityPowRequest,
+// e.g. {"a":{"value":3,"unit":"m"},"b":2}.
+type quantityPowRequest struct {
+	A unitservice.Quantity `json:"a"`
+	B float64              `json:"b"`
+}
+
+// convertRequest is the JSON encoding of a mathendpoint.ConvertRequest, e.g.
+// {"a":{"value":1,"unit":"mi"},"unit":"km"}.
+type convertRequest struct {
+	A    unitservice.Quantity `json:"a"`
+	Unit string               `json:"unit"`
+}
+
+// quantityResponse is the JSON encoding of a mathendpoint.QuantityResponse.
+type quantityResponse struct {
+	V unitservice.Quantity `json:"v"`
+}
+
+// decodeHTTPQuantityOpRequest is a transport/http.DecodeRequestFunc that decodes a
+// JSON-encoded QuantityOp request from the HTTP request body. Primarily useful in a
+// server.
+func decodeHTTPQuantityOpRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req quantityOpRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return mathendpoint2.QuantityOpRequest{A: req.A, B: req.B}, nil
+}
+
+// decodeHTTPQuantityPowRequest is decodeHTTPQuantityOpRequest for Pow.
+func decodeHTTPQuantityPowRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req quantityPowRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return mathendpoint2.QuantityPowRequest{A: req.A, B: req.B}, nil
+}
+
+// decodeHTTPConvertRequest is decodeHTTPQuantityOpRequest for Convert.
+func decodeHTTPConvertRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req convertRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, problem.Malformed(err)
+	}
+	return mathendpoint2.ConvertRequest{A: req.A, Unit: req.Unit}, nil
+}
+
+// encodeHTTPQuantityOpRequest is a transport/http.EncodeRequestFunc that
+// JSON-encodes a QuantityOp request to the request body. Primarily useful in a
+// client.
+func encodeHTTPQuantityOpRequest(ctx context.Context, r *http.Request, request interface{}) error {
+	req := request.(mathendpoint2.QuantityOpRequest)
+	return encodeHTTPGenericRequest(ctx, r, quantityOpRequest{A: req.A, B: req.B})
+}
+
+// encodeHTTPQuantityPowRequest is encodeHTTPQuantityOpRequest for Pow.
+func encodeHTTPQuantityPowRequest(ctx context.Context, r *http.Request, request interface{}) error {
+	req := request.(mathendpoint2.QuantityPowRequest)
+	return encodeHTTPGenericRequest(ctx, r, quantityPowRequest{A: req.A, B: req.B})
+}
+
+// encodeHTTPConvertRequest is encodeHTTPQuantityOpRequest for Convert.
+func encodeHTTPConvertRequest(ctx context.Context, r *http.Request, request interface{}) error {
+	req := request.(mathendpoint2.ConvertRequest)
+	return encodeHTTPGenericRequest(ctx, r, convertRequest{A: req.A, Unit: req.Unit})
+}
+
+// encodeHTTPUnitsResponse is a transport/http.EncodeResponseFunc that encodes
+// the response of a method of the Units service as JSON to the response
+// writer. Primarily useful in a server.
+func encodeHTTPUnitsResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if resp, ok := response.(mathendpoint2.QuantityResponse); ok && resp.Err == nil {
+		response = quantityResponse{V: resp.V}
+	}
+	return encodeHTTPGenericResponse(ctx, w, response)
+}
+
+// decodeHTTPQuantityResponse is a transport/http.DecodeResponseFunc that
+// decodes a JSON-encoded Quantity response from the HTTP response body, see
+// decodeHTTPMathOpResponse. Primarily useful in a client.
+func decodeHTTPQuantityResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		return nil, errorDecoder(r)
+	}
+	var resp quantityResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return mathendpoint2.QuantityResponse{V: resp.V}, err
+}
@@ -6,6 +6,10 @@ import (
 	mathservice2 "github.com/jwenz723/mathserver/grpc_and_http/std/pkg/mathservice"
 	server2 "github.com/jwenz723/mathserver/grpc_and_http/std/pkg/server"
 	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+	mathservice3 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
 	"github.com/oklog/oklog/pkg/group"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -22,12 +26,25 @@ import (
 func main() {
 	fs := flag.NewFlagSet("mathsvc", flag.ExitOnError)
 	var (
-		debugAddr      = fs.String("debug.addr", ":8080", "Debug and metrics listen address")
-		httpAddr       = fs.String("http-addr", ":8081", "HTTP listen address")
-		grpcAddr       = fs.String("grpc-addr", ":8082", "gRPC listen address")
+		debugAddr    = fs.String("debug.addr", ":8080", "Debug and metrics listen address")
+		httpAddr     = fs.String("http-addr", ":8081", "HTTP listen address")
+		grpcAddr     = fs.String("grpc-addr", ":8082", "gRPC listen address")
+		statusErrors = fs.Bool("grpc-status-errors", false, "Report service errors as gRPC status codes instead of in the reply")
+		unitsFile    = fs.String("units", "", "File defining units besides the built-in ones, one per line such as: kn = 1 nmi/h")
+		maxDigits    = fs.Int("max-digits", combinatoricsservice.DefaultMaxDigits, "Most decimal digits of the exact results of the Combinatorics service")
 	)
+	defaultPrecision := precision.Precision{Mode: precision.Float64}
+	fs.Var(&defaultPrecision.Mode, "precision", "Default precision mode: float64, bigfloat or rational")
+	fs.UintVar(&defaultPrecision.Bits, "precision-bits", precision.DefaultBits, "Default mantissa bits used by the bigfloat precision mode")
+	var nonFinite mathservice3.NonFinitePolicy
+	fs.Var(&nonFinite, "non-finite", "Policy for NaN and infinite results of the Math service: pass, reject or string")
 	fs.Usage = usageFor(fs, os.Args[0]+" [flags]")
 	fs.Parse(os.Args[1:])
+	if defaultPrecision.Bits > precision.MaxBits {
+		fmt.Fprintf(os.Stderr, "invalid value %d for flag -precision-bits: at most %d bits are supported\n", defaultPrecision.Bits, precision.MaxBits)
+		fs.Usage()
+		os.Exit(2)
+	}
 
 	logger, _ := zap.NewProduction()
 	duration := prometheus.NewSummaryVec(prometheus.SummaryOpts{
@@ -37,11 +54,58 @@ func main() {
 	}, []string{"method", "success"})
 	prometheus.MustRegister(duration)
 
+	units := unitservice.NewRegistry()
+	if *unitsFile != "" {
+		if err := units.LoadFile(*unitsFile); err != nil {
+			logger.Error("failed to load units",
+				zap.String("file", *unitsFile),
+				zap.Error(err))
+			os.Exit(1)
+		}
+	}
+
 	var (
-		service = mathservice2.ObservabilityMiddleware(duration, logger)(mathservice2.NewBasicService())
-		grpcSvc = server2.NewGrpcServer(service)
-		httpRouter = server2.NewHttpRouter(service, logger)
+		service    = mathservice2.New(duration, logger, defaultPrecision, nonFinite)
+		grpcSvc    = server2.NewGrpcServer(service, *statusErrors)
+		httpRouter = http.NewServeMux()
+
+		complexService = mathservice2.NewComplex(duration, logger)
+		complexGrpcSvc = server2.NewComplexGrpcServer(complexService, *statusErrors)
+
+		linalgService = mathservice2.NewLinearAlgebra(duration, logger)
+		linalgGrpcSvc = server2.NewLinearAlgebraGrpcServer(linalgService, *statusErrors)
+
+		unitsService = mathservice2.NewUnits(duration, logger, units)
+		unitsGrpcSvc = server2.NewUnitsGrpcServer(unitsService, *statusErrors)
+
+		financeService = mathservice2.NewFinance(duration, logger)
+		financeGrpcSvc = server2.NewFinanceGrpcServer(financeService, *statusErrors)
+
+		ntService = mathservice2.NewNumberTheory(duration, logger)
+		ntGrpcSvc = server2.NewNumberTheoryGrpcServer(ntService, *statusErrors)
+
+		combService = mathservice2.NewCombinatorics(duration, logger, *maxDigits)
+		combGrpcSvc = server2.NewCombinatoricsGrpcServer(combService, *statusErrors)
+
+		calcService = mathservice2.NewCalculus(duration, logger)
+		calcGrpcSvc = server2.NewCalculusGrpcServer(calcService, *statusErrors)
+
+		symService = mathservice2.NewSymbolic(duration, logger)
+		symGrpcSvc = server2.NewSymbolicGrpcServer(symService, *statusErrors)
 	)
+	// The Complex, LinearAlgebra, Units, Finance, NumberTheory, Combinatorics,
+	// Calculus and Symbolic services are served under /complex/,
+	// /linearalgebra/, /units/, /finance/, /numbertheory/, /combinatorics/,
+	// /calculus/ and /symbolic/ next to the Math service.
+	httpRouter.Handle("/complex/", server2.NewComplexHttpRouter(complexService, logger))
+	httpRouter.Handle("/linearalgebra/", server2.NewLinearAlgebraHttpRouter(linalgService, logger))
+	httpRouter.Handle("/units/", server2.NewUnitsHttpRouter(unitsService, logger))
+	httpRouter.Handle("/finance/", server2.NewFinanceHttpRouter(financeService, logger))
+	httpRouter.Handle("/numbertheory/", server2.NewNumberTheoryHttpRouter(ntService, logger))
+	httpRouter.Handle("/combinatorics/", server2.NewCombinatoricsHttpRouter(combService, logger))
+	httpRouter.Handle("/calculus/", server2.NewCalculusHttpRouter(calcService, logger))
+	httpRouter.Handle("/symbolic/", server2.NewSymbolicHttpRouter(symService, logger))
+	httpRouter.Handle("/", server2.NewHttpRouter(service, logger))
 
 	var g group.Group
 	{
@@ -89,6 +153,14 @@ func main() {
 		g.Add(func() error {
 			grpcServer := grpc.NewServer()
 			pb.RegisterMathServer(grpcServer, &grpcSvc)
+			pb.RegisterComplexServer(grpcServer, &complexGrpcSvc)
+			pb.RegisterLinearAlgebraServer(grpcServer, &linalgGrpcSvc)
+			pb.RegisterUnitsServer(grpcServer, &unitsGrpcSvc)
+			pb.RegisterFinanceServer(grpcServer, &financeGrpcSvc)
+			pb.RegisterNumberTheoryServer(grpcServer, &ntGrpcSvc)
+			pb.RegisterCombinatoricsServer(grpcServer, &combGrpcSvc)
+			pb.RegisterCalculusServer(grpcServer, &calcGrpcSvc)
+			pb.RegisterSymbolicServer(grpcServer, &symGrpcSvc)
 			return grpcServer.Serve(lis)
 		}, func(error) {
 			lis.Close()
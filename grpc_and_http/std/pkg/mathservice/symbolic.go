@@ -0,0 +1,53 @@
+package mathservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/jwenz723/mathserver/pkg/symbolicservice"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -service Symbolic -type symbolicObservabilityMiddleware -o symbolic_gen.go
+
+// NewSymbolic returns a basic symbolicservice.Service with all of the
+// expected middlewares wired in.
+func NewSymbolic(duration *prometheus.SummaryVec, logger *zap.Logger) symbolicservice.Service {
+	var svc symbolicservice.Service
+	{
+		svc = symbolicservice.NewBasicService()
+		svc = SymbolicObservabilityMiddleware(duration, logger)(svc)
+	}
+	return svc
+}
+
+// SymbolicObservabilityMiddleware implements both logging and prometheus
+// metrics for each symbolicservice.Service method. The methods are observed
+// as Symbolic.<Method>.
+func SymbolicObservabilityMiddleware(duration *prometheus.SummaryVec, logger *zap.Logger) symbolicservice.Middleware {
+	return func(next symbolicservice.Service) symbolicservice.Service {
+		return symbolicObservabilityMiddleware{duration, logger, next}
+	}
+}
+
+type symbolicObservabilityMiddleware struct {
+	duration *prometheus.SummaryVec
+	logger   *zap.Logger
+	next     symbolicservice.Service
+}
+
+// observeMethodExecution observes a call of method, logging the given
+// keyvals with the expression of its request and result.
+func (mw symbolicObservabilityMiddleware) observeMethodExecution(ctx context.Context, method string, begin time.Time, err error, keyvals ...interface{}) {
+	var kv []interface{}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		switch v := keyvals[i+1].(type) {
+		case symbolicservice.Request:
+			kv = append(kv, "expression", v.Expression, "variable", v.Variable)
+		case symbolicservice.Result:
+			kv = append(kv, "infix", v.Infix)
+		}
+	}
+	observe(mw.logger, mw.duration, method, begin, err, kv...)
+}
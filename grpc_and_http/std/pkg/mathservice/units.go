@@ -0,0 +1,44 @@
+package mathservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/jwenz723/mathserver/pkg/unitservice"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -service Units -type unitsObservabilityMiddleware -o units_gen.go
+
+// NewUnits returns a basic unitservice.Service knowing the units of r with
+// all of the expected middlewares wired in.
+func NewUnits(duration *prometheus.SummaryVec, logger *zap.Logger, r *unitservice.Registry) unitservice.Service {
+	var svc unitservice.Service
+	{
+		svc = unitservice.NewBasicService(r)
+		svc = UnitsObservabilityMiddleware(duration, logger)(svc)
+	}
+	return svc
+}
+
+// UnitsObservabilityMiddleware implements both logging and prometheus metrics
+// for each unitservice.Service method. The methods are observed as
+// Units.<Method>.
+func UnitsObservabilityMiddleware(duration *prometheus.SummaryVec, logger *zap.Logger) unitservice.Middleware {
+	return func(next unitservice.Service) unitservice.Service {
+		return unitsObservabilityMiddleware{duration, logger, next}
+	}
+}
+
+type unitsObservabilityMiddleware struct {
+	duration *prometheus.SummaryVec
+	logger   *zap.Logger
+	next     unitservice.Service
+}
+
+// observeMethodExecution observes a call of method, logging the given
+// keyvals, its operands and result.
+func (mw unitsObservabilityMiddleware) observeMethodExecution(ctx context.Context, method string, begin time.Time, err error, keyvals ...interface{}) {
+	observe(mw.logger, mw.duration, method, begin, err, keyvals...)
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"encoding/json"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/compute"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"net/http"
+	"strings"
+)
+
+// BatchItem is a single operation of a BatchRequest. Op names the operation
+// the way the HTTP paths do, e.g. "divide" or "sumall", and ID is returned in
+// its result so that clients can correlate the two. A is also the operand of
+// the unary operations.
+type BatchItem struct {
+	ID         uint64                `json:"id"`
+	Op         string                `json:"op"`
+	A          float64               `json:"a"`
+	B          float64               `json:"b"`
+	Values     []float64             `json:"values,omitempty"`
+	Expression string                `json:"expression,omitempty"`
+	Precision  precision.Precision   `json:"precision"`
+	Division   mathservice2.Division `json:"division,omitempty"`
+}
+
+// BatchRequest collects the request parameters for POST /batch. Concurrency
+// is the number of items performed at once, 0 and 1 perform them one after
+// the other.
+type BatchRequest struct {
+	Items       []BatchItem `json:"items"`
+	Concurrency int         `json:"concurrency,omitempty"`
+}
+
+// BatchResult is the outcome of a BatchItem, its error is described by a
+// problem details object.
+type BatchResult struct {
+	ID    uint64            `json:"id"`
+	V     jsonfloat.Float64 `json:"v"`
+	Exact string            `json:"exact,omitempty"`
+	Error *problem.Problem  `json:"error,omitempty"`
+}
+
+// BatchResponse collects the response values for POST /batch, with a result
+// for each item in the same order as the request.
+type BatchResponse struct {
+	Results []BatchResult `json:"results"`
+}
+
+// batchHandlerFunc serves POST /batch. The items are performed by the unary
+// methods of the gRPC server, like the items of a Batch call, and the
+// response succeeds even when some of them failed.
+func (s *httpServer) batchHandlerFunc() http.HandlerFunc {
+	srv := NewGrpcServer(s.svc, false)
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req BatchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		grpcReq := &pb.BatchRequest{Items: make([]*pb.ComputeRequest, len(req.Items)), Concurrency: uint32(req.Concurrency)}
+		for i, item := range req.Items {
+			grpcReq.Items[i] = &pb.ComputeRequest{
+				Id:         item.ID,
+				Op:         pb.ComputeRequest_Op(pb.ComputeRequest_Op_value[strings.ToUpper(item.Op)]),
+				A:          item.A,
+				B:          item.B,
+				Values:     item.Values,
+				Expression: item.Expression,
+				Precision:  item.Precision.Proto(),
+				Division:   item.Division.Proto(),
+			}
+		}
+		reply, err := compute.ServeBatch(r.Context(), grpcReq, &srv, nil)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		resp := BatchResponse{Results: make([]BatchResult, len(reply.Results))}
+		for i, res := range reply.Results {
+			resp.Results[i] = BatchResult{ID: res.Id, V: jsonfloat.Float64(res.Reply.GetV()), Exact: res.Reply.GetExact()}
+			if err := rpcstatus.Err(res.Reply.GetCode(), res.Reply.GetErr()); err != nil {
+				resp.Results[i].Error = problem.New(res.Reply.GetCode(), err, r.URL.Path)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(resp)
+	}
+}
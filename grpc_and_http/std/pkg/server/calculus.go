@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/calculusservice"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"go.uber.org/zap"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service Calculus -type calculusGrpcServer -o calculus_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.CalculusServer = &calculusGrpcServer{}
+)
+
+type calculusGrpcServer struct {
+	svc          calculusservice.Service
+	statusErrors bool
+}
+
+// NewCalculusGrpcServer returns a CalculusServer backed by svc, reporting
+// errors like NewGrpcServer does.
+func NewCalculusGrpcServer(svc calculusservice.Service, statusErrors bool) calculusGrpcServer {
+	return calculusGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// calculusReply returns the reply to a call that computed r, or failed with
+// err.
+func (s *calculusGrpcServer) calculusReply(r calculusservice.Result, err error) (*pb.CalculusReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	reply := r.Proto()
+	reply.Err, reply.Code = err2str(err), rpcstatus.Code(err)
+	return reply, nil
+}
+
+type calculusHttpServer struct {
+	logger *zap.Logger
+	router *mux.Router
+	svc    calculusservice.Service
+}
+
+// NewCalculusHttpRouter returns a router serving the methods of svc at their
+// lower-cased names under /calculus/, e.g. /calculus/findroot. The requests
+// are the JSON encodings of calculusservice.Problem, the responses those of
+// calculusservice.Result.
+func NewCalculusHttpRouter(svc calculusservice.Service, logger *zap.Logger) *mux.Router {
+	s := calculusHttpServer{
+		logger: logger,
+		router: mux.NewRouter(),
+		svc:    svc,
+	}
+	s.routes()
+	return s.router
+}
+
+func (s *calculusHttpServer) routes() {
+	s.logger.Debug("setting up calculus handlers")
+	r := s.router.Methods("POST").PathPrefix("/calculus").Subrouter()
+	r.Path("/integrate").HandlerFunc(calculusHandlerFunc(s.svc.Integrate))
+	r.Path("/differentiate").HandlerFunc(calculusHandlerFunc(s.svc.Differentiate))
+	r.Path("/findroot").HandlerFunc(calculusHandlerFunc(s.svc.FindRoot))
+	r.Path("/minimize").HandlerFunc(calculusHandlerFunc(s.svc.Minimize))
+}
+
+// calculusHandlerFunc serves a method of the Calculus service.
+func calculusHandlerFunc(op func(ctx context.Context, p calculusservice.Problem) (calculusservice.Result, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req calculusservice.Problem
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := op(r.Context(), req)
+		writeJSON(w, r, v, err)
+	}
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"go.uber.org/zap"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service Combinatorics -type combinatoricsGrpcServer -o combinatorics_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.CombinatoricsServer = &combinatoricsGrpcServer{}
+)
+
+type combinatoricsGrpcServer struct {
+	svc          combinatoricsservice.Service
+	statusErrors bool
+}
+
+// NewCombinatoricsGrpcServer returns a CombinatoricsServer backed by svc,
+// reporting errors like NewGrpcServer does.
+func NewCombinatoricsGrpcServer(svc combinatoricsservice.Service, statusErrors bool) combinatoricsGrpcServer {
+	return combinatoricsGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// integerReply returns the reply to a call that computed v, or failed with err.
+func (s *combinatoricsGrpcServer) integerReply(v string, err error) (*pb.IntegerReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.IntegerReply{
+		V:    v,
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+type combinatoricsHttpServer struct {
+	logger *zap.Logger
+	router *mux.Router
+	svc    combinatoricsservice.Service
+}
+
+// NewCombinatoricsHttpRouter returns a router serving the methods of svc at
+// their lower-cased names under /combinatorics/, e.g.
+// /combinatorics/binomial. The requests are the JSON encodings of
+// combinatoricsservice.Operands.
+func NewCombinatoricsHttpRouter(svc combinatoricsservice.Service, logger *zap.Logger) *mux.Router {
+	s := combinatoricsHttpServer{
+		logger: logger,
+		router: mux.NewRouter(),
+		svc:    svc,
+	}
+	s.routes()
+	return s.router
+}
+
+func (s *combinatoricsHttpServer) routes() {
+	s.logger.Debug("setting up combinatorics handlers")
+	r := s.router.Methods("POST").PathPrefix("/combinatorics").Subrouter()
+	r.Path("/factorial").HandlerFunc(combinatoricsHandlerFunc(s.svc.Factorial))
+	r.Path("/binomial").HandlerFunc(combinatoricsHandlerFunc(s.svc.Binomial))
+	r.Path("/permutations").HandlerFunc(combinatoricsHandlerFunc(s.svc.Permutations))
+}
+
+// CombinatoricsResponse collects the response values for the methods of the
+// Combinatorics service, e.g. {"v":"120"}.
+type CombinatoricsResponse struct {
+	V string `json:"v"`
+}
+
+// combinatoricsHandlerFunc serves a method of the Combinatorics service.
+func combinatoricsHandlerFunc(op func(ctx context.Context, o combinatoricsservice.Operands) (string, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req combinatoricsservice.Operands
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := op(r.Context(), req)
+		writeJSON(w, r, CombinatoricsResponse{V: v}, err)
+	}
+}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/complexservice"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"go.uber.org/zap"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service Complex -type complexGrpcServer -o complex_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.ComplexServer = &complexGrpcServer{}
+)
+
+type complexGrpcServer struct {
+	svc          complexservice.Service
+	statusErrors bool
+}
+
+// NewComplexGrpcServer returns a ComplexServer backed by svc, reporting
+// errors like NewGrpcServer does.
+func NewComplexGrpcServer(svc complexservice.Service, statusErrors bool) complexGrpcServer {
+	return complexGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// complexOpReply returns the reply to a call that computed v, or failed with
+// err.
+func (s *complexGrpcServer) complexOpReply(v complex128, err error) (*pb.ComplexOpReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.ComplexOpReply{
+		V:    complexservice.Proto(v),
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+// mathOpReply is complexOpReply for the methods returning a real number.
+func (s *complexGrpcServer) mathOpReply(v float64, err error) (*pb.MathOpReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.MathOpReply{
+		V:    v,
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+type complexHttpServer struct {
+	logger *zap.Logger
+	router *mux.Router
+	svc    complexservice.Service
+}
+
+// NewComplexHttpRouter returns a router serving the methods of svc at their
+// lower-cased names under /complex/, e.g. /complex/divide. It's meant to be
+// mounted next to the router returned by NewHttpRouter.
+func NewComplexHttpRouter(svc complexservice.Service, logger *zap.Logger) *mux.Router {
+	s := complexHttpServer{
+		logger: logger,
+		router: mux.NewRouter(),
+		svc:    svc,
+	}
+	s.routes()
+	return s.router
+}
+
+func (s *complexHttpServer) routes() {
+	s.logger.Debug("setting up complex handlers")
+	r := s.router.Methods("POST").PathPrefix("/complex").Subrouter()
+	r.Path("/sum").HandlerFunc(complexOpHandlerFunc(s.svc.Sum))
+	r.Path("/subtract").HandlerFunc(complexOpHandlerFunc(s.svc.Subtract))
+	r.Path("/multiply").HandlerFunc(complexOpHandlerFunc(s.svc.Multiply))
+	r.Path("/divide").HandlerFunc(complexOpHandlerFunc(s.svc.Divide))
+	r.Path("/pow").HandlerFunc(complexOpHandlerFunc(s.svc.Pow))
+	r.Path("/abs").HandlerFunc(complexRealHandlerFunc(s.svc.Abs))
+	r.Path("/phase").HandlerFunc(complexRealHandlerFunc(s.svc.Phase))
+	r.Path("/conj").HandlerFunc(complexUnaryHandlerFunc(s.svc.Conj))
+	r.Path("/sqrt").HandlerFunc(complexUnaryHandlerFunc(s.svc.Sqrt))
+}
+
+// ComplexOpRequest collects the request parameters for the methods of the
+// Complex service, e.g. {"a":{"real":1,"imag":2},"b":{"real":0,"imag":1}}.
+// B is only used by the methods taking a pair of operands.
+type ComplexOpRequest struct {
+	A jsonfloat.Complex128 `json:"a"`
+	B jsonfloat.Complex128 `json:"b"`
+}
+
+// ComplexOpResponse collects the response values for the methods of the
+// Complex service returning a complex number. Abs and Phase respond with a
+// MathOpResponse.
+type ComplexOpResponse struct {
+	V jsonfloat.Complex128 `json:"v"`
+}
+
+// complexOpHandlerFunc serves a method computing op on a pair of operands.
+func complexOpHandlerFunc(op func(ctx context.Context, a, b complex128) (complex128, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ComplexOpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := op(r.Context(), complex128(req.A), complex128(req.B))
+		writeComplexResponse(w, r, v, err)
+	}
+}
+
+// complexUnaryHandlerFunc serves a method computing op on a single operand.
+func complexUnaryHandlerFunc(op func(ctx context.Context, a complex128) (complex128, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ComplexOpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := op(r.Context(), complex128(req.A))
+		writeComplexResponse(w, r, v, err)
+	}
+}
+
+// complexRealHandlerFunc serves a method computing a real number from a
+// single operand.
+func complexRealHandlerFunc(op func(ctx context.Context, a complex128) (float64, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ComplexOpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := op(r.Context(), complex128(req.A))
+		writeResponse(w, r, v, "", err)
+	}
+}
+
+func writeComplexResponse(w http.ResponseWriter, r *http.Request, v complex128, err error) {
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	js, err := json.Marshal(ComplexOpResponse{V: jsonfloat.Complex128(v)})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(js)
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/financeservice"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"go.uber.org/zap"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service Finance -type financeGrpcServer -o finance_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.FinanceServer = &financeGrpcServer{}
+)
+
+type financeGrpcServer struct {
+	svc          financeservice.Service
+	statusErrors bool
+}
+
+// NewFinanceGrpcServer returns a FinanceServer backed by svc, reporting errors
+// like NewGrpcServer does.
+func NewFinanceGrpcServer(svc financeservice.Service, statusErrors bool) financeGrpcServer {
+	return financeGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// AmortizationSchedule streams one row per period of a loan
+func (s *financeGrpcServer) AmortizationSchedule(req *pb.AmortizationRequest, stream pb.Finance_AmortizationScheduleServer) error {
+	var sendErr error
+	err := s.svc.AmortizationSchedule(stream.Context(), financeservice.LoanFromProto(req), func(row financeservice.AmortizationRow) error {
+		sendErr = stream.Send(row.Proto())
+		return sendErr
+	})
+	switch {
+	case err == nil:
+		return nil
+	case sendErr != nil:
+		return sendErr
+	case s.statusErrors:
+		return rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return stream.Send(&pb.AmortizationRow{Err: err2str(err), Code: rpcstatus.Code(err)})
+}
+
+// decimalReply returns the reply to a call that computed v, or failed with err.
+func (s *financeGrpcServer) decimalReply(v financeservice.Decimal, err error) (*pb.DecimalReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.DecimalReply{
+		V:    string(v),
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+type financeHttpServer struct {
+	logger *zap.Logger
+	router *mux.Router
+	svc    financeservice.Service
+}
+
+// NewFinanceHttpRouter returns a router serving the methods of svc at their
+// lower-cased names under /finance/, e.g. /finance/npv. The requests are the
+// JSON encodings of the financeservice types, the whole schedule is returned
+// at once.
+func NewFinanceHttpRouter(svc financeservice.Service, logger *zap.Logger) *mux.Router {
+	s := financeHttpServer{
+		logger: logger,
+		router: mux.NewRouter(),
+		svc:    svc,
+	}
+	s.routes()
+	return s.router
+}
+
+func (s *financeHttpServer) routes() {
+	s.logger.Debug("setting up finance handlers")
+	r := s.router.Methods("POST").PathPrefix("/finance").Subrouter()
+	r.Path("/npv").HandlerFunc(cashFlowHandlerFunc(s.svc.NPV))
+	r.Path("/irr").HandlerFunc(cashFlowHandlerFunc(s.svc.IRR))
+	r.Path("/pmt").HandlerFunc(timeValueHandlerFunc(s.svc.PMT))
+	r.Path("/fv").HandlerFunc(timeValueHandlerFunc(s.svc.FV))
+	r.Path("/pv").HandlerFunc(timeValueHandlerFunc(s.svc.PV))
+	r.Path("/compoundinterest").HandlerFunc(s.compoundInterest)
+	r.Path("/amortizationschedule").HandlerFunc(s.amortizationSchedule)
+}
+
+// DecimalResponse collects the response values for the methods of the
+// Finance service returning a single number, e.g. {"v":"-1199.10"}.
+type DecimalResponse struct {
+	V financeservice.Decimal `json:"v"`
+}
+
+// AmortizationResponse collects the response values for AmortizationSchedule.
+type AmortizationResponse struct {
+	V []financeservice.AmortizationRow `json:"v"`
+}
+
+// cashFlowHandlerFunc serves a method computing op on a series of cash flows.
+func cashFlowHandlerFunc(op func(ctx context.Context, c financeservice.CashFlows) (financeservice.Decimal, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req financeservice.CashFlows
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := op(r.Context(), req)
+		writeJSON(w, r, DecimalResponse{V: v}, err)
+	}
+}
+
+// timeValueHandlerFunc serves a method computing op on the time value of
+// money.
+func timeValueHandlerFunc(op func(ctx context.Context, tv financeservice.TimeValue) (financeservice.Decimal, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req financeservice.TimeValue
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := op(r.Context(), req)
+		writeJSON(w, r, DecimalResponse{V: v}, err)
+	}
+}
+
+func (s *financeHttpServer) compoundInterest(w http.ResponseWriter, r *http.Request) {
+	var req financeservice.Compounding
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, problem.Malformed(err))
+		return
+	}
+
+	v, err := s.svc.CompoundInterest(r.Context(), req)
+	writeJSON(w, r, DecimalResponse{V: v}, err)
+}
+
+func (s *financeHttpServer) amortizationSchedule(w http.ResponseWriter, r *http.Request) {
+	var req financeservice.Loan
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, problem.Malformed(err))
+		return
+	}
+
+	var rows []financeservice.AmortizationRow
+	err := s.svc.AmortizationSchedule(r.Context(), req, func(row financeservice.AmortizationRow) error {
+		rows = append(rows, row)
+		return nil
+	})
+	writeJSON(w, r, AmortizationResponse{V: rows}, err)
+}
@@ -1,10 +1,15 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"github.com/gorilla/mux"
-	mathservice2 "github.com/jwenz723/mathserver/grpc_and_http/std/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/expr"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
 	"go.uber.org/zap"
 	"net/http"
 )
@@ -25,49 +30,109 @@ func NewHttpRouter(svc mathservice2.Service, logger *zap.Logger) *mux.Router {
 	return s.router
 }
 
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind http -o http_gen.go
+
 func (s *httpServer) routes() {
-	s.router.Methods("POST").PathPrefix("/").HandlerFunc(s.mathOpHandlerFunc())
+	s.logger.Debug("setting up math handlers")
+	s.router.Methods("POST").Path("/evaluate").HandlerFunc(s.evaluateHandlerFunc())
+	s.router.Methods("POST").Path("/batch").HandlerFunc(s.batchHandlerFunc())
+	s.operationRoutes()
 }
 
-// MathOpRequest collects the request parameters for the math methods.
+// MathOpRequest collects the request parameters for the math methods. The
+// Division is only used by IntDivide and Remainder.
 type MathOpRequest struct {
-	A, B float64
+	A, B      float64
+	Precision precision.Precision   `json:"precision"`
+	Division  mathservice2.Division `json:"division"`
 }
 
-// MathOpResponse collects the response values for the math methods.
+// MathOpResponse collects the response values for the math methods, V may be
+// NaN or infinite. Errors are reported with a problem details response
+// instead.
 type MathOpResponse struct {
-	V   float64 `json:"v"`
-	Err error   `json:"-"`
+	V     jsonfloat.Float64 `json:"v"`
+	Exact string            `json:"exact,omitempty"`
+	Err   error             `json:"-"`
+}
+
+// MathListRequest collects the request parameters for the math methods that
+// operate on a list of values.
+type MathListRequest struct {
+	Values    []float64           `json:"values"`
+	Precision precision.Precision `json:"precision"`
+}
+
+// UnaryOpRequest collects the request parameters for the math methods that
+// take a single operand.
+type UnaryOpRequest struct {
+	X         float64             `json:"x"`
+	Precision precision.Precision `json:"precision"`
+}
+
+// EvaluateRequest collects the request parameters for the Evaluate method.
+type EvaluateRequest struct {
+	Expression string              `json:"expression"`
+	Precision  precision.Precision `json:"precision"`
 }
 
-func (s *httpServer) mathOpHandlerFunc() http.HandlerFunc {
-	fmt.Println("setting up math handler")
+// mathOpHandlerFunc serves an operation computing op on a pair of operands.
+func (s *httpServer) mathOpHandlerFunc(op func(ctx context.Context, a, b float64) (float64, error)) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		req, err := decodeRequest(r)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		ctx, res := precision.NewContext(r.Context(), req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := op(ctx, req.A, req.B)
+		writeResponse(w, r, v, res.String(), err)
+	}
+}
+
+// mathListHandlerFunc serves an operation computing op on a list of values.
+func (s *httpServer) mathListHandlerFunc(op func(ctx context.Context, values []float64) (float64, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MathListRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		ctx, res := precision.NewContext(r.Context(), req.Precision)
+		v, err := op(ctx, req.Values)
+		writeResponse(w, r, v, res.String(), err)
+	}
+}
+
+// unaryOpHandlerFunc serves an operation computing op on a single operand.
+func (s *httpServer) unaryOpHandlerFunc(op func(ctx context.Context, x float64) (float64, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UnaryOpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
 			return
 		}
 
-		var v float64
-		switch r.URL.Path {
-		case "/divide":
-			v, err = s.svc.Divide(r.Context(), req.A, req.B)
-		case "/max":
-			v, err = s.svc.Max(r.Context(), req.A, req.B)
-		case "/min":
-			v, err = s.svc.Min(r.Context(), req.A, req.B)
-		case "/multiply":
-			v, err = s.svc.Multiply(r.Context(), req.A, req.B)
-		case "/pow":
-			v, err = s.svc.Pow(r.Context(), req.A, req.B)
-		case "/subtract":
-			v, err = s.svc.Subtract(r.Context(), req.A, req.B)
-		case "/sum":
-			v, err = s.svc.Sum(r.Context(), req.A, req.B)
+		ctx, res := precision.NewContext(r.Context(), req.Precision)
+		v, err := op(ctx, req.X)
+		writeResponse(w, r, v, res.String(), err)
+	}
+}
+
+func (s *httpServer) evaluateHandlerFunc() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req EvaluateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
 		}
 
-		writeResponse(w, v, err)
+		ctx, res := precision.NewContext(r.Context(), req.Precision)
+		v, err := expr.Evaluate(ctx, s.svc, req.Expression)
+		writeResponse(w, r, v, res.String(), err)
 	}
 }
 
@@ -77,18 +142,28 @@ func decodeRequest(r *http.Request) (MathOpRequest, error) {
 	return req, err
 }
 
-func writeResponse(w http.ResponseWriter, v float64, err error) {
+func writeResponse(w http.ResponseWriter, r *http.Request, v float64, exact string, err error) {
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
 	resp := MathOpResponse{
-		V:   v,
-		Err: err,
+		V:     jsonfloat.Float64(v),
+		Exact: exact,
 	}
 
 	js, err := json.Marshal(resp)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, r, err)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.Write(js)
 }
+
+// writeError writes a problem details response describing err.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	problem.New(rpcstatus.Code(err), err, r.URL.Path).Write(w)
+}
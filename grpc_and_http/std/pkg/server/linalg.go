@@ -0,0 +1,254 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+	"github.com/jwenz723/mathserver/pkg/linalgservice"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"go.uber.org/zap"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service LinearAlgebra -type linalgGrpcServer -o linalg_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.LinearAlgebraServer = &linalgGrpcServer{}
+)
+
+type linalgGrpcServer struct {
+	svc          linalgservice.Service
+	statusErrors bool
+}
+
+// NewLinearAlgebraGrpcServer returns a LinearAlgebraServer backed by svc,
+// reporting errors like NewGrpcServer does.
+func NewLinearAlgebraGrpcServer(svc linalgservice.Service, statusErrors bool) linalgGrpcServer {
+	return linalgGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// mathOpReply returns the reply to a call that computed the number v, or
+// failed with err.
+func (s *linalgGrpcServer) mathOpReply(v float64, err error) (*pb.MathOpReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.MathOpReply{
+		V:    v,
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+// vectorReply is mathOpReply for the methods returning a vector.
+func (s *linalgGrpcServer) vectorReply(v []float64, err error) (*pb.VectorReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.VectorReply{
+		V:    v,
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+// matrixReply is mathOpReply for the methods returning a matrix.
+func (s *linalgGrpcServer) matrixReply(v linalgservice.Matrix, err error) (*pb.MatrixReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.MatrixReply{
+		V:    v.Proto(),
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+type linalgHttpServer struct {
+	logger *zap.Logger
+	router *mux.Router
+	svc    linalgservice.Service
+}
+
+// NewLinearAlgebraHttpRouter returns a router serving the methods of svc at
+// their lower-cased names under /linearalgebra/, e.g. /linearalgebra/solve.
+// It's meant to be mounted next to the router returned by NewHttpRouter.
+func NewLinearAlgebraHttpRouter(svc linalgservice.Service, logger *zap.Logger) *mux.Router {
+	s := linalgHttpServer{
+		logger: logger,
+		router: mux.NewRouter(),
+		svc:    svc,
+	}
+	s.routes()
+	return s.router
+}
+
+func (s *linalgHttpServer) routes() {
+	s.logger.Debug("setting up linear algebra handlers")
+	r := s.router.Methods("POST").PathPrefix("/linearalgebra").Subrouter()
+	r.Path("/dot").HandlerFunc(s.dotHandlerFunc())
+	r.Path("/cross").HandlerFunc(s.crossHandlerFunc())
+	r.Path("/norm").HandlerFunc(s.normHandlerFunc())
+	r.Path("/add").HandlerFunc(matrixOpHandlerFunc(s.svc.Add))
+	r.Path("/multiply").HandlerFunc(matrixOpHandlerFunc(s.svc.Multiply))
+	r.Path("/transpose").HandlerFunc(matrixUnaryHandlerFunc(s.svc.Transpose))
+	r.Path("/determinant").HandlerFunc(s.determinantHandlerFunc())
+	r.Path("/inverse").HandlerFunc(matrixUnaryHandlerFunc(s.svc.Inverse))
+	r.Path("/solve").HandlerFunc(s.solveHandlerFunc())
+}
+
+// VectorOpRequest collects the request parameters for the vector methods of
+// the LinearAlgebra service, e.g. {"a":[1,2,3],"b":[4,5,6]}. Norm only uses
+// A.
+type VectorOpRequest struct {
+	A jsonfloat.Slice `json:"a"`
+	B jsonfloat.Slice `json:"b"`
+}
+
+// MatrixOpRequest collects the request parameters for the matrix methods of
+// the LinearAlgebra service, e.g. {"a":{"rows":2,"cols":2,"values":[1,2,3,4]}}.
+// Transpose, Determinant and Inverse only use A.
+type MatrixOpRequest struct {
+	A linalgservice.Matrix `json:"a"`
+	B linalgservice.Matrix `json:"b"`
+}
+
+// SolveRequest collects the request parameters for the Solve method.
+type SolveRequest struct {
+	A linalgservice.Matrix `json:"a"`
+	B jsonfloat.Slice      `json:"b"`
+}
+
+// VectorResponse collects the response values for the methods of the
+// LinearAlgebra service returning a vector. The methods returning a number
+// respond with a MathOpResponse.
+type VectorResponse struct {
+	V jsonfloat.Slice `json:"v"`
+}
+
+// MatrixResponse collects the response values for the methods of the
+// LinearAlgebra service returning a matrix.
+type MatrixResponse struct {
+	V linalgservice.Matrix `json:"v"`
+}
+
+func (s *linalgHttpServer) dotHandlerFunc() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req VectorOpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := s.svc.Dot(r.Context(), req.A, req.B)
+		writeResponse(w, r, v, "", err)
+	}
+}
+
+func (s *linalgHttpServer) crossHandlerFunc() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req VectorOpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := s.svc.Cross(r.Context(), req.A, req.B)
+		writeJSON(w, r, VectorResponse{V: v}, err)
+	}
+}
+
+func (s *linalgHttpServer) normHandlerFunc() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req VectorOpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := s.svc.Norm(r.Context(), req.A)
+		writeResponse(w, r, v, "", err)
+	}
+}
+
+func (s *linalgHttpServer) determinantHandlerFunc() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MatrixOpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := s.svc.Determinant(r.Context(), req.A)
+		writeResponse(w, r, v, "", err)
+	}
+}
+
+func (s *linalgHttpServer) solveHandlerFunc() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SolveRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := s.svc.Solve(r.Context(), req.A, req.B)
+		writeJSON(w, r, VectorResponse{V: v}, err)
+	}
+}
+
+// matrixOpHandlerFunc serves a method computing a matrix from a pair of
+// matrices.
+func matrixOpHandlerFunc(op func(ctx context.Context, a, b linalgservice.Matrix) (linalgservice.Matrix, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MatrixOpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := op(r.Context(), req.A, req.B)
+		writeJSON(w, r, MatrixResponse{V: v}, err)
+	}
+}
+
+// matrixUnaryHandlerFunc serves a method computing a matrix from a single
+// matrix.
+func matrixUnaryHandlerFunc(op func(ctx context.Context, a linalgservice.Matrix) (linalgservice.Matrix, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MatrixOpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := op(r.Context(), req.A)
+		writeJSON(w, r, MatrixResponse{V: v}, err)
+	}
+}
+
+// writeJSON writes resp as the response to a call, or a problem details
+// response if it failed with err.
+func writeJSON(w http.ResponseWriter, r *http.Request, resp interface{}, err error) {
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	js, err := json.Marshal(resp)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(js)
+}
@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/numtheoryservice"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"go.uber.org/zap"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service NumberTheory -type numberTheoryGrpcServer -o numtheory_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.NumberTheoryServer = &numberTheoryGrpcServer{}
+)
+
+type numberTheoryGrpcServer struct {
+	svc          numtheoryservice.Service
+	statusErrors bool
+}
+
+// NewNumberTheoryGrpcServer returns a NumberTheoryServer backed by svc,
+// reporting errors like NewGrpcServer does.
+func NewNumberTheoryGrpcServer(svc numtheoryservice.Service, statusErrors bool) numberTheoryGrpcServer {
+	return numberTheoryGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// integerReply returns the reply to a call that computed v, or failed with err.
+func (s *numberTheoryGrpcServer) integerReply(v numtheoryservice.Integer, err error) (*pb.IntegerReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.IntegerReply{
+		V:    string(v),
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+type numberTheoryHttpServer struct {
+	logger *zap.Logger
+	router *mux.Router
+	svc    numtheoryservice.Service
+}
+
+// NewNumberTheoryHttpRouter returns a router serving the methods of svc at
+// their lower-cased names under /numbertheory/, e.g. /numbertheory/isprime.
+// The requests are the JSON encodings of the numtheoryservice types.
+func NewNumberTheoryHttpRouter(svc numtheoryservice.Service, logger *zap.Logger) *mux.Router {
+	s := numberTheoryHttpServer{
+		logger: logger,
+		router: mux.NewRouter(),
+		svc:    svc,
+	}
+	s.routes()
+	return s.router
+}
+
+func (s *numberTheoryHttpServer) routes() {
+	s.logger.Debug("setting up number theory handlers")
+	r := s.router.Methods("POST").PathPrefix("/numbertheory").Subrouter()
+	r.Path("/isprime").HandlerFunc(s.isPrime)
+	r.Path("/factorize").HandlerFunc(s.factorize)
+	r.Path("/modpow").HandlerFunc(s.modPow)
+	r.Path("/modinverse").HandlerFunc(s.modInverse)
+	r.Path("/eulerphi").HandlerFunc(operandHandlerFunc(s.svc.EulerPhi))
+	r.Path("/nextprime").HandlerFunc(operandHandlerFunc(s.svc.NextPrime))
+}
+
+// IntegerResponse collects the response values for the methods of the
+// NumberTheory service returning an integer, e.g. {"v":"445"}.
+type IntegerResponse struct {
+	V numtheoryservice.Integer `json:"v"`
+}
+
+// PrimalityResponse collects the response values for IsPrime, e.g.
+// {"v":{"prime":true,"probable":false}}.
+type PrimalityResponse struct {
+	V numtheoryservice.Primality `json:"v"`
+}
+
+// FactorsResponse collects the response values for Factorize, e.g.
+// {"v":[{"prime":"2","exponent":3},{"prime":"3","exponent":1}]}.
+type FactorsResponse struct {
+	V []numtheoryservice.Factor `json:"v"`
+}
+
+// operandHandlerFunc serves a method computing an integer from an operand.
+func operandHandlerFunc(op func(ctx context.Context, o numtheoryservice.Operand) (numtheoryservice.Integer, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req numtheoryservice.Operand
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := op(r.Context(), req)
+		writeJSON(w, r, IntegerResponse{V: v}, err)
+	}
+}
+
+func (s *numberTheoryHttpServer) isPrime(w http.ResponseWriter, r *http.Request) {
+	var req numtheoryservice.Operand
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, problem.Malformed(err))
+		return
+	}
+
+	p, err := s.svc.IsPrime(r.Context(), req)
+	writeJSON(w, r, PrimalityResponse{V: p}, err)
+}
+
+func (s *numberTheoryHttpServer) factorize(w http.ResponseWriter, r *http.Request) {
+	var req numtheoryservice.Operand
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, problem.Malformed(err))
+		return
+	}
+
+	factors, err := s.svc.Factorize(r.Context(), req)
+	writeJSON(w, r, FactorsResponse{V: factors}, err)
+}
+
+func (s *numberTheoryHttpServer) modPow(w http.ResponseWriter, r *http.Request) {
+	var req numtheoryservice.ModPowOperands
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, problem.Malformed(err))
+		return
+	}
+
+	v, err := s.svc.ModPow(r.Context(), req)
+	writeJSON(w, r, IntegerResponse{V: v}, err)
+}
+
+func (s *numberTheoryHttpServer) modInverse(w http.ResponseWriter, r *http.Request) {
+	var req numtheoryservice.ModInverseOperands
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, problem.Malformed(err))
+		return
+	}
+
+	v, err := s.svc.ModInverse(r.Context(), req)
+	writeJSON(w, r, IntegerResponse{V: v}, err)
+}
+
+// primalityReply is integerReply for IsPrime.
+func (s *numberTheoryGrpcServer) primalityReply(p numtheoryservice.Primality, err error) (*pb.PrimalityReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.PrimalityReply{
+		Prime:    p.Prime,
+		Probable: p.Probable,
+		Err:      err2str(err),
+		Code:     rpcstatus.Code(err),
+	}, nil
+}
+
+// factorsReply is integerReply for Factorize.
+func (s *numberTheoryGrpcServer) factorsReply(factors []numtheoryservice.Factor, err error) (*pb.FactorsReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.FactorsReply{
+		Factors: numtheoryservice.FactorsProto(factors),
+		Err:     err2str(err),
+		Code:    rpcstatus.Code(err),
+	}, nil
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"github.com/jwenz723/mathserver/pkg/symbolicservice"
+	"go.uber.org/zap"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service Symbolic -type symbolicGrpcServer -o symbolic_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.SymbolicServer = &symbolicGrpcServer{}
+)
+
+type symbolicGrpcServer struct {
+	svc          symbolicservice.Service
+	statusErrors bool
+}
+
+// NewSymbolicGrpcServer returns a SymbolicServer backed by svc, reporting
+// errors like NewGrpcServer does.
+func NewSymbolicGrpcServer(svc symbolicservice.Service, statusErrors bool) symbolicGrpcServer {
+	return symbolicGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// symbolicReply returns the reply to a call that computed r, or failed with
+// err.
+func (s *symbolicGrpcServer) symbolicReply(r symbolicservice.Result, err error) (*pb.SymbolicReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	reply := r.Proto()
+	reply.Err, reply.Code = err2str(err), rpcstatus.Code(err)
+	return reply, nil
+}
+
+type symbolicHttpServer struct {
+	logger *zap.Logger
+	router *mux.Router
+	svc    symbolicservice.Service
+}
+
+// NewSymbolicHttpRouter returns a router serving the methods of svc at their
+// lower-cased names under /symbolic/, e.g. /symbolic/simplify. The requests
+// are the JSON encodings of symbolicservice.Request, the responses those of
+// symbolicservice.Result.
+func NewSymbolicHttpRouter(svc symbolicservice.Service, logger *zap.Logger) *mux.Router {
+	s := symbolicHttpServer{
+		logger: logger,
+		router: mux.NewRouter(),
+		svc:    svc,
+	}
+	s.routes()
+	return s.router
+}
+
+func (s *symbolicHttpServer) routes() {
+	s.logger.Debug("setting up symbolic handlers")
+	r := s.router.Methods("POST").PathPrefix("/symbolic").Subrouter()
+	r.Path("/format").HandlerFunc(symbolicHandlerFunc(s.svc.Format))
+	r.Path("/simplify").HandlerFunc(symbolicHandlerFunc(s.svc.Simplify))
+	r.Path("/differentiate").HandlerFunc(symbolicHandlerFunc(s.svc.Differentiate))
+}
+
+// symbolicHandlerFunc serves a method of the Symbolic service.
+func symbolicHandlerFunc(op func(ctx context.Context, r symbolicservice.Request) (symbolicservice.Result, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req symbolicservice.Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := op(r.Context(), req)
+		writeJSON(w, r, v, err)
+	}
+}
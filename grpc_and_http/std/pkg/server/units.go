@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
+	"go.uber.org/zap"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service Units -type unitsGrpcServer -o units_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.UnitsServer = &unitsGrpcServer{}
+)
+
+type unitsGrpcServer struct {
+	svc          unitservice.Service
+	statusErrors bool
+}
+
+// NewUnitsGrpcServer returns a UnitsServer backed by svc, reporting errors
+// like NewGrpcServer does.
+func NewUnitsGrpcServer(svc unitservice.Service, statusErrors bool) unitsGrpcServer {
+	return unitsGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// quantityReply returns the reply to a call that computed v, or failed with
+// err.
+func (s *unitsGrpcServer) quantityReply(v unitservice.Quantity, err error) (*pb.QuantityReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.QuantityReply{
+		V:    v.Proto(),
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+type unitsHttpServer struct {
+	logger *zap.Logger
+	router *mux.Router
+	svc    unitservice.Service
+}
+
+// NewUnitsHttpRouter returns a router serving the methods of svc at their
+// lower-cased names under /units/, e.g. /units/convert. It's meant to be
+// mounted next to the router returned by NewHttpRouter.
+func NewUnitsHttpRouter(svc unitservice.Service, logger *zap.Logger) *mux.Router {
+	s := unitsHttpServer{
+		logger: logger,
+		router: mux.NewRouter(),
+		svc:    svc,
+	}
+	s.routes()
+	return s.router
+}
+
+func (s *unitsHttpServer) routes() {
+	s.logger.Debug("setting up units handlers")
+	r := s.router.Methods("POST").PathPrefix("/units").Subrouter()
+	r.Path("/sum").HandlerFunc(quantityOpHandlerFunc(s.svc.Sum))
+	r.Path("/subtract").HandlerFunc(quantityOpHandlerFunc(s.svc.Subtract))
+	r.Path("/multiply").HandlerFunc(quantityOpHandlerFunc(s.svc.Multiply))
+	r.Path("/divide").HandlerFunc(quantityOpHandlerFunc(s.svc.Divide))
+	r.Path("/pow").HandlerFunc(s.pow)
+	r.Path("/convert").HandlerFunc(s.convert)
+}
+
+// QuantityOpRequest collects the request parameters for the methods of the
+// Units service taking a pair of quantities, e.g.
+// {"a":{"value":1,"unit":"km"},"b":{"value":500,"unit":"m"}}.
+type QuantityOpRequest struct {
+	A unitservice.Quantity `json:"a"`
+	B unitservice.Quantity `json:"b"`
+}
+
+// QuantityPowRequest collects the request parameters for Pow, e.g.
+// {"a":{"value":3,"unit":"m"},"b":2}.
+type QuantityPowRequest struct {
+	A unitservice.Quantity `json:"a"`
+	B float64              `json:"b"`
+}
+
+// ConvertRequest collects the request parameters for Convert, e.g.
+// {"a":{"value":1,"unit":"mi"},"unit":"km"}.
+type ConvertRequest struct {
+	A    unitservice.Quantity `json:"a"`
+	Unit string               `json:"unit"`
+}
+
+// QuantityResponse collects the response values for the methods of the Units
+// service.
+type QuantityResponse struct {
+	V unitservice.Quantity `json:"v"`
+}
+
+// quantityOpHandlerFunc serves a method computing op on a pair of quantities.
+func quantityOpHandlerFunc(op func(ctx context.Context, a, b unitservice.Quantity) (unitservice.Quantity, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req QuantityOpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, r, problem.Malformed(err))
+			return
+		}
+
+		v, err := op(r.Context(), req.A, req.B)
+		writeJSON(w, r, QuantityResponse{V: v}, err)
+	}
+}
+
+func (s *unitsHttpServer) pow(w http.ResponseWriter, r *http.Request) {
+	var req QuantityPowRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, problem.Malformed(err))
+		return
+	}
+
+	v, err := s.svc.Pow(r.Context(), req.A, req.B)
+	writeJSON(w, r, QuantityResponse{V: v}, err)
+}
+
+func (s *unitsHttpServer) convert(w http.ResponseWriter, r *http.Request) {
+	var req ConvertRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, r, problem.Malformed(err))
+		return
+	}
+
+	v, err := s.svc.Convert(r.Context(), req.A, req.Unit)
+	writeJSON(w, r, QuantityResponse{V: v}, err)
+}
@@ -6,10 +6,15 @@ import (
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/metrics/prometheus"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
-	"github.com/jwenz723/mathserver/grpc_only/gokit/pkg/mathendpoint"
-	"github.com/jwenz723/mathserver/grpc_only/gokit/pkg/mathservice"
 	"github.com/jwenz723/mathserver/grpc_only/gokit/pkg/mathtransport"
 	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+	"github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/gokit/mathservice"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/statsservice"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
 	"github.com/oklog/oklog/pkg/group"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -27,9 +32,23 @@ func main() {
 	var (
 		debugAddr      = fs.String("debug.addr", ":8080", "Debug and metrics listen address")
 		grpcAddr       = fs.String("grpc-addr", ":8082", "gRPC listen address")
+		statusErrors   = fs.Bool("grpc-status-errors", false, "Report service errors as gRPC status codes instead of in the reply")
+		unitsFile      = fs.String("units", "", "File defining units besides the built-in ones, one per line such as: kn = 1 nmi/h")
+		maxDigits      = fs.Int("max-digits", combinatoricsservice.DefaultMaxDigits, "Most decimal digits of the exact results of the Combinatorics service")
+		statsMaxValues = fs.Int("stats-max-values", statsservice.DefaultMaxValues, "Most values of each series a stream of the Statistics service may carry")
 	)
+	defaultPrecision := precision.Precision{Mode: precision.Float64}
+	fs.Var(&defaultPrecision.Mode, "precision", "Default precision mode: float64, bigfloat or rational")
+	fs.UintVar(&defaultPrecision.Bits, "precision-bits", precision.DefaultBits, "Default mantissa bits used by the bigfloat precision mode")
+	var nonFinite mathservice2.NonFinitePolicy
+	fs.Var(&nonFinite, "non-finite", "Policy for NaN and infinite results of the Math service: pass, reject or string")
 	fs.Usage = usageFor(fs, os.Args[0]+" [flags]")
 	fs.Parse(os.Args[1:])
+	if defaultPrecision.Bits > precision.MaxBits {
+		fmt.Fprintf(os.Stderr, "invalid value %d for flag -precision-bits: at most %d bits are supported\n", defaultPrecision.Bits, precision.MaxBits)
+		fs.Usage()
+		os.Exit(2)
+	}
 
 	var logger log.Logger
 	{
@@ -45,10 +64,34 @@ func main() {
 		Help:      "Request duration in seconds.",
 	}, []string{"method", "success"})
 
+	units := unitservice.NewRegistry()
+	if *unitsFile != "" {
+		if err := units.LoadFile(*unitsFile); err != nil {
+			logger.Log("during", "LoadUnits", "err", err)
+			os.Exit(1)
+		}
+	}
+
 	var (
-		service        = mathservice.New(duration, logger)
-		endpoints      = mathendpoint.New(service, logger)
-		grpcServer     = mathtransport.NewGRPCServer(endpoints, logger)
+		service          = mathservice.New(duration, logger, defaultPrecision, nonFinite)
+		endpoints        = mathendpoint.New(service, logger)
+		grpcServer       = mathtransport.NewGRPCServer(endpoints, logger, *statusErrors)
+		statsEndpoints   = mathendpoint.NewStatistics(mathservice.NewStatistics(duration, logger))
+		statsServer      = mathtransport.NewStatisticsGRPCServer(statsEndpoints, logger, *statusErrors, *statsMaxValues)
+		unitsEndpoints   = mathendpoint.NewUnits(mathservice.NewUnits(duration, logger, units))
+		unitsServer      = mathtransport.NewUnitsGRPCServer(unitsEndpoints, logger, *statusErrors)
+		financeEndpoints = mathendpoint.NewFinance(mathservice.NewFinance(duration, logger))
+		financeServer    = mathtransport.NewFinanceGRPCServer(financeEndpoints, logger, *statusErrors)
+		ntEndpoints      = mathendpoint.NewNumberTheory(mathservice.NewNumberTheory(duration, logger))
+		ntServer         = mathtransport.NewNumberTheoryGRPCServer(ntEndpoints, logger, *statusErrors)
+		combEndpoints    = mathendpoint.NewCombinatorics(mathservice.NewCombinatorics(duration, logger, *maxDigits))
+		combServer       = mathtransport.NewCombinatoricsGRPCServer(combEndpoints, logger, *statusErrors)
+		calcEndpoints    = mathendpoint.NewCalculus(mathservice.NewCalculus(duration, logger))
+		calcServer       = mathtransport.NewCalculusGRPCServer(calcEndpoints, logger, *statusErrors)
+		complexEndpoints = mathendpoint.NewComplex(mathservice.NewComplex(duration, logger))
+		complexServer    = mathtransport.NewComplexGRPCServer(complexEndpoints, logger, *statusErrors)
+		linalgEndpoints  = mathendpoint.NewLinearAlgebra(mathservice.NewLinearAlgebra(duration, logger))
+		linalgServer     = mathtransport.NewLinearAlgebraGRPCServer(linalgEndpoints, logger, *statusErrors)
 	)
 
 	var g group.Group
@@ -79,6 +122,14 @@ func main() {
 			// the here demonstrated zipkin tracing middleware.
 			baseServer := grpc.NewServer(grpc.UnaryInterceptor(kitgrpc.Interceptor))
 			pb.RegisterMathServer(baseServer, grpcServer)
+			pb.RegisterStatisticsServer(baseServer, statsServer)
+			pb.RegisterUnitsServer(baseServer, unitsServer)
+			pb.RegisterFinanceServer(baseServer, financeServer)
+			pb.RegisterNumberTheoryServer(baseServer, ntServer)
+			pb.RegisterCombinatoricsServer(baseServer, combServer)
+			pb.RegisterCalculusServer(baseServer, calcServer)
+			pb.RegisterComplexServer(baseServer, complexServer)
+			pb.RegisterLinearAlgebraServer(baseServer, linalgServer)
 			return baseServer.Serve(grpcListener)
 		}, func(error) {
 			grpcListener.Close()
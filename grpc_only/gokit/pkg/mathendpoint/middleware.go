@@ -1,22 +0,0 @@
-package mathendpoint
-
-import (
-	"context"
-	"fmt"
-	"time"
-
-	"github.com/go-kit/kit/endpoint"
-	"github.com/go-kit/kit/metrics"
-)
-
-// InstrumentingMiddleware adds prometheus metrics for each endpoint invocation
-func InstrumentingMiddleware(duration metrics.Histogram) endpoint.Middleware {
-	return func(next endpoint.Endpoint) endpoint.Endpoint {
-		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-			defer func(begin time.Time) {
-				duration.With("success", fmt.Sprint(err == nil)).Observe(time.Since(begin).Seconds())
-			}(time.Now())
-			return next(ctx, request)
-		}
-	}
-}
\ No newline at end of file
@@ -0,0 +1,98 @@
+package mathtransport
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/calculusservice"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service Calculus -type calculusGRPCServer -o calculus_gen.go
+
+// NewCalculusGRPCServer makes a set of endpoints available as a gRPC
+// CalculusServer, reporting errors like NewGRPCServer does.
+func NewCalculusGRPCServer(endpoints mathendpoint2.CalculusSet, logger log.Logger, statusErrors bool) pb.CalculusServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeResponse := encodeGRPCCalculusResponse
+	if statusErrors {
+		encodeResponse = encodeGRPCCalculusStatusResponse
+	}
+
+	return &calculusGRPCServer{
+		integrate:     grpctransport.NewServer(endpoints.IntegrateEndpoint, decodeGRPCCalculusRequest, encodeResponse, options...),
+		differentiate: grpctransport.NewServer(endpoints.DifferentiateEndpoint, decodeGRPCCalculusRequest, encodeResponse, options...),
+		findRoot:      grpctransport.NewServer(endpoints.FindRootEndpoint, decodeGRPCCalculusRequest, encodeResponse, options...),
+		minimize:      grpctransport.NewServer(endpoints.MinimizeEndpoint, decodeGRPCCalculusRequest, encodeResponse, options...),
+	}
+}
+
+// NewCalculusGRPCClient returns a calculusservice.Service backed by a gRPC
+// server at the other end of the conn, see NewGRPCClient.
+func NewCalculusGRPCClient(conn *grpc.ClientConn, logger log.Logger) calculusservice.Service {
+	client := func(method string) endpoint.Endpoint {
+		return decodeGRPCStatusAs(grpctransport.NewClient(
+			conn,
+			"pb.Calculus",
+			method,
+			encodeGRPCCalculusRequest,
+			decodeGRPCCalculusResponse,
+			pb.CalculusReply{},
+		).Endpoint(), func(err error) interface{} {
+			return mathendpoint2.CalculusResponse{Err: err}
+		})
+	}
+
+	return mathendpoint2.CalculusSet{
+		IntegrateEndpoint:     client("Integrate"),
+		DifferentiateEndpoint: client("Differentiate"),
+		FindRootEndpoint:      client("FindRoot"),
+		MinimizeEndpoint:      client("Minimize"),
+	}
+}
+
+// decodeGRPCCalculusRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC Calculus request to a user-domain Problem. Primarily useful in a server.
+func decodeGRPCCalculusRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return calculusservice.ProblemFromProto(grpcReq.(*pb.CalculusRequest)), nil
+}
+
+// encodeGRPCCalculusRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain Problem to a gRPC Calculus request. Primarily useful in a client.
+func encodeGRPCCalculusRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return request.(calculusservice.Problem).Proto(), nil
+}
+
+// encodeGRPCCalculusResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Calculus response to a gRPC Calculus reply. Primarily useful in a server.
+func encodeGRPCCalculusResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.CalculusResponse)
+	reply := resp.Result.Proto()
+	reply.Err, reply.Code = err2str(resp.Err), rpcstatus.Code(resp.Err)
+	return reply, nil
+}
+
+// encodeGRPCCalculusStatusResponse is encodeGRPCMathOpStatusResponse for the
+// Calculus service. Primarily useful in a server.
+func encodeGRPCCalculusStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.CalculusResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCCalculusResponse(ctx, response)
+}
+
+// decodeGRPCCalculusResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Calculus reply to a user-domain Calculus response. Primarily useful in a client.
+func decodeGRPCCalculusResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.CalculusReply)
+	return mathendpoint2.CalculusResponse{Result: calculusservice.ResultFromProto(reply), Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
@@ -0,0 +1,94 @@
+package mathtransport
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service Combinatorics -type combinatoricsGRPCServer -o combinatorics_gen.go
+
+// NewCombinatoricsGRPCServer makes a set of endpoints available as a gRPC
+// CombinatoricsServer, reporting errors like NewGRPCServer does.
+func NewCombinatoricsGRPCServer(endpoints mathendpoint2.CombinatoricsSet, logger log.Logger, statusErrors bool) pb.CombinatoricsServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeResponse := encodeGRPCCombinatoricsResponse
+	if statusErrors {
+		encodeResponse = encodeGRPCCombinatoricsStatusResponse
+	}
+
+	return &combinatoricsGRPCServer{
+		factorial:    grpctransport.NewServer(endpoints.FactorialEndpoint, decodeGRPCCombinatoricsRequest, encodeResponse, options...),
+		binomial:     grpctransport.NewServer(endpoints.BinomialEndpoint, decodeGRPCCombinatoricsRequest, encodeResponse, options...),
+		permutations: grpctransport.NewServer(endpoints.PermutationsEndpoint, decodeGRPCCombinatoricsRequest, encodeResponse, options...),
+	}
+}
+
+// NewCombinatoricsGRPCClient returns a combinatoricsservice.Service backed by
+// a gRPC server at the other end of the conn, see NewGRPCClient.
+func NewCombinatoricsGRPCClient(conn *grpc.ClientConn, logger log.Logger) combinatoricsservice.Service {
+	client := func(method string) endpoint.Endpoint {
+		return decodeGRPCStatusAs(grpctransport.NewClient(
+			conn,
+			"pb.Combinatorics",
+			method,
+			encodeGRPCCombinatoricsRequest,
+			decodeGRPCCombinatoricsResponse,
+			pb.IntegerReply{},
+		).Endpoint(), func(err error) interface{} {
+			return mathendpoint2.CombinatoricsResponse{Err: err}
+		})
+	}
+
+	return mathendpoint2.CombinatoricsSet{
+		FactorialEndpoint:    client("Factorial"),
+		BinomialEndpoint:     client("Binomial"),
+		PermutationsEndpoint: client("Permutations"),
+	}
+}
+
+// decodeGRPCCombinatoricsRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC Combinatorics request to user-domain Operands. Primarily useful in a server.
+func decodeGRPCCombinatoricsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return combinatoricsservice.OperandsFromProto(grpcReq.(*pb.CombinatoricsRequest)), nil
+}
+
+// encodeGRPCCombinatoricsRequest is a transport/grpc.EncodeRequestFunc that converts
+// user-domain Operands to a gRPC Combinatorics request. Primarily useful in a client.
+func encodeGRPCCombinatoricsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return request.(combinatoricsservice.Operands).Proto(), nil
+}
+
+// encodeGRPCCombinatoricsResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Combinatorics response to a gRPC Integer reply. Primarily useful in a server.
+func encodeGRPCCombinatoricsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.CombinatoricsResponse)
+	return &pb.IntegerReply{V: resp.V, Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCCombinatoricsStatusResponse is encodeGRPCMathOpStatusResponse for
+// the Combinatorics service. Primarily useful in a server.
+func encodeGRPCCombinatoricsStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.CombinatoricsResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCCombinatoricsResponse(ctx, response)
+}
+
+// decodeGRPCCombinatoricsResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Integer reply to a user-domain Combinatorics response. Primarily useful in a client.
+func decodeGRPCCombinatoricsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.IntegerReply)
+	return mathendpoint2.CombinatoricsResponse{V: reply.V, Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
@@ -0,0 +1,123 @@
+package mathtransport
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/complexservice"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service Complex -type complexGRPCServer -o complex_gen.go
+
+// NewComplexGRPCServer makes a set of endpoints available as a gRPC
+// ComplexServer, reporting errors like NewGRPCServer does.
+func NewComplexGRPCServer(endpoints mathendpoint2.ComplexSet, logger log.Logger, statusErrors bool) pb.ComplexServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeComplexOpResponse, encodeMathOpResponse := encodeGRPCComplexOpResponse, encodeGRPCMathOpResponse
+	if statusErrors {
+		encodeComplexOpResponse, encodeMathOpResponse = encodeGRPCComplexOpStatusResponse, encodeGRPCMathOpStatusResponse
+	}
+	handler := func(e endpoint.Endpoint, encodeResponse grpctransport.EncodeResponseFunc) grpctransport.Handler {
+		return grpctransport.NewServer(e, decodeGRPCComplexOpRequest, encodeResponse, options...)
+	}
+
+	return &complexGRPCServer{
+		sum:      handler(endpoints.SumEndpoint, encodeComplexOpResponse),
+		subtract: handler(endpoints.SubtractEndpoint, encodeComplexOpResponse),
+		multiply: handler(endpoints.MultiplyEndpoint, encodeComplexOpResponse),
+		divide:   handler(endpoints.DivideEndpoint, encodeComplexOpResponse),
+		pow:      handler(endpoints.PowEndpoint, encodeComplexOpResponse),
+		abs:      handler(endpoints.AbsEndpoint, encodeMathOpResponse),
+		phase:    handler(endpoints.PhaseEndpoint, encodeMathOpResponse),
+		conj:     handler(endpoints.ConjEndpoint, encodeComplexOpResponse),
+		sqrt:     handler(endpoints.SqrtEndpoint, encodeComplexOpResponse),
+	}
+}
+
+// NewComplexGRPCClient returns a complexservice.Service backed by a gRPC
+// server at the other end of the conn, see NewGRPCClient.
+func NewComplexGRPCClient(conn *grpc.ClientConn, logger log.Logger) complexservice.Service {
+	client := func(method string, decodeResponse grpctransport.DecodeResponseFunc, reply interface{}) endpoint.Endpoint {
+		return grpctransport.NewClient(
+			conn,
+			"pb.Complex",
+			method,
+			encodeGRPCComplexOpRequest,
+			decodeResponse,
+			reply,
+		).Endpoint()
+	}
+	complexOp := func(method string) endpoint.Endpoint {
+		return decodeComplexGRPCStatusMiddleware(client(method, decodeGRPCComplexOpResponse, pb.ComplexOpReply{}))
+	}
+	realOp := func(method string) endpoint.Endpoint {
+		return decodeGRPCStatusMiddleware(client(method, decodeGRPCMathOpResponse, pb.MathOpReply{}))
+	}
+
+	return mathendpoint2.ComplexSet{
+		SumEndpoint:      complexOp("Sum"),
+		SubtractEndpoint: complexOp("Subtract"),
+		MultiplyEndpoint: complexOp("Multiply"),
+		DivideEndpoint:   complexOp("Divide"),
+		PowEndpoint:      complexOp("Pow"),
+		AbsEndpoint:      realOp("Abs"),
+		PhaseEndpoint:    realOp("Phase"),
+		ConjEndpoint:     complexOp("Conj"),
+		SqrtEndpoint:     complexOp("Sqrt"),
+	}
+}
+
+// decodeGRPCComplexOpRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC ComplexOp request to a user-domain ComplexOp request. Primarily useful in a server.
+func decodeGRPCComplexOpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ComplexOpRequest)
+	return mathendpoint2.ComplexOpRequest{A: complexservice.FromProto(req.A), B: complexservice.FromProto(req.B)}, nil
+}
+
+// encodeGRPCComplexOpRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain ComplexOp request to a gRPC ComplexOp request. Primarily useful in a client.
+func encodeGRPCComplexOpRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.ComplexOpRequest)
+	return &pb.ComplexOpRequest{A: complexservice.Proto(req.A), B: complexservice.Proto(req.B)}, nil
+}
+
+// encodeGRPCComplexOpResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain ComplexOp response to a gRPC ComplexOp reply. Primarily useful in a server.
+func encodeGRPCComplexOpResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.ComplexOpResponse)
+	return &pb.ComplexOpReply{V: complexservice.Proto(resp.V), Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCComplexOpStatusResponse is encodeGRPCMathOpStatusResponse for the
+// methods returning a ComplexOpReply. Primarily useful in a server.
+func encodeGRPCComplexOpStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.ComplexOpResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCComplexOpResponse(ctx, response)
+}
+
+// decodeGRPCComplexOpResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC ComplexOp reply to a user-domain ComplexOp response. Primarily useful in a client.
+func decodeGRPCComplexOpResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.ComplexOpReply)
+	return mathendpoint2.ComplexOpResponse{V: complexservice.FromProto(reply.V), Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
+
+// decodeComplexGRPCStatusMiddleware is decodeGRPCStatusMiddleware for the
+// methods returning a ComplexOpReply. Primarily useful in a client.
+func decodeComplexGRPCStatusMiddleware(next endpoint.Endpoint) endpoint.Endpoint {
+	return decodeGRPCStatusAs(next, func(err error) interface{} {
+		return mathendpoint2.ComplexOpResponse{Err: err}
+	})
+}
@@ -0,0 +1,174 @@
+package mathtransport
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/jwenz723/mathserver/pb"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/linalgservice"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service LinearAlgebra -type linalgGRPCServer -o linalg_gen.go
+
+// NewLinearAlgebraGRPCServer makes a set of endpoints available as a gRPC
+// LinearAlgebraServer, reporting errors like NewGRPCServer does.
+func NewLinearAlgebraGRPCServer(endpoints mathendpoint2.LinearAlgebraSet, logger log.Logger, statusErrors bool) pb.LinearAlgebraServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeMathOpResponse, encodeVectorResponse, encodeMatrixResponse := encodeGRPCMathOpResponse, encodeGRPCVectorResponse, encodeGRPCMatrixResponse
+	if statusErrors {
+		encodeMathOpResponse, encodeVectorResponse, encodeMatrixResponse = encodeGRPCMathOpStatusResponse, encodeGRPCVectorStatusResponse, encodeGRPCMatrixStatusResponse
+	}
+	handler := func(e endpoint.Endpoint, decodeRequest grpctransport.DecodeRequestFunc, encodeResponse grpctransport.EncodeResponseFunc) grpctransport.Handler {
+		return grpctransport.NewServer(e, decodeRequest, encodeResponse, options...)
+	}
+
+	return &linalgGRPCServer{
+		dot:         handler(endpoints.DotEndpoint, decodeGRPCVectorOpRequest, encodeMathOpResponse),
+		cross:       handler(endpoints.CrossEndpoint, decodeGRPCVectorOpRequest, encodeVectorResponse),
+		norm:        handler(endpoints.NormEndpoint, decodeGRPCVectorOpRequest, encodeMathOpResponse),
+		add:         handler(endpoints.AddEndpoint, decodeGRPCMatrixOpRequest, encodeMatrixResponse),
+		multiply:    handler(endpoints.MultiplyEndpoint, decodeGRPCMatrixOpRequest, encodeMatrixResponse),
+		transpose:   handler(endpoints.TransposeEndpoint, decodeGRPCMatrixOpRequest, encodeMatrixResponse),
+		determinant: handler(endpoints.DeterminantEndpoint, decodeGRPCMatrixOpRequest, encodeMathOpResponse),
+		inverse:     handler(endpoints.InverseEndpoint, decodeGRPCMatrixOpRequest, encodeMatrixResponse),
+		solve:       handler(endpoints.SolveEndpoint, decodeGRPCSolveRequest, encodeVectorResponse),
+	}
+}
+
+// NewLinearAlgebraGRPCClient returns a linalgservice.Service backed by a gRPC
+// server at the other end of the conn, see NewGRPCClient.
+func NewLinearAlgebraGRPCClient(conn *grpc.ClientConn, logger log.Logger) linalgservice.Service {
+	client := func(method string, encodeRequest grpctransport.EncodeRequestFunc, decodeResponse grpctransport.DecodeResponseFunc, reply interface{}) endpoint.Endpoint {
+		return grpctransport.NewClient(
+			conn,
+			"pb.LinearAlgebra",
+			method,
+			encodeRequest,
+			decodeResponse,
+			reply,
+		).Endpoint()
+	}
+	number := func(method string, encodeRequest grpctransport.EncodeRequestFunc) endpoint.Endpoint {
+		return decodeGRPCStatusMiddleware(client(method, encodeRequest, decodeGRPCMathOpResponse, pb.MathOpReply{}))
+	}
+	vector := func(method string, encodeRequest grpctransport.EncodeRequestFunc) endpoint.Endpoint {
+		return decodeGRPCStatusAs(client(method, encodeRequest, decodeGRPCVectorResponse, pb.VectorReply{}), func(err error) interface{} {
+			return mathendpoint2.VectorResponse{Err: err}
+		})
+	}
+	matrix := func(method string) endpoint.Endpoint {
+		return decodeGRPCStatusAs(client(method, encodeGRPCMatrixOpRequest, decodeGRPCMatrixResponse, pb.MatrixReply{}), func(err error) interface{} {
+			return mathendpoint2.MatrixResponse{Err: err}
+		})
+	}
+
+	return mathendpoint2.LinearAlgebraSet{
+		DotEndpoint:         number("Dot", encodeGRPCVectorOpRequest),
+		CrossEndpoint:       vector("Cross", encodeGRPCVectorOpRequest),
+		NormEndpoint:        number("Norm", encodeGRPCVectorOpRequest),
+		AddEndpoint:         matrix("Add"),
+		MultiplyEndpoint:    matrix("Multiply"),
+		TransposeEndpoint:   matrix("Transpose"),
+		DeterminantEndpoint: number("Determinant", encodeGRPCMatrixOpRequest),
+		InverseEndpoint:     matrix("Inverse"),
+		SolveEndpoint:       vector("Solve", encodeGRPCSolveRequest),
+	}
+}
+
+// decodeGRPCVectorOpRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC VectorOp request to a user-domain VectorOp request. Primarily useful in a server.
+func decodeGRPCVectorOpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.VectorOpRequest)
+	return mathendpoint2.VectorOpRequest{A: req.A, B: req.B}, nil
+}
+
+// encodeGRPCVectorOpRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain VectorOp request to a gRPC VectorOp request. Primarily useful in a client.
+func encodeGRPCVectorOpRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.VectorOpRequest)
+	return &pb.VectorOpRequest{A: req.A, B: req.B}, nil
+}
+
+// decodeGRPCMatrixOpRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC MatrixOp request to a user-domain MatrixOp request. Primarily useful in a server.
+func decodeGRPCMatrixOpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.MatrixOpRequest)
+	return mathendpoint2.MatrixOpRequest{A: linalgservice.FromProto(req.A), B: linalgservice.FromProto(req.B)}, nil
+}
+
+// encodeGRPCMatrixOpRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain MatrixOp request to a gRPC MatrixOp request. Primarily useful in a client.
+func encodeGRPCMatrixOpRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.MatrixOpRequest)
+	return &pb.MatrixOpRequest{A: req.A.Proto(), B: req.B.Proto()}, nil
+}
+
+// decodeGRPCSolveRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC Solve request to a user-domain Solve request. Primarily useful in a server.
+func decodeGRPCSolveRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.SolveRequest)
+	return mathendpoint2.SolveRequest{A: linalgservice.FromProto(req.A), B: req.B}, nil
+}
+
+// encodeGRPCSolveRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain Solve request to a gRPC Solve request. Primarily useful in a client.
+func encodeGRPCSolveRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.SolveRequest)
+	return &pb.SolveRequest{A: req.A.Proto(), B: req.B}, nil
+}
+
+// encodeGRPCVectorResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Vector response to a gRPC Vector reply. Primarily useful in a server.
+func encodeGRPCVectorResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.VectorResponse)
+	return &pb.VectorReply{V: resp.V, Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCVectorStatusResponse is encodeGRPCMathOpStatusResponse for the
+// methods returning a VectorReply. Primarily useful in a server.
+func encodeGRPCVectorStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.VectorResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCVectorResponse(ctx, response)
+}
+
+// decodeGRPCVectorResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Vector reply to a user-domain Vector response. Primarily useful in a client.
+func decodeGRPCVectorResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.VectorReply)
+	return mathendpoint2.VectorResponse{V: reply.V, Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
+
+// encodeGRPCMatrixResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Matrix response to a gRPC Matrix reply. Primarily useful in a server.
+func encodeGRPCMatrixResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.MatrixResponse)
+	return &pb.MatrixReply{V: resp.V.Proto(), Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCMatrixStatusResponse is encodeGRPCMathOpStatusResponse for the
+// methods returning a MatrixReply. Primarily useful in a server.
+func encodeGRPCMatrixStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.MatrixResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCMatrixResponse(ctx, response)
+}
+
+// decodeGRPCMatrixResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Matrix reply to a user-domain Matrix response. Primarily useful in a client.
+func decodeGRPCMatrixResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.MatrixReply)
+	return mathendpoint2.MatrixResponse{V: linalgservice.FromProto(reply.V), Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
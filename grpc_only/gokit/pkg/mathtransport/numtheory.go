@@ -0,0 +1,174 @@
+package mathtransport
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/jwenz723/mathserver/pb"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/numtheoryservice"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service NumberTheory -type numberTheoryGRPCServer -o numtheory_gen.go
+
+// NewNumberTheoryGRPCServer makes a set of endpoints available as a gRPC
+// NumberTheoryServer, reporting errors like NewGRPCServer does.
+func NewNumberTheoryGRPCServer(endpoints mathendpoint2.NumberTheorySet, logger log.Logger, statusErrors bool) pb.NumberTheoryServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeInteger, encodePrimality, encodeFactors := encodeGRPCIntegerResponse, encodeGRPCPrimalityResponse, encodeGRPCFactorsResponse
+	if statusErrors {
+		encodeInteger, encodePrimality, encodeFactors = encodeGRPCIntegerStatusResponse, encodeGRPCPrimalityStatusResponse, encodeGRPCFactorsStatusResponse
+	}
+
+	return &numberTheoryGRPCServer{
+		isPrime:    grpctransport.NewServer(endpoints.IsPrimeEndpoint, decodeGRPCIntegerRequest, encodePrimality, options...),
+		factorize:  grpctransport.NewServer(endpoints.FactorizeEndpoint, decodeGRPCIntegerRequest, encodeFactors, options...),
+		modPow:     grpctransport.NewServer(endpoints.ModPowEndpoint, decodeGRPCModPowRequest, encodeInteger, options...),
+		modInverse: grpctransport.NewServer(endpoints.ModInverseEndpoint, decodeGRPCModInverseRequest, encodeInteger, options...),
+		eulerPhi:   grpctransport.NewServer(endpoints.EulerPhiEndpoint, decodeGRPCIntegerRequest, encodeInteger, options...),
+		nextPrime:  grpctransport.NewServer(endpoints.NextPrimeEndpoint, decodeGRPCIntegerRequest, encodeInteger, options...),
+	}
+}
+
+// NewNumberTheoryGRPCClient returns a numtheoryservice.Service backed by a
+// gRPC server at the other end of the conn, see NewGRPCClient.
+func NewNumberTheoryGRPCClient(conn *grpc.ClientConn, logger log.Logger) numtheoryservice.Service {
+	client := func(method string, encodeRequest grpctransport.EncodeRequestFunc, decodeResponse grpctransport.DecodeResponseFunc, reply interface{}, failed func(error) interface{}) endpoint.Endpoint {
+		return decodeGRPCStatusAs(grpctransport.NewClient(
+			conn,
+			"pb.NumberTheory",
+			method,
+			encodeRequest,
+			decodeResponse,
+			reply,
+		).Endpoint(), failed)
+	}
+	integer := func(method string, encodeRequest grpctransport.EncodeRequestFunc) endpoint.Endpoint {
+		return client(method, encodeRequest, decodeGRPCIntegerResponse, pb.IntegerReply{}, func(err error) interface{} {
+			return mathendpoint2.IntegerResponse{Err: err}
+		})
+	}
+
+	return mathendpoint2.NumberTheorySet{
+		IsPrimeEndpoint: client("IsPrime", encodeGRPCIntegerRequest, decodeGRPCPrimalityResponse, pb.PrimalityReply{}, func(err error) interface{} {
+			return mathendpoint2.PrimalityResponse{Err: err}
+		}),
+		FactorizeEndpoint: client("Factorize", encodeGRPCIntegerRequest, decodeGRPCFactorsResponse, pb.FactorsReply{}, func(err error) interface{} {
+			return mathendpoint2.FactorsResponse{Err: err}
+		}),
+		ModPowEndpoint:     integer("ModPow", encodeGRPCModPowRequest),
+		ModInverseEndpoint: integer("ModInverse", encodeGRPCModInverseRequest),
+		EulerPhiEndpoint:   integer("EulerPhi", encodeGRPCIntegerRequest),
+		NextPrimeEndpoint:  integer("NextPrime", encodeGRPCIntegerRequest),
+	}
+}
+
+// decodeGRPCIntegerRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC Integer request to a user-domain Operand. Primarily useful in a server.
+func decodeGRPCIntegerRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return numtheoryservice.OperandFromProto(grpcReq.(*pb.IntegerRequest)), nil
+}
+
+// decodeGRPCModPowRequest is decodeGRPCIntegerRequest for ModPow.
+func decodeGRPCModPowRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return numtheoryservice.ModPowOperandsFromProto(grpcReq.(*pb.ModPowRequest)), nil
+}
+
+// decodeGRPCModInverseRequest is decodeGRPCIntegerRequest for ModInverse.
+func decodeGRPCModInverseRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	return numtheoryservice.ModInverseOperandsFromProto(grpcReq.(*pb.ModInverseRequest)), nil
+}
+
+// encodeGRPCIntegerRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain Operand to a gRPC Integer request. Primarily useful in a client.
+func encodeGRPCIntegerRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return request.(numtheoryservice.Operand).Proto(), nil
+}
+
+// encodeGRPCModPowRequest is encodeGRPCIntegerRequest for ModPow.
+func encodeGRPCModPowRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return request.(numtheoryservice.ModPowOperands).Proto(), nil
+}
+
+// encodeGRPCModInverseRequest is encodeGRPCIntegerRequest for ModInverse.
+func encodeGRPCModInverseRequest(_ context.Context, request interface{}) (interface{}, error) {
+	return request.(numtheoryservice.ModInverseOperands).Proto(), nil
+}
+
+// encodeGRPCIntegerResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Integer response to a gRPC Integer reply. Primarily useful in a server.
+func encodeGRPCIntegerResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.IntegerResponse)
+	return &pb.IntegerReply{V: string(resp.V), Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCPrimalityResponse is encodeGRPCIntegerResponse for IsPrime.
+func encodeGRPCPrimalityResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.PrimalityResponse)
+	return &pb.PrimalityReply{Prime: resp.V.Prime, Probable: resp.V.Probable, Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCFactorsResponse is encodeGRPCIntegerResponse for Factorize.
+func encodeGRPCFactorsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.FactorsResponse)
+	return &pb.FactorsReply{Factors: numtheoryservice.FactorsProto(resp.Factors), Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCIntegerStatusResponse is encodeGRPCMathOpStatusResponse for the
+// methods returning an IntegerReply. Primarily useful in a server.
+func encodeGRPCIntegerStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.IntegerResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCIntegerResponse(ctx, response)
+}
+
+// encodeGRPCPrimalityStatusResponse is encodeGRPCIntegerStatusResponse for
+// IsPrime.
+func encodeGRPCPrimalityStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.PrimalityResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCPrimalityResponse(ctx, response)
+}
+
+// encodeGRPCFactorsStatusResponse is encodeGRPCIntegerStatusResponse for
+// Factorize.
+func encodeGRPCFactorsStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.FactorsResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCFactorsResponse(ctx, response)
+}
+
+// decodeGRPCIntegerResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Integer reply to a user-domain Integer response. Primarily useful in a client.
+func decodeGRPCIntegerResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.IntegerReply)
+	return mathendpoint2.IntegerResponse{V: numtheoryservice.Integer(reply.V), Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
+
+// decodeGRPCPrimalityResponse is decodeGRPCIntegerResponse for IsPrime.
+func decodeGRPCPrimalityResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.PrimalityReply)
+	return mathendpoint2.PrimalityResponse{
+		V:   numtheoryservice.Primality{Prime: reply.Prime, Probable: reply.Probable},
+		Err: rpcstatus.Err(reply.Code, reply.Err),
+	}, nil
+}
+
+// decodeGRPCFactorsResponse is decodeGRPCIntegerResponse for Factorize.
+func decodeGRPCFactorsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.FactorsReply)
+	return mathendpoint2.FactorsResponse{Factors: numtheoryservice.FactorsFromProto(reply.Factors), Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
@@ -0,0 +1,218 @@
+package mathtransport
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/jwenz723/mathserver/pb"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"github.com/jwenz723/mathserver/pkg/statsservice"
+	"google.golang.org/grpc"
+)
+
+type statisticsGRPCServer struct {
+	describe        grpctransport.Handler
+	correlate       grpctransport.Handler
+	encodeDescribe  grpctransport.EncodeResponseFunc
+	encodeCorrelate grpctransport.EncodeResponseFunc
+	maxValues       int
+}
+
+// NewStatisticsGRPCServer makes a set of endpoints available as a gRPC
+// StatisticsServer, reporting errors like NewGRPCServer does. go-kit has no
+// streaming transport, so the chunks of each stream are merged into a single
+// StatisticsChunk that's handled like the request of a unary call. A stream
+// carrying more than maxValues values in a series,
+// statsservice.DefaultMaxValues when it's 0, is answered with
+// statsservice.ErrTooManyValues without calling the endpoint.
+func NewStatisticsGRPCServer(endpoints mathendpoint2.StatisticsSet, logger log.Logger, statusErrors bool, maxValues int) pb.StatisticsServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeDescribe, encodeCorrelate := encodeGRPCDescribeResponse, encodeGRPCCorrelateResponse
+	if statusErrors {
+		encodeDescribe, encodeCorrelate = encodeGRPCDescribeStatusResponse, encodeGRPCCorrelateStatusResponse
+	}
+	return &statisticsGRPCServer{
+		describe: grpctransport.NewServer(
+			endpoints.DescribeEndpoint,
+			decodeGRPCDescribeRequest,
+			encodeDescribe,
+			options...,
+		),
+		correlate: grpctransport.NewServer(
+			endpoints.CorrelateEndpoint,
+			decodeGRPCCorrelateRequest,
+			encodeCorrelate,
+			options...,
+		),
+		encodeDescribe:  encodeDescribe,
+		encodeCorrelate: encodeCorrelate,
+		maxValues:       maxValues,
+	}
+}
+
+func (s *statisticsGRPCServer) Describe(stream pb.Statistics_DescribeServer) error {
+	var rep interface{}
+	req, err := statsservice.Receive(stream.Recv, s.maxValues)
+	switch {
+	case errors.Is(err, statsservice.ErrTooManyValues):
+		rep, err = s.encodeDescribe(stream.Context(), mathendpoint2.DescribeResponse{Err: err})
+	case err == nil:
+		_, rep, err = s.describe.ServeGRPC(stream.Context(), req)
+	}
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(rep.(*pb.DescribeReply))
+}
+
+func (s *statisticsGRPCServer) Correlate(stream pb.Statistics_CorrelateServer) error {
+	var rep interface{}
+	req, err := statsservice.Receive(stream.Recv, s.maxValues)
+	switch {
+	case errors.Is(err, statsservice.ErrTooManyValues):
+		rep, err = s.encodeCorrelate(stream.Context(), mathendpoint2.CorrelateResponse{Err: err})
+	case err == nil:
+		_, rep, err = s.correlate.ServeGRPC(stream.Context(), req)
+	}
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(rep.(*pb.CorrelateReply))
+}
+
+// NewStatisticsGRPCClient returns a statsservice.Service backed by a gRPC
+// server at the other end of the conn, streaming the datasets in chunks of
+// chunkSize values, statsservice.DefaultChunkSize when it's 0.
+func NewStatisticsGRPCClient(conn *grpc.ClientConn, chunkSize int) statsservice.Service {
+	client := pb.NewStatisticsClient(conn)
+	return mathendpoint2.StatisticsSet{
+		DescribeEndpoint: decodeGRPCStatusAs(
+			func(ctx context.Context, request interface{}) (interface{}, error) {
+				req := request.(mathendpoint2.DescribeRequest)
+				stream, err := client.Describe(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if err := statsservice.Send(stream.Send, req.Values, nil, req.Options.Proto(), chunkSize); err != nil {
+					return nil, err
+				}
+				reply, err := stream.CloseAndRecv()
+				if err != nil {
+					return nil, err
+				}
+				return decodeGRPCDescribeResponse(ctx, reply)
+			},
+			func(err error) interface{} { return mathendpoint2.DescribeResponse{Err: err} },
+		),
+		CorrelateEndpoint: decodeGRPCStatusAs(
+			func(ctx context.Context, request interface{}) (interface{}, error) {
+				req := request.(mathendpoint2.CorrelateRequest)
+				stream, err := client.Correlate(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if err := statsservice.Send(stream.Send, req.X, req.Y, nil, chunkSize); err != nil {
+					return nil, err
+				}
+				reply, err := stream.CloseAndRecv()
+				if err != nil {
+					return nil, err
+				}
+				return decodeGRPCCorrelateResponse(ctx, reply)
+			},
+			func(err error) interface{} { return mathendpoint2.CorrelateResponse{Err: err} },
+		),
+	}
+}
+
+// decodeGRPCDescribeRequest is a transport/grpc.DecodeRequestFunc that
+// converts the merged chunks of a Describe stream to a user-domain Describe
+// request. Primarily useful in a server.
+func decodeGRPCDescribeRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.StatisticsChunk)
+	return mathendpoint2.DescribeRequest{Values: req.X, Options: statsservice.OptionsFromProto(req.Options)}, nil
+}
+
+// decodeGRPCCorrelateRequest is decodeGRPCDescribeRequest for Correlate.
+func decodeGRPCCorrelateRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.StatisticsChunk)
+	return mathendpoint2.CorrelateRequest{X: req.X, Y: req.Y}, nil
+}
+
+// encodeGRPCDescribeResponse is a transport/grpc.EncodeResponseFunc that
+// converts a user-domain Describe response to a gRPC Describe reply.
+// Primarily useful in a server.
+func encodeGRPCDescribeResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.DescribeResponse)
+	if resp.Err != nil {
+		return &pb.DescribeReply{Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+	}
+	return resp.V.Proto(), nil
+}
+
+// encodeGRPCDescribeStatusResponse is encodeGRPCDescribeResponse failing the
+// call with a status error when the response carries an error.
+func encodeGRPCDescribeStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.DescribeResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCDescribeResponse(ctx, response)
+}
+
+// encodeGRPCCorrelateResponse is encodeGRPCDescribeResponse for Correlate.
+func encodeGRPCCorrelateResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.CorrelateResponse)
+	if resp.Err != nil {
+		return &pb.CorrelateReply{Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+	}
+	return resp.V.Proto(), nil
+}
+
+// encodeGRPCCorrelateStatusResponse is encodeGRPCDescribeStatusResponse for
+// Correlate.
+func encodeGRPCCorrelateStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.CorrelateResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCCorrelateResponse(ctx, response)
+}
+
+// decodeGRPCDescribeResponse converts a gRPC Describe reply to a user-domain
+// Describe response. Primarily useful in a client.
+func decodeGRPCDescribeResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.DescribeReply)
+	if reply.Code != pb.ErrorCode_NO_ERROR || reply.Err != "" {
+		return mathendpoint2.DescribeResponse{Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+	}
+	return mathendpoint2.DescribeResponse{V: statsservice.DescriptionFromProto(reply)}, nil
+}
+
+// decodeGRPCCorrelateResponse is decodeGRPCDescribeResponse for Correlate.
+func decodeGRPCCorrelateResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.CorrelateReply)
+	if reply.Code != pb.ErrorCode_NO_ERROR || reply.Err != "" {
+		return mathendpoint2.CorrelateResponse{Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+	}
+	return mathendpoint2.CorrelateResponse{V: statsservice.CorrelationFromProto(reply)}, nil
+}
+
+// decodeGRPCStatusAs is decodeGRPCStatusMiddleware for the methods whose
+// response carrying err is returned by failed. Primarily useful in a client.
+func decodeGRPCStatusAs(next endpoint.Endpoint, failed func(err error) interface{}) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		response, err := next(ctx, request)
+		if code, msg, ok := rpcstatus.Parse(err); ok {
+			return failed(rpcstatus.Err(code, msg)), nil
+		}
+		return response, err
+	}
+}
@@ -0,0 +1,129 @@
+package mathtransport
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"github.com/jwenz723/mathserver/pb"
+	mathendpoint2 "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
+	"google.golang.org/grpc"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind gokit-grpc -service Units -type unitsGRPCServer -o units_gen.go
+
+// NewUnitsGRPCServer makes a set of endpoints available as a gRPC
+// UnitsServer, reporting errors like NewGRPCServer does.
+func NewUnitsGRPCServer(endpoints mathendpoint2.UnitsSet, logger log.Logger, statusErrors bool) pb.UnitsServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+	encodeResponse := encodeGRPCQuantityResponse
+	if statusErrors {
+		encodeResponse = encodeGRPCQuantityStatusResponse
+	}
+	handler := func(e endpoint.Endpoint, decodeRequest grpctransport.DecodeRequestFunc) grpctransport.Handler {
+		return grpctransport.NewServer(e, decodeRequest, encodeResponse, options...)
+	}
+
+	return &unitsGRPCServer{
+		sum:      handler(endpoints.SumEndpoint, decodeGRPCQuantityOpRequest),
+		subtract: handler(endpoints.SubtractEndpoint, decodeGRPCQuantityOpRequest),
+		multiply: handler(endpoints.MultiplyEndpoint, decodeGRPCQuantityOpRequest),
+		divide:   handler(endpoints.DivideEndpoint, decodeGRPCQuantityOpRequest),
+		pow:      handler(endpoints.PowEndpoint, decodeGRPCQuantityPowRequest),
+		convert:  handler(endpoints.ConvertEndpoint, decodeGRPCConvertRequest),
+	}
+}
+
+// NewUnitsGRPCClient returns a unitservice.Service backed by a gRPC server at
+// the other end of the conn, see NewGRPCClient.
+func NewUnitsGRPCClient(conn *grpc.ClientConn, logger log.Logger) unitservice.Service {
+	client := func(method string, encodeRequest grpctransport.EncodeRequestFunc) endpoint.Endpoint {
+		return decodeGRPCStatusAs(grpctransport.NewClient(
+			conn,
+			"pb.Units",
+			method,
+			encodeRequest,
+			decodeGRPCQuantityResponse,
+			pb.QuantityReply{},
+		).Endpoint(), func(err error) interface{} {
+			return mathendpoint2.QuantityResponse{Err: err}
+		})
+	}
+
+	return mathendpoint2.UnitsSet{
+		SumEndpoint:      client("Sum", encodeGRPCQuantityOpRequest),
+		SubtractEndpoint: client("Subtract", encodeGRPCQuantityOpRequest),
+		MultiplyEndpoint: client("Multiply", encodeGRPCQuantityOpRequest),
+		DivideEndpoint:   client("Divide", encodeGRPCQuantityOpRequest),
+		PowEndpoint:      client("Pow", encodeGRPCQuantityPowRequest),
+		ConvertEndpoint:  client("Convert", encodeGRPCConvertRequest),
+	}
+}
+
+// decodeGRPCQuantityOpRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC QuantityOp request to a user-domain QuantityOp request. Primarily useful in a server.
+func decodeGRPCQuantityOpRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.QuantityOpRequest)
+	return mathendpoint2.QuantityOpRequest{A: unitservice.FromProto(req.A), B: unitservice.FromProto(req.B)}, nil
+}
+
+// decodeGRPCQuantityPowRequest is decodeGRPCQuantityOpRequest for Pow.
+func decodeGRPCQuantityPowRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.QuantityPowRequest)
+	return mathendpoint2.QuantityPowRequest{A: unitservice.FromProto(req.A), B: req.B}, nil
+}
+
+// decodeGRPCConvertRequest is decodeGRPCQuantityOpRequest for Convert.
+func decodeGRPCConvertRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ConvertRequest)
+	return mathendpoint2.ConvertRequest{A: unitservice.FromProto(req.A), Unit: req.Unit}, nil
+}
+
+// encodeGRPCQuantityOpRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain QuantityOp request to a gRPC QuantityOp request. Primarily useful in a client.
+func encodeGRPCQuantityOpRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.QuantityOpRequest)
+	return &pb.QuantityOpRequest{A: req.A.Proto(), B: req.B.Proto()}, nil
+}
+
+// encodeGRPCQuantityPowRequest is encodeGRPCQuantityOpRequest for Pow.
+func encodeGRPCQuantityPowRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.QuantityPowRequest)
+	return &pb.QuantityPowRequest{A: req.A.Proto(), B: req.B}, nil
+}
+
+// encodeGRPCConvertRequest is encodeGRPCQuantityOpRequest for Convert.
+func encodeGRPCConvertRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(mathendpoint2.ConvertRequest)
+	return &pb.ConvertRequest{A: req.A.Proto(), Unit: req.Unit}, nil
+}
+
+// encodeGRPCQuantityResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain Quantity response to a gRPC Quantity reply. Primarily useful in a server.
+func encodeGRPCQuantityResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.QuantityResponse)
+	return &pb.QuantityReply{V: resp.V.Proto(), Err: err2str(resp.Err), Code: rpcstatus.Code(resp.Err)}, nil
+}
+
+// encodeGRPCQuantityStatusResponse is encodeGRPCMathOpStatusResponse for the
+// methods of the Units service. Primarily useful in a server.
+func encodeGRPCQuantityStatusResponse(ctx context.Context, response interface{}) (interface{}, error) {
+	resp := response.(mathendpoint2.QuantityResponse)
+	if resp.Err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(resp.Err), resp.Err)
+	}
+	return encodeGRPCQuantityResponse(ctx, response)
+}
+
+// decodeGRPCQuantityResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC Quantity reply to a user-domain Quantity response. Primarily useful in a client.
+func decodeGRPCQuantityResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.QuantityReply)
+	return mathendpoint2.QuantityResponse{V: unitservice.FromProto(reply.V), Err: rpcstatus.Err(reply.Code, reply.Err)}, nil
+}
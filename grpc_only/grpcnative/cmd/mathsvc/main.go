@@ -6,9 +6,18 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
-	"github.com/jwenz723/mathserver/grpc_only/grpcnative/pkg/mathservice"
 	"github.com/jwenz723/mathserver/grpc_only/grpcnative/pkg/server"
 	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/calculusservice"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+	"github.com/jwenz723/mathserver/pkg/complexservice"
+	"github.com/jwenz723/mathserver/pkg/financeservice"
+	"github.com/jwenz723/mathserver/pkg/linalgservice"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/numtheoryservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/statsservice"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
 	"github.com/oklog/oklog/pkg/group"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
@@ -26,15 +35,47 @@ func main() {
 	var (
 		debugAddr      = fs.String("debug.addr", ":8080", "Debug and metrics listen address")
 		grpcAddr       = fs.String("grpc-addr", ":8082", "gRPC listen address")
+		statusErrors   = fs.Bool("grpc-status-errors", false, "Report service errors as gRPC status codes instead of in the reply")
+		unitsFile      = fs.String("units", "", "File defining units besides the built-in ones, one per line such as: kn = 1 nmi/h")
+		maxDigits      = fs.Int("max-digits", combinatoricsservice.DefaultMaxDigits, "Most decimal digits of the exact results of the Combinatorics service")
+		statsMaxValues = fs.Int("stats-max-values", statsservice.DefaultMaxValues, "Most values of each series a stream of the Statistics service may carry")
 	)
+	defaultPrecision := precision.Precision{Mode: precision.Float64}
+	fs.Var(&defaultPrecision.Mode, "precision", "Default precision mode: float64, bigfloat or rational")
+	fs.UintVar(&defaultPrecision.Bits, "precision-bits", precision.DefaultBits, "Default mantissa bits used by the bigfloat precision mode")
+	var nonFinite mathservice.NonFinitePolicy
+	fs.Var(&nonFinite, "non-finite", "Policy for NaN and infinite results of the Math service: pass, reject or string")
 	fs.Usage = usageFor(fs, os.Args[0]+" [flags]")
 	fs.Parse(os.Args[1:])
+	if defaultPrecision.Bits > precision.MaxBits {
+		fmt.Fprintf(os.Stderr, "invalid value %d for flag -precision-bits: at most %d bits are supported\n", defaultPrecision.Bits, precision.MaxBits)
+		fs.Usage()
+		os.Exit(2)
+	}
 
 	logger, _ := zap.NewProduction()
 
+	units := unitservice.NewRegistry()
+	if *unitsFile != "" {
+		if err := units.LoadFile(*unitsFile); err != nil {
+			logger.Error("failed to load units",
+				zap.String("file", *unitsFile),
+				zap.Error(err))
+			os.Exit(1)
+		}
+	}
+
 	var (
-		service = mathservice.NewBasicService()
-		grpcSvc = server.NewGrpcServer(service)
+		service    = mathservice.NonFiniteMiddleware(nonFinite)(mathservice.NewBasicService(defaultPrecision))
+		grpcSvc    = server.NewGrpcServer(service, *statusErrors)
+		statsSvc   = server.NewStatisticsGrpcServer(statsservice.NewBasicService(), *statusErrors, *statsMaxValues)
+		unitsSvc   = server.NewUnitsGrpcServer(unitservice.NewBasicService(units), *statusErrors)
+		financeSvc = server.NewFinanceGrpcServer(financeservice.NewBasicService(), *statusErrors)
+		ntSvc      = server.NewNumberTheoryGrpcServer(numtheoryservice.NewBasicService(), *statusErrors)
+		combSvc    = server.NewCombinatoricsGrpcServer(combinatoricsservice.NewBasicService(*maxDigits), *statusErrors)
+		calcSvc    = server.NewCalculusGrpcServer(calculusservice.NewBasicService(), *statusErrors)
+		complexSvc = server.NewComplexGrpcServer(complexservice.NewBasicService(), *statusErrors)
+		linalgSvc  = server.NewLinearAlgebraGrpcServer(linalgservice.NewBasicService(), *statusErrors)
 	)
 
 	var g group.Group
@@ -65,14 +106,31 @@ func main() {
 		}
 
 		g.Add(func() error {
+			unary := grpc_middleware.ChainUnaryServer(
+				grpc_prometheus.UnaryServerInterceptor,
+				grpc_zap.UnaryServerInterceptor(logger),
+				grpc_zap.PayloadUnaryServerInterceptor(logger, grpcSvc.GrpcLoggingDecider()),
+			)
+			// operations of a Compute stream go through the unary interceptors too
+			grpcSvc.Interceptor = unary
 			grpcServer := grpc.NewServer(
-				grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-					grpc_prometheus.UnaryServerInterceptor,
-					grpc_zap.UnaryServerInterceptor(logger),
-					grpc_zap.PayloadUnaryServerInterceptor(logger, grpcSvc.GrpcLoggingDecider()),
+				grpc.UnaryInterceptor(unary),
+				grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+					grpc_prometheus.StreamServerInterceptor,
+					grpc_zap.StreamServerInterceptor(logger),
 				)),
 			)
 			pb.RegisterMathServer(grpcServer, &grpcSvc)
+			// the Statistics streams are logged and measured by the stream interceptors
+			pb.RegisterStatisticsServer(grpcServer, &statsSvc)
+			pb.RegisterUnitsServer(grpcServer, &unitsSvc)
+			// as is the AmortizationSchedule stream of the Finance service
+			pb.RegisterFinanceServer(grpcServer, &financeSvc)
+			pb.RegisterNumberTheoryServer(grpcServer, &ntSvc)
+			pb.RegisterCombinatoricsServer(grpcServer, &combSvc)
+			pb.RegisterCalculusServer(grpcServer, &calcSvc)
+			pb.RegisterComplexServer(grpcServer, &complexSvc)
+			pb.RegisterLinearAlgebraServer(grpcServer, &linalgSvc)
 			return grpcServer.Serve(lis)
 		}, func(error) {
 			lis.Close()
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/calculusservice"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service Calculus -type calculusGrpcServer -o calculus_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.CalculusServer = &calculusGrpcServer{}
+)
+
+type calculusGrpcServer struct {
+	svc          calculusservice.Service
+	statusErrors bool
+}
+
+// NewCalculusGrpcServer returns a CalculusServer backed by svc, reporting
+// errors like NewGrpcServer does. Its calls are logged and measured by the
+// interceptors of the gRPC server.
+func NewCalculusGrpcServer(svc calculusservice.Service, statusErrors bool) calculusGrpcServer {
+	return calculusGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// calculusReply returns the reply to a call that computed r, or failed with
+// err.
+func (s *calculusGrpcServer) calculusReply(r calculusservice.Result, err error) (*pb.CalculusReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	reply := r.Proto()
+	reply.Err, reply.Code = err2str(err), rpcstatus.Code(err)
+	return reply, nil
+}
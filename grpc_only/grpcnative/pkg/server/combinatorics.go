@@ -0,0 +1,41 @@
+package server
+
+import (
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service Combinatorics -type combinatoricsGrpcServer -o combinatorics_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.CombinatoricsServer = &combinatoricsGrpcServer{}
+)
+
+type combinatoricsGrpcServer struct {
+	svc          combinatoricsservice.Service
+	statusErrors bool
+}
+
+// NewCombinatoricsGrpcServer returns a CombinatoricsServer backed by svc,
+// reporting errors like NewGrpcServer does. Its calls are logged and measured
+// by the interceptors of the gRPC server.
+func NewCombinatoricsGrpcServer(svc combinatoricsservice.Service, statusErrors bool) combinatoricsGrpcServer {
+	return combinatoricsGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// integerReply returns the reply to a call that computed v, or failed with err.
+func (s *combinatoricsGrpcServer) integerReply(v string, err error) (*pb.IntegerReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.IntegerReply{
+		V:    v,
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/numtheoryservice"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service NumberTheory -type numberTheoryGrpcServer -o numtheory_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.NumberTheoryServer = &numberTheoryGrpcServer{}
+)
+
+type numberTheoryGrpcServer struct {
+	svc          numtheoryservice.Service
+	statusErrors bool
+}
+
+// NewNumberTheoryGrpcServer returns a NumberTheoryServer backed by svc,
+// reporting errors like NewGrpcServer does. Its calls are logged and measured
+// by the interceptors of the gRPC server.
+func NewNumberTheoryGrpcServer(svc numtheoryservice.Service, statusErrors bool) numberTheoryGrpcServer {
+	return numberTheoryGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// integerReply returns the reply to a call that computed v, or failed with err.
+func (s *numberTheoryGrpcServer) integerReply(v numtheoryservice.Integer, err error) (*pb.IntegerReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.IntegerReply{
+		V:    string(v),
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+// primalityReply is integerReply for IsPrime.
+func (s *numberTheoryGrpcServer) primalityReply(p numtheoryservice.Primality, err error) (*pb.PrimalityReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.PrimalityReply{
+		Prime:    p.Prime,
+		Probable: p.Probable,
+		Err:      err2str(err),
+		Code:     rpcstatus.Code(err),
+	}, nil
+}
+
+// factorsReply is integerReply for Factorize.
+func (s *numberTheoryGrpcServer) factorsReply(factors []numtheoryservice.Factor, err error) (*pb.FactorsReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.FactorsReply{
+		Factors: numtheoryservice.FactorsProto(factors),
+		Err:     err2str(err),
+		Code:    rpcstatus.Code(err),
+	}, nil
+}
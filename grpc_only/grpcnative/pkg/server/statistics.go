@@ -0,0 +1,72 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"github.com/jwenz723/mathserver/pkg/statsservice"
+)
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.StatisticsServer = &statisticsGrpcServer{}
+)
+
+type statisticsGrpcServer struct {
+	svc          statsservice.Service
+	statusErrors bool
+	maxValues    int
+}
+
+// NewStatisticsGrpcServer returns a StatisticsServer backed by svc, reporting
+// errors like NewGrpcServer does. Its streams are logged and measured by the
+// stream interceptors of the gRPC server. A stream carrying more than
+// maxValues values in a series, statsservice.DefaultMaxValues when it's 0,
+// fails with statsservice.ErrTooManyValues.
+func NewStatisticsGrpcServer(svc statsservice.Service, statusErrors bool, maxValues int) statisticsGrpcServer {
+	return statisticsGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+		maxValues:    maxValues,
+	}
+}
+
+// Describe returns the descriptive statistics of the values streamed in x
+func (s *statisticsGrpcServer) Describe(stream pb.Statistics_DescribeServer) error {
+	c, err := statsservice.Receive(stream.Recv, s.maxValues)
+	if err != nil && !errors.Is(err, statsservice.ErrTooManyValues) {
+		return err
+	}
+	var d statsservice.Description
+	if err == nil {
+		d, err = s.svc.Describe(stream.Context(), c.X, statsservice.OptionsFromProto(c.Options))
+	}
+	if err != nil {
+		if s.statusErrors {
+			return rpcstatus.Error(rpcstatus.Code(err), err)
+		}
+		return stream.SendAndClose(&pb.DescribeReply{Err: err2str(err), Code: rpcstatus.Code(err)})
+	}
+	return stream.SendAndClose(d.Proto())
+}
+
+// Correlate returns the covariance and correlation of the series streamed in
+// x and y
+func (s *statisticsGrpcServer) Correlate(stream pb.Statistics_CorrelateServer) error {
+	c, err := statsservice.Receive(stream.Recv, s.maxValues)
+	if err != nil && !errors.Is(err, statsservice.ErrTooManyValues) {
+		return err
+	}
+	var v statsservice.Correlation
+	if err == nil {
+		v, err = s.svc.Correlate(stream.Context(), c.X, c.Y)
+	}
+	if err != nil {
+		if s.statusErrors {
+			return rpcstatus.Error(rpcstatus.Code(err), err)
+		}
+		return stream.SendAndClose(&pb.CorrelateReply{Err: err2str(err), Code: rpcstatus.Code(err)})
+	}
+	return stream.SendAndClose(v.Proto())
+}
@@ -6,6 +6,10 @@ import (
 	"github.com/jwenz723/mathserver/grpc_only/std/pkg/mathservice"
 	"github.com/jwenz723/mathserver/grpc_only/std/pkg/server"
 	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
 	"github.com/oklog/oklog/pkg/group"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -22,11 +26,24 @@ import (
 func main() {
 	fs := flag.NewFlagSet("mathsvc", flag.ExitOnError)
 	var (
-		debugAddr      = fs.String("debug.addr", ":8080", "Debug and metrics listen address")
-		grpcAddr       = fs.String("grpc-addr", ":8082", "gRPC listen address")
+		debugAddr    = fs.String("debug.addr", ":8080", "Debug and metrics listen address")
+		grpcAddr     = fs.String("grpc-addr", ":8082", "gRPC listen address")
+		statusErrors = fs.Bool("grpc-status-errors", false, "Report service errors as gRPC status codes instead of in the reply")
+		unitsFile    = fs.String("units", "", "File defining units besides the built-in ones, one per line such as: kn = 1 nmi/h")
+		maxDigits    = fs.Int("max-digits", combinatoricsservice.DefaultMaxDigits, "Most decimal digits of the exact results of the Combinatorics service")
 	)
+	defaultPrecision := precision.Precision{Mode: precision.Float64}
+	fs.Var(&defaultPrecision.Mode, "precision", "Default precision mode: float64, bigfloat or rational")
+	fs.UintVar(&defaultPrecision.Bits, "precision-bits", precision.DefaultBits, "Default mantissa bits used by the bigfloat precision mode")
+	var nonFinite mathservice2.NonFinitePolicy
+	fs.Var(&nonFinite, "non-finite", "Policy for NaN and infinite results of the Math service: pass, reject or string")
 	fs.Usage = usageFor(fs, os.Args[0]+" [flags]")
 	fs.Parse(os.Args[1:])
+	if defaultPrecision.Bits > precision.MaxBits {
+		fmt.Fprintf(os.Stderr, "invalid value %d for flag -precision-bits: at most %d bits are supported\n", defaultPrecision.Bits, precision.MaxBits)
+		fs.Usage()
+		os.Exit(2)
+	}
 
 	logger, _ := zap.NewProduction()
 	duration := prometheus.NewSummaryVec(prometheus.SummaryOpts{
@@ -36,9 +53,29 @@ func main() {
 	}, []string{"method", "success"})
 	prometheus.MustRegister(duration)
 
+	units := unitservice.NewRegistry()
+	if *unitsFile != "" {
+		if err := units.LoadFile(*unitsFile); err != nil {
+			logger.Error("failed to load units",
+				zap.String("file", *unitsFile),
+				zap.Error(err))
+			os.Exit(1)
+		}
+	}
+
 	var (
-		service = mathservice.ObservabilityMiddleware(duration, logger)(mathservice.NewBasicService())
-		grpcSvc = server.NewGrpcServer(service)
+		service = mathservice.New(duration, logger, defaultPrecision, nonFinite)
+		grpcSvc = server.NewGrpcServer(service, *statusErrors)
+
+		unitsService = mathservice.NewUnits(duration, logger, units)
+		unitsGrpcSvc = server.NewUnitsGrpcServer(unitsService, *statusErrors)
+
+		financeGrpcSvc = server.NewFinanceGrpcServer(mathservice.NewFinance(duration, logger), *statusErrors)
+		ntGrpcSvc      = server.NewNumberTheoryGrpcServer(mathservice.NewNumberTheory(duration, logger), *statusErrors)
+		combGrpcSvc    = server.NewCombinatoricsGrpcServer(mathservice.NewCombinatorics(duration, logger, *maxDigits), *statusErrors)
+		calcGrpcSvc    = server.NewCalculusGrpcServer(mathservice.NewCalculus(duration, logger), *statusErrors)
+		complexGrpcSvc = server.NewComplexGrpcServer(mathservice.NewComplex(duration, logger), *statusErrors)
+		linalgGrpcSvc  = server.NewLinearAlgebraGrpcServer(mathservice.NewLinearAlgebra(duration, logger), *statusErrors)
 	)
 
 	var g group.Group
@@ -71,6 +108,13 @@ func main() {
 		g.Add(func() error {
 			grpcServer := grpc.NewServer()
 			pb.RegisterMathServer(grpcServer, &grpcSvc)
+			pb.RegisterUnitsServer(grpcServer, &unitsGrpcSvc)
+			pb.RegisterFinanceServer(grpcServer, &financeGrpcSvc)
+			pb.RegisterNumberTheoryServer(grpcServer, &ntGrpcSvc)
+			pb.RegisterCombinatoricsServer(grpcServer, &combGrpcSvc)
+			pb.RegisterCalculusServer(grpcServer, &calcGrpcSvc)
+			pb.RegisterComplexServer(grpcServer, &complexGrpcSvc)
+			pb.RegisterLinearAlgebraServer(grpcServer, &linalgGrpcSvc)
 			return grpcServer.Serve(lis)
 		}, func(error) {
 			lis.Close()
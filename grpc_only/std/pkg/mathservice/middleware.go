@@ -3,27 +3,41 @@ package mathservice
 import (
 	"context"
 	"fmt"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"time"
 )
 
-type Middleware func(Service) Service
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -o middleware_gen.go
+
+// New returns a basic Service with all of the expected middlewares wired in,
+// applying nonFinite to its results.
+func New(duration *prometheus.SummaryVec, logger *zap.Logger, p precision.Precision, nonFinite mathservice2.NonFinitePolicy) mathservice2.Service {
+	var svc mathservice2.Service
+	{
+		svc = mathservice2.NewBasicService(p)
+		svc = mathservice2.NonFiniteMiddleware(nonFinite)(svc)
+		svc = ObservabilityMiddleware(duration, logger)(svc)
+	}
+	return svc
+}
 
 // ObservabilityMiddleware implements both logging and prometheus metrics for each Service method
-func ObservabilityMiddleware(duration *prometheus.SummaryVec, logger *zap.Logger) Middleware {
-	return func(next Service) Service {
-		return observabilityMiddleware{duration,logger, next}
+func ObservabilityMiddleware(duration *prometheus.SummaryVec, logger *zap.Logger) mathservice2.Middleware {
+	return func(next mathservice2.Service) mathservice2.Service {
+		return observabilityMiddleware{duration, logger, next}
 	}
 }
 
 type observabilityMiddleware struct {
 	duration *prometheus.SummaryVec
 	logger   *zap.Logger
-	next     Service
+	next     mathservice2.Service
 }
 
-func (mw observabilityMiddleware) observeMethodExecution(method string, a, b, v float64, begin time.Time, err error) {
+func (mw observabilityMiddleware) observeMethodExecution(ctx context.Context, method string, a, b, v float64, begin time.Time, err error) {
 	duration := time.Since(begin)
 
 	mw.logger.Info("method executed",
@@ -36,58 +50,41 @@ func (mw observabilityMiddleware) observeMethodExecution(method string, a, b, v
 	mw.duration.WithLabelValues(method, fmt.Sprint(err == nil)).Observe(duration.Seconds())
 }
 
-func (mw observabilityMiddleware) Divide(ctx context.Context, a, b float64) (v float64, err error) {
-	defer func(begin time.Time) {
-		m := "Divide"
-		mw.observeMethodExecution(m, a, b, v, begin, err)
-	}(time.Now())
-	return mw.next.Divide(ctx, a, b)
-}
+func (mw observabilityMiddleware) observeListMethodExecution(ctx context.Context, method string, values []float64, v float64, begin time.Time, err error) {
+	duration := time.Since(begin)
 
-func (mw observabilityMiddleware) Max(ctx context.Context, a, b float64) (v float64, err error) {
-	defer func(begin time.Time) {
-		m := "Max"
-		mw.observeMethodExecution(m, a, b, v, begin, err)
-	}(time.Now())
-	return mw.next.Max(ctx, a, b)
+	mw.logger.Info("method executed",
+		zap.String("method", method),
+		zap.Int("n", len(values)),
+		zap.Float64("v", v),
+		zap.Duration("duration", duration),
+		zap.Error(err))
+	mw.duration.WithLabelValues(method, fmt.Sprint(err == nil)).Observe(duration.Seconds())
 }
 
-func (mw observabilityMiddleware) Min(ctx context.Context, a, b float64) (v float64, err error) {
-	defer func(begin time.Time) {
-		m := "Min"
-		mw.observeMethodExecution(m, a, b, v, begin, err)
-	}(time.Now())
-	return mw.next.Min(ctx, a, b)
-}
+func (mw observabilityMiddleware) observeUnaryMethodExecution(ctx context.Context, method string, x, v float64, begin time.Time, err error) {
+	duration := time.Since(begin)
 
-func (mw observabilityMiddleware) Multiply(ctx context.Context, a, b float64) (v float64, err error) {
-	defer func(begin time.Time) {
-		m := "Multiply"
-		mw.observeMethodExecution(m, a, b, v, begin, err)
-	}(time.Now())
-	return mw.next.Multiply(ctx, a, b)
+	mw.logger.Info("method executed",
+		zap.String("method", method),
+		zap.Float64("x", x),
+		zap.Float64("v", v),
+		zap.Duration("duration", duration),
+		zap.Error(err))
+	mw.duration.WithLabelValues(method, fmt.Sprint(err == nil)).Observe(duration.Seconds())
 }
 
-func (mw observabilityMiddleware) Pow(ctx context.Context, a, b float64) (v float64, err error) {
-	defer func(begin time.Time) {
-		m := "Pow"
-		mw.observeMethodExecution(m, a, b, v, begin, err)
-	}(time.Now())
-	return mw.next.Pow(ctx, a, b)
-}
+// observe logs a call of method made at begin, which failed with err, along
+// with keyvals, alternating keys and values, and records its duration.
+func observe(logger *zap.Logger, duration *prometheus.SummaryVec, method string, begin time.Time, err error, keyvals ...interface{}) {
+	d := time.Since(begin)
 
-func (mw observabilityMiddleware) Subtract(ctx context.Context, a, b float64) (v float64, err error) {
-	defer func(begin time.Time) {
-		m := "Subtract"
-		mw.observeMethodExecution(m, a, b, v, begin, err)
-	}(time.Now())
-	return mw.next.Subtract(ctx, a, b)
+	fields := []zap.Field{zap.String("method", method)}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		fields = append(fields, zap.Any(fmt.Sprint(keyvals[i]), keyvals[i+1]))
+	}
+	logger.Info("method executed", append(fields,
+		zap.Duration("duration", d),
+		zap.Error(err))...)
+	duration.WithLabelValues(method, fmt.Sprint(err == nil)).Observe(d.Seconds())
 }
-
-func (mw observabilityMiddleware) Sum(ctx context.Context, a, b float64) (v float64, err error) {
-	defer func(begin time.Time) {
-		m := "Sum"
-		mw.observeMethodExecution(m, a, b, v, begin, err)
-	}(time.Now())
-	return mw.next.Sum(ctx, a, b)
-}
\ No newline at end of file
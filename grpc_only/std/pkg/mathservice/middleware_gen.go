@@ -0,0 +1,344 @@
+// Code generated by mathsvcgen from mathsvc.proto. DO NOT EDIT.
+
+package mathservice
+
+import (
+	"context"
+	"time"
+)
+
+func (mw observabilityMiddleware) Divide(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Divide"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.Divide(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) Max(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Max"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.Max(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) Min(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Min"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.Min(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) Multiply(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Multiply"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.Multiply(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) Pow(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Pow"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.Pow(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) Subtract(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Subtract"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.Subtract(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) Sum(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Sum"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.Sum(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) SumAll(ctx context.Context, values []float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "SumAll"
+		mw.observeListMethodExecution(ctx, m, values, v, begin, err)
+	}(time.Now())
+	return mw.next.SumAll(ctx, values)
+}
+
+func (mw observabilityMiddleware) Product(ctx context.Context, values []float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Product"
+		mw.observeListMethodExecution(ctx, m, values, v, begin, err)
+	}(time.Now())
+	return mw.next.Product(ctx, values)
+}
+
+func (mw observabilityMiddleware) Mean(ctx context.Context, values []float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Mean"
+		mw.observeListMethodExecution(ctx, m, values, v, begin, err)
+	}(time.Now())
+	return mw.next.Mean(ctx, values)
+}
+
+func (mw observabilityMiddleware) Median(ctx context.Context, values []float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Median"
+		mw.observeListMethodExecution(ctx, m, values, v, begin, err)
+	}(time.Now())
+	return mw.next.Median(ctx, values)
+}
+
+func (mw observabilityMiddleware) Variance(ctx context.Context, values []float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Variance"
+		mw.observeListMethodExecution(ctx, m, values, v, begin, err)
+	}(time.Now())
+	return mw.next.Variance(ctx, values)
+}
+
+func (mw observabilityMiddleware) StdDev(ctx context.Context, values []float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "StdDev"
+		mw.observeListMethodExecution(ctx, m, values, v, begin, err)
+	}(time.Now())
+	return mw.next.StdDev(ctx, values)
+}
+
+func (mw observabilityMiddleware) Mod(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Mod"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.Mod(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) IntDivide(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "IntDivide"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.IntDivide(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) Remainder(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Remainder"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.Remainder(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) Gcd(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Gcd"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.Gcd(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) Lcm(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Lcm"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.Lcm(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) Sqrt(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Sqrt"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Sqrt(ctx, x)
+}
+
+func (mw observabilityMiddleware) Abs(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Abs"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Abs(ctx, x)
+}
+
+func (mw observabilityMiddleware) Negate(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Negate"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Negate(ctx, x)
+}
+
+func (mw observabilityMiddleware) Exp(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Exp"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Exp(ctx, x)
+}
+
+func (mw observabilityMiddleware) Ln(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Ln"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Ln(ctx, x)
+}
+
+func (mw observabilityMiddleware) Log10(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Log10"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Log10(ctx, x)
+}
+
+func (mw observabilityMiddleware) Log2(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Log2"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Log2(ctx, x)
+}
+
+func (mw observabilityMiddleware) Floor(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Floor"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Floor(ctx, x)
+}
+
+func (mw observabilityMiddleware) Ceil(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Ceil"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Ceil(ctx, x)
+}
+
+func (mw observabilityMiddleware) Round(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Round"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Round(ctx, x)
+}
+
+func (mw observabilityMiddleware) Trunc(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Trunc"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Trunc(ctx, x)
+}
+
+func (mw observabilityMiddleware) Sin(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Sin"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Sin(ctx, x)
+}
+
+func (mw observabilityMiddleware) Cos(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Cos"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Cos(ctx, x)
+}
+
+func (mw observabilityMiddleware) Tan(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Tan"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Tan(ctx, x)
+}
+
+func (mw observabilityMiddleware) Asin(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Asin"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Asin(ctx, x)
+}
+
+func (mw observabilityMiddleware) Acos(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Acos"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Acos(ctx, x)
+}
+
+func (mw observabilityMiddleware) Atan(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Atan"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Atan(ctx, x)
+}
+
+func (mw observabilityMiddleware) Gamma(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Gamma"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Gamma(ctx, x)
+}
+
+func (mw observabilityMiddleware) LogGamma(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "LogGamma"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.LogGamma(ctx, x)
+}
+
+func (mw observabilityMiddleware) Beta(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Beta"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.Beta(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) Erf(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Erf"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Erf(ctx, x)
+}
+
+func (mw observabilityMiddleware) Erfc(ctx context.Context, x float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "Erfc"
+		mw.observeUnaryMethodExecution(ctx, m, x, v, begin, err)
+	}(time.Now())
+	return mw.next.Erfc(ctx, x)
+}
+
+func (mw observabilityMiddleware) BesselJ(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "BesselJ"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.BesselJ(ctx, a, b)
+}
+
+func (mw observabilityMiddleware) BesselY(ctx context.Context, a, b float64) (v float64, err error) {
+	defer func(begin time.Time) {
+		m := "BesselY"
+		mw.observeMethodExecution(ctx, m, a, b, v, begin, err)
+	}(time.Now())
+	return mw.next.BesselY(ctx, a, b)
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/complexservice"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service Complex -type complexGrpcServer -o complex_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.ComplexServer = &complexGrpcServer{}
+)
+
+type complexGrpcServer struct {
+	svc          complexservice.Service
+	statusErrors bool
+}
+
+// NewComplexGrpcServer returns a ComplexServer backed by svc, reporting
+// errors like NewGrpcServer does.
+func NewComplexGrpcServer(svc complexservice.Service, statusErrors bool) complexGrpcServer {
+	return complexGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// complexOpReply returns the reply to a call that computed v, or failed with
+// err.
+func (s *complexGrpcServer) complexOpReply(v complex128, err error) (*pb.ComplexOpReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.ComplexOpReply{
+		V:    complexservice.Proto(v),
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+// mathOpReply is complexOpReply for the methods returning a real number.
+func (s *complexGrpcServer) mathOpReply(v float64, err error) (*pb.MathOpReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.MathOpReply{
+		V:    v,
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
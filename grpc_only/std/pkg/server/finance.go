@@ -0,0 +1,58 @@
+package server
+
+import (
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/financeservice"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service Finance -type financeGrpcServer -o finance_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.FinanceServer = &financeGrpcServer{}
+)
+
+type financeGrpcServer struct {
+	svc          financeservice.Service
+	statusErrors bool
+}
+
+// NewFinanceGrpcServer returns a FinanceServer backed by svc, reporting errors
+// like NewGrpcServer does.
+func NewFinanceGrpcServer(svc financeservice.Service, statusErrors bool) financeGrpcServer {
+	return financeGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// AmortizationSchedule streams one row per period of a loan
+func (s *financeGrpcServer) AmortizationSchedule(req *pb.AmortizationRequest, stream pb.Finance_AmortizationScheduleServer) error {
+	var sendErr error
+	err := s.svc.AmortizationSchedule(stream.Context(), financeservice.LoanFromProto(req), func(row financeservice.AmortizationRow) error {
+		sendErr = stream.Send(row.Proto())
+		return sendErr
+	})
+	switch {
+	case err == nil:
+		return nil
+	case sendErr != nil:
+		return sendErr
+	case s.statusErrors:
+		return rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return stream.Send(&pb.AmortizationRow{Err: err2str(err), Code: rpcstatus.Code(err)})
+}
+
+// decimalReply returns the reply to a call that computed v, or failed with err.
+func (s *financeGrpcServer) decimalReply(v financeservice.Decimal, err error) (*pb.DecimalReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.DecimalReply{
+		V:    string(v),
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
@@ -2,85 +2,71 @@ package server
 
 import (
 	"context"
-	mathservice2 "github.com/jwenz723/mathserver/grpc_only/std/pkg/mathservice"
 	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/compute"
+	"github.com/jwenz723/mathserver/pkg/expr"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
 )
 
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -o grpc_gen.go
+
 // compile time assertions to ensure our types are implementing interfaces
 var (
 	_ pb.MathServer = &grpcServer{}
 )
 
 type grpcServer struct {
-	svc mathservice2.Service
+	svc          mathservice2.Service
+	statusErrors bool
 }
 
-func NewGrpcServer(svc mathservice2.Service) grpcServer {
+// NewGrpcServer returns a MathServer backed by svc. When statusErrors is set
+// errors returned by svc fail the call with a gRPC status, see package
+// rpcstatus, rather than being returned in the MathOpReply.
+func NewGrpcServer(svc mathservice2.Service, statusErrors bool) grpcServer {
 	return grpcServer{
-		svc: svc,
+		svc:          svc,
+		statusErrors: statusErrors,
 	}
 }
 
-// Divide two integers, a/b
-func (s *grpcServer) Divide(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	v, err := s.svc.Divide(ctx, req.A, req.B)
-	return &pb.MathOpReply{
-		V:                    v,
-		Err:                  err2str(err),
-	}, nil
-}
-
-// Max two integers, returns the greater value of a and b
-func (s *grpcServer) Max(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	v, err := s.svc.Max(ctx, req.A, req.B)
-	return &pb.MathOpReply{
-		V:                    v,
-		Err:                  err2str(err),
-	}, nil
-}
-
-// Min two integers, returns the lesser value of a and b
-func (s *grpcServer) Min(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	v, err := s.svc.Min(ctx, req.A, req.B)
-	return &pb.MathOpReply{
-		V:                    v,
-		Err:                  err2str(err),
-	}, nil
-}
-
-// Multiply two integers, a*b
-func (s *grpcServer) Multiply(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	v, err := s.svc.Multiply(ctx, req.A, req.B)
-	return &pb.MathOpReply{
-		V:                    v,
-		Err:                  err2str(err),
-	}, nil
+// Evaluate an arithmetic expression, e.g. (3+4)*2^5/7
+func (s *grpcServer) Evaluate(ctx context.Context, req *pb.EvaluateRequest) (*pb.MathOpReply, error) {
+	ctx, res := precision.NewContext(ctx, precision.FromProto(req.Precision))
+	v, err := expr.Evaluate(ctx, s.svc, req.Expression)
+	return s.reply(v, res, err, "expression")
 }
 
-// Pow two integers, a^b
-func (s *grpcServer) Pow(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	v, err := s.svc.Pow(ctx, req.A, req.B)
-	return &pb.MathOpReply{
-		V:                    v,
-		Err:                  err2str(err),
-	}, nil
+// Batch performs several operations, the error of each is returned in its own
+// result
+func (s *grpcServer) Batch(ctx context.Context, req *pb.BatchRequest) (*pb.BatchReply, error) {
+	reply, err := compute.ServeBatch(ctx, req, s, nil)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return reply, nil
 }
 
-// Subtract two integers, a-b
-func (s *grpcServer) Subtract(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	v, err := s.svc.Subtract(ctx, req.A, req.B)
-	return &pb.MathOpReply{
-		V:                    v,
-		Err:                  err2str(err),
-	}, nil
+// Compute performs the operations received on the stream and streams the
+// replies back as they complete
+func (s *grpcServer) Compute(stream pb.Math_ComputeServer) error {
+	return compute.Serve(stream, s, nil)
 }
 
-// Sums two integers. a+b
-func (s *grpcServer) Sum(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
-	v, err := s.svc.Sum(ctx, req.A, req.B)
+// reply returns the reply to a call that computed v, or failed with err. The
+// fields name the request fields at fault for err when the failure is
+// reported as a status.
+func (s *grpcServer) reply(v float64, res *precision.Result, err error, fields ...string) (*pb.MathOpReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err, fields...)
+	}
 	return &pb.MathOpReply{
-		V:                    v,
-		Err:                  err2str(err),
+		V:     v,
+		Err:   err2str(err),
+		Exact: res.String(),
+		Code:  rpcstatus.Code(err),
 	}, nil
 }
 
@@ -89,4 +75,4 @@ func err2str(err error) string {
 		return ""
 	}
 	return err.Error()
-}
\ No newline at end of file
+}
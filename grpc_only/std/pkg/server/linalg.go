@@ -0,0 +1,65 @@
+package server
+
+import (
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/linalgservice"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service LinearAlgebra -type linalgGrpcServer -o linalg_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.LinearAlgebraServer = &linalgGrpcServer{}
+)
+
+type linalgGrpcServer struct {
+	svc          linalgservice.Service
+	statusErrors bool
+}
+
+// NewLinearAlgebraGrpcServer returns a LinearAlgebraServer backed by svc,
+// reporting errors like NewGrpcServer does.
+func NewLinearAlgebraGrpcServer(svc linalgservice.Service, statusErrors bool) linalgGrpcServer {
+	return linalgGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// mathOpReply returns the reply to a call that computed the number v, or
+// failed with err.
+func (s *linalgGrpcServer) mathOpReply(v float64, err error) (*pb.MathOpReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.MathOpReply{
+		V:    v,
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+// vectorReply is mathOpReply for the methods returning a vector.
+func (s *linalgGrpcServer) vectorReply(v []float64, err error) (*pb.VectorReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.VectorReply{
+		V:    v,
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
+
+// matrixReply is mathOpReply for the methods returning a matrix.
+func (s *linalgGrpcServer) matrixReply(v linalgservice.Matrix, err error) (*pb.MatrixReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.MatrixReply{
+		V:    v.Proto(),
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind grpc -service Units -type unitsGrpcServer -o units_gen.go
+
+// compile time assertions to ensure our types are implementing interfaces
+var (
+	_ pb.UnitsServer = &unitsGrpcServer{}
+)
+
+type unitsGrpcServer struct {
+	svc          unitservice.Service
+	statusErrors bool
+}
+
+// NewUnitsGrpcServer returns a UnitsServer backed by svc, reporting errors
+// like NewGrpcServer does.
+func NewUnitsGrpcServer(svc unitservice.Service, statusErrors bool) unitsGrpcServer {
+	return unitsGrpcServer{
+		svc:          svc,
+		statusErrors: statusErrors,
+	}
+}
+
+// quantityReply returns the reply to a call that computed v, or failed with
+// err.
+func (s *unitsGrpcServer) quantityReply(v unitservice.Quantity, err error) (*pb.QuantityReply, error) {
+	if err != nil && s.statusErrors {
+		return nil, rpcstatus.Error(rpcstatus.Code(err), err)
+	}
+	return &pb.QuantityReply{
+		V:    v.Proto(),
+		Err:  err2str(err),
+		Code: rpcstatus.Code(err),
+	}, nil
+}
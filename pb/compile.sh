@@ -12,3 +12,7 @@
 #  https://github.com/grpc/grpc-go/tree/master/examples
 
 protoc mathsvc.proto --go_out=plugins=grpc:.
+
+# Regenerate the code of each operation from the new descriptor, see
+# cmd/mathsvcgen
+(cd .. && go generate ./...)
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -24,9 +25,615 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// ErrorCode identifies the errors returned by the Math service so that clients
+// can tell them apart without comparing error messages.
+type ErrorCode int32
+
+const (
+	ErrorCode_NO_ERROR ErrorCode = 0
+	// UNKNOWN is used for errors that don't have a more specific code
+	ErrorCode_UNKNOWN        ErrorCode = 1
+	ErrorCode_DIVIDE_BY_ZERO ErrorCode = 2
+	ErrorCode_NO_MAX         ErrorCode = 3
+	ErrorCode_NO_MIN         ErrorCode = 4
+	ErrorCode_NO_VALUES      ErrorCode = 5
+	// SYNTAX_ERROR is returned by Evaluate when the expression can't be parsed
+	ErrorCode_SYNTAX_ERROR         ErrorCode = 6
+	ErrorCode_NON_INTEGER_EXPONENT ErrorCode = 7
+	ErrorCode_EXPONENT_TOO_LARGE   ErrorCode = 8
+	ErrorCode_NOT_REPRESENTABLE    ErrorCode = 9
+	// UNKNOWN_OPERATION is returned by Compute and Batch for an operation that
+	// doesn't exist
+	ErrorCode_UNKNOWN_OPERATION ErrorCode = 10
+	// MODULO_BY_ZERO is returned by Mod and Remainder when b is zero
+	ErrorCode_MODULO_BY_ZERO ErrorCode = 11
+	// NOT_INTEGER is returned by the integer operations when an operand isn't
+	// a whole number
+	ErrorCode_NOT_INTEGER ErrorCode = 12
+	// NEGATIVE_SQRT is returned by Sqrt when x is negative
+	ErrorCode_NEGATIVE_SQRT ErrorCode = 13
+	// NON_POSITIVE_LOG is returned by the logarithms when x isn't positive
+	ErrorCode_NON_POSITIVE_LOG ErrorCode = 14
+	// OUT_OF_DOMAIN is returned by Asin and Acos when x is outside [-1, 1]
+	ErrorCode_OUT_OF_DOMAIN ErrorCode = 15
+	// NON_FINITE is returned when the result is NaN or infinite and the server
+	// is configured to reject such results
+	ErrorCode_NON_FINITE ErrorCode = 16
+	// DIMENSION_MISMATCH is returned by the LinearAlgebra service when the
+	// dimensions of the operands don't fit the operation
+	ErrorCode_DIMENSION_MISMATCH ErrorCode = 17
+	// MALFORMED_MATRIX is returned when the values of a matrix don't match its
+	// rows and cols
+	ErrorCode_MALFORMED_MATRIX ErrorCode = 18
+	// NOT_SQUARE is returned by Determinant, Inverse and Solve when a isn't a
+	// square matrix
+	ErrorCode_NOT_SQUARE ErrorCode = 19
+	// SINGULAR_MATRIX is returned by Inverse and Solve when a has no inverse
+	ErrorCode_SINGULAR_MATRIX ErrorCode = 20
+	// ZERO_POLYNOMIAL is returned by Roots when every coefficient of a is zero,
+	// so every number is a root
+	ErrorCode_ZERO_POLYNOMIAL ErrorCode = 21
+	// INVALID_TOLERANCE is returned by Roots when the tolerance is negative or
+	// NaN
+	ErrorCode_INVALID_TOLERANCE ErrorCode = 22
+	// NO_CONVERGENCE is returned by Roots when the roots can't be found within
+	// the tolerance, e.g. because a coefficient isn't finite
+	ErrorCode_NO_CONVERGENCE ErrorCode = 23
+	// NON_FINITE_VALUE is returned by the Statistics service when a value of the
+	// dataset is NaN or infinite
+	ErrorCode_NON_FINITE_VALUE ErrorCode = 24
+	// INVALID_QUANTILE is returned by Describe when a quantile is outside
+	// [0, 1]
+	ErrorCode_INVALID_QUANTILE ErrorCode = 25
+	// LENGTH_MISMATCH is returned by Correlate when x and y don't have the same
+	// number of values
+	ErrorCode_LENGTH_MISMATCH ErrorCode = 26
+	// TOO_MANY_BUCKETS is returned by Describe when the histogram would have
+	// more buckets than the server allows
+	ErrorCode_TOO_MANY_BUCKETS ErrorCode = 27
+	// UNKNOWN_UNIT is returned by the Units service when a unit isn't defined or
+	// can't be parsed
+	ErrorCode_UNKNOWN_UNIT ErrorCode = 28
+	// INCOMPATIBLE_UNITS is returned by the Units service when the operands of
+	// Sum, Subtract or Convert don't have the same dimension
+	ErrorCode_INCOMPATIBLE_UNITS ErrorCode = 29
+	// FRACTIONAL_DIMENSION is returned by Pow when the unit of the result would
+	// have a fractional exponent, e.g. m^0.5
+	ErrorCode_FRACTIONAL_DIMENSION ErrorCode = 30
+	// INVALID_DECIMAL is returned by the Finance service when an amount or a
+	// rate isn't a decimal number
+	ErrorCode_INVALID_DECIMAL ErrorCode = 31
+	// INVALID_RATE is returned by the Finance service when a rate is -1 or
+	// less, so that money wouldn't keep its sign over a period
+	ErrorCode_INVALID_RATE ErrorCode = 32
+	// INVALID_PERIODS is returned by the Finance service when a number of
+	// periods or a compounding frequency is out of range
+	ErrorCode_INVALID_PERIODS ErrorCode = 33
+	// INVALID_SCALE is returned by the Finance service when the scale of a
+	// request is too large
+	ErrorCode_INVALID_SCALE ErrorCode = 34
+	// NO_SIGN_CHANGE is returned by IRR when the cash flows are all positive
+	// or all negative, so that they have no internal rate of return
+	ErrorCode_NO_SIGN_CHANGE ErrorCode = 35
+	// IRR_NO_CONVERGENCE is returned by IRR when its search for the rate
+	// doesn't converge, e.g. from a poor starting rate
+	ErrorCode_IRR_NO_CONVERGENCE ErrorCode = 36
+	// INVALID_INTEGER is returned by the NumberTheory service when an operand
+	// isn't a decimal integer
+	ErrorCode_INVALID_INTEGER ErrorCode = 37
+	// OPERAND_TOO_LARGE is returned by the NumberTheory service when an
+	// operand has more than 4096 bits
+	ErrorCode_OPERAND_TOO_LARGE ErrorCode = 38
+	// NOT_POSITIVE is returned by the NumberTheory service when an operand
+	// that must be positive, such as a modulus, isn't
+	ErrorCode_NOT_POSITIVE ErrorCode = 39
+	// NO_INVERSE is returned by ModInverse, and ModPow with a negative
+	// exponent, when the operand and the modulus aren't coprime
+	ErrorCode_NO_INVERSE ErrorCode = 40
+	// INVALID_BUDGET is returned by the NumberTheory service when the compute
+	// budget of a request is more than the server allows
+	ErrorCode_INVALID_BUDGET ErrorCode = 41
+	// BUDGET_EXCEEDED is returned by the NumberTheory service when a request
+	// couldn't be completed within its compute budget
+	ErrorCode_BUDGET_EXCEEDED ErrorCode = 42
+	// POLE is returned by Gamma, LogGamma and Beta when an operand is zero or
+	// a negative integer, where the gamma function has a pole
+	ErrorCode_POLE ErrorCode = 43
+	// INVALID_ORDER is returned by BesselJ and BesselY when the order a isn't
+	// an integer between -10000 and 10000
+	ErrorCode_INVALID_ORDER ErrorCode = 44
+	// NON_POSITIVE_ARGUMENT is returned by BesselY when b isn't positive
+	ErrorCode_NON_POSITIVE_ARGUMENT ErrorCode = 45
+	// NEGATIVE_OPERAND is returned by the Combinatorics service when n or k is
+	// negative
+	ErrorCode_NEGATIVE_OPERAND ErrorCode = 46
+	// TOO_MANY_DIGITS is returned by the Combinatorics service when the result
+	// has more decimal digits than the server allows
+	ErrorCode_TOO_MANY_DIGITS ErrorCode = 47
+	// CALCULUS_NO_CONVERGENCE is returned by the Calculus service when a method
+	// doesn't reach the tolerance within max_iterations
+	ErrorCode_CALCULUS_NO_CONVERGENCE ErrorCode = 48
+	// CALCULUS_INVALID_TOLERANCE is returned by the Calculus service when the
+	// tolerance is negative or NaN
+	ErrorCode_CALCULUS_INVALID_TOLERANCE ErrorCode = 49
+	// INVALID_ITERATIONS is returned by the Calculus service when
+	// max_iterations is negative or more than the server allows
+	ErrorCode_INVALID_ITERATIONS ErrorCode = 50
+	// INVALID_INTERVAL is returned by the Calculus service when a, b or x isn't
+	// finite, or when a isn't less than b for FindRoot and Minimize
+	ErrorCode_INVALID_INTERVAL ErrorCode = 51
+	// NO_BRACKET is returned by FindRoot when the expression has the same sign
+	// at a and b
+	ErrorCode_NO_BRACKET ErrorCode = 52
+	// NON_FINITE_FUNCTION is returned by the Calculus service when the
+	// expression is NaN or infinite where it's evaluated
+	ErrorCode_NON_FINITE_FUNCTION ErrorCode = 53
+	// INVALID_METHOD is returned by the Calculus service when the method isn't
+	// one of those of the called method
+	ErrorCode_INVALID_METHOD ErrorCode = 54
+	// INVALID_VARIABLE is returned by the Symbolic service when the variable
+	// isn't a name that can be a variable
+	ErrorCode_INVALID_VARIABLE ErrorCode = 55
+	// NOT_DIFFERENTIABLE is returned by the Symbolic service when the
+	// expression has no symbolic derivative, as max and min don't
+	ErrorCode_NOT_DIFFERENTIABLE ErrorCode = 56
+	// EXPRESSION_TOO_LARGE is returned by the Symbolic service when the
+	// expression has more terms than allowed
+	ErrorCode_EXPRESSION_TOO_LARGE ErrorCode = 57
+	// INVALID_PRECISION is returned when the bits of a BIGFLOAT precision are
+	// more than the server allows
+	ErrorCode_INVALID_PRECISION ErrorCode = 58
+	// TOO_MANY_VALUES is returned by the Statistics service when a stream
+	// carries more values than the server allows
+	ErrorCode_TOO_MANY_VALUES ErrorCode = 59
+	// INVALID_DIVISION is returned when a request asks for a division that
+	// doesn't exist
+	ErrorCode_INVALID_DIVISION ErrorCode = 60
+	// TOO_MANY_ITEMS is returned by Batch when the request has more items than
+	// the server allows
+	ErrorCode_TOO_MANY_ITEMS ErrorCode = 61
+)
+
+var ErrorCode_name = map[int32]string{
+	0:  "NO_ERROR",
+	1:  "UNKNOWN",
+	2:  "DIVIDE_BY_ZERO",
+	3:  "NO_MAX",
+	4:  "NO_MIN",
+	5:  "NO_VALUES",
+	6:  "SYNTAX_ERROR",
+	7:  "NON_INTEGER_EXPONENT",
+	8:  "EXPONENT_TOO_LARGE",
+	9:  "NOT_REPRESENTABLE",
+	10: "UNKNOWN_OPERATION",
+	11: "MODULO_BY_ZERO",
+	12: "NOT_INTEGER",
+	13: "NEGATIVE_SQRT",
+	14: "NON_POSITIVE_LOG",
+	15: "OUT_OF_DOMAIN",
+	16: "NON_FINITE",
+	17: "DIMENSION_MISMATCH",
+	18: "MALFORMED_MATRIX",
+	19: "NOT_SQUARE",
+	20: "SINGULAR_MATRIX",
+	21: "ZERO_POLYNOMIAL",
+	22: "INVALID_TOLERANCE",
+	23: "NO_CONVERGENCE",
+	24: "NON_FINITE_VALUE",
+	25: "INVALID_QUANTILE",
+	26: "LENGTH_MISMATCH",
+	27: "TOO_MANY_BUCKETS",
+	28: "UNKNOWN_UNIT",
+	29: "INCOMPATIBLE_UNITS",
+	30: "FRACTIONAL_DIMENSION",
+	31: "INVALID_DECIMAL",
+	32: "INVALID_RATE",
+	33: "INVALID_PERIODS",
+	34: "INVALID_SCALE",
+	35: "NO_SIGN_CHANGE",
+	36: "IRR_NO_CONVERGENCE",
+	37: "INVALID_INTEGER",
+	38: "OPERAND_TOO_LARGE",
+	39: "NOT_POSITIVE",
+	40: "NO_INVERSE",
+	41: "INVALID_BUDGET",
+	42: "BUDGET_EXCEEDED",
+	43: "POLE",
+	44: "INVALID_ORDER",
+	45: "NON_POSITIVE_ARGUMENT",
+	46: "NEGATIVE_OPERAND",
+	47: "TOO_MANY_DIGITS",
+	48: "CALCULUS_NO_CONVERGENCE",
+	49: "CALCULUS_INVALID_TOLERANCE",
+	50: "INVALID_ITERATIONS",
+	51: "INVALID_INTERVAL",
+	52: "NO_BRACKET",
+	53: "NON_FINITE_FUNCTION",
+	54: "INVALID_METHOD",
+	55: "INVALID_VARIABLE",
+	56: "NOT_DIFFERENTIABLE",
+	57: "EXPRESSION_TOO_LARGE",
+	58: "INVALID_PRECISION",
+	59: "TOO_MANY_VALUES",
+	60: "INVALID_DIVISION",
+	61: "TOO_MANY_ITEMS",
+}
+
+var ErrorCode_value = map[string]int32{
+	"NO_ERROR":                   0,
+	"UNKNOWN":                    1,
+	"DIVIDE_BY_ZERO":             2,
+	"NO_MAX":                     3,
+	"NO_MIN":                     4,
+	"NO_VALUES":                  5,
+	"SYNTAX_ERROR":               6,
+	"NON_INTEGER_EXPONENT":       7,
+	"EXPONENT_TOO_LARGE":         8,
+	"NOT_REPRESENTABLE":          9,
+	"UNKNOWN_OPERATION":          10,
+	"MODULO_BY_ZERO":             11,
+	"NOT_INTEGER":                12,
+	"NEGATIVE_SQRT":              13,
+	"NON_POSITIVE_LOG":           14,
+	"OUT_OF_DOMAIN":              15,
+	"NON_FINITE":                 16,
+	"DIMENSION_MISMATCH":         17,
+	"MALFORMED_MATRIX":           18,
+	"NOT_SQUARE":                 19,
+	"SINGULAR_MATRIX":            20,
+	"ZERO_POLYNOMIAL":            21,
+	"INVALID_TOLERANCE":          22,
+	"NO_CONVERGENCE":             23,
+	"NON_FINITE_VALUE":           24,
+	"INVALID_QUANTILE":           25,
+	"LENGTH_MISMATCH":            26,
+	"TOO_MANY_BUCKETS":           27,
+	"UNKNOWN_UNIT":               28,
+	"INCOMPATIBLE_UNITS":         29,
+	"FRACTIONAL_DIMENSION":       30,
+	"INVALID_DECIMAL":            31,
+	"INVALID_RATE":               32,
+	"INVALID_PERIODS":            33,
+	"INVALID_SCALE":              34,
+	"NO_SIGN_CHANGE":             35,
+	"IRR_NO_CONVERGENCE":         36,
+	"INVALID_INTEGER":            37,
+	"OPERAND_TOO_LARGE":          38,
+	"NOT_POSITIVE":               39,
+	"NO_INVERSE":                 40,
+	"INVALID_BUDGET":             41,
+	"BUDGET_EXCEEDED":            42,
+	"POLE":                       43,
+	"INVALID_ORDER":              44,
+	"NON_POSITIVE_ARGUMENT":      45,
+	"NEGATIVE_OPERAND":           46,
+	"TOO_MANY_DIGITS":            47,
+	"CALCULUS_NO_CONVERGENCE":    48,
+	"CALCULUS_INVALID_TOLERANCE": 49,
+	"INVALID_ITERATIONS":         50,
+	"INVALID_INTERVAL":           51,
+	"NO_BRACKET":                 52,
+	"NON_FINITE_FUNCTION":        53,
+	"INVALID_METHOD":             54,
+	"INVALID_VARIABLE":           55,
+	"NOT_DIFFERENTIABLE":         56,
+	"EXPRESSION_TOO_LARGE":       57,
+	"INVALID_PRECISION":          58,
+	"TOO_MANY_VALUES":            59,
+	"INVALID_DIVISION":           60,
+	"TOO_MANY_ITEMS":             61,
+}
+
+func (x ErrorCode) String() string {
+	return proto.EnumName(ErrorCode_name, int32(x))
+}
+
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{0}
+}
+
+// Division selects how IntDivide rounds a quotient that isn't a whole number,
+// and so the sign of the Remainder.
+type Division int32
+
+const (
+	// TRUNCATED rounds toward zero, the remainder has the sign of a
+	Division_TRUNCATED Division = 0
+	// FLOORED rounds toward negative infinity, the remainder has the sign of b
+	Division_FLOORED Division = 1
+)
+
+var Division_name = map[int32]string{
+	0: "TRUNCATED",
+	1: "FLOORED",
+}
+
+var Division_value = map[string]int32{
+	"TRUNCATED": 0,
+	"FLOORED":   1,
+}
+
+func (x Division) String() string {
+	return proto.EnumName(Division_name, int32(x))
+}
+
+func (Division) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{1}
+}
+
+type Precision_Mode int32
+
+const (
+	// DEFAULT uses the server's default mode
+	Precision_DEFAULT Precision_Mode = 0
+	Precision_FLOAT64 Precision_Mode = 1
+	// BIGFLOAT computes with a binary floating point mantissa of the given bits
+	Precision_BIGFLOAT Precision_Mode = 2
+	// RATIONAL computes with exact rational numbers
+	Precision_RATIONAL Precision_Mode = 3
+)
+
+var Precision_Mode_name = map[int32]string{
+	0: "DEFAULT",
+	1: "FLOAT64",
+	2: "BIGFLOAT",
+	3: "RATIONAL",
+}
+
+var Precision_Mode_value = map[string]int32{
+	"DEFAULT":  0,
+	"FLOAT64":  1,
+	"BIGFLOAT": 2,
+	"RATIONAL": 3,
+}
+
+func (x Precision_Mode) String() string {
+	return proto.EnumName(Precision_Mode_name, int32(x))
+}
+
+func (Precision_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{3, 0}
+}
+
+type ComputeRequest_Op int32
+
+const (
+	ComputeRequest_UNKNOWN_OP ComputeRequest_Op = 0
+	ComputeRequest_DIVIDE     ComputeRequest_Op = 1
+	ComputeRequest_MAX        ComputeRequest_Op = 2
+	ComputeRequest_MIN        ComputeRequest_Op = 3
+	ComputeRequest_MULTIPLY   ComputeRequest_Op = 4
+	ComputeRequest_POW        ComputeRequest_Op = 5
+	ComputeRequest_SUBTRACT   ComputeRequest_Op = 6
+	ComputeRequest_SUM        ComputeRequest_Op = 7
+	ComputeRequest_EVALUATE   ComputeRequest_Op = 8
+	ComputeRequest_SUMALL     ComputeRequest_Op = 9
+	ComputeRequest_PRODUCT    ComputeRequest_Op = 10
+	ComputeRequest_MEAN       ComputeRequest_Op = 11
+	ComputeRequest_MEDIAN     ComputeRequest_Op = 12
+	ComputeRequest_VARIANCE   ComputeRequest_Op = 13
+	ComputeRequest_STDDEV     ComputeRequest_Op = 14
+	ComputeRequest_MOD        ComputeRequest_Op = 15
+	ComputeRequest_INTDIVIDE  ComputeRequest_Op = 16
+	ComputeRequest_REMAINDER  ComputeRequest_Op = 17
+	ComputeRequest_GCD        ComputeRequest_Op = 18
+	ComputeRequest_LCM        ComputeRequest_Op = 19
+	ComputeRequest_SQRT       ComputeRequest_Op = 20
+	ComputeRequest_ABS        ComputeRequest_Op = 21
+	ComputeRequest_NEGATE     ComputeRequest_Op = 22
+	ComputeRequest_EXP        ComputeRequest_Op = 23
+	ComputeRequest_LN         ComputeRequest_Op = 24
+	ComputeRequest_LOG10      ComputeRequest_Op = 25
+	ComputeRequest_LOG2       ComputeRequest_Op = 26
+	ComputeRequest_FLOOR      ComputeRequest_Op = 27
+	ComputeRequest_CEIL       ComputeRequest_Op = 28
+	ComputeRequest_ROUND      ComputeRequest_Op = 29
+	ComputeRequest_TRUNC      ComputeRequest_Op = 30
+	ComputeRequest_SIN        ComputeRequest_Op = 31
+	ComputeRequest_COS        ComputeRequest_Op = 32
+	ComputeRequest_TAN        ComputeRequest_Op = 33
+	ComputeRequest_ASIN       ComputeRequest_Op = 34
+	ComputeRequest_ACOS       ComputeRequest_Op = 35
+	ComputeRequest_ATAN       ComputeRequest_Op = 36
+	ComputeRequest_GAMMA      ComputeRequest_Op = 37
+	ComputeRequest_LOGGAMMA   ComputeRequest_Op = 38
+	ComputeRequest_BETA       ComputeRequest_Op = 39
+	ComputeRequest_ERF        ComputeRequest_Op = 40
+	ComputeRequest_ERFC       ComputeRequest_Op = 41
+	ComputeRequest_BESSELJ    ComputeRequest_Op = 42
+	ComputeRequest_BESSELY    ComputeRequest_Op = 43
+)
+
+var ComputeRequest_Op_name = map[int32]string{
+	0:  "UNKNOWN_OP",
+	1:  "DIVIDE",
+	2:  "MAX",
+	3:  "MIN",
+	4:  "MULTIPLY",
+	5:  "POW",
+	6:  "SUBTRACT",
+	7:  "SUM",
+	8:  "EVALUATE",
+	9:  "SUMALL",
+	10: "PRODUCT",
+	11: "MEAN",
+	12: "MEDIAN",
+	13: "VARIANCE",
+	14: "STDDEV",
+	15: "MOD",
+	16: "INTDIVIDE",
+	17: "REMAINDER",
+	18: "GCD",
+	19: "LCM",
+	20: "SQRT",
+	21: "ABS",
+	22: "NEGATE",
+	23: "EXP",
+	24: "LN",
+	25: "LOG10",
+	26: "LOG2",
+	27: "FLOOR",
+	28: "CEIL",
+	29: "ROUND",
+	30: "TRUNC",
+	31: "SIN",
+	32: "COS",
+	33: "TAN",
+	34: "ASIN",
+	35: "ACOS",
+	36: "ATAN",
+	37: "GAMMA",
+	38: "LOGGAMMA",
+	39: "BETA",
+	40: "ERF",
+	41: "ERFC",
+	42: "BESSELJ",
+	43: "BESSELY",
+}
+
+var ComputeRequest_Op_value = map[string]int32{
+	"UNKNOWN_OP": 0,
+	"DIVIDE":     1,
+	"MAX":        2,
+	"MIN":        3,
+	"MULTIPLY":   4,
+	"POW":        5,
+	"SUBTRACT":   6,
+	"SUM":        7,
+	"EVALUATE":   8,
+	"SUMALL":     9,
+	"PRODUCT":    10,
+	"MEAN":       11,
+	"MEDIAN":     12,
+	"VARIANCE":   13,
+	"STDDEV":     14,
+	"MOD":        15,
+	"INTDIVIDE":  16,
+	"REMAINDER":  17,
+	"GCD":        18,
+	"LCM":        19,
+	"SQRT":       20,
+	"ABS":        21,
+	"NEGATE":     22,
+	"EXP":        23,
+	"LN":         24,
+	"LOG10":      25,
+	"LOG2":       26,
+	"FLOOR":      27,
+	"CEIL":       28,
+	"ROUND":      29,
+	"TRUNC":      30,
+	"SIN":        31,
+	"COS":        32,
+	"TAN":        33,
+	"ASIN":       34,
+	"ACOS":       35,
+	"ATAN":       36,
+	"GAMMA":      37,
+	"LOGGAMMA":   38,
+	"BETA":       39,
+	"ERF":        40,
+	"ERFC":       41,
+	"BESSELJ":    42,
+	"BESSELY":    43,
+}
+
+func (x ComputeRequest_Op) String() string {
+	return proto.EnumName(ComputeRequest_Op_name, int32(x))
+}
+
+func (ComputeRequest_Op) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{7, 0}
+}
+
+// Interpolation selects how a quantile falling between two values of the
+// sorted dataset is computed from them.
+type StatisticsOptions_Interpolation int32
+
+const (
+	StatisticsOptions_LINEAR StatisticsOptions_Interpolation = 0
+	StatisticsOptions_LOWER  StatisticsOptions_Interpolation = 1
+	StatisticsOptions_HIGHER StatisticsOptions_Interpolation = 2
+	// NEAREST picks the closest value, ties go to the even index
+	StatisticsOptions_NEAREST  StatisticsOptions_Interpolation = 3
+	StatisticsOptions_MIDPOINT StatisticsOptions_Interpolation = 4
+)
+
+var StatisticsOptions_Interpolation_name = map[int32]string{
+	0: "LINEAR",
+	1: "LOWER",
+	2: "HIGHER",
+	3: "NEAREST",
+	4: "MIDPOINT",
+}
+
+var StatisticsOptions_Interpolation_value = map[string]int32{
+	"LINEAR":   0,
+	"LOWER":    1,
+	"HIGHER":   2,
+	"NEAREST":  3,
+	"MIDPOINT": 4,
+}
+
+func (x StatisticsOptions_Interpolation) String() string {
+	return proto.EnumName(StatisticsOptions_Interpolation_name, int32(x))
+}
+
+func (StatisticsOptions_Interpolation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{26, 0}
+}
+
+// Method selects the algorithm, DEFAULT picks the one the method uses
+// unless told otherwise.
+type CalculusRequest_Method int32
+
+const (
+	CalculusRequest_DEFAULT CalculusRequest_Method = 0
+	// GAUSS_KRONROD and SIMPSON are used by Integrate
+	CalculusRequest_GAUSS_KRONROD CalculusRequest_Method = 1
+	CalculusRequest_SIMPSON       CalculusRequest_Method = 2
+	// BRENT, BISECTION and NEWTON are used by FindRoot
+	CalculusRequest_BRENT     CalculusRequest_Method = 3
+	CalculusRequest_BISECTION CalculusRequest_Method = 4
+	CalculusRequest_NEWTON    CalculusRequest_Method = 5
+	// GOLDEN_SECTION is used by Minimize
+	CalculusRequest_GOLDEN_SECTION CalculusRequest_Method = 6
+)
+
+var CalculusRequest_Method_name = map[int32]string{
+	0: "DEFAULT",
+	1: "GAUSS_KRONROD",
+	2: "SIMPSON",
+	3: "BRENT",
+	4: "BISECTION",
+	5: "NEWTON",
+	6: "GOLDEN_SECTION",
+}
+
+var CalculusRequest_Method_value = map[string]int32{
+	"DEFAULT":        0,
+	"GAUSS_KRONROD":  1,
+	"SIMPSON":        2,
+	"BRENT":          3,
+	"BISECTION":      4,
+	"NEWTON":         5,
+	"GOLDEN_SECTION": 6,
+}
+
+func (x CalculusRequest_Method) String() string {
+	return proto.EnumName(CalculusRequest_Method_name, int32(x))
+}
+
+func (CalculusRequest_Method) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{50, 0}
+}
+
 type MathOpRequest struct {
-	A                    float64  `protobuf:"fixed64,1,opt,name=a,proto3" json:"a,omitempty"`
-	B                    float64  `protobuf:"fixed64,2,opt,name=b,proto3" json:"b,omitempty"`
+	A         float64    `protobuf:"fixed64,1,opt,name=a,proto3" json:"a,omitempty"`
+	B         float64    `protobuf:"fixed64,2,opt,name=b,proto3" json:"b,omitempty"`
+	Precision *Precision `protobuf:"bytes,3,opt,name=precision,proto3" json:"precision,omitempty"`
+	// division is only used by Mod, IntDivide and Remainder
+	Division             Division `protobuf:"varint,4,opt,name=division,proto3,enum=pb.Division" json:"division,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -71,12 +678,32 @@ func (m *MathOpRequest) GetB() float64 {
 	return 0
 }
 
+func (m *MathOpRequest) GetPrecision() *Precision {
+	if m != nil {
+		return m.Precision
+	}
+	return nil
+}
+
+func (m *MathOpRequest) GetDivision() Division {
+	if m != nil {
+		return m.Division
+	}
+	return Division_TRUNCATED
+}
+
 type MathOpReply struct {
-	V                    float64  `protobuf:"fixed64,1,opt,name=v,proto3" json:"v,omitempty"`
-	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	V   float64 `protobuf:"fixed64,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err string  `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	// exact is the result as a decimal string when an arbitrary precision was
+	// requested. Rationals without a finite decimal expansion are written as a
+	// fraction, e.g. 1/3.
+	Exact string `protobuf:"bytes,3,opt,name=exact,proto3" json:"exact,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,4,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *MathOpReply) Reset()         { *m = MathOpReply{} }
@@ -118,334 +745,7789 @@ func (m *MathOpReply) GetErr() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MathOpRequest)(nil), "pb.MathOpRequest")
-	proto.RegisterType((*MathOpReply)(nil), "pb.MathOpReply")
+func (m *MathOpReply) GetExact() string {
+	if m != nil {
+		return m.Exact
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("mathsvc.proto", fileDescriptor_2c63e992315a488f) }
+func (m *MathOpReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
 
-var fileDescriptor_2c63e992315a488f = []byte{
-	// 197 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xcd, 0x4d, 0x2c, 0xc9,
-	0x28, 0x2e, 0x4b, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2a, 0x48, 0x52, 0xd2, 0xe6,
-	0xe2, 0xf5, 0x4d, 0x2c, 0xc9, 0xf0, 0x2f, 0x08, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x11, 0xe2,
-	0xe1, 0x62, 0x4c, 0x94, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x0c, 0x62, 0x4c, 0x04, 0xf1, 0x92, 0x24,
-	0x98, 0x20, 0xbc, 0x24, 0x25, 0x5d, 0x2e, 0x6e, 0x98, 0xe2, 0x82, 0x9c, 0x4a, 0x90, 0x64, 0x19,
-	0x4c, 0x69, 0x99, 0x90, 0x00, 0x17, 0x73, 0x6a, 0x51, 0x11, 0x58, 0x31, 0x67, 0x10, 0x88, 0x69,
-	0x74, 0x8e, 0x89, 0x8b, 0x05, 0xa4, 0x5e, 0x48, 0x8f, 0x8b, 0xcd, 0x25, 0xb3, 0x2c, 0x33, 0x25,
-	0x55, 0x48, 0x50, 0xaf, 0x20, 0x49, 0x0f, 0xc5, 0x42, 0x29, 0x7e, 0x64, 0xa1, 0x82, 0x9c, 0x4a,
-	0x25, 0x06, 0x21, 0x6d, 0x2e, 0x66, 0xdf, 0xc4, 0x0a, 0x12, 0x14, 0x67, 0xe6, 0x11, 0xa9, 0xd8,
-	0x80, 0x8b, 0xc3, 0xb7, 0x34, 0xa7, 0x24, 0x13, 0xe4, 0x7c, 0xa2, 0x8d, 0x0f, 0xc8, 0x2f, 0x27,
-	0xde, 0xf8, 0xe0, 0xd2, 0xa4, 0x92, 0xa2, 0xc4, 0xe4, 0x12, 0xe2, 0x8d, 0x0f, 0x2e, 0xcd, 0x25,
-	0x4e, 0x71, 0x12, 0x1b, 0x38, 0xde, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x82, 0x63,
-	0xd4, 0xc8, 0x01, 0x00, 0x00,
+// ErrorDetail is attached, along with a google.rpc.BadRequest, to the status
+// of a failed call when the server reports errors using gRPC status codes.
+type ErrorDetail struct {
+	Code                 ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ErrorDetail) Reset()         { *m = ErrorDetail{} }
+func (m *ErrorDetail) String() string { return proto.CompactTextString(m) }
+func (*ErrorDetail) ProtoMessage()    {}
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{2}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *ErrorDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ErrorDetail.Unmarshal(m, b)
+}
+func (m *ErrorDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ErrorDetail.Marshal(b, m, deterministic)
+}
+func (m *ErrorDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorDetail.Merge(m, src)
+}
+func (m *ErrorDetail) XXX_Size() int {
+	return xxx_messageInfo_ErrorDetail.Size(m)
+}
+func (m *ErrorDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorDetail.DiscardUnknown(m)
+}
 
-// MathClient is the client API for Math service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MathClient interface {
-	// Divide two integers, a/b
-	Divide(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
-	// Max two integers, returns the greater value of a and b
-	Max(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
-	// Min two integers, returns the lesser value of a and b
-	Min(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
-	// Multiply two integers, a*b
-	Multiply(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
-	// Pow two integers, a^b
-	Pow(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
-	// Subtract two integers, a-b
-	Subtract(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
-	// Sums two integers. a+b
-	Sum(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+var xxx_messageInfo_ErrorDetail proto.InternalMessageInfo
+
+func (m *ErrorDetail) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// Precision selects the arithmetic used to compute a result.
+type Precision struct {
+	Mode Precision_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=pb.Precision_Mode" json:"mode,omitempty"`
+	// bits is the mantissa precision used by BIGFLOAT, 0 uses the server default
+	Bits                 uint32   `protobuf:"varint,2,opt,name=bits,proto3" json:"bits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Precision) Reset()         { *m = Precision{} }
+func (m *Precision) String() string { return proto.CompactTextString(m) }
+func (*Precision) ProtoMessage()    {}
+func (*Precision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{3}
+}
+
+func (m *Precision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Precision.Unmarshal(m, b)
+}
+func (m *Precision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Precision.Marshal(b, m, deterministic)
+}
+func (m *Precision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Precision.Merge(m, src)
+}
+func (m *Precision) XXX_Size() int {
+	return xxx_messageInfo_Precision.Size(m)
+}
+func (m *Precision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Precision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Precision proto.InternalMessageInfo
+
+func (m *Precision) GetMode() Precision_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return Precision_DEFAULT
+}
+
+func (m *Precision) GetBits() uint32 {
+	if m != nil {
+		return m.Bits
+	}
+	return 0
+}
+
+type EvaluateRequest struct {
+	Expression           string     `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Precision            *Precision `protobuf:"bytes,2,opt,name=precision,proto3" json:"precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *EvaluateRequest) Reset()         { *m = EvaluateRequest{} }
+func (m *EvaluateRequest) String() string { return proto.CompactTextString(m) }
+func (*EvaluateRequest) ProtoMessage()    {}
+func (*EvaluateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{4}
+}
+
+func (m *EvaluateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluateRequest.Unmarshal(m, b)
+}
+func (m *EvaluateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluateRequest.Marshal(b, m, deterministic)
+}
+func (m *EvaluateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateRequest.Merge(m, src)
+}
+func (m *EvaluateRequest) XXX_Size() int {
+	return xxx_messageInfo_EvaluateRequest.Size(m)
+}
+func (m *EvaluateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateRequest proto.InternalMessageInfo
+
+func (m *EvaluateRequest) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *EvaluateRequest) GetPrecision() *Precision {
+	if m != nil {
+		return m.Precision
+	}
+	return nil
+}
+
+type MathListRequest struct {
+	Values               []float64  `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Precision            *Precision `protobuf:"bytes,2,opt,name=precision,proto3" json:"precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *MathListRequest) Reset()         { *m = MathListRequest{} }
+func (m *MathListRequest) String() string { return proto.CompactTextString(m) }
+func (*MathListRequest) ProtoMessage()    {}
+func (*MathListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{5}
+}
+
+func (m *MathListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MathListRequest.Unmarshal(m, b)
+}
+func (m *MathListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MathListRequest.Marshal(b, m, deterministic)
+}
+func (m *MathListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MathListRequest.Merge(m, src)
+}
+func (m *MathListRequest) XXX_Size() int {
+	return xxx_messageInfo_MathListRequest.Size(m)
+}
+func (m *MathListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MathListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MathListRequest proto.InternalMessageInfo
+
+func (m *MathListRequest) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *MathListRequest) GetPrecision() *Precision {
+	if m != nil {
+		return m.Precision
+	}
+	return nil
+}
+
+// UnaryOpRequest is the request of the operations taking a single operand.
+type UnaryOpRequest struct {
+	X                    float64    `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Precision            *Precision `protobuf:"bytes,2,opt,name=precision,proto3" json:"precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *UnaryOpRequest) Reset()         { *m = UnaryOpRequest{} }
+func (m *UnaryOpRequest) String() string { return proto.CompactTextString(m) }
+func (*UnaryOpRequest) ProtoMessage()    {}
+func (*UnaryOpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{6}
+}
+
+func (m *UnaryOpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnaryOpRequest.Unmarshal(m, b)
+}
+func (m *UnaryOpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnaryOpRequest.Marshal(b, m, deterministic)
+}
+func (m *UnaryOpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnaryOpRequest.Merge(m, src)
+}
+func (m *UnaryOpRequest) XXX_Size() int {
+	return xxx_messageInfo_UnaryOpRequest.Size(m)
+}
+func (m *UnaryOpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnaryOpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnaryOpRequest proto.InternalMessageInfo
+
+func (m *UnaryOpRequest) GetX() float64 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+func (m *UnaryOpRequest) GetPrecision() *Precision {
+	if m != nil {
+		return m.Precision
+	}
+	return nil
+}
+
+// ComputeRequest is a single operation performed by Compute.
+type ComputeRequest struct {
+	// id is chosen by the client and returned in the reply to correlate the two
+	Id uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Op ComputeRequest_Op `protobuf:"varint,2,opt,name=op,proto3,enum=pb.ComputeRequest_Op" json:"op,omitempty"`
+	// a and b are the operands of the binary operations, a is also the operand
+	// of the unary operations
+	A float64 `protobuf:"fixed64,3,opt,name=a,proto3" json:"a,omitempty"`
+	B float64 `protobuf:"fixed64,4,opt,name=b,proto3" json:"b,omitempty"`
+	// values are the operands of the list operations
+	Values []float64 `protobuf:"fixed64,5,rep,packed,name=values,proto3" json:"values,omitempty"`
+	// expression is the operand of EVALUATE
+	Expression           string     `protobuf:"bytes,6,opt,name=expression,proto3" json:"expression,omitempty"`
+	Precision            *Precision `protobuf:"bytes,7,opt,name=precision,proto3" json:"precision,omitempty"`
+	Division             Division   `protobuf:"varint,8,opt,name=division,proto3,enum=pb.Division" json:"division,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ComputeRequest) Reset()         { *m = ComputeRequest{} }
+func (m *ComputeRequest) String() string { return proto.CompactTextString(m) }
+func (*ComputeRequest) ProtoMessage()    {}
+func (*ComputeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{7}
+}
+
+func (m *ComputeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ComputeRequest.Unmarshal(m, b)
+}
+func (m *ComputeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ComputeRequest.Marshal(b, m, deterministic)
+}
+func (m *ComputeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComputeRequest.Merge(m, src)
+}
+func (m *ComputeRequest) XXX_Size() int {
+	return xxx_messageInfo_ComputeRequest.Size(m)
+}
+func (m *ComputeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComputeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComputeRequest proto.InternalMessageInfo
+
+func (m *ComputeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ComputeRequest) GetOp() ComputeRequest_Op {
+	if m != nil {
+		return m.Op
+	}
+	return ComputeRequest_UNKNOWN_OP
+}
+
+func (m *ComputeRequest) GetA() float64 {
+	if m != nil {
+		return m.A
+	}
+	return 0
+}
+
+func (m *ComputeRequest) GetB() float64 {
+	if m != nil {
+		return m.B
+	}
+	return 0
+}
+
+func (m *ComputeRequest) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *ComputeRequest) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *ComputeRequest) GetPrecision() *Precision {
+	if m != nil {
+		return m.Precision
+	}
+	return nil
+}
+
+func (m *ComputeRequest) GetDivision() Division {
+	if m != nil {
+		return m.Division
+	}
+	return Division_TRUNCATED
+}
+
+type ComputeReply struct {
+	Id                   uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reply                *MathOpReply `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ComputeReply) Reset()         { *m = ComputeReply{} }
+func (m *ComputeReply) String() string { return proto.CompactTextString(m) }
+func (*ComputeReply) ProtoMessage()    {}
+func (*ComputeReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{8}
+}
+
+func (m *ComputeReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ComputeReply.Unmarshal(m, b)
+}
+func (m *ComputeReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ComputeReply.Marshal(b, m, deterministic)
+}
+func (m *ComputeReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComputeReply.Merge(m, src)
+}
+func (m *ComputeReply) XXX_Size() int {
+	return xxx_messageInfo_ComputeReply.Size(m)
+}
+func (m *ComputeReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComputeReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComputeReply proto.InternalMessageInfo
+
+func (m *ComputeReply) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ComputeReply) GetReply() *MathOpReply {
+	if m != nil {
+		return m.Reply
+	}
+	return nil
+}
+
+// BatchRequest is a list of operations performed by Batch.
+type BatchRequest struct {
+	Items []*ComputeRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// concurrency is the number of items performed at once, 0 and 1 perform them
+	// one after the other. The server may perform fewer at once.
+	Concurrency          uint32   `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchRequest) Reset()         { *m = BatchRequest{} }
+func (m *BatchRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchRequest) ProtoMessage()    {}
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{9}
+}
+
+func (m *BatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchRequest.Unmarshal(m, b)
+}
+func (m *BatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRequest.Merge(m, src)
+}
+func (m *BatchRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchRequest.Size(m)
+}
+func (m *BatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRequest proto.InternalMessageInfo
+
+func (m *BatchRequest) GetItems() []*ComputeRequest {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *BatchRequest) GetConcurrency() uint32 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+// BatchReply holds a result for each item of the request, in the same order.
+type BatchReply struct {
+	Results              []*ComputeReply `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BatchReply) Reset()         { *m = BatchReply{} }
+func (m *BatchReply) String() string { return proto.CompactTextString(m) }
+func (*BatchReply) ProtoMessage()    {}
+func (*BatchReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{10}
+}
+
+func (m *BatchReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchReply.Unmarshal(m, b)
+}
+func (m *BatchReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchReply.Marshal(b, m, deterministic)
+}
+func (m *BatchReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchReply.Merge(m, src)
+}
+func (m *BatchReply) XXX_Size() int {
+	return xxx_messageInfo_BatchReply.Size(m)
+}
+func (m *BatchReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchReply proto.InternalMessageInfo
+
+func (m *BatchReply) GetResults() []*ComputeReply {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// ComplexNumber is a complex number, real+imag*i.
+type ComplexNumber struct {
+	Real                 float64  `protobuf:"fixed64,1,opt,name=real,proto3" json:"real,omitempty"`
+	Imag                 float64  `protobuf:"fixed64,2,opt,name=imag,proto3" json:"imag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ComplexNumber) Reset()         { *m = ComplexNumber{} }
+func (m *ComplexNumber) String() string { return proto.CompactTextString(m) }
+func (*ComplexNumber) ProtoMessage()    {}
+func (*ComplexNumber) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{11}
+}
+
+func (m *ComplexNumber) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ComplexNumber.Unmarshal(m, b)
+}
+func (m *ComplexNumber) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ComplexNumber.Marshal(b, m, deterministic)
+}
+func (m *ComplexNumber) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComplexNumber.Merge(m, src)
+}
+func (m *ComplexNumber) XXX_Size() int {
+	return xxx_messageInfo_ComplexNumber.Size(m)
+}
+func (m *ComplexNumber) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComplexNumber.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComplexNumber proto.InternalMessageInfo
+
+func (m *ComplexNumber) GetReal() float64 {
+	if m != nil {
+		return m.Real
+	}
+	return 0
+}
+
+func (m *ComplexNumber) GetImag() float64 {
+	if m != nil {
+		return m.Imag
+	}
+	return 0
+}
+
+// ComplexOpRequest holds the operands of the methods of the Complex service.
+// Abs, Phase, Conj and Sqrt only use a.
+type ComplexOpRequest struct {
+	A                    *ComplexNumber `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B                    *ComplexNumber `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ComplexOpRequest) Reset()         { *m = ComplexOpRequest{} }
+func (m *ComplexOpRequest) String() string { return proto.CompactTextString(m) }
+func (*ComplexOpRequest) ProtoMessage()    {}
+func (*ComplexOpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{12}
+}
+
+func (m *ComplexOpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ComplexOpRequest.Unmarshal(m, b)
+}
+func (m *ComplexOpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ComplexOpRequest.Marshal(b, m, deterministic)
+}
+func (m *ComplexOpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComplexOpRequest.Merge(m, src)
+}
+func (m *ComplexOpRequest) XXX_Size() int {
+	return xxx_messageInfo_ComplexOpRequest.Size(m)
+}
+func (m *ComplexOpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComplexOpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComplexOpRequest proto.InternalMessageInfo
+
+func (m *ComplexOpRequest) GetA() *ComplexNumber {
+	if m != nil {
+		return m.A
+	}
+	return nil
+}
+
+func (m *ComplexOpRequest) GetB() *ComplexNumber {
+	if m != nil {
+		return m.B
+	}
+	return nil
+}
+
+type ComplexOpReply struct {
+	V   *ComplexNumber `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err string         `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ComplexOpReply) Reset()         { *m = ComplexOpReply{} }
+func (m *ComplexOpReply) String() string { return proto.CompactTextString(m) }
+func (*ComplexOpReply) ProtoMessage()    {}
+func (*ComplexOpReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{13}
+}
+
+func (m *ComplexOpReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ComplexOpReply.Unmarshal(m, b)
+}
+func (m *ComplexOpReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ComplexOpReply.Marshal(b, m, deterministic)
+}
+func (m *ComplexOpReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComplexOpReply.Merge(m, src)
+}
+func (m *ComplexOpReply) XXX_Size() int {
+	return xxx_messageInfo_ComplexOpReply.Size(m)
+}
+func (m *ComplexOpReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComplexOpReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComplexOpReply proto.InternalMessageInfo
+
+func (m *ComplexOpReply) GetV() *ComplexNumber {
+	if m != nil {
+		return m.V
+	}
+	return nil
+}
+
+func (m *ComplexOpReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *ComplexOpReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// Matrix is a matrix of rows rows and cols columns, values holds its elements
+// in row-major order.
+type Matrix struct {
+	Rows                 uint32    `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols                 uint32    `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
+	Values               []float64 `protobuf:"fixed64,3,rep,packed,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Matrix) Reset()         { *m = Matrix{} }
+func (m *Matrix) String() string { return proto.CompactTextString(m) }
+func (*Matrix) ProtoMessage()    {}
+func (*Matrix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{14}
+}
+
+func (m *Matrix) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Matrix.Unmarshal(m, b)
+}
+func (m *Matrix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Matrix.Marshal(b, m, deterministic)
+}
+func (m *Matrix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Matrix.Merge(m, src)
+}
+func (m *Matrix) XXX_Size() int {
+	return xxx_messageInfo_Matrix.Size(m)
+}
+func (m *Matrix) XXX_DiscardUnknown() {
+	xxx_messageInfo_Matrix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Matrix proto.InternalMessageInfo
+
+func (m *Matrix) GetRows() uint32 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *Matrix) GetCols() uint32 {
+	if m != nil {
+		return m.Cols
+	}
+	return 0
+}
+
+func (m *Matrix) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// VectorOpRequest holds the operands of the vector methods of the
+// LinearAlgebra service. Norm only uses a.
+type VectorOpRequest struct {
+	A                    []float64 `protobuf:"fixed64,1,rep,packed,name=a,proto3" json:"a,omitempty"`
+	B                    []float64 `protobuf:"fixed64,2,rep,packed,name=b,proto3" json:"b,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *VectorOpRequest) Reset()         { *m = VectorOpRequest{} }
+func (m *VectorOpRequest) String() string { return proto.CompactTextString(m) }
+func (*VectorOpRequest) ProtoMessage()    {}
+func (*VectorOpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{15}
+}
+
+func (m *VectorOpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorOpRequest.Unmarshal(m, b)
+}
+func (m *VectorOpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorOpRequest.Marshal(b, m, deterministic)
+}
+func (m *VectorOpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorOpRequest.Merge(m, src)
+}
+func (m *VectorOpRequest) XXX_Size() int {
+	return xxx_messageInfo_VectorOpRequest.Size(m)
+}
+func (m *VectorOpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorOpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VectorOpRequest proto.InternalMessageInfo
+
+func (m *VectorOpRequest) GetA() []float64 {
+	if m != nil {
+		return m.A
+	}
+	return nil
+}
+
+func (m *VectorOpRequest) GetB() []float64 {
+	if m != nil {
+		return m.B
+	}
+	return nil
+}
+
+// MatrixOpRequest holds the operands of the matrix methods of the
+// LinearAlgebra service. Transpose, Determinant and Inverse only use a.
+type MatrixOpRequest struct {
+	A                    *Matrix  `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B                    *Matrix  `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MatrixOpRequest) Reset()         { *m = MatrixOpRequest{} }
+func (m *MatrixOpRequest) String() string { return proto.CompactTextString(m) }
+func (*MatrixOpRequest) ProtoMessage()    {}
+func (*MatrixOpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{16}
+}
+
+func (m *MatrixOpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatrixOpRequest.Unmarshal(m, b)
+}
+func (m *MatrixOpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatrixOpRequest.Marshal(b, m, deterministic)
+}
+func (m *MatrixOpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatrixOpRequest.Merge(m, src)
+}
+func (m *MatrixOpRequest) XXX_Size() int {
+	return xxx_messageInfo_MatrixOpRequest.Size(m)
+}
+func (m *MatrixOpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatrixOpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatrixOpRequest proto.InternalMessageInfo
+
+func (m *MatrixOpRequest) GetA() *Matrix {
+	if m != nil {
+		return m.A
+	}
+	return nil
+}
+
+func (m *MatrixOpRequest) GetB() *Matrix {
+	if m != nil {
+		return m.B
+	}
+	return nil
+}
+
+type SolveRequest struct {
+	A                    *Matrix   `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B                    []float64 `protobuf:"fixed64,2,rep,packed,name=b,proto3" json:"b,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SolveRequest) Reset()         { *m = SolveRequest{} }
+func (m *SolveRequest) String() string { return proto.CompactTextString(m) }
+func (*SolveRequest) ProtoMessage()    {}
+func (*SolveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{17}
+}
+
+func (m *SolveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SolveRequest.Unmarshal(m, b)
+}
+func (m *SolveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SolveRequest.Marshal(b, m, deterministic)
+}
+func (m *SolveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SolveRequest.Merge(m, src)
+}
+func (m *SolveRequest) XXX_Size() int {
+	return xxx_messageInfo_SolveRequest.Size(m)
+}
+func (m *SolveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SolveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SolveRequest proto.InternalMessageInfo
+
+func (m *SolveRequest) GetA() *Matrix {
+	if m != nil {
+		return m.A
+	}
+	return nil
+}
+
+func (m *SolveRequest) GetB() []float64 {
+	if m != nil {
+		return m.B
+	}
+	return nil
+}
+
+type VectorReply struct {
+	V   []float64 `protobuf:"fixed64,1,rep,packed,name=v,proto3" json:"v,omitempty"`
+	Err string    `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *VectorReply) Reset()         { *m = VectorReply{} }
+func (m *VectorReply) String() string { return proto.CompactTextString(m) }
+func (*VectorReply) ProtoMessage()    {}
+func (*VectorReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{18}
+}
+
+func (m *VectorReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorReply.Unmarshal(m, b)
+}
+func (m *VectorReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorReply.Marshal(b, m, deterministic)
+}
+func (m *VectorReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorReply.Merge(m, src)
+}
+func (m *VectorReply) XXX_Size() int {
+	return xxx_messageInfo_VectorReply.Size(m)
+}
+func (m *VectorReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VectorReply proto.InternalMessageInfo
+
+func (m *VectorReply) GetV() []float64 {
+	if m != nil {
+		return m.V
+	}
+	return nil
+}
+
+func (m *VectorReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *VectorReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+type MatrixReply struct {
+	V   *Matrix `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err string  `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *MatrixReply) Reset()         { *m = MatrixReply{} }
+func (m *MatrixReply) String() string { return proto.CompactTextString(m) }
+func (*MatrixReply) ProtoMessage()    {}
+func (*MatrixReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{19}
+}
+
+func (m *MatrixReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatrixReply.Unmarshal(m, b)
+}
+func (m *MatrixReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatrixReply.Marshal(b, m, deterministic)
+}
+func (m *MatrixReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatrixReply.Merge(m, src)
+}
+func (m *MatrixReply) XXX_Size() int {
+	return xxx_messageInfo_MatrixReply.Size(m)
+}
+func (m *MatrixReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatrixReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatrixReply proto.InternalMessageInfo
+
+func (m *MatrixReply) GetV() *Matrix {
+	if m != nil {
+		return m.V
+	}
+	return nil
+}
+
+func (m *MatrixReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *MatrixReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// PolynomialOpRequest holds the operands of the methods of the Polynomial
+// service. Derivative and Integral only use a.
+type PolynomialOpRequest struct {
+	A                    []float64 `protobuf:"fixed64,1,rep,packed,name=a,proto3" json:"a,omitempty"`
+	B                    []float64 `protobuf:"fixed64,2,rep,packed,name=b,proto3" json:"b,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PolynomialOpRequest) Reset()         { *m = PolynomialOpRequest{} }
+func (m *PolynomialOpRequest) String() string { return proto.CompactTextString(m) }
+func (*PolynomialOpRequest) ProtoMessage()    {}
+func (*PolynomialOpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{20}
+}
+
+func (m *PolynomialOpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolynomialOpRequest.Unmarshal(m, b)
+}
+func (m *PolynomialOpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolynomialOpRequest.Marshal(b, m, deterministic)
+}
+func (m *PolynomialOpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolynomialOpRequest.Merge(m, src)
+}
+func (m *PolynomialOpRequest) XXX_Size() int {
+	return xxx_messageInfo_PolynomialOpRequest.Size(m)
+}
+func (m *PolynomialOpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolynomialOpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolynomialOpRequest proto.InternalMessageInfo
+
+func (m *PolynomialOpRequest) GetA() []float64 {
+	if m != nil {
+		return m.A
+	}
+	return nil
+}
+
+func (m *PolynomialOpRequest) GetB() []float64 {
+	if m != nil {
+		return m.B
+	}
+	return nil
+}
+
+type PolynomialEvaluateRequest struct {
+	A                    []float64 `protobuf:"fixed64,1,rep,packed,name=a,proto3" json:"a,omitempty"`
+	X                    float64   `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PolynomialEvaluateRequest) Reset()         { *m = PolynomialEvaluateRequest{} }
+func (m *PolynomialEvaluateRequest) String() string { return proto.CompactTextString(m) }
+func (*PolynomialEvaluateRequest) ProtoMessage()    {}
+func (*PolynomialEvaluateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{21}
+}
+
+func (m *PolynomialEvaluateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolynomialEvaluateRequest.Unmarshal(m, b)
+}
+func (m *PolynomialEvaluateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolynomialEvaluateRequest.Marshal(b, m, deterministic)
+}
+func (m *PolynomialEvaluateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolynomialEvaluateRequest.Merge(m, src)
+}
+func (m *PolynomialEvaluateRequest) XXX_Size() int {
+	return xxx_messageInfo_PolynomialEvaluateRequest.Size(m)
+}
+func (m *PolynomialEvaluateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolynomialEvaluateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolynomialEvaluateRequest proto.InternalMessageInfo
+
+func (m *PolynomialEvaluateRequest) GetA() []float64 {
+	if m != nil {
+		return m.A
+	}
+	return nil
+}
+
+func (m *PolynomialEvaluateRequest) GetX() float64 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+// RootsRequest holds the operands of Roots. The roots are refined until none
+// of them moves by more than tolerance, relative to its modulus when that's
+// greater than 1, and a root whose imaginary part is within the same
+// tolerance is reported as real. A tolerance of 0 selects the server's
+// default.
+type RootsRequest struct {
+	A                    []float64 `protobuf:"fixed64,1,rep,packed,name=a,proto3" json:"a,omitempty"`
+	Tolerance            float64   `protobuf:"fixed64,2,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *RootsRequest) Reset()         { *m = RootsRequest{} }
+func (m *RootsRequest) String() string { return proto.CompactTextString(m) }
+func (*RootsRequest) ProtoMessage()    {}
+func (*RootsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{22}
+}
+
+func (m *RootsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RootsRequest.Unmarshal(m, b)
+}
+func (m *RootsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RootsRequest.Marshal(b, m, deterministic)
+}
+func (m *RootsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RootsRequest.Merge(m, src)
+}
+func (m *RootsRequest) XXX_Size() int {
+	return xxx_messageInfo_RootsRequest.Size(m)
+}
+func (m *RootsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RootsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RootsRequest proto.InternalMessageInfo
+
+func (m *RootsRequest) GetA() []float64 {
+	if m != nil {
+		return m.A
+	}
+	return nil
+}
+
+func (m *RootsRequest) GetTolerance() float64 {
+	if m != nil {
+		return m.Tolerance
+	}
+	return 0
+}
+
+type PolynomialReply struct {
+	V   []float64 `protobuf:"fixed64,1,rep,packed,name=v,proto3" json:"v,omitempty"`
+	Err string    `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PolynomialReply) Reset()         { *m = PolynomialReply{} }
+func (m *PolynomialReply) String() string { return proto.CompactTextString(m) }
+func (*PolynomialReply) ProtoMessage()    {}
+func (*PolynomialReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{23}
+}
+
+func (m *PolynomialReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolynomialReply.Unmarshal(m, b)
+}
+func (m *PolynomialReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolynomialReply.Marshal(b, m, deterministic)
+}
+func (m *PolynomialReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolynomialReply.Merge(m, src)
+}
+func (m *PolynomialReply) XXX_Size() int {
+	return xxx_messageInfo_PolynomialReply.Size(m)
+}
+func (m *PolynomialReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolynomialReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolynomialReply proto.InternalMessageInfo
+
+func (m *PolynomialReply) GetV() []float64 {
+	if m != nil {
+		return m.V
+	}
+	return nil
+}
+
+func (m *PolynomialReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *PolynomialReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// RootsReply holds the roots found by Roots, each repeated as many times as
+// its multiplicity and sorted by real then imaginary part. The imaginary
+// part of a real root is 0.
+type RootsReply struct {
+	V   []*ComplexNumber `protobuf:"bytes,1,rep,name=v,proto3" json:"v,omitempty"`
+	Err string           `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *RootsReply) Reset()         { *m = RootsReply{} }
+func (m *RootsReply) String() string { return proto.CompactTextString(m) }
+func (*RootsReply) ProtoMessage()    {}
+func (*RootsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{24}
+}
+
+func (m *RootsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RootsReply.Unmarshal(m, b)
+}
+func (m *RootsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RootsReply.Marshal(b, m, deterministic)
+}
+func (m *RootsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RootsReply.Merge(m, src)
+}
+func (m *RootsReply) XXX_Size() int {
+	return xxx_messageInfo_RootsReply.Size(m)
+}
+func (m *RootsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RootsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RootsReply proto.InternalMessageInfo
+
+func (m *RootsReply) GetV() []*ComplexNumber {
+	if m != nil {
+		return m.V
+	}
+	return nil
+}
+
+func (m *RootsReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *RootsReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// StatisticsChunk is one of the messages streamed to the Statistics service.
+// The dataset is the concatenation of the values of every chunk.
+type StatisticsChunk struct {
+	X []float64 `protobuf:"fixed64,1,rep,packed,name=x,proto3" json:"x,omitempty"`
+	// y is the second series of Correlate
+	Y []float64 `protobuf:"fixed64,2,rep,packed,name=y,proto3" json:"y,omitempty"`
+	// options is only read from the first chunk
+	Options              *StatisticsOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *StatisticsChunk) Reset()         { *m = StatisticsChunk{} }
+func (m *StatisticsChunk) String() string { return proto.CompactTextString(m) }
+func (*StatisticsChunk) ProtoMessage()    {}
+func (*StatisticsChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{25}
+}
+
+func (m *StatisticsChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatisticsChunk.Unmarshal(m, b)
+}
+func (m *StatisticsChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatisticsChunk.Marshal(b, m, deterministic)
+}
+func (m *StatisticsChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatisticsChunk.Merge(m, src)
+}
+func (m *StatisticsChunk) XXX_Size() int {
+	return xxx_messageInfo_StatisticsChunk.Size(m)
+}
+func (m *StatisticsChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatisticsChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatisticsChunk proto.InternalMessageInfo
+
+func (m *StatisticsChunk) GetX() []float64 {
+	if m != nil {
+		return m.X
+	}
+	return nil
+}
+
+func (m *StatisticsChunk) GetY() []float64 {
+	if m != nil {
+		return m.Y
+	}
+	return nil
+}
+
+func (m *StatisticsChunk) GetOptions() *StatisticsOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// StatisticsOptions configures Describe.
+type StatisticsOptions struct {
+	// quantiles are the quantiles to compute, between 0 and 1, p50, p90 and
+	// p99 when there are none
+	Quantiles     []float64                       `protobuf:"fixed64,1,rep,packed,name=quantiles,proto3" json:"quantiles,omitempty"`
+	Interpolation StatisticsOptions_Interpolation `protobuf:"varint,2,opt,name=interpolation,proto3,enum=pb.StatisticsOptions_Interpolation" json:"interpolation,omitempty"`
+	// buckets is the number of buckets of equal width between the minimum and
+	// maximum of the histogram, no histogram is computed when it's 0
+	Buckets              uint32   `protobuf:"varint,3,opt,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatisticsOptions) Reset()         { *m = StatisticsOptions{} }
+func (m *StatisticsOptions) String() string { return proto.CompactTextString(m) }
+func (*StatisticsOptions) ProtoMessage()    {}
+func (*StatisticsOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{26}
+}
+
+func (m *StatisticsOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatisticsOptions.Unmarshal(m, b)
+}
+func (m *StatisticsOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatisticsOptions.Marshal(b, m, deterministic)
+}
+func (m *StatisticsOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatisticsOptions.Merge(m, src)
+}
+func (m *StatisticsOptions) XXX_Size() int {
+	return xxx_messageInfo_StatisticsOptions.Size(m)
+}
+func (m *StatisticsOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatisticsOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatisticsOptions proto.InternalMessageInfo
+
+func (m *StatisticsOptions) GetQuantiles() []float64 {
+	if m != nil {
+		return m.Quantiles
+	}
+	return nil
+}
+
+func (m *StatisticsOptions) GetInterpolation() StatisticsOptions_Interpolation {
+	if m != nil {
+		return m.Interpolation
+	}
+	return StatisticsOptions_LINEAR
+}
+
+func (m *StatisticsOptions) GetBuckets() uint32 {
+	if m != nil {
+		return m.Buckets
+	}
+	return 0
+}
+
+type Quantile struct {
+	Q                    float64  `protobuf:"fixed64,1,opt,name=q,proto3" json:"q,omitempty"`
+	V                    float64  `protobuf:"fixed64,2,opt,name=v,proto3" json:"v,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Quantile) Reset()         { *m = Quantile{} }
+func (m *Quantile) String() string { return proto.CompactTextString(m) }
+func (*Quantile) ProtoMessage()    {}
+func (*Quantile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{27}
+}
+
+func (m *Quantile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Quantile.Unmarshal(m, b)
+}
+func (m *Quantile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Quantile.Marshal(b, m, deterministic)
+}
+func (m *Quantile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quantile.Merge(m, src)
+}
+func (m *Quantile) XXX_Size() int {
+	return xxx_messageInfo_Quantile.Size(m)
+}
+func (m *Quantile) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quantile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quantile proto.InternalMessageInfo
+
+func (m *Quantile) GetQ() float64 {
+	if m != nil {
+		return m.Q
+	}
+	return 0
+}
+
+func (m *Quantile) GetV() float64 {
+	if m != nil {
+		return m.V
+	}
+	return 0
+}
+
+// HistogramBucket counts the values in [lower, upper), the last bucket also
+// counts the values equal to its upper bound.
+type HistogramBucket struct {
+	Lower                float64  `protobuf:"fixed64,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper                float64  `protobuf:"fixed64,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	Count                uint64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistogramBucket) Reset()         { *m = HistogramBucket{} }
+func (m *HistogramBucket) String() string { return proto.CompactTextString(m) }
+func (*HistogramBucket) ProtoMessage()    {}
+func (*HistogramBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{28}
+}
+
+func (m *HistogramBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistogramBucket.Unmarshal(m, b)
+}
+func (m *HistogramBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistogramBucket.Marshal(b, m, deterministic)
+}
+func (m *HistogramBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistogramBucket.Merge(m, src)
+}
+func (m *HistogramBucket) XXX_Size() int {
+	return xxx_messageInfo_HistogramBucket.Size(m)
+}
+func (m *HistogramBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistogramBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistogramBucket proto.InternalMessageInfo
+
+func (m *HistogramBucket) GetLower() float64 {
+	if m != nil {
+		return m.Lower
+	}
+	return 0
+}
+
+func (m *HistogramBucket) GetUpper() float64 {
+	if m != nil {
+		return m.Upper
+	}
+	return 0
+}
+
+func (m *HistogramBucket) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// DescribeReply holds the statistics computed by Describe. The variance,
+// skewness and kurtosis are those of the population, the kurtosis is the
+// excess kurtosis, 0 for a normal distribution. mode holds the values that
+// occur most often, sorted, and is empty when no value occurs twice.
+type DescribeReply struct {
+	Count     uint64             `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Min       float64            `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max       float64            `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	Mean      float64            `protobuf:"fixed64,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	Variance  float64            `protobuf:"fixed64,5,opt,name=variance,proto3" json:"variance,omitempty"`
+	StdDev    float64            `protobuf:"fixed64,6,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	Skewness  float64            `protobuf:"fixed64,7,opt,name=skewness,proto3" json:"skewness,omitempty"`
+	Kurtosis  float64            `protobuf:"fixed64,8,opt,name=kurtosis,proto3" json:"kurtosis,omitempty"`
+	Mode      []float64          `protobuf:"fixed64,9,rep,packed,name=mode,proto3" json:"mode,omitempty"`
+	Quantiles []*Quantile        `protobuf:"bytes,10,rep,name=quantiles,proto3" json:"quantiles,omitempty"`
+	Histogram []*HistogramBucket `protobuf:"bytes,11,rep,name=histogram,proto3" json:"histogram,omitempty"`
+	Err       string             `protobuf:"bytes,12,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,13,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *DescribeReply) Reset()         { *m = DescribeReply{} }
+func (m *DescribeReply) String() string { return proto.CompactTextString(m) }
+func (*DescribeReply) ProtoMessage()    {}
+func (*DescribeReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{29}
+}
+
+func (m *DescribeReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeReply.Unmarshal(m, b)
+}
+func (m *DescribeReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeReply.Marshal(b, m, deterministic)
+}
+func (m *DescribeReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeReply.Merge(m, src)
+}
+func (m *DescribeReply) XXX_Size() int {
+	return xxx_messageInfo_DescribeReply.Size(m)
+}
+func (m *DescribeReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeReply proto.InternalMessageInfo
+
+func (m *DescribeReply) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *DescribeReply) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *DescribeReply) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *DescribeReply) GetMean() float64 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+func (m *DescribeReply) GetVariance() float64 {
+	if m != nil {
+		return m.Variance
+	}
+	return 0
+}
+
+func (m *DescribeReply) GetStdDev() float64 {
+	if m != nil {
+		return m.StdDev
+	}
+	return 0
+}
+
+func (m *DescribeReply) GetSkewness() float64 {
+	if m != nil {
+		return m.Skewness
+	}
+	return 0
+}
+
+func (m *DescribeReply) GetKurtosis() float64 {
+	if m != nil {
+		return m.Kurtosis
+	}
+	return 0
+}
+
+func (m *DescribeReply) GetMode() []float64 {
+	if m != nil {
+		return m.Mode
+	}
+	return nil
+}
+
+func (m *DescribeReply) GetQuantiles() []*Quantile {
+	if m != nil {
+		return m.Quantiles
+	}
+	return nil
+}
+
+func (m *DescribeReply) GetHistogram() []*HistogramBucket {
+	if m != nil {
+		return m.Histogram
+	}
+	return nil
+}
+
+func (m *DescribeReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *DescribeReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// CorrelateReply holds the population covariance and the Pearson correlation
+// coefficient of the series, NaN when one of them is constant.
+type CorrelateReply struct {
+	Count       uint64  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Covariance  float64 `protobuf:"fixed64,2,opt,name=covariance,proto3" json:"covariance,omitempty"`
+	Correlation float64 `protobuf:"fixed64,3,opt,name=correlation,proto3" json:"correlation,omitempty"`
+	Err         string  `protobuf:"bytes,4,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,5,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CorrelateReply) Reset()         { *m = CorrelateReply{} }
+func (m *CorrelateReply) String() string { return proto.CompactTextString(m) }
+func (*CorrelateReply) ProtoMessage()    {}
+func (*CorrelateReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{30}
+}
+
+func (m *CorrelateReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CorrelateReply.Unmarshal(m, b)
+}
+func (m *CorrelateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CorrelateReply.Marshal(b, m, deterministic)
+}
+func (m *CorrelateReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorrelateReply.Merge(m, src)
+}
+func (m *CorrelateReply) XXX_Size() int {
+	return xxx_messageInfo_CorrelateReply.Size(m)
+}
+func (m *CorrelateReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorrelateReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorrelateReply proto.InternalMessageInfo
+
+func (m *CorrelateReply) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *CorrelateReply) GetCovariance() float64 {
+	if m != nil {
+		return m.Covariance
+	}
+	return 0
+}
+
+func (m *CorrelateReply) GetCorrelation() float64 {
+	if m != nil {
+		return m.Correlation
+	}
+	return 0
+}
+
+func (m *CorrelateReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *CorrelateReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// Quantity is a number in a unit. A unit is a product of the units known to
+// the server, each optionally raised to an integer power, e.g. "kg*m/s^2".
+// The empty unit is dimensionless.
+type Quantity struct {
+	Value                float64  `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit                 string   `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Quantity) Reset()         { *m = Quantity{} }
+func (m *Quantity) String() string { return proto.CompactTextString(m) }
+func (*Quantity) ProtoMessage()    {}
+func (*Quantity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{31}
+}
+
+func (m *Quantity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Quantity.Unmarshal(m, b)
+}
+func (m *Quantity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Quantity.Marshal(b, m, deterministic)
+}
+func (m *Quantity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quantity.Merge(m, src)
+}
+func (m *Quantity) XXX_Size() int {
+	return xxx_messageInfo_Quantity.Size(m)
+}
+func (m *Quantity) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quantity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quantity proto.InternalMessageInfo
+
+func (m *Quantity) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Quantity) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+type QuantityOpRequest struct {
+	A                    *Quantity `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B                    *Quantity `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *QuantityOpRequest) Reset()         { *m = QuantityOpRequest{} }
+func (m *QuantityOpRequest) String() string { return proto.CompactTextString(m) }
+func (*QuantityOpRequest) ProtoMessage()    {}
+func (*QuantityOpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{32}
+}
+
+func (m *QuantityOpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuantityOpRequest.Unmarshal(m, b)
+}
+func (m *QuantityOpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuantityOpRequest.Marshal(b, m, deterministic)
+}
+func (m *QuantityOpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuantityOpRequest.Merge(m, src)
+}
+func (m *QuantityOpRequest) XXX_Size() int {
+	return xxx_messageInfo_QuantityOpRequest.Size(m)
+}
+func (m *QuantityOpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuantityOpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuantityOpRequest proto.InternalMessageInfo
+
+func (m *QuantityOpRequest) GetA() *Quantity {
+	if m != nil {
+		return m.A
+	}
+	return nil
+}
+
+func (m *QuantityOpRequest) GetB() *Quantity {
+	if m != nil {
+		return m.B
+	}
+	return nil
+}
+
+type QuantityPowRequest struct {
+	A                    *Quantity `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B                    float64   `protobuf:"fixed64,2,opt,name=b,proto3" json:"b,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *QuantityPowRequest) Reset()         { *m = QuantityPowRequest{} }
+func (m *QuantityPowRequest) String() string { return proto.CompactTextString(m) }
+func (*QuantityPowRequest) ProtoMessage()    {}
+func (*QuantityPowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{33}
+}
+
+func (m *QuantityPowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuantityPowRequest.Unmarshal(m, b)
+}
+func (m *QuantityPowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuantityPowRequest.Marshal(b, m, deterministic)
+}
+func (m *QuantityPowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuantityPowRequest.Merge(m, src)
+}
+func (m *QuantityPowRequest) XXX_Size() int {
+	return xxx_messageInfo_QuantityPowRequest.Size(m)
+}
+func (m *QuantityPowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuantityPowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuantityPowRequest proto.InternalMessageInfo
+
+func (m *QuantityPowRequest) GetA() *Quantity {
+	if m != nil {
+		return m.A
+	}
+	return nil
+}
+
+func (m *QuantityPowRequest) GetB() float64 {
+	if m != nil {
+		return m.B
+	}
+	return 0
+}
+
+type ConvertRequest struct {
+	A                    *Quantity `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	Unit                 string    `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ConvertRequest) Reset()         { *m = ConvertRequest{} }
+func (m *ConvertRequest) String() string { return proto.CompactTextString(m) }
+func (*ConvertRequest) ProtoMessage()    {}
+func (*ConvertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{34}
+}
+
+func (m *ConvertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConvertRequest.Unmarshal(m, b)
+}
+func (m *ConvertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConvertRequest.Marshal(b, m, deterministic)
+}
+func (m *ConvertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertRequest.Merge(m, src)
+}
+func (m *ConvertRequest) XXX_Size() int {
+	return xxx_messageInfo_ConvertRequest.Size(m)
+}
+func (m *ConvertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertRequest proto.InternalMessageInfo
+
+func (m *ConvertRequest) GetA() *Quantity {
+	if m != nil {
+		return m.A
+	}
+	return nil
+}
+
+func (m *ConvertRequest) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+type QuantityReply struct {
+	V   *Quantity `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err string    `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *QuantityReply) Reset()         { *m = QuantityReply{} }
+func (m *QuantityReply) String() string { return proto.CompactTextString(m) }
+func (*QuantityReply) ProtoMessage()    {}
+func (*QuantityReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{35}
+}
+
+func (m *QuantityReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuantityReply.Unmarshal(m, b)
+}
+func (m *QuantityReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuantityReply.Marshal(b, m, deterministic)
+}
+func (m *QuantityReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuantityReply.Merge(m, src)
+}
+func (m *QuantityReply) XXX_Size() int {
+	return xxx_messageInfo_QuantityReply.Size(m)
+}
+func (m *QuantityReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuantityReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuantityReply proto.InternalMessageInfo
+
+func (m *QuantityReply) GetV() *Quantity {
+	if m != nil {
+		return m.V
+	}
+	return nil
+}
+
+func (m *QuantityReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *QuantityReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// CashFlowRequest holds values received, positive, or paid, negative, a
+// period apart. scale is 2 when it's unset, or 8 for IRR.
+type CashFlowRequest struct {
+	Rate                 string                `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Values               []string              `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Scale                *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=scale,proto3" json:"scale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CashFlowRequest) Reset()         { *m = CashFlowRequest{} }
+func (m *CashFlowRequest) String() string { return proto.CompactTextString(m) }
+func (*CashFlowRequest) ProtoMessage()    {}
+func (*CashFlowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{36}
+}
+
+func (m *CashFlowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CashFlowRequest.Unmarshal(m, b)
+}
+func (m *CashFlowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CashFlowRequest.Marshal(b, m, deterministic)
+}
+func (m *CashFlowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CashFlowRequest.Merge(m, src)
+}
+func (m *CashFlowRequest) XXX_Size() int {
+	return xxx_messageInfo_CashFlowRequest.Size(m)
+}
+func (m *CashFlowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CashFlowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CashFlowRequest proto.InternalMessageInfo
+
+func (m *CashFlowRequest) GetRate() string {
+	if m != nil {
+		return m.Rate
+	}
+	return ""
+}
+
+func (m *CashFlowRequest) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *CashFlowRequest) GetScale() *wrappers.UInt32Value {
+	if m != nil {
+		return m.Scale
+	}
+	return nil
+}
+
+// TimeValueRequest holds the terms of an annuity, each method ignores the
+// value it computes. Empty amounts are 0 and scale is 2 when it's unset.
+type TimeValueRequest struct {
+	Rate                 string                `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Periods              int64                 `protobuf:"varint,2,opt,name=periods,proto3" json:"periods,omitempty"`
+	Pv                   string                `protobuf:"bytes,3,opt,name=pv,proto3" json:"pv,omitempty"`
+	Fv                   string                `protobuf:"bytes,4,opt,name=fv,proto3" json:"fv,omitempty"`
+	Pmt                  string                `protobuf:"bytes,5,opt,name=pmt,proto3" json:"pmt,omitempty"`
+	Scale                *wrappers.UInt32Value `protobuf:"bytes,6,opt,name=scale,proto3" json:"scale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TimeValueRequest) Reset()         { *m = TimeValueRequest{} }
+func (m *TimeValueRequest) String() string { return proto.CompactTextString(m) }
+func (*TimeValueRequest) ProtoMessage()    {}
+func (*TimeValueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{37}
+}
+
+func (m *TimeValueRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeValueRequest.Unmarshal(m, b)
+}
+func (m *TimeValueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeValueRequest.Marshal(b, m, deterministic)
+}
+func (m *TimeValueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeValueRequest.Merge(m, src)
+}
+func (m *TimeValueRequest) XXX_Size() int {
+	return xxx_messageInfo_TimeValueRequest.Size(m)
+}
+func (m *TimeValueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeValueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeValueRequest proto.InternalMessageInfo
+
+func (m *TimeValueRequest) GetRate() string {
+	if m != nil {
+		return m.Rate
+	}
+	return ""
+}
+
+func (m *TimeValueRequest) GetPeriods() int64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+func (m *TimeValueRequest) GetPv() string {
+	if m != nil {
+		return m.Pv
+	}
+	return ""
+}
+
+func (m *TimeValueRequest) GetFv() string {
+	if m != nil {
+		return m.Fv
+	}
+	return ""
+}
+
+func (m *TimeValueRequest) GetPmt() string {
+	if m != nil {
+		return m.Pmt
+	}
+	return ""
+}
+
+func (m *TimeValueRequest) GetScale() *wrappers.UInt32Value {
+	if m != nil {
+		return m.Scale
+	}
+	return nil
+}
+
+// CompoundInterestRequest holds the terms of a deposit, the rate is
+// compounded once per period when frequency is 0 and scale is 2 when it's
+// unset.
+type CompoundInterestRequest struct {
+	Principal            string                `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Rate                 string                `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	Periods              int64                 `protobuf:"varint,3,opt,name=periods,proto3" json:"periods,omitempty"`
+	Frequency            int64                 `protobuf:"varint,4,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Scale                *wrappers.UInt32Value `protobuf:"bytes,5,opt,name=scale,proto3" json:"scale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CompoundInterestRequest) Reset()         { *m = CompoundInterestRequest{} }
+func (m *CompoundInterestRequest) String() string { return proto.CompactTextString(m) }
+func (*CompoundInterestRequest) ProtoMessage()    {}
+func (*CompoundInterestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{38}
+}
+
+func (m *CompoundInterestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompoundInterestRequest.Unmarshal(m, b)
+}
+func (m *CompoundInterestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompoundInterestRequest.Marshal(b, m, deterministic)
+}
+func (m *CompoundInterestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompoundInterestRequest.Merge(m, src)
+}
+func (m *CompoundInterestRequest) XXX_Size() int {
+	return xxx_messageInfo_CompoundInterestRequest.Size(m)
+}
+func (m *CompoundInterestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompoundInterestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompoundInterestRequest proto.InternalMessageInfo
+
+func (m *CompoundInterestRequest) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
+}
+
+func (m *CompoundInterestRequest) GetRate() string {
+	if m != nil {
+		return m.Rate
+	}
+	return ""
+}
+
+func (m *CompoundInterestRequest) GetPeriods() int64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+func (m *CompoundInterestRequest) GetFrequency() int64 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *CompoundInterestRequest) GetScale() *wrappers.UInt32Value {
+	if m != nil {
+		return m.Scale
+	}
+	return nil
+}
+
+// AmortizationRequest holds the terms of a loan repaid over periods, scale is
+// 2 when it's unset.
+type AmortizationRequest struct {
+	Principal            string                `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Rate                 string                `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	Periods              int64                 `protobuf:"varint,3,opt,name=periods,proto3" json:"periods,omitempty"`
+	Scale                *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=scale,proto3" json:"scale,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AmortizationRequest) Reset()         { *m = AmortizationRequest{} }
+func (m *AmortizationRequest) String() string { return proto.CompactTextString(m) }
+func (*AmortizationRequest) ProtoMessage()    {}
+func (*AmortizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{39}
+}
+
+func (m *AmortizationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AmortizationRequest.Unmarshal(m, b)
+}
+func (m *AmortizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AmortizationRequest.Marshal(b, m, deterministic)
+}
+func (m *AmortizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmortizationRequest.Merge(m, src)
+}
+func (m *AmortizationRequest) XXX_Size() int {
+	return xxx_messageInfo_AmortizationRequest.Size(m)
+}
+func (m *AmortizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmortizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmortizationRequest proto.InternalMessageInfo
+
+func (m *AmortizationRequest) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
+}
+
+func (m *AmortizationRequest) GetRate() string {
+	if m != nil {
+		return m.Rate
+	}
+	return ""
+}
+
+func (m *AmortizationRequest) GetPeriods() int64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+func (m *AmortizationRequest) GetScale() *wrappers.UInt32Value {
+	if m != nil {
+		return m.Scale
+	}
+	return nil
+}
+
+type DecimalReply struct {
+	V   string `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err string `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *DecimalReply) Reset()         { *m = DecimalReply{} }
+func (m *DecimalReply) String() string { return proto.CompactTextString(m) }
+func (*DecimalReply) ProtoMessage()    {}
+func (*DecimalReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{40}
+}
+
+func (m *DecimalReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecimalReply.Unmarshal(m, b)
+}
+func (m *DecimalReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecimalReply.Marshal(b, m, deterministic)
+}
+func (m *DecimalReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecimalReply.Merge(m, src)
+}
+func (m *DecimalReply) XXX_Size() int {
+	return xxx_messageInfo_DecimalReply.Size(m)
+}
+func (m *DecimalReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecimalReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecimalReply proto.InternalMessageInfo
+
+func (m *DecimalReply) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *DecimalReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *DecimalReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// AmortizationRow is a period of an amortization schedule: the payment made,
+// split into the interest and the principal it repays, and the balance left.
+// When the request fails a single row is streamed, holding the error.
+type AmortizationRow struct {
+	Period    int64  `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	Payment   string `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment,omitempty"`
+	Interest  string `protobuf:"bytes,3,opt,name=interest,proto3" json:"interest,omitempty"`
+	Principal string `protobuf:"bytes,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	Balance   string `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	Err       string `protobuf:"bytes,6,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,7,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *AmortizationRow) Reset()         { *m = AmortizationRow{} }
+func (m *AmortizationRow) String() string { return proto.CompactTextString(m) }
+func (*AmortizationRow) ProtoMessage()    {}
+func (*AmortizationRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{41}
+}
+
+func (m *AmortizationRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AmortizationRow.Unmarshal(m, b)
+}
+func (m *AmortizationRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AmortizationRow.Marshal(b, m, deterministic)
+}
+func (m *AmortizationRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmortizationRow.Merge(m, src)
+}
+func (m *AmortizationRow) XXX_Size() int {
+	return xxx_messageInfo_AmortizationRow.Size(m)
+}
+func (m *AmortizationRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmortizationRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmortizationRow proto.InternalMessageInfo
+
+func (m *AmortizationRow) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *AmortizationRow) GetPayment() string {
+	if m != nil {
+		return m.Payment
+	}
+	return ""
+}
+
+func (m *AmortizationRow) GetInterest() string {
+	if m != nil {
+		return m.Interest
+	}
+	return ""
+}
+
+func (m *AmortizationRow) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
+}
+
+func (m *AmortizationRow) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *AmortizationRow) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *AmortizationRow) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// IntegerRequest holds the operand of the NumberTheory methods taking one.
+type IntegerRequest struct {
+	N                    string   `protobuf:"bytes,1,opt,name=n,proto3" json:"n,omitempty"`
+	BudgetMs             uint32   `protobuf:"varint,2,opt,name=budget_ms,json=budgetMs,proto3" json:"budget_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IntegerRequest) Reset()         { *m = IntegerRequest{} }
+func (m *IntegerRequest) String() string { return proto.CompactTextString(m) }
+func (*IntegerRequest) ProtoMessage()    {}
+func (*IntegerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{42}
+}
+
+func (m *IntegerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IntegerRequest.Unmarshal(m, b)
+}
+func (m *IntegerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IntegerRequest.Marshal(b, m, deterministic)
+}
+func (m *IntegerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegerRequest.Merge(m, src)
+}
+func (m *IntegerRequest) XXX_Size() int {
+	return xxx_messageInfo_IntegerRequest.Size(m)
+}
+func (m *IntegerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegerRequest proto.InternalMessageInfo
+
+func (m *IntegerRequest) GetN() string {
+	if m != nil {
+		return m.N
+	}
+	return ""
+}
+
+func (m *IntegerRequest) GetBudgetMs() uint32 {
+	if m != nil {
+		return m.BudgetMs
+	}
+	return 0
+}
+
+// CombinatoricsRequest holds the operands of the Combinatorics methods,
+// Factorial ignores k.
+type CombinatoricsRequest struct {
+	N                    int64    `protobuf:"varint,1,opt,name=n,proto3" json:"n,omitempty"`
+	K                    int64    `protobuf:"varint,2,opt,name=k,proto3" json:"k,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CombinatoricsRequest) Reset()         { *m = CombinatoricsRequest{} }
+func (m *CombinatoricsRequest) String() string { return proto.CompactTextString(m) }
+func (*CombinatoricsRequest) ProtoMessage()    {}
+func (*CombinatoricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{43}
+}
+
+func (m *CombinatoricsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CombinatoricsRequest.Unmarshal(m, b)
+}
+func (m *CombinatoricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CombinatoricsRequest.Marshal(b, m, deterministic)
+}
+func (m *CombinatoricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CombinatoricsRequest.Merge(m, src)
+}
+func (m *CombinatoricsRequest) XXX_Size() int {
+	return xxx_messageInfo_CombinatoricsRequest.Size(m)
+}
+func (m *CombinatoricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CombinatoricsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CombinatoricsRequest proto.InternalMessageInfo
+
+func (m *CombinatoricsRequest) GetN() int64 {
+	if m != nil {
+		return m.N
+	}
+	return 0
+}
+
+func (m *CombinatoricsRequest) GetK() int64 {
+	if m != nil {
+		return m.K
+	}
+	return 0
+}
+
+type ModPowRequest struct {
+	Base                 string   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Exponent             string   `protobuf:"bytes,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	Modulus              string   `protobuf:"bytes,3,opt,name=modulus,proto3" json:"modulus,omitempty"`
+	BudgetMs             uint32   `protobuf:"varint,4,opt,name=budget_ms,json=budgetMs,proto3" json:"budget_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModPowRequest) Reset()         { *m = ModPowRequest{} }
+func (m *ModPowRequest) String() string { return proto.CompactTextString(m) }
+func (*ModPowRequest) ProtoMessage()    {}
+func (*ModPowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{44}
+}
+
+func (m *ModPowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModPowRequest.Unmarshal(m, b)
+}
+func (m *ModPowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModPowRequest.Marshal(b, m, deterministic)
+}
+func (m *ModPowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModPowRequest.Merge(m, src)
+}
+func (m *ModPowRequest) XXX_Size() int {
+	return xxx_messageInfo_ModPowRequest.Size(m)
+}
+func (m *ModPowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModPowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModPowRequest proto.InternalMessageInfo
+
+func (m *ModPowRequest) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *ModPowRequest) GetExponent() string {
+	if m != nil {
+		return m.Exponent
+	}
+	return ""
+}
+
+func (m *ModPowRequest) GetModulus() string {
+	if m != nil {
+		return m.Modulus
+	}
+	return ""
+}
+
+func (m *ModPowRequest) GetBudgetMs() uint32 {
+	if m != nil {
+		return m.BudgetMs
+	}
+	return 0
+}
+
+type ModInverseRequest struct {
+	A                    string   `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	Modulus              string   `protobuf:"bytes,2,opt,name=modulus,proto3" json:"modulus,omitempty"`
+	BudgetMs             uint32   `protobuf:"varint,3,opt,name=budget_ms,json=budgetMs,proto3" json:"budget_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModInverseRequest) Reset()         { *m = ModInverseRequest{} }
+func (m *ModInverseRequest) String() string { return proto.CompactTextString(m) }
+func (*ModInverseRequest) ProtoMessage()    {}
+func (*ModInverseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{45}
+}
+
+func (m *ModInverseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModInverseRequest.Unmarshal(m, b)
+}
+func (m *ModInverseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModInverseRequest.Marshal(b, m, deterministic)
+}
+func (m *ModInverseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModInverseRequest.Merge(m, src)
+}
+func (m *ModInverseRequest) XXX_Size() int {
+	return xxx_messageInfo_ModInverseRequest.Size(m)
+}
+func (m *ModInverseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModInverseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModInverseRequest proto.InternalMessageInfo
+
+func (m *ModInverseRequest) GetA() string {
+	if m != nil {
+		return m.A
+	}
+	return ""
+}
+
+func (m *ModInverseRequest) GetModulus() string {
+	if m != nil {
+		return m.Modulus
+	}
+	return ""
+}
+
+func (m *ModInverseRequest) GetBudgetMs() uint32 {
+	if m != nil {
+		return m.BudgetMs
+	}
+	return 0
+}
+
+type IntegerReply struct {
+	V   string `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Err string `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *IntegerReply) Reset()         { *m = IntegerReply{} }
+func (m *IntegerReply) String() string { return proto.CompactTextString(m) }
+func (*IntegerReply) ProtoMessage()    {}
+func (*IntegerReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{46}
+}
+
+func (m *IntegerReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IntegerReply.Unmarshal(m, b)
+}
+func (m *IntegerReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IntegerReply.Marshal(b, m, deterministic)
+}
+func (m *IntegerReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegerReply.Merge(m, src)
+}
+func (m *IntegerReply) XXX_Size() int {
+	return xxx_messageInfo_IntegerReply.Size(m)
+}
+func (m *IntegerReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegerReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegerReply proto.InternalMessageInfo
+
+func (m *IntegerReply) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+func (m *IntegerReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *IntegerReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// PrimalityReply tells whether n is prime. probable is set when n was found
+// prime by a probabilistic test, which may be wrong with a probability below
+// 4^-20.
+type PrimalityReply struct {
+	Prime    bool   `protobuf:"varint,1,opt,name=prime,proto3" json:"prime,omitempty"`
+	Probable bool   `protobuf:"varint,2,opt,name=probable,proto3" json:"probable,omitempty"`
+	Err      string `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,4,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PrimalityReply) Reset()         { *m = PrimalityReply{} }
+func (m *PrimalityReply) String() string { return proto.CompactTextString(m) }
+func (*PrimalityReply) ProtoMessage()    {}
+func (*PrimalityReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{47}
+}
+
+func (m *PrimalityReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrimalityReply.Unmarshal(m, b)
+}
+func (m *PrimalityReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrimalityReply.Marshal(b, m, deterministic)
+}
+func (m *PrimalityReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrimalityReply.Merge(m, src)
+}
+func (m *PrimalityReply) XXX_Size() int {
+	return xxx_messageInfo_PrimalityReply.Size(m)
+}
+func (m *PrimalityReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrimalityReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrimalityReply proto.InternalMessageInfo
+
+func (m *PrimalityReply) GetPrime() bool {
+	if m != nil {
+		return m.Prime
+	}
+	return false
+}
+
+func (m *PrimalityReply) GetProbable() bool {
+	if m != nil {
+		return m.Probable
+	}
+	return false
+}
+
+func (m *PrimalityReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *PrimalityReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// Factor is a prime factor and the number of times it divides n.
+type Factor struct {
+	Prime                string   `protobuf:"bytes,1,opt,name=prime,proto3" json:"prime,omitempty"`
+	Exponent             uint32   `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Factor) Reset()         { *m = Factor{} }
+func (m *Factor) String() string { return proto.CompactTextString(m) }
+func (*Factor) ProtoMessage()    {}
+func (*Factor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{48}
+}
+
+func (m *Factor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Factor.Unmarshal(m, b)
+}
+func (m *Factor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Factor.Marshal(b, m, deterministic)
+}
+func (m *Factor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Factor.Merge(m, src)
+}
+func (m *Factor) XXX_Size() int {
+	return xxx_messageInfo_Factor.Size(m)
+}
+func (m *Factor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Factor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Factor proto.InternalMessageInfo
+
+func (m *Factor) GetPrime() string {
+	if m != nil {
+		return m.Prime
+	}
+	return ""
+}
+
+func (m *Factor) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+type FactorsReply struct {
+	Factors []*Factor `protobuf:"bytes,1,rep,name=factors,proto3" json:"factors,omitempty"`
+	Err     string    `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,3,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *FactorsReply) Reset()         { *m = FactorsReply{} }
+func (m *FactorsReply) String() string { return proto.CompactTextString(m) }
+func (*FactorsReply) ProtoMessage()    {}
+func (*FactorsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{49}
+}
+
+func (m *FactorsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FactorsReply.Unmarshal(m, b)
+}
+func (m *FactorsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FactorsReply.Marshal(b, m, deterministic)
+}
+func (m *FactorsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactorsReply.Merge(m, src)
+}
+func (m *FactorsReply) XXX_Size() int {
+	return xxx_messageInfo_FactorsReply.Size(m)
+}
+func (m *FactorsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactorsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactorsReply proto.InternalMessageInfo
+
+func (m *FactorsReply) GetFactors() []*Factor {
+	if m != nil {
+		return m.Factors
+	}
+	return nil
+}
+
+func (m *FactorsReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *FactorsReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// CalculusRequest holds the operands of the Calculus methods. Integrate,
+// FindRoot and Minimize work on the interval from a to b, Differentiate at x.
+type CalculusRequest struct {
+	// expression is a function of x, e.g. "x^3 - 2*x"
+	Expression string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	A          float64                `protobuf:"fixed64,2,opt,name=a,proto3" json:"a,omitempty"`
+	B          float64                `protobuf:"fixed64,3,opt,name=b,proto3" json:"b,omitempty"`
+	X          float64                `protobuf:"fixed64,4,opt,name=x,proto3" json:"x,omitempty"`
+	Method     CalculusRequest_Method `protobuf:"varint,5,opt,name=method,proto3,enum=pb.CalculusRequest_Method" json:"method,omitempty"`
+	// tolerance is the error allowed in the result, relative to its magnitude
+	// when that's more than 1, 1e-10 when it's 0
+	Tolerance float64 `protobuf:"fixed64,6,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	// max_iterations is the most iterations a method may use, 1000 when it's 0
+	MaxIterations        uint32   `protobuf:"varint,7,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CalculusRequest) Reset()         { *m = CalculusRequest{} }
+func (m *CalculusRequest) String() string { return proto.CompactTextString(m) }
+func (*CalculusRequest) ProtoMessage()    {}
+func (*CalculusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{50}
+}
+
+func (m *CalculusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalculusRequest.Unmarshal(m, b)
+}
+func (m *CalculusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalculusRequest.Marshal(b, m, deterministic)
+}
+func (m *CalculusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalculusRequest.Merge(m, src)
+}
+func (m *CalculusRequest) XXX_Size() int {
+	return xxx_messageInfo_CalculusRequest.Size(m)
+}
+func (m *CalculusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalculusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalculusRequest proto.InternalMessageInfo
+
+func (m *CalculusRequest) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *CalculusRequest) GetA() float64 {
+	if m != nil {
+		return m.A
+	}
+	return 0
+}
+
+func (m *CalculusRequest) GetB() float64 {
+	if m != nil {
+		return m.B
+	}
+	return 0
+}
+
+func (m *CalculusRequest) GetX() float64 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+func (m *CalculusRequest) GetMethod() CalculusRequest_Method {
+	if m != nil {
+		return m.Method
+	}
+	return CalculusRequest_DEFAULT
+}
+
+func (m *CalculusRequest) GetTolerance() float64 {
+	if m != nil {
+		return m.Tolerance
+	}
+	return 0
+}
+
+func (m *CalculusRequest) GetMaxIterations() uint32 {
+	if m != nil {
+		return m.MaxIterations
+	}
+	return 0
+}
+
+// CalculusReply holds the result v of a Calculus method: the integral, the
+// derivative, the root or where the minimum is. fx is the value of the
+// expression at v for FindRoot and Minimize.
+type CalculusReply struct {
+	V          float64 `protobuf:"fixed64,1,opt,name=v,proto3" json:"v,omitempty"`
+	Fx         float64 `protobuf:"fixed64,2,opt,name=fx,proto3" json:"fx,omitempty"`
+	Iterations uint32  `protobuf:"varint,3,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	// error_estimate estimates the absolute error of v
+	ErrorEstimate float64 `protobuf:"fixed64,4,opt,name=error_estimate,json=errorEstimate,proto3" json:"error_estimate,omitempty"`
+	Err           string  `protobuf:"bytes,5,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,6,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CalculusReply) Reset()         { *m = CalculusReply{} }
+func (m *CalculusReply) String() string { return proto.CompactTextString(m) }
+func (*CalculusReply) ProtoMessage()    {}
+func (*CalculusReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{51}
+}
+
+func (m *CalculusReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalculusReply.Unmarshal(m, b)
+}
+func (m *CalculusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalculusReply.Marshal(b, m, deterministic)
+}
+func (m *CalculusReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalculusReply.Merge(m, src)
+}
+func (m *CalculusReply) XXX_Size() int {
+	return xxx_messageInfo_CalculusReply.Size(m)
+}
+func (m *CalculusReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalculusReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalculusReply proto.InternalMessageInfo
+
+func (m *CalculusReply) GetV() float64 {
+	if m != nil {
+		return m.V
+	}
+	return 0
+}
+
+func (m *CalculusReply) GetFx() float64 {
+	if m != nil {
+		return m.Fx
+	}
+	return 0
+}
+
+func (m *CalculusReply) GetIterations() uint32 {
+	if m != nil {
+		return m.Iterations
+	}
+	return 0
+}
+
+func (m *CalculusReply) GetErrorEstimate() float64 {
+	if m != nil {
+		return m.ErrorEstimate
+	}
+	return 0
+}
+
+func (m *CalculusReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *CalculusReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+// SymbolicRequest holds the operands of the Symbolic methods.
+type SymbolicRequest struct {
+	// expression is a formula such as "a*x^2 + sin(x)"
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// variable is the name of the variable Differentiate differentiates with
+	// respect to, e.g. "x"
+	Variable             string   `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SymbolicRequest) Reset()         { *m = SymbolicRequest{} }
+func (m *SymbolicRequest) String() string { return proto.CompactTextString(m) }
+func (*SymbolicRequest) ProtoMessage()    {}
+func (*SymbolicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{52}
+}
+
+func (m *SymbolicRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SymbolicRequest.Unmarshal(m, b)
+}
+func (m *SymbolicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SymbolicRequest.Marshal(b, m, deterministic)
+}
+func (m *SymbolicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbolicRequest.Merge(m, src)
+}
+func (m *SymbolicRequest) XXX_Size() int {
+	return xxx_messageInfo_SymbolicRequest.Size(m)
+}
+func (m *SymbolicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbolicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbolicRequest proto.InternalMessageInfo
+
+func (m *SymbolicRequest) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *SymbolicRequest) GetVariable() string {
+	if m != nil {
+		return m.Variable
+	}
+	return ""
+}
+
+// SymbolicReply holds the expression resulting from a Symbolic method in
+// every notation.
+type SymbolicReply struct {
+	// infix is the expression with the syntax of the requests, e.g. "3*x^2"
+	Infix string `protobuf:"bytes,1,opt,name=infix,proto3" json:"infix,omitempty"`
+	// latex is the expression as a LaTeX math formula, e.g. "3x^{2}"
+	Latex string `protobuf:"bytes,2,opt,name=latex,proto3" json:"latex,omitempty"`
+	// mathml is the expression as a presentation MathML math element
+	Mathml string `protobuf:"bytes,3,opt,name=mathml,proto3" json:"mathml,omitempty"`
+	Err    string `protobuf:"bytes,4,opt,name=err,proto3" json:"err,omitempty"`
+	// code identifies the error described by err.
+	Code                 ErrorCode `protobuf:"varint,5,opt,name=code,proto3,enum=pb.ErrorCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SymbolicReply) Reset()         { *m = SymbolicReply{} }
+func (m *SymbolicReply) String() string { return proto.CompactTextString(m) }
+func (*SymbolicReply) ProtoMessage()    {}
+func (*SymbolicReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c63e992315a488f, []int{53}
+}
+
+func (m *SymbolicReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SymbolicReply.Unmarshal(m, b)
+}
+func (m *SymbolicReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SymbolicReply.Marshal(b, m, deterministic)
+}
+func (m *SymbolicReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SymbolicReply.Merge(m, src)
+}
+func (m *SymbolicReply) XXX_Size() int {
+	return xxx_messageInfo_SymbolicReply.Size(m)
+}
+func (m *SymbolicReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SymbolicReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SymbolicReply proto.InternalMessageInfo
+
+func (m *SymbolicReply) GetInfix() string {
+	if m != nil {
+		return m.Infix
+	}
+	return ""
+}
+
+func (m *SymbolicReply) GetLatex() string {
+	if m != nil {
+		return m.Latex
+	}
+	return ""
+}
+
+func (m *SymbolicReply) GetMathml() string {
+	if m != nil {
+		return m.Mathml
+	}
+	return ""
+}
+
+func (m *SymbolicReply) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *SymbolicReply) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_NO_ERROR
+}
+
+func init() {
+	proto.RegisterEnum("pb.ErrorCode", ErrorCode_name, ErrorCode_value)
+	proto.RegisterEnum("pb.Division", Division_name, Division_value)
+	proto.RegisterEnum("pb.Precision_Mode", Precision_Mode_name, Precision_Mode_value)
+	proto.RegisterEnum("pb.ComputeRequest_Op", ComputeRequest_Op_name, ComputeRequest_Op_value)
+	proto.RegisterEnum("pb.StatisticsOptions_Interpolation", StatisticsOptions_Interpolation_name, StatisticsOptions_Interpolation_value)
+	proto.RegisterEnum("pb.CalculusRequest_Method", CalculusRequest_Method_name, CalculusRequest_Method_value)
+	proto.RegisterType((*MathOpRequest)(nil), "pb.MathOpRequest")
+	proto.RegisterType((*MathOpReply)(nil), "pb.MathOpReply")
+	proto.RegisterType((*ErrorDetail)(nil), "pb.ErrorDetail")
+	proto.RegisterType((*Precision)(nil), "pb.Precision")
+	proto.RegisterType((*EvaluateRequest)(nil), "pb.EvaluateRequest")
+	proto.RegisterType((*MathListRequest)(nil), "pb.MathListRequest")
+	proto.RegisterType((*UnaryOpRequest)(nil), "pb.UnaryOpRequest")
+	proto.RegisterType((*ComputeRequest)(nil), "pb.ComputeRequest")
+	proto.RegisterType((*ComputeReply)(nil), "pb.ComputeReply")
+	proto.RegisterType((*BatchRequest)(nil), "pb.BatchRequest")
+	proto.RegisterType((*BatchReply)(nil), "pb.BatchReply")
+	proto.RegisterType((*ComplexNumber)(nil), "pb.ComplexNumber")
+	proto.RegisterType((*ComplexOpRequest)(nil), "pb.ComplexOpRequest")
+	proto.RegisterType((*ComplexOpReply)(nil), "pb.ComplexOpReply")
+	proto.RegisterType((*Matrix)(nil), "pb.Matrix")
+	proto.RegisterType((*VectorOpRequest)(nil), "pb.VectorOpRequest")
+	proto.RegisterType((*MatrixOpRequest)(nil), "pb.MatrixOpRequest")
+	proto.RegisterType((*SolveRequest)(nil), "pb.SolveRequest")
+	proto.RegisterType((*VectorReply)(nil), "pb.VectorReply")
+	proto.RegisterType((*MatrixReply)(nil), "pb.MatrixReply")
+	proto.RegisterType((*PolynomialOpRequest)(nil), "pb.PolynomialOpRequest")
+	proto.RegisterType((*PolynomialEvaluateRequest)(nil), "pb.PolynomialEvaluateRequest")
+	proto.RegisterType((*RootsRequest)(nil), "pb.RootsRequest")
+	proto.RegisterType((*PolynomialReply)(nil), "pb.PolynomialReply")
+	proto.RegisterType((*RootsReply)(nil), "pb.RootsReply")
+	proto.RegisterType((*StatisticsChunk)(nil), "pb.StatisticsChunk")
+	proto.RegisterType((*StatisticsOptions)(nil), "pb.StatisticsOptions")
+	proto.RegisterType((*Quantile)(nil), "pb.Quantile")
+	proto.RegisterType((*HistogramBucket)(nil), "pb.HistogramBucket")
+	proto.RegisterType((*DescribeReply)(nil), "pb.DescribeReply")
+	proto.RegisterType((*CorrelateReply)(nil), "pb.CorrelateReply")
+	proto.RegisterType((*Quantity)(nil), "pb.Quantity")
+	proto.RegisterType((*QuantityOpRequest)(nil), "pb.QuantityOpRequest")
+	proto.RegisterType((*QuantityPowRequest)(nil), "pb.QuantityPowRequest")
+	proto.RegisterType((*ConvertRequest)(nil), "pb.ConvertRequest")
+	proto.RegisterType((*QuantityReply)(nil), "pb.QuantityReply")
+	proto.RegisterType((*CashFlowRequest)(nil), "pb.CashFlowRequest")
+	proto.RegisterType((*TimeValueRequest)(nil), "pb.TimeValueRequest")
+	proto.RegisterType((*CompoundInterestRequest)(nil), "pb.CompoundInterestRequest")
+	proto.RegisterType((*AmortizationRequest)(nil), "pb.AmortizationRequest")
+	proto.RegisterType((*DecimalReply)(nil), "pb.DecimalReply")
+	proto.RegisterType((*AmortizationRow)(nil), "pb.AmortizationRow")
+	proto.RegisterType((*IntegerRequest)(nil), "pb.IntegerRequest")
+	proto.RegisterType((*CombinatoricsRequest)(nil), "pb.CombinatoricsRequest")
+	proto.RegisterType((*ModPowRequest)(nil), "pb.ModPowRequest")
+	proto.RegisterType((*ModInverseRequest)(nil), "pb.ModInverseRequest")
+	proto.RegisterType((*IntegerReply)(nil), "pb.IntegerReply")
+	proto.RegisterType((*PrimalityReply)(nil), "pb.PrimalityReply")
+	proto.RegisterType((*Factor)(nil), "pb.Factor")
+	proto.RegisterType((*FactorsReply)(nil), "pb.FactorsReply")
+	proto.RegisterType((*CalculusRequest)(nil), "pb.CalculusRequest")
+	proto.RegisterType((*CalculusReply)(nil), "pb.CalculusReply")
+	proto.RegisterType((*SymbolicRequest)(nil), "pb.SymbolicRequest")
+	proto.RegisterType((*SymbolicReply)(nil), "pb.SymbolicReply")
+}
+
+func init() { proto.RegisterFile("mathsvc.proto", fileDescriptor_2c63e992315a488f) }
+
+var fileDescriptor_2c63e992315a488f = []byte{
+	// 4152 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7a, 0xcd, 0x6f, 0xdb, 0xda,
+	0x72, 0x78, 0xa8, 0x2f, 0x5b, 0x63, 0xcb, 0x3e, 0xa6, 0xf3, 0xe1, 0xeb, 0x9b, 0x9b, 0xe4, 0xea,
+	0xde, 0x9b, 0xe7, 0x97, 0xdc, 0x38, 0xb1, 0x92, 0xfb, 0xf9, 0xf2, 0xfb, 0xa1, 0xb4, 0x44, 0x2b,
+	0x7c, 0xa1, 0x48, 0xe5, 0x90, 0xf2, 0x4d, 0x1e, 0x8a, 0xa7, 0x52, 0x12, 0x6d, 0xb3, 0x91, 0x48,
+	0x85, 0xa4, 0x1c, 0xf9, 0x2d, 0xba, 0x28, 0x50, 0xa0, 0x5d, 0x74, 0xd9, 0x65, 0xb7, 0x05, 0xba,
+	0xea, 0xb2, 0xfb, 0x02, 0x05, 0x0a, 0xb4, 0x40, 0xff, 0x83, 0x76, 0xd3, 0x02, 0xed, 0xa6, 0x8b,
+	0xfe, 0x05, 0xc5, 0x1c, 0x7e, 0xcb, 0x72, 0x2a, 0x05, 0xb7, 0xbb, 0x73, 0xe6, 0xcc, 0xcc, 0x99,
+	0x33, 0x67, 0xce, 0xcc, 0x70, 0x86, 0x50, 0x19, 0x19, 0xfe, 0x99, 0x77, 0xde, 0xdf, 0x1f, 0xbb,
+	0x8e, 0xef, 0xf0, 0xb9, 0x71, 0x6f, 0xf7, 0xce, 0xa9, 0xe3, 0x9c, 0x0e, 0xcd, 0xc7, 0x0c, 0xd2,
+	0x9b, 0x9c, 0x3c, 0x7e, 0xef, 0x1a, 0xe3, 0xb1, 0xe9, 0x7a, 0x01, 0x4e, 0xf5, 0x8f, 0x39, 0xa8,
+	0xb4, 0x0c, 0xff, 0x4c, 0x1d, 0x53, 0xf3, 0xdd, 0xc4, 0xf4, 0x7c, 0x7e, 0x1d, 0x38, 0x63, 0x87,
+	0xbb, 0xc7, 0xed, 0x71, 0x94, 0x33, 0x70, 0xd6, 0xdb, 0xc9, 0x05, 0xb3, 0x1e, 0xff, 0x10, 0xca,
+	0x63, 0xd7, 0xec, 0x5b, 0x9e, 0xe5, 0xd8, 0x3b, 0xf9, 0x7b, 0xdc, 0xde, 0x5a, 0xad, 0xb2, 0x3f,
+	0xee, 0xed, 0xb7, 0x23, 0x20, 0x4d, 0xd6, 0xf9, 0x3d, 0x58, 0x1d, 0x58, 0xe7, 0x01, 0x6e, 0xe1,
+	0x1e, 0xb7, 0xb7, 0x51, 0x5b, 0x47, 0xdc, 0x46, 0x08, 0xa3, 0xf1, 0x6a, 0xf5, 0x04, 0xd6, 0x22,
+	0x19, 0xc6, 0xc3, 0x0b, 0xdc, 0xf3, 0x3c, 0x92, 0xe0, 0x9c, 0x27, 0x90, 0x37, 0x5d, 0x97, 0xc9,
+	0x50, 0xa6, 0x38, 0xe4, 0xaf, 0x43, 0xd1, 0x9c, 0x1a, 0x7d, 0x9f, 0x49, 0x50, 0xa6, 0xc1, 0x84,
+	0xff, 0x1c, 0x0a, 0x7d, 0x67, 0x60, 0x86, 0x5b, 0x31, 0xb1, 0x44, 0xd7, 0x75, 0xdc, 0xba, 0x33,
+	0x30, 0x29, 0x5b, 0xaa, 0x3e, 0x81, 0x35, 0x06, 0x6a, 0x98, 0xbe, 0x61, 0x0d, 0x63, 0x0a, 0xee,
+	0x6a, 0x8a, 0x3f, 0xe1, 0xa0, 0x1c, 0x1f, 0x8e, 0xbf, 0x0f, 0x85, 0x51, 0x42, 0xc0, 0x67, 0x4e,
+	0xbe, 0xdf, 0x62, 0x54, 0xb8, 0xce, 0xf3, 0x50, 0xe8, 0x59, 0xbe, 0xc7, 0x64, 0xae, 0x50, 0x36,
+	0xae, 0x3e, 0x87, 0x02, 0x62, 0xf0, 0x6b, 0xb0, 0xd2, 0x10, 0x8f, 0x84, 0x8e, 0xac, 0x93, 0x6b,
+	0x38, 0x39, 0x92, 0x55, 0x41, 0xff, 0xf6, 0x19, 0xe1, 0xf8, 0x75, 0x58, 0x3d, 0x94, 0x9a, 0x6c,
+	0x4e, 0x72, 0x38, 0xa3, 0x82, 0x2e, 0xa9, 0x8a, 0x20, 0x93, 0x7c, 0xf5, 0xb7, 0xb0, 0x29, 0x9e,
+	0x1b, 0xc3, 0x89, 0xe1, 0x9b, 0xd1, 0x3d, 0xdd, 0x01, 0x30, 0xa7, 0x63, 0xd7, 0xf4, 0x98, 0x82,
+	0x39, 0xa6, 0x8a, 0x14, 0x24, 0x7b, 0x57, 0xb9, 0x0f, 0xdf, 0x55, 0xf5, 0x18, 0x36, 0xf1, 0x06,
+	0x64, 0xcb, 0xf3, 0x23, 0xfe, 0x37, 0xa1, 0x84, 0x3b, 0x9a, 0xde, 0x0e, 0x77, 0x2f, 0xbf, 0xc7,
+	0xd1, 0x70, 0xb6, 0x1c, 0xdf, 0x97, 0xb0, 0xd1, 0xb1, 0x0d, 0xf7, 0x22, 0x63, 0x5e, 0xd3, 0xe8,
+	0x72, 0xa7, 0xcb, 0x31, 0xfb, 0x97, 0x22, 0x6c, 0xd4, 0x9d, 0xd1, 0x78, 0x92, 0x28, 0x61, 0x03,
+	0x72, 0xd6, 0x80, 0xb1, 0x2b, 0xd0, 0x9c, 0x35, 0xe0, 0xbf, 0x82, 0x9c, 0x33, 0x66, 0x8c, 0x36,
+	0x6a, 0x37, 0x90, 0x51, 0x16, 0x7f, 0x5f, 0x1d, 0xd3, 0x9c, 0x33, 0x0e, 0x6c, 0x3c, 0x9f, 0xb1,
+	0xf1, 0x42, 0x64, 0xe3, 0xc9, 0xb9, 0x8b, 0x99, 0x73, 0x67, 0xf5, 0x5d, 0xfa, 0xb0, 0xbe, 0x57,
+	0x96, 0x78, 0x1b, 0xab, 0x1f, 0x7c, 0x1b, 0xff, 0x99, 0x87, 0x9c, 0x3a, 0xe6, 0x37, 0x00, 0x3a,
+	0xca, 0x4b, 0x45, 0xfd, 0x49, 0xe9, 0xaa, 0x6d, 0x72, 0x8d, 0x07, 0x28, 0x35, 0xa4, 0x63, 0xa9,
+	0x21, 0x12, 0x8e, 0x5f, 0x81, 0x7c, 0x4b, 0x78, 0x4d, 0x72, 0x6c, 0x20, 0x29, 0x24, 0x8f, 0xc6,
+	0xd3, 0xea, 0xc8, 0xba, 0xd4, 0x96, 0xdf, 0x90, 0x02, 0x82, 0xdb, 0xea, 0x4f, 0xa4, 0x88, 0x60,
+	0xad, 0x73, 0xa8, 0x53, 0xa1, 0xae, 0x93, 0x12, 0x82, 0xb5, 0x4e, 0x8b, 0xac, 0x20, 0x58, 0x3c,
+	0x16, 0xe4, 0x8e, 0xa0, 0x8b, 0x64, 0x15, 0x39, 0x6b, 0x9d, 0x96, 0x20, 0xcb, 0xa4, 0x8c, 0xf6,
+	0xd9, 0xa6, 0x6a, 0xa3, 0x53, 0xd7, 0x09, 0xf0, 0xab, 0x50, 0x68, 0x89, 0x82, 0x42, 0xd6, 0x10,
+	0xa5, 0x25, 0x36, 0x24, 0x41, 0x21, 0xeb, 0x48, 0x7c, 0x2c, 0x50, 0x49, 0x50, 0xea, 0x22, 0xa9,
+	0x30, 0x62, 0xbd, 0xd1, 0x10, 0x8f, 0xc9, 0x06, 0x93, 0x46, 0x6d, 0x90, 0x4d, 0xbe, 0x02, 0x65,
+	0x49, 0xd1, 0x43, 0x71, 0x09, 0x4e, 0xa9, 0xd8, 0x12, 0x24, 0xa5, 0x21, 0x52, 0xb2, 0x85, 0x68,
+	0xcd, 0x7a, 0x83, 0xf0, 0x38, 0x90, 0xeb, 0x2d, 0xb2, 0x8d, 0x1b, 0x69, 0xaf, 0xa8, 0x4e, 0xae,
+	0x23, 0x48, 0x38, 0xd4, 0xc8, 0x0d, 0xe4, 0xab, 0x88, 0x4d, 0x14, 0xf0, 0x26, 0x02, 0xc5, 0xd7,
+	0x6d, 0x72, 0x8b, 0x2f, 0x41, 0x4e, 0x56, 0xc8, 0x0e, 0x5f, 0x86, 0xa2, 0xac, 0x36, 0x0f, 0x9e,
+	0x90, 0x4f, 0x90, 0x54, 0x56, 0x9b, 0x35, 0xb2, 0x8b, 0xc0, 0x23, 0x59, 0x55, 0x29, 0xf9, 0x14,
+	0x81, 0x75, 0x51, 0x92, 0xc9, 0x6d, 0x04, 0x52, 0xb5, 0xa3, 0x34, 0xc8, 0x67, 0x38, 0xd4, 0x69,
+	0x47, 0xa9, 0x93, 0x3b, 0x4c, 0x11, 0x92, 0x42, 0xee, 0xe2, 0xa0, 0xae, 0x6a, 0xe4, 0x1e, 0x0e,
+	0x74, 0x41, 0x21, 0x9f, 0x23, 0xa9, 0x80, 0x6b, 0x55, 0x36, 0xc2, 0xc5, 0x2f, 0xd8, 0x08, 0x57,
+	0xbf, 0x44, 0x1e, 0x4d, 0xa1, 0xd5, 0x12, 0xc8, 0x57, 0xa8, 0x06, 0x59, 0x6d, 0x06, 0xb3, 0xfb,
+	0x88, 0x72, 0x28, 0xea, 0x02, 0xf9, 0x05, 0x13, 0x96, 0x1e, 0x91, 0x3d, 0x04, 0x89, 0xf4, 0xa8,
+	0x4e, 0x7e, 0x89, 0x4a, 0x3d, 0x14, 0x35, 0x4d, 0x94, 0x7f, 0x4d, 0x1e, 0x24, 0x93, 0x37, 0xe4,
+	0x61, 0x55, 0x84, 0xf5, 0xd8, 0x5e, 0xd1, 0x11, 0x5e, 0xb6, 0xee, 0xa2, 0x8b, 0x0b, 0xe1, 0x4b,
+	0xd9, 0x44, 0x93, 0x49, 0x39, 0x4e, 0x1a, 0xac, 0x56, 0x7f, 0x03, 0xeb, 0x87, 0x86, 0xdf, 0x3f,
+	0x8b, 0x1e, 0xc9, 0x1e, 0x14, 0x2d, 0xdf, 0x1c, 0x05, 0x0f, 0x79, 0x2d, 0xf0, 0x5b, 0xd9, 0x77,
+	0x41, 0x03, 0x04, 0xfe, 0x1e, 0xac, 0xf5, 0x1d, 0xbb, 0x3f, 0x71, 0x5d, 0xd3, 0xee, 0x5f, 0x84,
+	0xfe, 0x2b, 0x0d, 0xaa, 0x7e, 0x0f, 0x10, 0xf2, 0x46, 0x01, 0x1f, 0xc0, 0x8a, 0x6b, 0x7a, 0x93,
+	0xa1, 0x1f, 0xf1, 0x26, 0x19, 0xde, 0x28, 0x53, 0x84, 0x50, 0xfd, 0x0e, 0x2a, 0xb8, 0x30, 0x34,
+	0xa7, 0xca, 0x64, 0xd4, 0x33, 0x5d, 0xf4, 0x92, 0xae, 0x69, 0x0c, 0x43, 0x67, 0xc0, 0xc6, 0x08,
+	0xb3, 0x46, 0xc6, 0x69, 0x18, 0x71, 0xd8, 0xb8, 0xaa, 0x03, 0x09, 0x09, 0x13, 0x2f, 0x72, 0x37,
+	0x0a, 0x52, 0x6b, 0xb5, 0xad, 0x68, 0xcb, 0x98, 0x33, 0xbe, 0xe9, 0xbb, 0x51, 0xdc, 0x9a, 0x8f,
+	0xd0, 0xab, 0x9e, 0xc0, 0x46, 0x8a, 0x2b, 0x1e, 0xe6, 0x6e, 0x14, 0x76, 0xe6, 0x93, 0xcc, 0x8b,
+	0x44, 0x51, 0x04, 0xc9, 0x5f, 0x1d, 0x41, 0x5e, 0x40, 0xa9, 0x65, 0xf8, 0xae, 0x35, 0x65, 0xe7,
+	0x75, 0xde, 0x7b, 0x6c, 0x8b, 0x0a, 0x65, 0x63, 0x84, 0xf5, 0x9d, 0x61, 0x1c, 0x29, 0x70, 0x9c,
+	0x72, 0x40, 0xf9, 0xb4, 0x03, 0xaa, 0x3e, 0x82, 0xcd, 0x63, 0xb3, 0xef, 0x3b, 0xee, 0xa5, 0x58,
+	0x9d, 0xcf, 0xc4, 0x6a, 0x36, 0xeb, 0x55, 0x45, 0xe6, 0xd2, 0x5d, 0x2b, 0xa5, 0xb5, 0x9d, 0x44,
+	0x6b, 0x10, 0xda, 0x8e, 0x6b, 0x4d, 0x91, 0x74, 0x27, 0x51, 0x57, 0x66, 0xa5, 0x57, 0xfd, 0x16,
+	0xd6, 0x35, 0x67, 0x78, 0x6e, 0xfe, 0xef, 0x3c, 0xb2, 0xdb, 0xb7, 0x61, 0x2d, 0x90, 0x36, 0x13,
+	0xd3, 0xf3, 0x7b, 0xdc, 0x47, 0x6b, 0xf2, 0xf7, 0x61, 0x2d, 0xdc, 0x8c, 0x71, 0xdc, 0x49, 0xae,
+	0x2b, 0x23, 0xc8, 0x47, 0x72, 0x3f, 0x80, 0xed, 0xb6, 0x33, 0xbc, 0xb0, 0x9d, 0x91, 0x65, 0x0c,
+	0x17, 0xd3, 0xf0, 0x77, 0xf0, 0x49, 0x42, 0x32, 0x1b, 0x9e, 0x2f, 0x11, 0x4e, 0xa3, 0x34, 0x6a,
+	0x5a, 0xfd, 0x11, 0xd6, 0xa9, 0xe3, 0xf8, 0xde, 0x7c, 0xdc, 0xdb, 0x50, 0xf6, 0x9d, 0xa1, 0xe9,
+	0x1a, 0x76, 0xdf, 0x0c, 0x69, 0x12, 0x40, 0x55, 0x87, 0xcd, 0x64, 0xd3, 0x9f, 0x4d, 0xb7, 0x3d,
+	0x80, 0x50, 0xa2, 0xd4, 0x4b, 0xc8, 0xff, 0xbc, 0x2f, 0xe1, 0xb7, 0xb0, 0xa9, 0xf9, 0x86, 0x6f,
+	0x79, 0xbe, 0xd5, 0xf7, 0xea, 0x67, 0x13, 0xfb, 0x6d, 0x94, 0x0c, 0xe4, 0x83, 0x64, 0x60, 0x1d,
+	0xb8, 0x8b, 0x48, 0xbb, 0x17, 0xfc, 0x63, 0x58, 0x71, 0xc6, 0xbe, 0xe5, 0xd8, 0x5e, 0x98, 0x69,
+	0xb2, 0x78, 0x9e, 0x70, 0x50, 0x83, 0x45, 0x1a, 0x61, 0x55, 0xff, 0x9d, 0x83, 0xad, 0x4b, 0xcb,
+	0xa8, 0xcd, 0x77, 0x13, 0xc3, 0xf6, 0xad, 0x61, 0x9c, 0xc9, 0x24, 0x00, 0x5e, 0x82, 0x8a, 0x65,
+	0xfb, 0xa6, 0x3b, 0x76, 0x86, 0x86, 0x1f, 0xe5, 0x20, 0x1b, 0xb5, 0x2f, 0xe6, 0x6e, 0xb5, 0x2f,
+	0xa5, 0x51, 0x69, 0x96, 0x92, 0xdf, 0x81, 0x95, 0xde, 0xa4, 0xff, 0xd6, 0xf4, 0x03, 0x79, 0x2b,
+	0x34, 0x9a, 0x56, 0x5b, 0x50, 0xc9, 0x50, 0x62, 0x34, 0x93, 0x25, 0x45, 0x14, 0x28, 0xb9, 0x16,
+	0x04, 0xaf, 0x9f, 0x44, 0x4a, 0x38, 0x04, 0xbf, 0x90, 0x9a, 0x2f, 0x44, 0x4a, 0x72, 0x18, 0x17,
+	0x10, 0x41, 0xd4, 0xf4, 0x30, 0x9c, 0x4b, 0x8d, 0xb6, 0x2a, 0x29, 0x3a, 0x29, 0x54, 0xef, 0xc3,
+	0xea, 0xab, 0xf0, 0x00, 0xa8, 0xb2, 0x77, 0x51, 0x36, 0xf5, 0x2e, 0x30, 0x84, 0xd0, 0xca, 0xce,
+	0xab, 0x1a, 0x6c, 0xbe, 0xb0, 0x3c, 0xdf, 0x39, 0x75, 0x8d, 0xd1, 0x21, 0x13, 0x05, 0x33, 0xe7,
+	0xa1, 0xf3, 0xde, 0x74, 0x43, 0x92, 0x60, 0x82, 0xd0, 0xc9, 0x78, 0x6c, 0xba, 0x21, 0x69, 0x30,
+	0x41, 0x68, 0xdf, 0x99, 0xd8, 0x41, 0x96, 0x5d, 0xa0, 0xc1, 0xa4, 0xfa, 0xdf, 0x39, 0xa8, 0x34,
+	0x4c, 0xaf, 0xef, 0x5a, 0xbd, 0x30, 0x48, 0xc5, 0x78, 0x5c, 0x0a, 0x0f, 0x2d, 0x64, 0x64, 0xd9,
+	0x21, 0x47, 0x1c, 0x32, 0x88, 0x31, 0x0d, 0xb3, 0x2e, 0x1c, 0xa2, 0xf3, 0x1b, 0x99, 0x86, 0x1d,
+	0xa6, 0x5e, 0x6c, 0xcc, 0xef, 0xc2, 0xea, 0xb9, 0xe1, 0x5a, 0xcc, 0xf6, 0x8b, 0x0c, 0x1e, 0xcf,
+	0xf9, 0x5b, 0xb0, 0xe2, 0xf9, 0x83, 0xee, 0xc0, 0x3c, 0x67, 0xe9, 0x17, 0x47, 0x4b, 0x9e, 0x3f,
+	0x68, 0x98, 0xe7, 0x48, 0xe4, 0xbd, 0x35, 0xdf, 0xdb, 0xa6, 0xe7, 0xb1, 0xcc, 0x8b, 0xa3, 0xf1,
+	0x1c, 0xd7, 0xde, 0x4e, 0x5c, 0xdf, 0xf1, 0x2c, 0x8f, 0x65, 0x5a, 0x1c, 0x8d, 0xe7, 0x4c, 0x00,
+	0x34, 0xda, 0x32, 0x33, 0x0b, 0x36, 0xe6, 0x1f, 0xa4, 0xed, 0x05, 0xd8, 0x1b, 0x60, 0xa9, 0x59,
+	0xa4, 0xf2, 0xb4, 0xf5, 0x1c, 0x40, 0xf9, 0x2c, 0xd2, 0xf0, 0xce, 0x1a, 0xc3, 0xdd, 0x46, 0xdc,
+	0x19, 0xb5, 0xd3, 0x04, 0x2b, 0x7a, 0x39, 0xeb, 0x97, 0x5f, 0x4e, 0xe5, 0xea, 0x97, 0xf3, 0x97,
+	0x1c, 0x06, 0x2b, 0xd7, 0x35, 0x87, 0x86, 0xff, 0x41, 0xad, 0xdf, 0x01, 0xe8, 0x3b, 0xb1, 0xfe,
+	0x02, 0xe5, 0xa7, 0x20, 0x41, 0x7c, 0x0f, 0xf8, 0x44, 0x5f, 0x70, 0x1c, 0x4d, 0x83, 0x22, 0xf9,
+	0x0a, 0x97, 0xe5, 0x2b, 0x5e, 0x2d, 0xdf, 0xb3, 0xc8, 0x22, 0x7d, 0x26, 0x18, 0x8b, 0x57, 0x91,
+	0x89, 0xb1, 0x09, 0x6a, 0x7a, 0x62, 0x5b, 0x7e, 0xe8, 0x31, 0xd8, 0xb8, 0xfa, 0x12, 0xb6, 0x22,
+	0xaa, 0xc4, 0xdf, 0xee, 0x26, 0xe1, 0x25, 0xa5, 0x76, 0xff, 0x02, 0x1d, 0xe3, 0x6e, 0x12, 0xa4,
+	0x66, 0xd6, 0x7a, 0xd5, 0xff, 0x0f, 0x7c, 0x34, 0x6d, 0x3b, 0xef, 0x17, 0xe1, 0x96, 0xf9, 0xb2,
+	0xad, 0xfe, 0x1e, 0x6a, 0xd8, 0x3e, 0x37, 0x5d, 0x7f, 0x11, 0xda, 0x79, 0xc7, 0xf9, 0x03, 0xa8,
+	0xc4, 0x28, 0xec, 0x8a, 0x76, 0x93, 0x00, 0x35, 0xc3, 0xe0, 0x23, 0x1d, 0xe8, 0x3b, 0xd8, 0xac,
+	0x1b, 0xde, 0xd9, 0xd1, 0x30, 0x39, 0x20, 0xe6, 0x14, 0x86, 0x6f, 0x86, 0x9f, 0x7f, 0x6c, 0x9c,
+	0xca, 0x1f, 0xd0, 0x97, 0x96, 0xe3, 0x0f, 0x98, 0x1a, 0x14, 0xbd, 0xbe, 0x31, 0x34, 0x43, 0x77,
+	0x7a, 0x7b, 0x3f, 0x28, 0x0d, 0xec, 0x47, 0xa5, 0x81, 0xfd, 0x8e, 0x64, 0xfb, 0x4f, 0x6b, 0xc7,
+	0x88, 0x4d, 0x03, 0xd4, 0xea, 0x5f, 0x71, 0x40, 0x74, 0x6b, 0x64, 0x06, 0xc0, 0x0f, 0x6c, 0xba,
+	0x03, 0x2b, 0x63, 0xd3, 0xb5, 0x9c, 0x41, 0x90, 0xcb, 0xe4, 0x69, 0x34, 0xc5, 0x24, 0x76, 0x7c,
+	0x1e, 0x7e, 0xaa, 0xe7, 0xc6, 0xe7, 0x38, 0x3f, 0x39, 0x0f, 0x0d, 0x2c, 0x77, 0xc2, 0x54, 0x31,
+	0x1e, 0xf9, 0xcc, 0xbc, 0xca, 0x14, 0x87, 0x89, 0xa0, 0xa5, 0xc5, 0x05, 0xfd, 0x5b, 0x0e, 0x6e,
+	0x61, 0x98, 0x72, 0x26, 0xf6, 0x80, 0x39, 0x5b, 0x33, 0xf9, 0x92, 0xbd, 0x8d, 0x5f, 0x66, 0x96,
+	0xdd, 0xb7, 0xc6, 0x61, 0xb6, 0x59, 0xa6, 0x09, 0x20, 0x3e, 0x4d, 0x6e, 0xfe, 0x69, 0xf2, 0xd9,
+	0xd3, 0xdc, 0x86, 0xf2, 0x89, 0x8b, 0x7c, 0x31, 0x3f, 0x2e, 0xb0, 0xb5, 0x04, 0x90, 0x48, 0x5e,
+	0x5c, 0x5c, 0xf2, 0xbf, 0xe0, 0x60, 0x5b, 0x18, 0x39, 0xae, 0x6f, 0xfd, 0x2e, 0x88, 0x2b, 0xff,
+	0x07, 0x52, 0xc7, 0x72, 0x15, 0x16, 0x97, 0xeb, 0x15, 0xac, 0x37, 0xcc, 0xbe, 0x35, 0x9a, 0xc9,
+	0x32, 0x70, 0xbb, 0x8f, 0x35, 0xe0, 0x7f, 0xe2, 0x60, 0x33, 0x73, 0x54, 0xe7, 0x3d, 0x5a, 0x6b,
+	0x20, 0x25, 0xe3, 0x9d, 0xa7, 0xe1, 0x8c, 0x1d, 0xc6, 0xb8, 0x18, 0x99, 0x76, 0xf4, 0xca, 0xa2,
+	0x29, 0x7a, 0x74, 0x2b, 0xbc, 0xe1, 0xd0, 0xac, 0xe2, 0x79, 0x56, 0x69, 0x85, 0x59, 0xa5, 0x61,
+	0x88, 0x36, 0x86, 0x71, 0x6c, 0x29, 0xd3, 0x68, 0x1a, 0x1d, 0xa7, 0x74, 0xf9, 0x38, 0x2b, 0x57,
+	0x1f, 0xe7, 0x57, 0xb0, 0x81, 0xa6, 0x76, 0x6a, 0xba, 0xa9, 0x44, 0x2e, 0x2a, 0xc5, 0x70, 0x36,
+	0xff, 0x29, 0x94, 0x7b, 0x93, 0xc1, 0xa9, 0xe9, 0x77, 0x47, 0x51, 0x86, 0xbf, 0x1a, 0x00, 0x5a,
+	0x5e, 0xb5, 0x06, 0xd7, 0xeb, 0xce, 0xa8, 0x67, 0xd9, 0x86, 0xef, 0xb8, 0x56, 0xdf, 0xbb, 0xc4,
+	0x22, 0x8f, 0x2c, 0xd6, 0x81, 0x7b, 0x1b, 0x3e, 0x28, 0xee, 0x6d, 0xf5, 0x1c, 0x2a, 0x2d, 0x67,
+	0xd0, 0xce, 0x3c, 0xff, 0x9e, 0xe1, 0xc5, 0x2f, 0x11, 0xc7, 0xa8, 0x1e, 0x73, 0x3a, 0x76, 0xec,
+	0x44, 0x73, 0xf1, 0x1c, 0x15, 0x30, 0x72, 0x06, 0x93, 0xe1, 0xc4, 0x0b, 0x35, 0x17, 0x4d, 0xb3,
+	0xb2, 0x16, 0x66, 0x64, 0x7d, 0x0d, 0x5b, 0x2d, 0x67, 0x20, 0xa1, 0x7b, 0xf4, 0xcc, 0x4b, 0x75,
+	0xc2, 0x72, 0xf0, 0x01, 0x11, 0x73, 0xce, 0x7d, 0x80, 0x73, 0x7e, 0x86, 0xf3, 0x2b, 0x58, 0x8f,
+	0x55, 0xf8, 0x33, 0x19, 0xd9, 0x7b, 0xd8, 0x68, 0xbb, 0x68, 0xb6, 0xb1, 0x23, 0xbe, 0x0e, 0xc5,
+	0xb1, 0x6b, 0x8d, 0x02, 0x35, 0xad, 0xd2, 0x60, 0x82, 0x7a, 0x1a, 0xbb, 0x4e, 0xcf, 0xe8, 0x0d,
+	0x83, 0x57, 0xb4, 0x4a, 0xe3, 0x79, 0xb4, 0x71, 0xfe, 0xf2, 0xc6, 0x1f, 0xa8, 0x2e, 0xfe, 0x08,
+	0xa5, 0x23, 0x03, 0xbf, 0x78, 0xb2, 0x1b, 0x96, 0x53, 0x1b, 0x66, 0x2e, 0xa6, 0x92, 0x5c, 0x4c,
+	0xd5, 0x82, 0xf5, 0x80, 0x36, 0xcc, 0xc0, 0xbf, 0x84, 0x95, 0x93, 0x60, 0x1e, 0xe6, 0xe1, 0xec,
+	0x13, 0x27, 0x40, 0xa1, 0xd1, 0xd2, 0xc7, 0xe9, 0xe7, 0xef, 0x73, 0x18, 0x46, 0x86, 0x7d, 0xbc,
+	0x9c, 0x45, 0x6b, 0x89, 0xec, 0xae, 0x73, 0x99, 0x7a, 0x59, 0x3e, 0xaa, 0x97, 0xb1, 0x1c, 0xbe,
+	0x10, 0x15, 0xf4, 0x6a, 0x50, 0x1a, 0x99, 0xfe, 0x99, 0x33, 0x08, 0xf3, 0x85, 0x5d, 0xf6, 0xfd,
+	0x90, 0xdd, 0x6e, 0xbf, 0xc5, 0x30, 0x68, 0x88, 0x99, 0xfd, 0xe0, 0x29, 0xcd, 0x7c, 0xf0, 0xf0,
+	0x5f, 0xc1, 0xc6, 0xc8, 0x98, 0x76, 0x2d, 0xdf, 0x74, 0x8d, 0xe0, 0x73, 0x60, 0x85, 0x29, 0xaf,
+	0x32, 0x32, 0xa6, 0x52, 0x0c, 0xac, 0x3a, 0x50, 0x0a, 0xd8, 0x66, 0x2b, 0xac, 0x5b, 0x50, 0x69,
+	0x0a, 0x1d, 0x4d, 0xeb, 0xbe, 0xa4, 0xaa, 0x42, 0xd5, 0x06, 0xe1, 0x70, 0x5d, 0x93, 0x5a, 0x6d,
+	0x4d, 0x55, 0x48, 0x0e, 0xd3, 0xef, 0x43, 0x2a, 0x2a, 0x98, 0x65, 0x57, 0xa0, 0x7c, 0x28, 0x69,
+	0x62, 0x1d, 0x8b, 0xae, 0xa4, 0x10, 0x94, 0x9c, 0x7e, 0xd2, 0x55, 0x85, 0x14, 0x79, 0x1e, 0x36,
+	0x9a, 0xaa, 0xdc, 0x10, 0x95, 0x6e, 0xb4, 0x5e, 0xaa, 0xfe, 0x35, 0x07, 0x95, 0xe4, 0x60, 0x97,
+	0xeb, 0xd6, 0x18, 0xe7, 0xa2, 0x6f, 0xbe, 0xdc, 0xc9, 0x14, 0x75, 0x9c, 0x3a, 0x43, 0xf0, 0x10,
+	0x52, 0x10, 0x3c, 0xa7, 0x89, 0x57, 0xd5, 0x35, 0x3d, 0xdf, 0x1a, 0xa1, 0x6f, 0x0f, 0x94, 0x5a,
+	0x61, 0x50, 0x31, 0x04, 0x46, 0x77, 0x5e, 0xbc, 0x7c, 0xe7, 0xa5, 0xab, 0xef, 0xbc, 0x05, 0x9b,
+	0xda, 0xc5, 0xa8, 0xe7, 0x0c, 0xad, 0xfe, 0xa2, 0x57, 0x1e, 0x25, 0xe2, 0xd1, 0xf3, 0x28, 0xd3,
+	0x78, 0x5e, 0xfd, 0x53, 0x0e, 0x2a, 0x09, 0xbf, 0xf0, 0x89, 0x59, 0xf6, 0x89, 0x35, 0x8d, 0x2c,
+	0x9e, 0x4d, 0x10, 0x8a, 0x19, 0xeb, 0x34, 0x64, 0x10, 0x4c, 0xd0, 0xe3, 0x63, 0x9f, 0x62, 0x34,
+	0x0c, 0xdf, 0x57, 0x38, 0xfb, 0xa8, 0xd4, 0xf3, 0xc1, 0x9f, 0x03, 0x94, 0x63, 0x18, 0x7e, 0x28,
+	0x29, 0x6a, 0x57, 0xa4, 0x54, 0xa5, 0x41, 0x75, 0x3d, 0xac, 0x99, 0x12, 0x0e, 0xaf, 0x30, 0xa8,
+	0x40, 0x76, 0x0f, 0xdf, 0x74, 0x7f, 0x23, 0x52, 0x95, 0xe4, 0xd8, 0x15, 0xab, 0x5d, 0xac, 0x9d,
+	0xe6, 0xa3, 0xb1, 0x84, 0x57, 0x5f, 0x81, 0xb2, 0xa2, 0x76, 0xb1, 0x24, 0x2a, 0x6a, 0xa4, 0xc8,
+	0x13, 0x58, 0xd7, 0xde, 0x28, 0xba, 0xf0, 0x3a, 0xe4, 0x5c, 0xe2, 0x77, 0xe0, 0xba, 0xa2, 0x2a,
+	0x5d, 0x49, 0xd1, 0xc5, 0xa6, 0x48, 0xbb, 0xe2, 0xeb, 0xb6, 0xaa, 0xa0, 0x11, 0xad, 0xf0, 0x37,
+	0x81, 0x8f, 0x66, 0x5d, 0x5d, 0x55, 0xbb, 0xb2, 0x40, 0x9b, 0x58, 0x55, 0xbd, 0x01, 0x5b, 0x8a,
+	0xaa, 0x77, 0xa9, 0xd8, 0xa6, 0xa2, 0x26, 0x2a, 0xba, 0x70, 0x28, 0x8b, 0xa4, 0x8c, 0xe0, 0xa4,
+	0xac, 0x2b, 0x06, 0x05, 0x7f, 0x02, 0x28, 0x6c, 0x4b, 0x6d, 0x74, 0x64, 0x35, 0x16, 0x76, 0x8d,
+	0xdf, 0x84, 0x35, 0xe4, 0x10, 0xee, 0x49, 0xd6, 0xd1, 0xb4, 0x59, 0x4d, 0x54, 0x3a, 0x16, 0xbb,
+	0xac, 0x5e, 0x5a, 0xe1, 0xaf, 0x03, 0x41, 0xb9, 0xda, 0xaa, 0x26, 0x31, 0xb0, 0xac, 0x36, 0xc9,
+	0x06, 0x22, 0xaa, 0x1d, 0xbd, 0xab, 0x1e, 0x75, 0x1b, 0x2a, 0xd6, 0x5d, 0xc9, 0x26, 0x96, 0x93,
+	0x11, 0xf1, 0x48, 0x52, 0x24, 0x1d, 0x6b, 0xb2, 0x37, 0x81, 0x6f, 0x48, 0x2d, 0x51, 0xd1, 0x24,
+	0x55, 0xe9, 0xb6, 0x24, 0xad, 0x25, 0xe8, 0xf5, 0x17, 0x64, 0x0b, 0x19, 0xb6, 0x04, 0xf9, 0x48,
+	0xa5, 0x2d, 0xb1, 0xd1, 0x6d, 0x09, 0x3a, 0x95, 0x5e, 0x13, 0x3e, 0xa0, 0xd6, 0xbb, 0xda, 0xab,
+	0x8e, 0x40, 0x45, 0xb2, 0xcd, 0x6f, 0xc3, 0xa6, 0x26, 0x29, 0xcd, 0x8e, 0x2c, 0xd0, 0x08, 0xe9,
+	0x3a, 0x02, 0x51, 0xf2, 0x6e, 0x5b, 0x95, 0xdf, 0x28, 0x6a, 0x4b, 0x12, 0x64, 0x72, 0x03, 0xcf,
+	0x2b, 0x29, 0xc7, 0x82, 0x2c, 0x35, 0xba, 0xba, 0x2a, 0x8b, 0x94, 0x95, 0x8d, 0x6f, 0xe2, 0x79,
+	0x15, 0xb5, 0x5b, 0x57, 0x95, 0x63, 0x91, 0x36, 0x45, 0x84, 0xdd, 0x8a, 0xce, 0x12, 0x88, 0x18,
+	0x5c, 0x06, 0xd9, 0x41, 0x68, 0xc4, 0xe0, 0x55, 0x47, 0x50, 0x74, 0x49, 0x16, 0xc9, 0x27, 0xb8,
+	0x97, 0x2c, 0x2a, 0x4d, 0xfd, 0x45, 0x22, 0xfb, 0x2e, 0xa2, 0xe2, 0x0d, 0xb4, 0x04, 0xe5, 0x4d,
+	0xf7, 0xb0, 0x53, 0x7f, 0x29, 0xea, 0x1a, 0xf9, 0x14, 0x2f, 0x33, 0xd2, 0x78, 0x47, 0x91, 0x74,
+	0x72, 0x1b, 0xcf, 0x2e, 0x29, 0x75, 0xb5, 0xd5, 0x16, 0x74, 0xe9, 0x50, 0x16, 0x19, 0x58, 0x23,
+	0x9f, 0xe1, 0x25, 0x1f, 0x61, 0xa9, 0x9c, 0xf5, 0x60, 0xba, 0xb1, 0x7a, 0xc8, 0x1d, 0xdc, 0x2e,
+	0x12, 0xa2, 0x21, 0xd6, 0xa5, 0x96, 0x20, 0x93, 0xbb, 0xc8, 0x38, 0x02, 0x52, 0x2c, 0x54, 0xdf,
+	0x4b, 0xa3, 0xb5, 0x45, 0x2a, 0xa9, 0x0d, 0x8d, 0x7c, 0x8e, 0x97, 0x11, 0x01, 0xb5, 0xba, 0x20,
+	0x8b, 0xa4, 0x1a, 0x9e, 0x5e, 0x93, 0x9a, 0x4a, 0xb7, 0xfe, 0x42, 0x50, 0x9a, 0x22, 0xf9, 0x82,
+	0x09, 0x45, 0x69, 0x77, 0x46, 0x2b, 0x5f, 0xa6, 0x79, 0x46, 0x96, 0xf0, 0x15, 0x6a, 0x95, 0x59,
+	0x8f, 0xd2, 0x48, 0xd9, 0xdc, 0x7d, 0x94, 0x08, 0xaf, 0x29, 0xb2, 0x06, 0xf2, 0x8b, 0xe0, 0xe2,
+	0xba, 0x12, 0x32, 0xd4, 0x44, 0xb2, 0x87, 0x3b, 0x47, 0xdc, 0x0e, 0x3b, 0x8d, 0xa6, 0xa8, 0x93,
+	0x5f, 0xe2, 0x0e, 0xc1, 0xb8, 0x2b, 0xbe, 0xae, 0x8b, 0x62, 0x43, 0x6c, 0x90, 0x07, 0x58, 0xbd,
+	0x6e, 0xab, 0xb2, 0x48, 0x1e, 0xa6, 0xe5, 0x57, 0x29, 0x56, 0xf0, 0xbf, 0xe6, 0x3f, 0x81, 0x1b,
+	0x19, 0xab, 0x13, 0x68, 0xb3, 0xd3, 0xc2, 0xe7, 0xf0, 0x88, 0x5d, 0x62, 0x64, 0xa3, 0xa1, 0x88,
+	0x64, 0x1f, 0xb7, 0x88, 0x6f, 0xa6, 0x21, 0x35, 0x51, 0xdd, 0x8f, 0xf9, 0x4f, 0xe1, 0x56, 0x5d,
+	0x90, 0xeb, 0x1d, 0xb9, 0xa3, 0xcd, 0x1e, 0xfb, 0x09, 0x7f, 0x07, 0x76, 0xe3, 0xc5, 0xcb, 0x06,
+	0x74, 0x10, 0xdc, 0x61, 0xa8, 0x16, 0x3d, 0x7c, 0x47, 0x1a, 0xa9, 0xa5, 0xcd, 0x05, 0xd5, 0x45,
+	0x8f, 0x05, 0x99, 0x3c, 0x0d, 0xd5, 0x70, 0x48, 0x05, 0x34, 0x0a, 0xf2, 0x8c, 0xbf, 0x05, 0xdb,
+	0x29, 0x53, 0x3b, 0xea, 0x28, 0x81, 0x8f, 0xff, 0x26, 0xad, 0x9f, 0x96, 0xa8, 0xbf, 0x50, 0x1b,
+	0xe4, 0xdb, 0x34, 0x4b, 0xd6, 0xf8, 0xc0, 0x87, 0xfc, 0x1d, 0x0a, 0x80, 0xba, 0x6e, 0x48, 0x47,
+	0x47, 0x22, 0xc6, 0x93, 0x00, 0xfe, 0x3d, 0x1a, 0x91, 0xf8, 0x1a, 0xdf, 0x3c, 0x7b, 0x59, 0xc9,
+	0xed, 0xfc, 0x90, 0x7e, 0x0a, 0x6d, 0x2a, 0xd6, 0x25, 0x66, 0x5b, 0x3f, 0x66, 0x74, 0x13, 0x7a,
+	0xa0, 0x5f, 0xa5, 0xf7, 0x44, 0x27, 0xc6, 0x50, 0x9f, 0xa3, 0x74, 0x31, 0xaa, 0xa4, 0x8b, 0x2d,
+	0x8d, 0xfc, 0xbf, 0x07, 0xf7, 0x61, 0x35, 0x6a, 0x22, 0xa1, 0x1b, 0x63, 0x2d, 0x0e, 0x41, 0x17,
+	0x1b, 0x71, 0xb3, 0x51, 0xa5, 0x62, 0x83, 0x70, 0xb5, 0xff, 0x22, 0x50, 0xc0, 0xd6, 0x01, 0xbf,
+	0x0f, 0x25, 0x24, 0x18, 0x98, 0xfc, 0x56, 0xba, 0x9d, 0xc0, 0x82, 0xc4, 0xee, 0x6c, 0x87, 0xa1,
+	0x7a, 0x8d, 0x7f, 0x08, 0xf9, 0x96, 0x31, 0x5d, 0x02, 0xd9, 0xb2, 0x17, 0x44, 0x7e, 0x02, 0xab,
+	0xad, 0xc9, 0xd0, 0xb7, 0x30, 0x9e, 0x2c, 0xcc, 0xbe, 0xed, 0xbc, 0x5f, 0x9c, 0xbd, 0x36, 0xe9,
+	0xf9, 0x2e, 0xf6, 0x8a, 0x17, 0x66, 0xaf, 0x4d, 0x46, 0x0b, 0x22, 0xd7, 0x60, 0x35, 0x2a, 0x01,
+	0xf3, 0xac, 0x08, 0x34, 0x53, 0x10, 0x9e, 0x2f, 0x52, 0x49, 0x9b, 0x8c, 0x84, 0xe1, 0x90, 0xdf,
+	0x8e, 0x16, 0x53, 0x1d, 0xd8, 0x79, 0x14, 0x07, 0xb0, 0xd2, 0x76, 0x9d, 0xc1, 0xa4, 0xef, 0x2f,
+	0x4c, 0xb2, 0x0f, 0x85, 0x16, 0x56, 0xd6, 0x16, 0xc5, 0x7f, 0x82, 0x89, 0xd4, 0xc0, 0x5a, 0x82,
+	0xa2, 0x06, 0xab, 0xc7, 0x51, 0x85, 0x69, 0x89, 0x5d, 0xb4, 0xa0, 0x78, 0xb7, 0x28, 0x05, 0xda,
+	0x92, 0x33, 0x58, 0xf0, 0x36, 0x0e, 0xa0, 0x2c, 0xd9, 0xfe, 0x52, 0x86, 0x7d, 0x00, 0x65, 0x6a,
+	0x8e, 0x0c, 0xcb, 0x1e, 0x98, 0xee, 0xe2, 0x06, 0xd2, 0xec, 0x0f, 0x16, 0x47, 0x96, 0xfb, 0x8b,
+	0x5a, 0xd3, 0x23, 0x28, 0x68, 0xef, 0x5c, 0x9f, 0x67, 0xbd, 0xba, 0x6c, 0x07, 0x7d, 0x1e, 0xfa,
+	0xd7, 0x90, 0x17, 0x7a, 0xde, 0xa2, 0xd8, 0x8f, 0xa1, 0xa4, 0x98, 0xa7, 0x68, 0xa8, 0x8b, 0xb3,
+	0x17, 0xa7, 0xe3, 0x45, 0xb1, 0x1f, 0x42, 0x4e, 0xb6, 0x17, 0x45, 0xde, 0x87, 0xa2, 0xec, 0x9c,
+	0x1e, 0x3c, 0x59, 0x14, 0xff, 0x11, 0x14, 0x64, 0xe7, 0xb4, 0xb6, 0x04, 0xfb, 0xa3, 0xa1, 0xe3,
+	0xb8, 0x4b, 0xb0, 0xaf, 0x9b, 0xd6, 0x70, 0x09, 0xf6, 0x14, 0x2b, 0x4e, 0x4b, 0xe0, 0xeb, 0xee,
+	0xc4, 0xee, 0x2f, 0xa1, 0x78, 0xcd, 0xb2, 0x97, 0xc0, 0xae, 0x3b, 0xde, 0x12, 0xd8, 0xba, 0x61,
+	0x2f, 0xa1, 0x18, 0xc1, 0xb3, 0x96, 0x42, 0xef, 0x3b, 0xde, 0x32, 0xe8, 0xbe, 0xb1, 0x8c, 0xd1,
+	0x34, 0x8d, 0xd1, 0xc8, 0x58, 0x14, 0xff, 0x00, 0x56, 0x65, 0xe7, 0x74, 0x29, 0x92, 0xaf, 0xa1,
+	0x70, 0x68, 0xfa, 0xc6, 0x82, 0xcf, 0x15, 0x1f, 0x88, 0x7b, 0xb2, 0xc4, 0x69, 0x45, 0xf7, 0xa4,
+	0xbf, 0xf8, 0x73, 0x5d, 0x39, 0x34, 0x3d, 0xcf, 0x1c, 0xfe, 0x7a, 0x41, 0x69, 0x62, 0x82, 0x37,
+	0x0b, 0x12, 0x7c, 0x03, 0x2b, 0x61, 0xcf, 0x9e, 0x9f, 0xf3, 0x73, 0xc0, 0xee, 0xa5, 0xa6, 0x7e,
+	0xf5, 0xda, 0x1e, 0xf7, 0x84, 0xe3, 0x1f, 0x42, 0x91, 0xfd, 0x0b, 0xc0, 0x33, 0x84, 0xf4, 0x2f,
+	0x07, 0xbb, 0x1b, 0x29, 0x08, 0x23, 0xa8, 0xfd, 0x6b, 0x3e, 0xd8, 0x64, 0x68, 0x4e, 0xf9, 0x83,
+	0x20, 0xb0, 0x5e, 0x4f, 0xf5, 0x16, 0x13, 0xf9, 0xf8, 0x19, 0x68, 0x20, 0xe2, 0xb7, 0xa9, 0xe8,
+	0xbd, 0x24, 0x5d, 0x9c, 0x54, 0x2c, 0x43, 0xf7, 0x2c, 0x4e, 0x8b, 0x96, 0xa1, 0x3a, 0x08, 0x12,
+	0x92, 0x65, 0x48, 0xf6, 0x03, 0xd7, 0x3d, 0x9f, 0x64, 0x6e, 0xe0, 0x2c, 0xb6, 0xcf, 0xb0, 0xce,
+	0xb7, 0x30, 0x45, 0x0d, 0x0a, 0x75, 0xc7, 0xfe, 0xc3, 0xa5, 0xa4, 0xaa, 0x85, 0xf1, 0x67, 0x09,
+	0x9a, 0xda, 0x3f, 0xe7, 0xa1, 0x22, 0x5b, 0xb6, 0x69, 0xb8, 0xc2, 0xf0, 0xd4, 0xec, 0xb9, 0x06,
+	0xff, 0x08, 0xf2, 0x0d, 0x27, 0xcc, 0x54, 0x66, 0x7e, 0x5d, 0x98, 0x6f, 0xb7, 0xc5, 0xba, 0xeb,
+	0x78, 0xde, 0x07, 0x08, 0x52, 0xbf, 0x14, 0x04, 0xa9, 0x8d, 0xe2, 0xb8, 0xa3, 0x85, 0x37, 0x78,
+	0x04, 0x79, 0x61, 0x30, 0x88, 0x33, 0x8e, 0xf4, 0xbf, 0x11, 0xbb, 0x9b, 0xa9, 0x7f, 0x08, 0x92,
+	0xbc, 0x26, 0xb6, 0x9d, 0x45, 0x69, 0x9e, 0x42, 0x59, 0x77, 0x0d, 0xdb, 0x1b, 0x3b, 0x9e, 0xb9,
+	0x30, 0xd1, 0x37, 0xb0, 0xd6, 0x30, 0x7d, 0xd3, 0x1d, 0x59, 0xb6, 0x61, 0xfb, 0x1f, 0x26, 0xcb,
+	0x26, 0x83, 0x61, 0x4d, 0x76, 0xe1, 0x9d, 0xbe, 0x86, 0x22, 0xfb, 0x9b, 0x23, 0x78, 0xb2, 0xe9,
+	0x1f, 0x3b, 0xe6, 0xe8, 0xb7, 0xf6, 0x6f, 0x39, 0x80, 0xe4, 0x67, 0x03, 0xfe, 0x79, 0x2a, 0xc5,
+	0xfd, 0x0c, 0xb1, 0xaf, 0xfc, 0xfb, 0x61, 0xbe, 0x93, 0x61, 0xca, 0xbf, 0x95, 0x25, 0x4c, 0xa4,
+	0xdd, 0xce, 0x2e, 0x44, 0x64, 0x3f, 0xa6, 0x2e, 0x61, 0x59, 0xda, 0xe7, 0x00, 0x0d, 0xd3, 0xb5,
+	0xce, 0x0d, 0xdf, 0x3a, 0x37, 0x3f, 0x66, 0x67, 0x56, 0x9b, 0x76, 0x8d, 0xe1, 0xd2, 0xb4, 0x0f,
+	0x31, 0x31, 0x70, 0x7c, 0x2f, 0xd0, 0x73, 0xfa, 0x67, 0x8f, 0xdd, 0x8d, 0x14, 0x24, 0x50, 0xf3,
+	0x1f, 0x01, 0x24, 0xff, 0x1a, 0xa0, 0xc7, 0x8a, 0x1a, 0xec, 0xc1, 0xb5, 0xce, 0xfc, 0x34, 0xb1,
+	0xcb, 0x7c, 0x7a, 0xa6, 0x07, 0x8f, 0xfe, 0x98, 0xff, 0x1e, 0xca, 0x71, 0x8f, 0x78, 0x3e, 0x61,
+	0xf8, 0x6c, 0xd3, 0x7d, 0x64, 0xa4, 0xac, 0xfd, 0x43, 0x0e, 0x8a, 0x1d, 0xdb, 0xf2, 0xbd, 0xc8,
+	0x31, 0xdf, 0x48, 0xb7, 0x2b, 0x93, 0xb3, 0x6e, 0xa5, 0xc1, 0xf3, 0x1c, 0xf3, 0x92, 0x74, 0xf1,
+	0xbd, 0x2e, 0x43, 0x97, 0x38, 0xe6, 0x65, 0xa8, 0x6a, 0x81, 0x63, 0xbe, 0x99, 0x5e, 0x4b, 0xfa,
+	0x28, 0x57, 0xd1, 0xac, 0x84, 0x2d, 0xe1, 0x28, 0x2a, 0xa6, 0xfb, 0xc3, 0x73, 0x69, 0x6a, 0x7f,
+	0x96, 0x87, 0x95, 0x23, 0x7c, 0xc5, 0x7d, 0x13, 0x3d, 0xbb, 0xd2, 0x3e, 0x0e, 0xae, 0x62, 0xa6,
+	0x6f, 0x1b, 0x84, 0xd4, 0x74, 0x7b, 0x2d, 0x88, 0x04, 0x12, 0xa5, 0x8b, 0xe3, 0x3f, 0x86, 0x7c,
+	0xbb, 0xa5, 0x07, 0x2e, 0x7a, 0xb6, 0x47, 0x7b, 0xc5, 0x06, 0xb9, 0xa3, 0xe3, 0xe5, 0xf0, 0xdb,
+	0xcb, 0xe0, 0xd7, 0x83, 0x1f, 0xf5, 0xd2, 0x2d, 0x58, 0xfe, 0xd3, 0x28, 0x54, 0xcc, 0x69, 0xcc,
+	0xce, 0x65, 0xf2, 0x02, 0xae, 0xa7, 0x5b, 0x84, 0x5a, 0xff, 0xcc, 0x1c, 0x4c, 0x86, 0xe1, 0xeb,
+	0x9d, 0xd3, 0x27, 0xdd, 0xdd, 0xbe, 0xb4, 0xe0, 0xbc, 0xaf, 0x5e, 0x7b, 0xc2, 0xd5, 0xfe, 0x8e,
+	0x83, 0x4a, 0xa6, 0xc5, 0xc6, 0xff, 0x00, 0xe5, 0xa0, 0x85, 0x82, 0xde, 0x6c, 0x27, 0x94, 0xec,
+	0x52, 0x0b, 0x2e, 0x10, 0x2b, 0xdd, 0x96, 0xaa, 0x5e, 0xe3, 0xbf, 0x87, 0xd5, 0x43, 0x2b, 0xf4,
+	0x83, 0xcb, 0x51, 0x3e, 0x87, 0xf5, 0xb6, 0xe9, 0x8e, 0x26, 0x7e, 0x58, 0xe7, 0x5f, 0x8a, 0xba,
+	0xf6, 0x8f, 0x39, 0x58, 0x0f, 0xfe, 0xbb, 0xd2, 0xcf, 0x4c, 0xc7, 0xbd, 0xe0, 0x9f, 0xc2, 0x8a,
+	0xe4, 0xb5, 0x59, 0x43, 0x89, 0xcf, 0xe0, 0xa7, 0x42, 0x73, 0xb6, 0xff, 0x15, 0x44, 0xa5, 0xf0,
+	0xe0, 0xbf, 0x9b, 0x4f, 0x46, 0x92, 0xf6, 0x92, 0x97, 0xfa, 0x4c, 0x0c, 0xba, 0x8d, 0x61, 0x16,
+	0x99, 0xee, 0x3c, 0xce, 0x3d, 0xe9, 0x77, 0x00, 0x49, 0x9b, 0x30, 0x78, 0x9e, 0x97, 0xda, 0x86,
+	0x73, 0x09, 0xb1, 0x76, 0x32, 0x19, 0x9a, 0x6e, 0xfb, 0xcc, 0xba, 0x5a, 0xba, 0x19, 0x9a, 0xa7,
+	0x50, 0x56, 0xcc, 0xa9, 0x7f, 0xb5, 0x26, 0xe6, 0x69, 0xf3, 0x3f, 0x38, 0x58, 0x8d, 0x7a, 0x36,
+	0xfc, 0x37, 0x50, 0x0e, 0xfd, 0x7b, 0xe4, 0x30, 0x67, 0xfa, 0x54, 0xbb, 0x5b, 0x59, 0x60, 0xb0,
+	0xf1, 0x0f, 0x50, 0x69, 0x58, 0x27, 0x27, 0xa6, 0x6b, 0xda, 0xbe, 0xb5, 0x1c, 0xe9, 0x33, 0x58,
+	0x3d, 0xb2, 0xec, 0x01, 0x3a, 0xff, 0xe5, 0xa8, 0x5a, 0x96, 0x6d, 0x8d, 0xf0, 0xee, 0x16, 0xa6,
+	0xaa, 0xfd, 0x0d, 0x07, 0xab, 0x51, 0x8f, 0x06, 0xbb, 0x72, 0x47, 0x8e, 0x3b, 0x32, 0xc2, 0x6d,
+	0x67, 0x7a, 0x41, 0xbb, 0x5b, 0x59, 0x60, 0xbc, 0xad, 0x66, 0x8d, 0xc6, 0x43, 0xeb, 0xe4, 0x62,
+	0x09, 0xaa, 0xf9, 0xda, 0x59, 0x84, 0xb4, 0x57, 0x62, 0x7f, 0x23, 0x3c, 0xfd, 0x9f, 0x01, 0x00,
+	0x43, 0xb6, 0x2c, 0xef, 0xc6, 0x32, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MathClient is the client API for Math service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MathClient interface {
+	// Divide two integers, a/b
+	Divide(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Max two integers, returns the greater value of a and b
+	Max(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Min two integers, returns the lesser value of a and b
+	Min(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Multiply two integers, a*b
+	Multiply(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Pow two integers, a^b
+	Pow(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Subtract two integers, a-b
+	Subtract(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Sums two integers. a+b
+	Sum(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Evaluate an arithmetic expression, e.g. (3+4)*2^5/7
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// SumAll sums all of the values
+	SumAll(ctx context.Context, in *MathListRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Product multiplies all of the values
+	Product(ctx context.Context, in *MathListRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Mean returns the arithmetic mean of the values
+	Mean(ctx context.Context, in *MathListRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Median returns the median of the values
+	Median(ctx context.Context, in *MathListRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Variance returns the population variance of the values
+	Variance(ctx context.Context, in *MathListRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// StdDev returns the population standard deviation of the values
+	StdDev(ctx context.Context, in *MathListRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Mod returns a modulo b, with the sign selected by the request's division
+	Mod(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// IntDivide divides two integers, rounding the quotient as selected by the
+	// request's division
+	IntDivide(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Remainder returns the remainder of IntDivide, a - b*IntDivide(a, b)
+	Remainder(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Gcd returns the greatest common divisor of two integers
+	Gcd(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Lcm returns the least common multiple of two integers
+	Lcm(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Sqrt returns the square root of x
+	Sqrt(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Abs returns the absolute value of x
+	Abs(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Negate returns -x
+	Negate(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Exp returns e^x
+	Exp(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Ln returns the natural logarithm of x
+	Ln(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Log10 returns the decimal logarithm of x
+	Log10(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Log2 returns the binary logarithm of x
+	Log2(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Floor returns the greatest integer less than or equal to x
+	Floor(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Ceil returns the least integer greater than or equal to x
+	Ceil(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Round returns the nearest integer to x, rounding half away from zero
+	Round(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Trunc returns the integer part of x
+	Trunc(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Sin returns the sine of x radians
+	Sin(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Cos returns the cosine of x radians
+	Cos(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Tan returns the tangent of x radians
+	Tan(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Asin returns the arcsine of x in radians
+	Asin(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Acos returns the arccosine of x in radians
+	Acos(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Atan returns the arctangent of x in radians
+	Atan(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Gamma returns the gamma function of x, which is (x-1)! when x is a
+	// positive integer
+	Gamma(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// LogGamma returns the natural logarithm of the absolute value of the
+	// gamma function of x, which stays finite long after Gamma overflows
+	LogGamma(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Beta returns the beta function of a and b, Γ(a)Γ(b)/Γ(a+b)
+	Beta(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Erf returns the error function of x
+	Erf(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Erfc returns the complementary error function of x, 1-Erf(x), without
+	// losing precision for large x
+	Erfc(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// BesselJ returns the Bessel function of the first kind of order a at b,
+	// a must be an integer between -10000 and 10000
+	BesselJ(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// BesselY returns the Bessel function of the second kind of order a at b,
+	// a must be an integer between -10000 and 10000 and b positive
+	BesselY(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Compute performs a stream of operations. Replies are streamed back as the
+	// operations complete, which may be in a different order than the requests.
+	Compute(ctx context.Context, opts ...grpc.CallOption) (Math_ComputeClient, error)
+	// Batch performs several operations in a single call. An operation that
+	// fails only fails its own result, the call fails with TOO_MANY_ITEMS when
+	// it has more than 1000 operations.
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchReply, error)
+}
+
+type mathClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMathClient(cc *grpc.ClientConn) MathClient {
+	return &mathClient{cc}
+}
+
+func (c *mathClient) Divide(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Divide", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Max(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Max", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Min(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Min", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Multiply(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Multiply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Pow(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Pow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Subtract(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Subtract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Sum(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Sum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Evaluate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) SumAll(ctx context.Context, in *MathListRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/SumAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Product(ctx context.Context, in *MathListRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Product", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Mean(ctx context.Context, in *MathListRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Mean", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Median(ctx context.Context, in *MathListRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Median", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Variance(ctx context.Context, in *MathListRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Variance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) StdDev(ctx context.Context, in *MathListRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/StdDev", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Mod(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Mod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) IntDivide(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/IntDivide", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Remainder(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Remainder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Gcd(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Gcd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Lcm(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Lcm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Sqrt(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Sqrt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Abs(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Abs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Negate(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Negate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Exp(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Exp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Ln(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Ln", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Log10(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Log10", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Log2(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Log2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Floor(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Floor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Ceil(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Ceil", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Round(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Round", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Trunc(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Trunc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Sin(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Sin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Cos(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Cos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Tan(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Tan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Asin(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Asin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Acos(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Acos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Atan(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Atan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Gamma(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Gamma", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) LogGamma(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/LogGamma", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Beta(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Beta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Erf(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Erf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Erfc(ctx context.Context, in *UnaryOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Erfc", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) BesselJ(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/BesselJ", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) BesselY(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/BesselY", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mathClient) Compute(ctx context.Context, opts ...grpc.CallOption) (Math_ComputeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Math_serviceDesc.Streams[0], "/pb.Math/Compute", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mathComputeClient{stream}
+	return x, nil
+}
+
+type Math_ComputeClient interface {
+	Send(*ComputeRequest) error
+	Recv() (*ComputeReply, error)
+	grpc.ClientStream
+}
+
+type mathComputeClient struct {
+	grpc.ClientStream
+}
+
+func (x *mathComputeClient) Send(m *ComputeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *mathComputeClient) Recv() (*ComputeReply, error) {
+	m := new(ComputeReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mathClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchReply, error) {
+	out := new(BatchReply)
+	err := c.cc.Invoke(ctx, "/pb.Math/Batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MathServer is the server API for Math service.
+type MathServer interface {
+	// Divide two integers, a/b
+	Divide(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Max two integers, returns the greater value of a and b
+	Max(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Min two integers, returns the lesser value of a and b
+	Min(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Multiply two integers, a*b
+	Multiply(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Pow two integers, a^b
+	Pow(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Subtract two integers, a-b
+	Subtract(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Sums two integers. a+b
+	Sum(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Evaluate an arithmetic expression, e.g. (3+4)*2^5/7
+	Evaluate(context.Context, *EvaluateRequest) (*MathOpReply, error)
+	// SumAll sums all of the values
+	SumAll(context.Context, *MathListRequest) (*MathOpReply, error)
+	// Product multiplies all of the values
+	Product(context.Context, *MathListRequest) (*MathOpReply, error)
+	// Mean returns the arithmetic mean of the values
+	Mean(context.Context, *MathListRequest) (*MathOpReply, error)
+	// Median returns the median of the values
+	Median(context.Context, *MathListRequest) (*MathOpReply, error)
+	// Variance returns the population variance of the values
+	Variance(context.Context, *MathListRequest) (*MathOpReply, error)
+	// StdDev returns the population standard deviation of the values
+	StdDev(context.Context, *MathListRequest) (*MathOpReply, error)
+	// Mod returns a modulo b, with the sign selected by the request's division
+	Mod(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// IntDivide divides two integers, rounding the quotient as selected by the
+	// request's division
+	IntDivide(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Remainder returns the remainder of IntDivide, a - b*IntDivide(a, b)
+	Remainder(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Gcd returns the greatest common divisor of two integers
+	Gcd(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Lcm returns the least common multiple of two integers
+	Lcm(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Sqrt returns the square root of x
+	Sqrt(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Abs returns the absolute value of x
+	Abs(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Negate returns -x
+	Negate(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Exp returns e^x
+	Exp(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Ln returns the natural logarithm of x
+	Ln(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Log10 returns the decimal logarithm of x
+	Log10(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Log2 returns the binary logarithm of x
+	Log2(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Floor returns the greatest integer less than or equal to x
+	Floor(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Ceil returns the least integer greater than or equal to x
+	Ceil(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Round returns the nearest integer to x, rounding half away from zero
+	Round(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Trunc returns the integer part of x
+	Trunc(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Sin returns the sine of x radians
+	Sin(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Cos returns the cosine of x radians
+	Cos(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Tan returns the tangent of x radians
+	Tan(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Asin returns the arcsine of x in radians
+	Asin(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Acos returns the arccosine of x in radians
+	Acos(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Atan returns the arctangent of x in radians
+	Atan(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Gamma returns the gamma function of x, which is (x-1)! when x is a
+	// positive integer
+	Gamma(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// LogGamma returns the natural logarithm of the absolute value of the
+	// gamma function of x, which stays finite long after Gamma overflows
+	LogGamma(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Beta returns the beta function of a and b, Γ(a)Γ(b)/Γ(a+b)
+	Beta(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Erf returns the error function of x
+	Erf(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// Erfc returns the complementary error function of x, 1-Erf(x), without
+	// losing precision for large x
+	Erfc(context.Context, *UnaryOpRequest) (*MathOpReply, error)
+	// BesselJ returns the Bessel function of the first kind of order a at b,
+	// a must be an integer between -10000 and 10000
+	BesselJ(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// BesselY returns the Bessel function of the second kind of order a at b,
+	// a must be an integer between -10000 and 10000 and b positive
+	BesselY(context.Context, *MathOpRequest) (*MathOpReply, error)
+	// Compute performs a stream of operations. Replies are streamed back as the
+	// operations complete, which may be in a different order than the requests.
+	Compute(Math_ComputeServer) error
+	// Batch performs several operations in a single call. An operation that
+	// fails only fails its own result, the call fails with TOO_MANY_ITEMS when
+	// it has more than 1000 operations.
+	Batch(context.Context, *BatchRequest) (*BatchReply, error)
+}
+
+// UnimplementedMathServer can be embedded to have forward compatible implementations.
+type UnimplementedMathServer struct {
+}
+
+func (*UnimplementedMathServer) Divide(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Divide not implemented")
+}
+func (*UnimplementedMathServer) Max(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Max not implemented")
+}
+func (*UnimplementedMathServer) Min(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Min not implemented")
+}
+func (*UnimplementedMathServer) Multiply(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Multiply not implemented")
+}
+func (*UnimplementedMathServer) Pow(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pow not implemented")
+}
+func (*UnimplementedMathServer) Subtract(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subtract not implemented")
+}
+func (*UnimplementedMathServer) Sum(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sum not implemented")
+}
+func (*UnimplementedMathServer) Evaluate(ctx context.Context, req *EvaluateRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (*UnimplementedMathServer) SumAll(ctx context.Context, req *MathListRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SumAll not implemented")
+}
+func (*UnimplementedMathServer) Product(ctx context.Context, req *MathListRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Product not implemented")
+}
+func (*UnimplementedMathServer) Mean(ctx context.Context, req *MathListRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mean not implemented")
+}
+func (*UnimplementedMathServer) Median(ctx context.Context, req *MathListRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Median not implemented")
+}
+func (*UnimplementedMathServer) Variance(ctx context.Context, req *MathListRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Variance not implemented")
+}
+func (*UnimplementedMathServer) StdDev(ctx context.Context, req *MathListRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StdDev not implemented")
+}
+func (*UnimplementedMathServer) Mod(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mod not implemented")
+}
+func (*UnimplementedMathServer) IntDivide(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntDivide not implemented")
+}
+func (*UnimplementedMathServer) Remainder(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remainder not implemented")
+}
+func (*UnimplementedMathServer) Gcd(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gcd not implemented")
+}
+func (*UnimplementedMathServer) Lcm(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lcm not implemented")
+}
+func (*UnimplementedMathServer) Sqrt(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sqrt not implemented")
+}
+func (*UnimplementedMathServer) Abs(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Abs not implemented")
+}
+func (*UnimplementedMathServer) Negate(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Negate not implemented")
+}
+func (*UnimplementedMathServer) Exp(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exp not implemented")
+}
+func (*UnimplementedMathServer) Ln(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ln not implemented")
+}
+func (*UnimplementedMathServer) Log10(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Log10 not implemented")
+}
+func (*UnimplementedMathServer) Log2(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Log2 not implemented")
+}
+func (*UnimplementedMathServer) Floor(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Floor not implemented")
+}
+func (*UnimplementedMathServer) Ceil(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ceil not implemented")
+}
+func (*UnimplementedMathServer) Round(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Round not implemented")
+}
+func (*UnimplementedMathServer) Trunc(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trunc not implemented")
+}
+func (*UnimplementedMathServer) Sin(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sin not implemented")
+}
+func (*UnimplementedMathServer) Cos(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cos not implemented")
+}
+func (*UnimplementedMathServer) Tan(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tan not implemented")
+}
+func (*UnimplementedMathServer) Asin(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Asin not implemented")
+}
+func (*UnimplementedMathServer) Acos(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Acos not implemented")
+}
+func (*UnimplementedMathServer) Atan(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Atan not implemented")
+}
+func (*UnimplementedMathServer) Gamma(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gamma not implemented")
+}
+func (*UnimplementedMathServer) LogGamma(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogGamma not implemented")
+}
+func (*UnimplementedMathServer) Beta(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Beta not implemented")
+}
+func (*UnimplementedMathServer) Erf(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Erf not implemented")
+}
+func (*UnimplementedMathServer) Erfc(ctx context.Context, req *UnaryOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Erfc not implemented")
+}
+func (*UnimplementedMathServer) BesselJ(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BesselJ not implemented")
+}
+func (*UnimplementedMathServer) BesselY(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BesselY not implemented")
+}
+func (*UnimplementedMathServer) Compute(srv Math_ComputeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Compute not implemented")
+}
+func (*UnimplementedMathServer) Batch(ctx context.Context, req *BatchRequest) (*BatchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+
+func RegisterMathServer(s *grpc.Server, srv MathServer) {
+	s.RegisterService(&_Math_serviceDesc, srv)
+}
+
+func _Math_Divide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Divide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Divide",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Divide(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Max_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Max(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Max",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Max(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Min_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Min(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Min",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Min(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Multiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Multiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Multiply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Multiply(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Pow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Pow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Pow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Pow(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Subtract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Subtract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Subtract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Subtract(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Sum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Sum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Sum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Sum(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Evaluate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Evaluate(ctx, req.(*EvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_SumAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).SumAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/SumAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).SumAll(ctx, req.(*MathListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Product_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Product(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Product",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Product(ctx, req.(*MathListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Mean_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Mean(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Mean",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Mean(ctx, req.(*MathListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Median_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Median(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Median",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Median(ctx, req.(*MathListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Variance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Variance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Variance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Variance(ctx, req.(*MathListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_StdDev_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).StdDev(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/StdDev",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).StdDev(ctx, req.(*MathListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Mod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Mod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Mod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Mod(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_IntDivide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).IntDivide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/IntDivide",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).IntDivide(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Remainder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Remainder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Remainder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Remainder(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Gcd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Gcd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Gcd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Gcd(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Lcm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Lcm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Lcm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Lcm(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Sqrt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Sqrt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Sqrt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Sqrt(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Abs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Abs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Abs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Abs(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Negate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Negate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Negate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Negate(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Exp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Exp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Exp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Exp(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Ln_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Ln(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Ln",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Ln(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Log10_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Log10(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Log10",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Log10(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Log2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Log2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Log2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Log2(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Floor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Floor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Floor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Floor(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Ceil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Ceil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Ceil",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Ceil(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Round_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Round(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Round",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Round(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Trunc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Trunc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Trunc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Trunc(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Sin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Sin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Sin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Sin(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Cos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Cos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Cos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Cos(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Tan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Tan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Tan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Tan(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Asin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Asin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Asin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Asin(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Acos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Acos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Acos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Acos(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Atan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Atan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Atan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Atan(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Gamma_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Gamma(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Gamma",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Gamma(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_LogGamma_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).LogGamma(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/LogGamma",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).LogGamma(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Beta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Beta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Beta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Beta(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Erf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Erf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Erf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Erf(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Erfc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnaryOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Erfc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Erfc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Erfc(ctx, req.(*UnaryOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_BesselJ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).BesselJ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/BesselJ",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).BesselJ(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_BesselY_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MathOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).BesselY(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/BesselY",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).BesselY(ctx, req.(*MathOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Math_Compute_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MathServer).Compute(&mathComputeServer{stream})
+}
+
+type Math_ComputeServer interface {
+	Send(*ComputeReply) error
+	Recv() (*ComputeRequest, error)
+	grpc.ServerStream
+}
+
+type mathComputeServer struct {
+	grpc.ServerStream
+}
+
+func (x *mathComputeServer) Send(m *ComputeReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *mathComputeServer) Recv() (*ComputeRequest, error) {
+	m := new(ComputeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Math_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MathServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Math/Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MathServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Math_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Math",
+	HandlerType: (*MathServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Divide",
+			Handler:    _Math_Divide_Handler,
+		},
+		{
+			MethodName: "Max",
+			Handler:    _Math_Max_Handler,
+		},
+		{
+			MethodName: "Min",
+			Handler:    _Math_Min_Handler,
+		},
+		{
+			MethodName: "Multiply",
+			Handler:    _Math_Multiply_Handler,
+		},
+		{
+			MethodName: "Pow",
+			Handler:    _Math_Pow_Handler,
+		},
+		{
+			MethodName: "Subtract",
+			Handler:    _Math_Subtract_Handler,
+		},
+		{
+			MethodName: "Sum",
+			Handler:    _Math_Sum_Handler,
+		},
+		{
+			MethodName: "Evaluate",
+			Handler:    _Math_Evaluate_Handler,
+		},
+		{
+			MethodName: "SumAll",
+			Handler:    _Math_SumAll_Handler,
+		},
+		{
+			MethodName: "Product",
+			Handler:    _Math_Product_Handler,
+		},
+		{
+			MethodName: "Mean",
+			Handler:    _Math_Mean_Handler,
+		},
+		{
+			MethodName: "Median",
+			Handler:    _Math_Median_Handler,
+		},
+		{
+			MethodName: "Variance",
+			Handler:    _Math_Variance_Handler,
+		},
+		{
+			MethodName: "StdDev",
+			Handler:    _Math_StdDev_Handler,
+		},
+		{
+			MethodName: "Mod",
+			Handler:    _Math_Mod_Handler,
+		},
+		{
+			MethodName: "IntDivide",
+			Handler:    _Math_IntDivide_Handler,
+		},
+		{
+			MethodName: "Remainder",
+			Handler:    _Math_Remainder_Handler,
+		},
+		{
+			MethodName: "Gcd",
+			Handler:    _Math_Gcd_Handler,
+		},
+		{
+			MethodName: "Lcm",
+			Handler:    _Math_Lcm_Handler,
+		},
+		{
+			MethodName: "Sqrt",
+			Handler:    _Math_Sqrt_Handler,
+		},
+		{
+			MethodName: "Abs",
+			Handler:    _Math_Abs_Handler,
+		},
+		{
+			MethodName: "Negate",
+			Handler:    _Math_Negate_Handler,
+		},
+		{
+			MethodName: "Exp",
+			Handler:    _Math_Exp_Handler,
+		},
+		{
+			MethodName: "Ln",
+			Handler:    _Math_Ln_Handler,
+		},
+		{
+			MethodName: "Log10",
+			Handler:    _Math_Log10_Handler,
+		},
+		{
+			MethodName: "Log2",
+			Handler:    _Math_Log2_Handler,
+		},
+		{
+			MethodName: "Floor",
+			Handler:    _Math_Floor_Handler,
+		},
+		{
+			MethodName: "Ceil",
+			Handler:    _Math_Ceil_Handler,
+		},
+		{
+			MethodName: "Round",
+			Handler:    _Math_Round_Handler,
+		},
+		{
+			MethodName: "Trunc",
+			Handler:    _Math_Trunc_Handler,
+		},
+		{
+			MethodName: "Sin",
+			Handler:    _Math_Sin_Handler,
+		},
+		{
+			MethodName: "Cos",
+			Handler:    _Math_Cos_Handler,
+		},
+		{
+			MethodName: "Tan",
+			Handler:    _Math_Tan_Handler,
+		},
+		{
+			MethodName: "Asin",
+			Handler:    _Math_Asin_Handler,
+		},
+		{
+			MethodName: "Acos",
+			Handler:    _Math_Acos_Handler,
+		},
+		{
+			MethodName: "Atan",
+			Handler:    _Math_Atan_Handler,
+		},
+		{
+			MethodName: "Gamma",
+			Handler:    _Math_Gamma_Handler,
+		},
+		{
+			MethodName: "LogGamma",
+			Handler:    _Math_LogGamma_Handler,
+		},
+		{
+			MethodName: "Beta",
+			Handler:    _Math_Beta_Handler,
+		},
+		{
+			MethodName: "Erf",
+			Handler:    _Math_Erf_Handler,
+		},
+		{
+			MethodName: "Erfc",
+			Handler:    _Math_Erfc_Handler,
+		},
+		{
+			MethodName: "BesselJ",
+			Handler:    _Math_BesselJ_Handler,
+		},
+		{
+			MethodName: "BesselY",
+			Handler:    _Math_BesselY_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _Math_Batch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Compute",
+			Handler:       _Math_Compute_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "mathsvc.proto",
+}
+
+// ComplexClient is the client API for Complex service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ComplexClient interface {
+	// Sum returns a+b
+	Sum(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error)
+	// Subtract returns a-b
+	Subtract(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error)
+	// Multiply returns a*b
+	Multiply(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error)
+	// Divide returns a/b
+	Divide(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error)
+	// Pow returns a^b
+	Pow(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error)
+	// Abs returns the absolute value, or modulus, of a
+	Abs(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Phase returns the phase, or argument, of a in the range [-Pi, Pi]
+	Phase(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Conj returns the complex conjugate of a
+	Conj(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error)
+	// Sqrt returns the square root of a, with a non-negative real part
+	Sqrt(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error)
+}
+
+type complexClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewComplexClient(cc *grpc.ClientConn) ComplexClient {
+	return &complexClient{cc}
+}
+
+func (c *complexClient) Sum(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error) {
+	out := new(ComplexOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Complex/Sum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complexClient) Subtract(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error) {
+	out := new(ComplexOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Complex/Subtract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complexClient) Multiply(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error) {
+	out := new(ComplexOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Complex/Multiply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complexClient) Divide(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error) {
+	out := new(ComplexOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Complex/Divide", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complexClient) Pow(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error) {
+	out := new(ComplexOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Complex/Pow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complexClient) Abs(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Complex/Abs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complexClient) Phase(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Complex/Phase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complexClient) Conj(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error) {
+	out := new(ComplexOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Complex/Conj", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *complexClient) Sqrt(ctx context.Context, in *ComplexOpRequest, opts ...grpc.CallOption) (*ComplexOpReply, error) {
+	out := new(ComplexOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Complex/Sqrt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ComplexServer is the server API for Complex service.
+type ComplexServer interface {
+	// Sum returns a+b
+	Sum(context.Context, *ComplexOpRequest) (*ComplexOpReply, error)
+	// Subtract returns a-b
+	Subtract(context.Context, *ComplexOpRequest) (*ComplexOpReply, error)
+	// Multiply returns a*b
+	Multiply(context.Context, *ComplexOpRequest) (*ComplexOpReply, error)
+	// Divide returns a/b
+	Divide(context.Context, *ComplexOpRequest) (*ComplexOpReply, error)
+	// Pow returns a^b
+	Pow(context.Context, *ComplexOpRequest) (*ComplexOpReply, error)
+	// Abs returns the absolute value, or modulus, of a
+	Abs(context.Context, *ComplexOpRequest) (*MathOpReply, error)
+	// Phase returns the phase, or argument, of a in the range [-Pi, Pi]
+	Phase(context.Context, *ComplexOpRequest) (*MathOpReply, error)
+	// Conj returns the complex conjugate of a
+	Conj(context.Context, *ComplexOpRequest) (*ComplexOpReply, error)
+	// Sqrt returns the square root of a, with a non-negative real part
+	Sqrt(context.Context, *ComplexOpRequest) (*ComplexOpReply, error)
+}
+
+// UnimplementedComplexServer can be embedded to have forward compatible implementations.
+type UnimplementedComplexServer struct {
+}
+
+func (*UnimplementedComplexServer) Sum(ctx context.Context, req *ComplexOpRequest) (*ComplexOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sum not implemented")
+}
+func (*UnimplementedComplexServer) Subtract(ctx context.Context, req *ComplexOpRequest) (*ComplexOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subtract not implemented")
+}
+func (*UnimplementedComplexServer) Multiply(ctx context.Context, req *ComplexOpRequest) (*ComplexOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Multiply not implemented")
+}
+func (*UnimplementedComplexServer) Divide(ctx context.Context, req *ComplexOpRequest) (*ComplexOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Divide not implemented")
+}
+func (*UnimplementedComplexServer) Pow(ctx context.Context, req *ComplexOpRequest) (*ComplexOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pow not implemented")
+}
+func (*UnimplementedComplexServer) Abs(ctx context.Context, req *ComplexOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Abs not implemented")
+}
+func (*UnimplementedComplexServer) Phase(ctx context.Context, req *ComplexOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Phase not implemented")
+}
+func (*UnimplementedComplexServer) Conj(ctx context.Context, req *ComplexOpRequest) (*ComplexOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Conj not implemented")
+}
+func (*UnimplementedComplexServer) Sqrt(ctx context.Context, req *ComplexOpRequest) (*ComplexOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sqrt not implemented")
+}
+
+func RegisterComplexServer(s *grpc.Server, srv ComplexServer) {
+	s.RegisterService(&_Complex_serviceDesc, srv)
+}
+
+func _Complex_Sum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplexServer).Sum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Complex/Sum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplexServer).Sum(ctx, req.(*ComplexOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Complex_Subtract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplexServer).Subtract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Complex/Subtract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplexServer).Subtract(ctx, req.(*ComplexOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Complex_Multiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplexServer).Multiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Complex/Multiply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplexServer).Multiply(ctx, req.(*ComplexOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Complex_Divide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplexServer).Divide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Complex/Divide",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplexServer).Divide(ctx, req.(*ComplexOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Complex_Pow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplexServer).Pow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Complex/Pow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplexServer).Pow(ctx, req.(*ComplexOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Complex_Abs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplexServer).Abs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Complex/Abs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplexServer).Abs(ctx, req.(*ComplexOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Complex_Phase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplexServer).Phase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Complex/Phase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplexServer).Phase(ctx, req.(*ComplexOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Complex_Conj_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplexServer).Conj(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Complex/Conj",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplexServer).Conj(ctx, req.(*ComplexOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Complex_Sqrt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComplexServer).Sqrt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Complex/Sqrt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComplexServer).Sqrt(ctx, req.(*ComplexOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Complex_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Complex",
+	HandlerType: (*ComplexServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Sum",
+			Handler:    _Complex_Sum_Handler,
+		},
+		{
+			MethodName: "Subtract",
+			Handler:    _Complex_Subtract_Handler,
+		},
+		{
+			MethodName: "Multiply",
+			Handler:    _Complex_Multiply_Handler,
+		},
+		{
+			MethodName: "Divide",
+			Handler:    _Complex_Divide_Handler,
+		},
+		{
+			MethodName: "Pow",
+			Handler:    _Complex_Pow_Handler,
+		},
+		{
+			MethodName: "Abs",
+			Handler:    _Complex_Abs_Handler,
+		},
+		{
+			MethodName: "Phase",
+			Handler:    _Complex_Phase_Handler,
+		},
+		{
+			MethodName: "Conj",
+			Handler:    _Complex_Conj_Handler,
+		},
+		{
+			MethodName: "Sqrt",
+			Handler:    _Complex_Sqrt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mathsvc.proto",
+}
+
+// LinearAlgebraClient is the client API for LinearAlgebra service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LinearAlgebraClient interface {
+	// Dot returns the dot product of the vectors a and b
+	Dot(ctx context.Context, in *VectorOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Cross returns the cross product of the 3-dimensional vectors a and b
+	Cross(ctx context.Context, in *VectorOpRequest, opts ...grpc.CallOption) (*VectorReply, error)
+	// Norm returns the Euclidean norm of the vector a
+	Norm(ctx context.Context, in *VectorOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Add returns the sum of the matrices a and b
+	Add(ctx context.Context, in *MatrixOpRequest, opts ...grpc.CallOption) (*MatrixReply, error)
+	// Multiply returns the matrix product ab
+	Multiply(ctx context.Context, in *MatrixOpRequest, opts ...grpc.CallOption) (*MatrixReply, error)
+	// Transpose returns the transpose of the matrix a
+	Transpose(ctx context.Context, in *MatrixOpRequest, opts ...grpc.CallOption) (*MatrixReply, error)
+	// Determinant returns the determinant of the square matrix a
+	Determinant(ctx context.Context, in *MatrixOpRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Inverse returns the inverse of the square matrix a
+	Inverse(ctx context.Context, in *MatrixOpRequest, opts ...grpc.CallOption) (*MatrixReply, error)
+	// Solve returns the vector x solving ax=b for the square matrix a
+	Solve(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*VectorReply, error)
+}
+
+type linearAlgebraClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLinearAlgebraClient(cc *grpc.ClientConn) LinearAlgebraClient {
+	return &linearAlgebraClient{cc}
+}
+
+func (c *linearAlgebraClient) Dot(ctx context.Context, in *VectorOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.LinearAlgebra/Dot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Cross(ctx context.Context, in *VectorOpRequest, opts ...grpc.CallOption) (*VectorReply, error) {
+	out := new(VectorReply)
+	err := c.cc.Invoke(ctx, "/pb.LinearAlgebra/Cross", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Norm(ctx context.Context, in *VectorOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.LinearAlgebra/Norm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Add(ctx context.Context, in *MatrixOpRequest, opts ...grpc.CallOption) (*MatrixReply, error) {
+	out := new(MatrixReply)
+	err := c.cc.Invoke(ctx, "/pb.LinearAlgebra/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Multiply(ctx context.Context, in *MatrixOpRequest, opts ...grpc.CallOption) (*MatrixReply, error) {
+	out := new(MatrixReply)
+	err := c.cc.Invoke(ctx, "/pb.LinearAlgebra/Multiply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Transpose(ctx context.Context, in *MatrixOpRequest, opts ...grpc.CallOption) (*MatrixReply, error) {
+	out := new(MatrixReply)
+	err := c.cc.Invoke(ctx, "/pb.LinearAlgebra/Transpose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Determinant(ctx context.Context, in *MatrixOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.LinearAlgebra/Determinant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Inverse(ctx context.Context, in *MatrixOpRequest, opts ...grpc.CallOption) (*MatrixReply, error) {
+	out := new(MatrixReply)
+	err := c.cc.Invoke(ctx, "/pb.LinearAlgebra/Inverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Solve(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*VectorReply, error) {
+	out := new(VectorReply)
+	err := c.cc.Invoke(ctx, "/pb.LinearAlgebra/Solve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LinearAlgebraServer is the server API for LinearAlgebra service.
+type LinearAlgebraServer interface {
+	// Dot returns the dot product of the vectors a and b
+	Dot(context.Context, *VectorOpRequest) (*MathOpReply, error)
+	// Cross returns the cross product of the 3-dimensional vectors a and b
+	Cross(context.Context, *VectorOpRequest) (*VectorReply, error)
+	// Norm returns the Euclidean norm of the vector a
+	Norm(context.Context, *VectorOpRequest) (*MathOpReply, error)
+	// Add returns the sum of the matrices a and b
+	Add(context.Context, *MatrixOpRequest) (*MatrixReply, error)
+	// Multiply returns the matrix product ab
+	Multiply(context.Context, *MatrixOpRequest) (*MatrixReply, error)
+	// Transpose returns the transpose of the matrix a
+	Transpose(context.Context, *MatrixOpRequest) (*MatrixReply, error)
+	// Determinant returns the determinant of the square matrix a
+	Determinant(context.Context, *MatrixOpRequest) (*MathOpReply, error)
+	// Inverse returns the inverse of the square matrix a
+	Inverse(context.Context, *MatrixOpRequest) (*MatrixReply, error)
+	// Solve returns the vector x solving ax=b for the square matrix a
+	Solve(context.Context, *SolveRequest) (*VectorReply, error)
+}
+
+// UnimplementedLinearAlgebraServer can be embedded to have forward compatible implementations.
+type UnimplementedLinearAlgebraServer struct {
+}
+
+func (*UnimplementedLinearAlgebraServer) Dot(ctx context.Context, req *VectorOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dot not implemented")
+}
+func (*UnimplementedLinearAlgebraServer) Cross(ctx context.Context, req *VectorOpRequest) (*VectorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cross not implemented")
+}
+func (*UnimplementedLinearAlgebraServer) Norm(ctx context.Context, req *VectorOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Norm not implemented")
+}
+func (*UnimplementedLinearAlgebraServer) Add(ctx context.Context, req *MatrixOpRequest) (*MatrixReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (*UnimplementedLinearAlgebraServer) Multiply(ctx context.Context, req *MatrixOpRequest) (*MatrixReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Multiply not implemented")
+}
+func (*UnimplementedLinearAlgebraServer) Transpose(ctx context.Context, req *MatrixOpRequest) (*MatrixReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transpose not implemented")
+}
+func (*UnimplementedLinearAlgebraServer) Determinant(ctx context.Context, req *MatrixOpRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Determinant not implemented")
+}
+func (*UnimplementedLinearAlgebraServer) Inverse(ctx context.Context, req *MatrixOpRequest) (*MatrixReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inverse not implemented")
+}
+func (*UnimplementedLinearAlgebraServer) Solve(ctx context.Context, req *SolveRequest) (*VectorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Solve not implemented")
+}
+
+func RegisterLinearAlgebraServer(s *grpc.Server, srv LinearAlgebraServer) {
+	s.RegisterService(&_LinearAlgebra_serviceDesc, srv)
+}
+
+func _LinearAlgebra_Dot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Dot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinearAlgebra/Dot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Dot(ctx, req.(*VectorOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Cross_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Cross(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinearAlgebra/Cross",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Cross(ctx, req.(*VectorOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Norm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Norm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinearAlgebra/Norm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Norm(ctx, req.(*VectorOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinearAlgebra/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Add(ctx, req.(*MatrixOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Multiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Multiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinearAlgebra/Multiply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Multiply(ctx, req.(*MatrixOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Transpose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Transpose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinearAlgebra/Transpose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Transpose(ctx, req.(*MatrixOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Determinant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Determinant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinearAlgebra/Determinant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Determinant(ctx, req.(*MatrixOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Inverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Inverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinearAlgebra/Inverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Inverse(ctx, req.(*MatrixOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Solve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Solve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinearAlgebra/Solve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Solve(ctx, req.(*SolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LinearAlgebra_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.LinearAlgebra",
+	HandlerType: (*LinearAlgebraServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Dot",
+			Handler:    _LinearAlgebra_Dot_Handler,
+		},
+		{
+			MethodName: "Cross",
+			Handler:    _LinearAlgebra_Cross_Handler,
+		},
+		{
+			MethodName: "Norm",
+			Handler:    _LinearAlgebra_Norm_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _LinearAlgebra_Add_Handler,
+		},
+		{
+			MethodName: "Multiply",
+			Handler:    _LinearAlgebra_Multiply_Handler,
+		},
+		{
+			MethodName: "Transpose",
+			Handler:    _LinearAlgebra_Transpose_Handler,
+		},
+		{
+			MethodName: "Determinant",
+			Handler:    _LinearAlgebra_Determinant_Handler,
+		},
+		{
+			MethodName: "Inverse",
+			Handler:    _LinearAlgebra_Inverse_Handler,
+		},
+		{
+			MethodName: "Solve",
+			Handler:    _LinearAlgebra_Solve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mathsvc.proto",
+}
+
+// PolynomialClient is the client API for Polynomial service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PolynomialClient interface {
+	// Evaluate returns the value of a at x, computed with Horner's method
+	Evaluate(ctx context.Context, in *PolynomialEvaluateRequest, opts ...grpc.CallOption) (*MathOpReply, error)
+	// Add returns a+b
+	Add(ctx context.Context, in *PolynomialOpRequest, opts ...grpc.CallOption) (*PolynomialReply, error)
+	// Multiply returns a*b
+	Multiply(ctx context.Context, in *PolynomialOpRequest, opts ...grpc.CallOption) (*PolynomialReply, error)
+	// Derivative returns the derivative of a
+	Derivative(ctx context.Context, in *PolynomialOpRequest, opts ...grpc.CallOption) (*PolynomialReply, error)
+	// Integral returns the antiderivative of a whose constant term is 0
+	Integral(ctx context.Context, in *PolynomialOpRequest, opts ...grpc.CallOption) (*PolynomialReply, error)
+	// Roots returns the real and complex roots of a, see RootsRequest
+	Roots(ctx context.Context, in *RootsRequest, opts ...grpc.CallOption) (*RootsReply, error)
+}
+
+type polynomialClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPolynomialClient(cc *grpc.ClientConn) PolynomialClient {
+	return &polynomialClient{cc}
+}
+
+func (c *polynomialClient) Evaluate(ctx context.Context, in *PolynomialEvaluateRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
+	out := new(MathOpReply)
+	err := c.cc.Invoke(ctx, "/pb.Polynomial/Evaluate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *polynomialClient) Add(ctx context.Context, in *PolynomialOpRequest, opts ...grpc.CallOption) (*PolynomialReply, error) {
+	out := new(PolynomialReply)
+	err := c.cc.Invoke(ctx, "/pb.Polynomial/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *polynomialClient) Multiply(ctx context.Context, in *PolynomialOpRequest, opts ...grpc.CallOption) (*PolynomialReply, error) {
+	out := new(PolynomialReply)
+	err := c.cc.Invoke(ctx, "/pb.Polynomial/Multiply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *polynomialClient) Derivative(ctx context.Context, in *PolynomialOpRequest, opts ...grpc.CallOption) (*PolynomialReply, error) {
+	out := new(PolynomialReply)
+	err := c.cc.Invoke(ctx, "/pb.Polynomial/Derivative", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *polynomialClient) Integral(ctx context.Context, in *PolynomialOpRequest, opts ...grpc.CallOption) (*PolynomialReply, error) {
+	out := new(PolynomialReply)
+	err := c.cc.Invoke(ctx, "/pb.Polynomial/Integral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *polynomialClient) Roots(ctx context.Context, in *RootsRequest, opts ...grpc.CallOption) (*RootsReply, error) {
+	out := new(RootsReply)
+	err := c.cc.Invoke(ctx, "/pb.Polynomial/Roots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PolynomialServer is the server API for Polynomial service.
+type PolynomialServer interface {
+	// Evaluate returns the value of a at x, computed with Horner's method
+	Evaluate(context.Context, *PolynomialEvaluateRequest) (*MathOpReply, error)
+	// Add returns a+b
+	Add(context.Context, *PolynomialOpRequest) (*PolynomialReply, error)
+	// Multiply returns a*b
+	Multiply(context.Context, *PolynomialOpRequest) (*PolynomialReply, error)
+	// Derivative returns the derivative of a
+	Derivative(context.Context, *PolynomialOpRequest) (*PolynomialReply, error)
+	// Integral returns the antiderivative of a whose constant term is 0
+	Integral(context.Context, *PolynomialOpRequest) (*PolynomialReply, error)
+	// Roots returns the real and complex roots of a, see RootsRequest
+	Roots(context.Context, *RootsRequest) (*RootsReply, error)
+}
+
+// UnimplementedPolynomialServer can be embedded to have forward compatible implementations.
+type UnimplementedPolynomialServer struct {
+}
+
+func (*UnimplementedPolynomialServer) Evaluate(ctx context.Context, req *PolynomialEvaluateRequest) (*MathOpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (*UnimplementedPolynomialServer) Add(ctx context.Context, req *PolynomialOpRequest) (*PolynomialReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (*UnimplementedPolynomialServer) Multiply(ctx context.Context, req *PolynomialOpRequest) (*PolynomialReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Multiply not implemented")
+}
+func (*UnimplementedPolynomialServer) Derivative(ctx context.Context, req *PolynomialOpRequest) (*PolynomialReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Derivative not implemented")
+}
+func (*UnimplementedPolynomialServer) Integral(ctx context.Context, req *PolynomialOpRequest) (*PolynomialReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Integral not implemented")
+}
+func (*UnimplementedPolynomialServer) Roots(ctx context.Context, req *RootsRequest) (*RootsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Roots not implemented")
+}
+
+func RegisterPolynomialServer(s *grpc.Server, srv PolynomialServer) {
+	s.RegisterService(&_Polynomial_serviceDesc, srv)
+}
+
+func _Polynomial_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolynomialEvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolynomialServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Polynomial/Evaluate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolynomialServer).Evaluate(ctx, req.(*PolynomialEvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Polynomial_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolynomialOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolynomialServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Polynomial/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolynomialServer).Add(ctx, req.(*PolynomialOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Polynomial_Multiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolynomialOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolynomialServer).Multiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Polynomial/Multiply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolynomialServer).Multiply(ctx, req.(*PolynomialOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Polynomial_Derivative_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolynomialOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolynomialServer).Derivative(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Polynomial/Derivative",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolynomialServer).Derivative(ctx, req.(*PolynomialOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Polynomial_Integral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolynomialOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolynomialServer).Integral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Polynomial/Integral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolynomialServer).Integral(ctx, req.(*PolynomialOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Polynomial_Roots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RootsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolynomialServer).Roots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Polynomial/Roots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolynomialServer).Roots(ctx, req.(*RootsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Polynomial_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Polynomial",
+	HandlerType: (*PolynomialServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Evaluate",
+			Handler:    _Polynomial_Evaluate_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _Polynomial_Add_Handler,
+		},
+		{
+			MethodName: "Multiply",
+			Handler:    _Polynomial_Multiply_Handler,
+		},
+		{
+			MethodName: "Derivative",
+			Handler:    _Polynomial_Derivative_Handler,
+		},
+		{
+			MethodName: "Integral",
+			Handler:    _Polynomial_Integral_Handler,
+		},
+		{
+			MethodName: "Roots",
+			Handler:    _Polynomial_Roots_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mathsvc.proto",
+}
+
+// StatisticsClient is the client API for Statistics service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StatisticsClient interface {
+	// Describe returns the descriptive statistics of the values streamed in x,
+	// configured by the options of the first chunk
+	Describe(ctx context.Context, opts ...grpc.CallOption) (Statistics_DescribeClient, error)
+	// Correlate returns the covariance and correlation of the series streamed
+	// in x and y, which are paired in the order they're streamed
+	Correlate(ctx context.Context, opts ...grpc.CallOption) (Statistics_CorrelateClient, error)
+}
+
+type statisticsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStatisticsClient(cc *grpc.ClientConn) StatisticsClient {
+	return &statisticsClient{cc}
+}
+
+func (c *statisticsClient) Describe(ctx context.Context, opts ...grpc.CallOption) (Statistics_DescribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Statistics_serviceDesc.Streams[0], "/pb.Statistics/Describe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &statisticsDescribeClient{stream}
+	return x, nil
+}
+
+type Statistics_DescribeClient interface {
+	Send(*StatisticsChunk) error
+	CloseAndRecv() (*DescribeReply, error)
+	grpc.ClientStream
+}
+
+type statisticsDescribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *statisticsDescribeClient) Send(m *StatisticsChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *statisticsDescribeClient) CloseAndRecv() (*DescribeReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(DescribeReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *statisticsClient) Correlate(ctx context.Context, opts ...grpc.CallOption) (Statistics_CorrelateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Statistics_serviceDesc.Streams[1], "/pb.Statistics/Correlate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &statisticsCorrelateClient{stream}
+	return x, nil
+}
+
+type Statistics_CorrelateClient interface {
+	Send(*StatisticsChunk) error
+	CloseAndRecv() (*CorrelateReply, error)
+	grpc.ClientStream
+}
+
+type statisticsCorrelateClient struct {
+	grpc.ClientStream
+}
+
+func (x *statisticsCorrelateClient) Send(m *StatisticsChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *statisticsCorrelateClient) CloseAndRecv() (*CorrelateReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CorrelateReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StatisticsServer is the server API for Statistics service.
+type StatisticsServer interface {
+	// Describe returns the descriptive statistics of the values streamed in x,
+	// configured by the options of the first chunk
+	Describe(Statistics_DescribeServer) error
+	// Correlate returns the covariance and correlation of the series streamed
+	// in x and y, which are paired in the order they're streamed
+	Correlate(Statistics_CorrelateServer) error
+}
+
+// UnimplementedStatisticsServer can be embedded to have forward compatible implementations.
+type UnimplementedStatisticsServer struct {
+}
+
+func (*UnimplementedStatisticsServer) Describe(srv Statistics_DescribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (*UnimplementedStatisticsServer) Correlate(srv Statistics_CorrelateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Correlate not implemented")
+}
+
+func RegisterStatisticsServer(s *grpc.Server, srv StatisticsServer) {
+	s.RegisterService(&_Statistics_serviceDesc, srv)
+}
+
+func _Statistics_Describe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StatisticsServer).Describe(&statisticsDescribeServer{stream})
+}
+
+type Statistics_DescribeServer interface {
+	SendAndClose(*DescribeReply) error
+	Recv() (*StatisticsChunk, error)
+	grpc.ServerStream
+}
+
+type statisticsDescribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *statisticsDescribeServer) SendAndClose(m *DescribeReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *statisticsDescribeServer) Recv() (*StatisticsChunk, error) {
+	m := new(StatisticsChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Statistics_Correlate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StatisticsServer).Correlate(&statisticsCorrelateServer{stream})
+}
+
+type Statistics_CorrelateServer interface {
+	SendAndClose(*CorrelateReply) error
+	Recv() (*StatisticsChunk, error)
+	grpc.ServerStream
+}
+
+type statisticsCorrelateServer struct {
+	grpc.ServerStream
+}
+
+func (x *statisticsCorrelateServer) SendAndClose(m *CorrelateReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *statisticsCorrelateServer) Recv() (*StatisticsChunk, error) {
+	m := new(StatisticsChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _Statistics_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Statistics",
+	HandlerType: (*StatisticsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Describe",
+			Handler:       _Statistics_Describe_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Correlate",
+			Handler:       _Statistics_Correlate_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "mathsvc.proto",
+}
+
+// UnitsClient is the client API for Units service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UnitsClient interface {
+	// Sum returns a+b in the unit of a, b must have the same dimension
+	Sum(ctx context.Context, in *QuantityOpRequest, opts ...grpc.CallOption) (*QuantityReply, error)
+	// Subtract returns a-b in the unit of a, b must have the same dimension
+	Subtract(ctx context.Context, in *QuantityOpRequest, opts ...grpc.CallOption) (*QuantityReply, error)
+	// Multiply returns a*b in the product of their units
+	Multiply(ctx context.Context, in *QuantityOpRequest, opts ...grpc.CallOption) (*QuantityReply, error)
+	// Divide returns a/b in the quotient of their units
+	Divide(ctx context.Context, in *QuantityOpRequest, opts ...grpc.CallOption) (*QuantityReply, error)
+	// Pow returns a^b, where b is a number
+	Pow(ctx context.Context, in *QuantityPowRequest, opts ...grpc.CallOption) (*QuantityReply, error)
+	// Convert returns a in unit, which must have the same dimension
+	Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*QuantityReply, error)
+}
+
+type unitsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUnitsClient(cc *grpc.ClientConn) UnitsClient {
+	return &unitsClient{cc}
+}
+
+func (c *unitsClient) Sum(ctx context.Context, in *QuantityOpRequest, opts ...grpc.CallOption) (*QuantityReply, error) {
+	out := new(QuantityReply)
+	err := c.cc.Invoke(ctx, "/pb.Units/Sum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitsClient) Subtract(ctx context.Context, in *QuantityOpRequest, opts ...grpc.CallOption) (*QuantityReply, error) {
+	out := new(QuantityReply)
+	err := c.cc.Invoke(ctx, "/pb.Units/Subtract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitsClient) Multiply(ctx context.Context, in *QuantityOpRequest, opts ...grpc.CallOption) (*QuantityReply, error) {
+	out := new(QuantityReply)
+	err := c.cc.Invoke(ctx, "/pb.Units/Multiply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitsClient) Divide(ctx context.Context, in *QuantityOpRequest, opts ...grpc.CallOption) (*QuantityReply, error) {
+	out := new(QuantityReply)
+	err := c.cc.Invoke(ctx, "/pb.Units/Divide", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitsClient) Pow(ctx context.Context, in *QuantityPowRequest, opts ...grpc.CallOption) (*QuantityReply, error) {
+	out := new(QuantityReply)
+	err := c.cc.Invoke(ctx, "/pb.Units/Pow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *unitsClient) Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*QuantityReply, error) {
+	out := new(QuantityReply)
+	err := c.cc.Invoke(ctx, "/pb.Units/Convert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UnitsServer is the server API for Units service.
+type UnitsServer interface {
+	// Sum returns a+b in the unit of a, b must have the same dimension
+	Sum(context.Context, *QuantityOpRequest) (*QuantityReply, error)
+	// Subtract returns a-b in the unit of a, b must have the same dimension
+	Subtract(context.Context, *QuantityOpRequest) (*QuantityReply, error)
+	// Multiply returns a*b in the product of their units
+	Multiply(context.Context, *QuantityOpRequest) (*QuantityReply, error)
+	// Divide returns a/b in the quotient of their units
+	Divide(context.Context, *QuantityOpRequest) (*QuantityReply, error)
+	// Pow returns a^b, where b is a number
+	Pow(context.Context, *QuantityPowRequest) (*QuantityReply, error)
+	// Convert returns a in unit, which must have the same dimension
+	Convert(context.Context, *ConvertRequest) (*QuantityReply, error)
+}
+
+// UnimplementedUnitsServer can be embedded to have forward compatible implementations.
+type UnimplementedUnitsServer struct {
+}
+
+func (*UnimplementedUnitsServer) Sum(ctx context.Context, req *QuantityOpRequest) (*QuantityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sum not implemented")
+}
+func (*UnimplementedUnitsServer) Subtract(ctx context.Context, req *QuantityOpRequest) (*QuantityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subtract not implemented")
+}
+func (*UnimplementedUnitsServer) Multiply(ctx context.Context, req *QuantityOpRequest) (*QuantityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Multiply not implemented")
+}
+func (*UnimplementedUnitsServer) Divide(ctx context.Context, req *QuantityOpRequest) (*QuantityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Divide not implemented")
+}
+func (*UnimplementedUnitsServer) Pow(ctx context.Context, req *QuantityPowRequest) (*QuantityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pow not implemented")
+}
+func (*UnimplementedUnitsServer) Convert(ctx context.Context, req *ConvertRequest) (*QuantityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
+}
+
+func RegisterUnitsServer(s *grpc.Server, srv UnitsServer) {
+	s.RegisterService(&_Units_serviceDesc, srv)
+}
+
+func _Units_Sum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuantityOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServer).Sum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Units/Sum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServer).Sum(ctx, req.(*QuantityOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Units_Subtract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuantityOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServer).Subtract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Units/Subtract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServer).Subtract(ctx, req.(*QuantityOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Units_Multiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuantityOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServer).Multiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Units/Multiply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServer).Multiply(ctx, req.(*QuantityOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Units_Divide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuantityOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServer).Divide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Units/Divide",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServer).Divide(ctx, req.(*QuantityOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Units_Pow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuantityPowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServer).Pow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Units/Pow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServer).Pow(ctx, req.(*QuantityPowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Units_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UnitsServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Units/Convert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UnitsServer).Convert(ctx, req.(*ConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Units_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Units",
+	HandlerType: (*UnitsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Sum",
+			Handler:    _Units_Sum_Handler,
+		},
+		{
+			MethodName: "Subtract",
+			Handler:    _Units_Subtract_Handler,
+		},
+		{
+			MethodName: "Multiply",
+			Handler:    _Units_Multiply_Handler,
+		},
+		{
+			MethodName: "Divide",
+			Handler:    _Units_Divide_Handler,
+		},
+		{
+			MethodName: "Pow",
+			Handler:    _Units_Pow_Handler,
+		},
+		{
+			MethodName: "Convert",
+			Handler:    _Units_Convert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mathsvc.proto",
+}
+
+// FinanceClient is the client API for Finance service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type FinanceClient interface {
+	// NPV returns the net present value of values discounted at rate, the
+	// first value being received now and each following one a period later
+	NPV(ctx context.Context, in *CashFlowRequest, opts ...grpc.CallOption) (*DecimalReply, error)
+	// IRR returns the internal rate of return of values, the rate at which
+	// their NPV is 0, starting its search from rate when it's set
+	IRR(ctx context.Context, in *CashFlowRequest, opts ...grpc.CallOption) (*DecimalReply, error)
+	// PMT returns the payment per period paying off pv over periods, leaving
+	// fv. Like pv and fv, it's negative when paid and positive when received.
+	PMT(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*DecimalReply, error)
+	// FV returns the future value of pv and a payment of pmt per period
+	FV(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*DecimalReply, error)
+	// PV returns the present value of fv and a payment of pmt per period
+	PV(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*DecimalReply, error)
+	// CompoundInterest returns principal compounded frequency times per
+	// period at rate/frequency, over periods
+	CompoundInterest(ctx context.Context, in *CompoundInterestRequest, opts ...grpc.CallOption) (*DecimalReply, error)
+	// AmortizationSchedule streams a row per period of the repayment of a
+	// loan of principal in equal payments
+	AmortizationSchedule(ctx context.Context, in *AmortizationRequest, opts ...grpc.CallOption) (Finance_AmortizationScheduleClient, error)
+}
+
+type financeClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFinanceClient(cc *grpc.ClientConn) FinanceClient {
+	return &financeClient{cc}
+}
+
+func (c *financeClient) NPV(ctx context.Context, in *CashFlowRequest, opts ...grpc.CallOption) (*DecimalReply, error) {
+	out := new(DecimalReply)
+	err := c.cc.Invoke(ctx, "/pb.Finance/NPV", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) IRR(ctx context.Context, in *CashFlowRequest, opts ...grpc.CallOption) (*DecimalReply, error) {
+	out := new(DecimalReply)
+	err := c.cc.Invoke(ctx, "/pb.Finance/IRR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) PMT(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*DecimalReply, error) {
+	out := new(DecimalReply)
+	err := c.cc.Invoke(ctx, "/pb.Finance/PMT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) FV(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*DecimalReply, error) {
+	out := new(DecimalReply)
+	err := c.cc.Invoke(ctx, "/pb.Finance/FV", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) PV(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*DecimalReply, error) {
+	out := new(DecimalReply)
+	err := c.cc.Invoke(ctx, "/pb.Finance/PV", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) CompoundInterest(ctx context.Context, in *CompoundInterestRequest, opts ...grpc.CallOption) (*DecimalReply, error) {
+	out := new(DecimalReply)
+	err := c.cc.Invoke(ctx, "/pb.Finance/CompoundInterest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) AmortizationSchedule(ctx context.Context, in *AmortizationRequest, opts ...grpc.CallOption) (Finance_AmortizationScheduleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Finance_serviceDesc.Streams[0], "/pb.Finance/AmortizationSchedule", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &financeAmortizationScheduleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Finance_AmortizationScheduleClient interface {
+	Recv() (*AmortizationRow, error)
+	grpc.ClientStream
+}
+
+type financeAmortizationScheduleClient struct {
+	grpc.ClientStream
+}
+
+func (x *financeAmortizationScheduleClient) Recv() (*AmortizationRow, error) {
+	m := new(AmortizationRow)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FinanceServer is the server API for Finance service.
+type FinanceServer interface {
+	// NPV returns the net present value of values discounted at rate, the
+	// first value being received now and each following one a period later
+	NPV(context.Context, *CashFlowRequest) (*DecimalReply, error)
+	// IRR returns the internal rate of return of values, the rate at which
+	// their NPV is 0, starting its search from rate when it's set
+	IRR(context.Context, *CashFlowRequest) (*DecimalReply, error)
+	// PMT returns the payment per period paying off pv over periods, leaving
+	// fv. Like pv and fv, it's negative when paid and positive when received.
+	PMT(context.Context, *TimeValueRequest) (*DecimalReply, error)
+	// FV returns the future value of pv and a payment of pmt per period
+	FV(context.Context, *TimeValueRequest) (*DecimalReply, error)
+	// PV returns the present value of fv and a payment of pmt per period
+	PV(context.Context, *TimeValueRequest) (*DecimalReply, error)
+	// CompoundInterest returns principal compounded frequency times per
+	// period at rate/frequency, over periods
+	CompoundInterest(context.Context, *CompoundInterestRequest) (*DecimalReply, error)
+	// AmortizationSchedule streams a row per period of the repayment of a
+	// loan of principal in equal payments
+	AmortizationSchedule(*AmortizationRequest, Finance_AmortizationScheduleServer) error
+}
+
+// UnimplementedFinanceServer can be embedded to have forward compatible implementations.
+type UnimplementedFinanceServer struct {
+}
+
+func (*UnimplementedFinanceServer) NPV(ctx context.Context, req *CashFlowRequest) (*DecimalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NPV not implemented")
+}
+func (*UnimplementedFinanceServer) IRR(ctx context.Context, req *CashFlowRequest) (*DecimalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IRR not implemented")
+}
+func (*UnimplementedFinanceServer) PMT(ctx context.Context, req *TimeValueRequest) (*DecimalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PMT not implemented")
+}
+func (*UnimplementedFinanceServer) FV(ctx context.Context, req *TimeValueRequest) (*DecimalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FV not implemented")
+}
+func (*UnimplementedFinanceServer) PV(ctx context.Context, req *TimeValueRequest) (*DecimalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PV not implemented")
+}
+func (*UnimplementedFinanceServer) CompoundInterest(ctx context.Context, req *CompoundInterestRequest) (*DecimalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompoundInterest not implemented")
+}
+func (*UnimplementedFinanceServer) AmortizationSchedule(req *AmortizationRequest, srv Finance_AmortizationScheduleServer) error {
+	return status.Errorf(codes.Unimplemented, "method AmortizationSchedule not implemented")
+}
+
+func RegisterFinanceServer(s *grpc.Server, srv FinanceServer) {
+	s.RegisterService(&_Finance_serviceDesc, srv)
+}
+
+func _Finance_NPV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).NPV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Finance/NPV",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).NPV(ctx, req.(*CashFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_IRR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).IRR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Finance/IRR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).IRR(ctx, req.(*CashFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_PMT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).PMT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Finance/PMT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).PMT(ctx, req.(*TimeValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_FV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).FV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Finance/FV",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).FV(ctx, req.(*TimeValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_PV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).PV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Finance/PV",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).PV(ctx, req.(*TimeValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_CompoundInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompoundInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CompoundInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Finance/CompoundInterest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CompoundInterest(ctx, req.(*CompoundInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_AmortizationSchedule_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AmortizationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FinanceServer).AmortizationSchedule(m, &financeAmortizationScheduleServer{stream})
+}
+
+type Finance_AmortizationScheduleServer interface {
+	Send(*AmortizationRow) error
+	grpc.ServerStream
+}
+
+type financeAmortizationScheduleServer struct {
+	grpc.ServerStream
+}
+
+func (x *financeAmortizationScheduleServer) Send(m *AmortizationRow) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Finance_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Finance",
+	HandlerType: (*FinanceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NPV",
+			Handler:    _Finance_NPV_Handler,
+		},
+		{
+			MethodName: "IRR",
+			Handler:    _Finance_IRR_Handler,
+		},
+		{
+			MethodName: "PMT",
+			Handler:    _Finance_PMT_Handler,
+		},
+		{
+			MethodName: "FV",
+			Handler:    _Finance_FV_Handler,
+		},
+		{
+			MethodName: "PV",
+			Handler:    _Finance_PV_Handler,
+		},
+		{
+			MethodName: "CompoundInterest",
+			Handler:    _Finance_CompoundInterest_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AmortizationSchedule",
+			Handler:       _Finance_AmortizationSchedule_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "mathsvc.proto",
+}
+
+// CombinatoricsClient is the client API for Combinatorics service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CombinatoricsClient interface {
+	// Factorial returns n!
+	Factorial(ctx context.Context, in *CombinatoricsRequest, opts ...grpc.CallOption) (*IntegerReply, error)
+	// Binomial returns the number of ways of choosing k of n items,
+	// n!/(k!(n-k)!), which is 0 when k is greater than n
+	Binomial(ctx context.Context, in *CombinatoricsRequest, opts ...grpc.CallOption) (*IntegerReply, error)
+	// Permutations returns the number of ordered arrangements of k of n items,
+	// n!/(n-k)!, which is 0 when k is greater than n
+	Permutations(ctx context.Context, in *CombinatoricsRequest, opts ...grpc.CallOption) (*IntegerReply, error)
+}
+
+type combinatoricsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCombinatoricsClient(cc *grpc.ClientConn) CombinatoricsClient {
+	return &combinatoricsClient{cc}
+}
+
+func (c *combinatoricsClient) Factorial(ctx context.Context, in *CombinatoricsRequest, opts ...grpc.CallOption) (*IntegerReply, error) {
+	out := new(IntegerReply)
+	err := c.cc.Invoke(ctx, "/pb.Combinatorics/Factorial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *combinatoricsClient) Binomial(ctx context.Context, in *CombinatoricsRequest, opts ...grpc.CallOption) (*IntegerReply, error) {
+	out := new(IntegerReply)
+	err := c.cc.Invoke(ctx, "/pb.Combinatorics/Binomial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *combinatoricsClient) Permutations(ctx context.Context, in *CombinatoricsRequest, opts ...grpc.CallOption) (*IntegerReply, error) {
+	out := new(IntegerReply)
+	err := c.cc.Invoke(ctx, "/pb.Combinatorics/Permutations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CombinatoricsServer is the server API for Combinatorics service.
+type CombinatoricsServer interface {
+	// Factorial returns n!
+	Factorial(context.Context, *CombinatoricsRequest) (*IntegerReply, error)
+	// Binomial returns the number of ways of choosing k of n items,
+	// n!/(k!(n-k)!), which is 0 when k is greater than n
+	Binomial(context.Context, *CombinatoricsRequest) (*IntegerReply, error)
+	// Permutations returns the number of ordered arrangements of k of n items,
+	// n!/(n-k)!, which is 0 when k is greater than n
+	Permutations(context.Context, *CombinatoricsRequest) (*IntegerReply, error)
+}
+
+// UnimplementedCombinatoricsServer can be embedded to have forward compatible implementations.
+type UnimplementedCombinatoricsServer struct {
+}
+
+func (*UnimplementedCombinatoricsServer) Factorial(ctx context.Context, req *CombinatoricsRequest) (*IntegerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Factorial not implemented")
+}
+func (*UnimplementedCombinatoricsServer) Binomial(ctx context.Context, req *CombinatoricsRequest) (*IntegerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Binomial not implemented")
+}
+func (*UnimplementedCombinatoricsServer) Permutations(ctx context.Context, req *CombinatoricsRequest) (*IntegerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Permutations not implemented")
+}
+
+func RegisterCombinatoricsServer(s *grpc.Server, srv CombinatoricsServer) {
+	s.RegisterService(&_Combinatorics_serviceDesc, srv)
+}
+
+func _Combinatorics_Factorial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombinatoricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CombinatoricsServer).Factorial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Combinatorics/Factorial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CombinatoricsServer).Factorial(ctx, req.(*CombinatoricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Combinatorics_Binomial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombinatoricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CombinatoricsServer).Binomial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Combinatorics/Binomial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CombinatoricsServer).Binomial(ctx, req.(*CombinatoricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Combinatorics_Permutations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombinatoricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CombinatoricsServer).Permutations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Combinatorics/Permutations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CombinatoricsServer).Permutations(ctx, req.(*CombinatoricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Combinatorics_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Combinatorics",
+	HandlerType: (*CombinatoricsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Factorial",
+			Handler:    _Combinatorics_Factorial_Handler,
+		},
+		{
+			MethodName: "Binomial",
+			Handler:    _Combinatorics_Binomial_Handler,
+		},
+		{
+			MethodName: "Permutations",
+			Handler:    _Combinatorics_Permutations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mathsvc.proto",
+}
+
+// NumberTheoryClient is the client API for NumberTheory service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NumberTheoryClient interface {
+	// IsPrime reports whether n is prime, deterministically when n is less
+	// than 2^64 and with a probabilistic test otherwise
+	IsPrime(ctx context.Context, in *IntegerRequest, opts ...grpc.CallOption) (*PrimalityReply, error)
+	// Factorize returns the prime factors of n, which must be positive, in
+	// increasing order
+	Factorize(ctx context.Context, in *IntegerRequest, opts ...grpc.CallOption) (*FactorsReply, error)
+	// ModPow returns base^exponent mod modulus, a negative exponent raising
+	// the inverse of base
+	ModPow(ctx context.Context, in *ModPowRequest, opts ...grpc.CallOption) (*IntegerReply, error)
+	// ModInverse returns the inverse of a mod modulus, between 0 and modulus
+	ModInverse(ctx context.Context, in *ModInverseRequest, opts ...grpc.CallOption) (*IntegerReply, error)
+	// EulerPhi returns the number of integers between 1 and n that are
+	// coprime with n, which must be positive
+	EulerPhi(ctx context.Context, in *IntegerRequest, opts ...grpc.CallOption) (*IntegerReply, error)
+	// NextPrime returns the smallest prime greater than n
+	NextPrime(ctx context.Context, in *IntegerRequest, opts ...grpc.CallOption) (*IntegerReply, error)
+}
+
+type numberTheoryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNumberTheoryClient(cc *grpc.ClientConn) NumberTheoryClient {
+	return &numberTheoryClient{cc}
+}
+
+func (c *numberTheoryClient) IsPrime(ctx context.Context, in *IntegerRequest, opts ...grpc.CallOption) (*PrimalityReply, error) {
+	out := new(PrimalityReply)
+	err := c.cc.Invoke(ctx, "/pb.NumberTheory/IsPrime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *numberTheoryClient) Factorize(ctx context.Context, in *IntegerRequest, opts ...grpc.CallOption) (*FactorsReply, error) {
+	out := new(FactorsReply)
+	err := c.cc.Invoke(ctx, "/pb.NumberTheory/Factorize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *numberTheoryClient) ModPow(ctx context.Context, in *ModPowRequest, opts ...grpc.CallOption) (*IntegerReply, error) {
+	out := new(IntegerReply)
+	err := c.cc.Invoke(ctx, "/pb.NumberTheory/ModPow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *numberTheoryClient) ModInverse(ctx context.Context, in *ModInverseRequest, opts ...grpc.CallOption) (*IntegerReply, error) {
+	out := new(IntegerReply)
+	err := c.cc.Invoke(ctx, "/pb.NumberTheory/ModInverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *numberTheoryClient) EulerPhi(ctx context.Context, in *IntegerRequest, opts ...grpc.CallOption) (*IntegerReply, error) {
+	out := new(IntegerReply)
+	err := c.cc.Invoke(ctx, "/pb.NumberTheory/EulerPhi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *numberTheoryClient) NextPrime(ctx context.Context, in *IntegerRequest, opts ...grpc.CallOption) (*IntegerReply, error) {
+	out := new(IntegerReply)
+	err := c.cc.Invoke(ctx, "/pb.NumberTheory/NextPrime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NumberTheoryServer is the server API for NumberTheory service.
+type NumberTheoryServer interface {
+	// IsPrime reports whether n is prime, deterministically when n is less
+	// than 2^64 and with a probabilistic test otherwise
+	IsPrime(context.Context, *IntegerRequest) (*PrimalityReply, error)
+	// Factorize returns the prime factors of n, which must be positive, in
+	// increasing order
+	Factorize(context.Context, *IntegerRequest) (*FactorsReply, error)
+	// ModPow returns base^exponent mod modulus, a negative exponent raising
+	// the inverse of base
+	ModPow(context.Context, *ModPowRequest) (*IntegerReply, error)
+	// ModInverse returns the inverse of a mod modulus, between 0 and modulus
+	ModInverse(context.Context, *ModInverseRequest) (*IntegerReply, error)
+	// EulerPhi returns the number of integers between 1 and n that are
+	// coprime with n, which must be positive
+	EulerPhi(context.Context, *IntegerRequest) (*IntegerReply, error)
+	// NextPrime returns the smallest prime greater than n
+	NextPrime(context.Context, *IntegerRequest) (*IntegerReply, error)
+}
+
+// UnimplementedNumberTheoryServer can be embedded to have forward compatible implementations.
+type UnimplementedNumberTheoryServer struct {
+}
+
+func (*UnimplementedNumberTheoryServer) IsPrime(ctx context.Context, req *IntegerRequest) (*PrimalityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsPrime not implemented")
+}
+func (*UnimplementedNumberTheoryServer) Factorize(ctx context.Context, req *IntegerRequest) (*FactorsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Factorize not implemented")
+}
+func (*UnimplementedNumberTheoryServer) ModPow(ctx context.Context, req *ModPowRequest) (*IntegerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModPow not implemented")
+}
+func (*UnimplementedNumberTheoryServer) ModInverse(ctx context.Context, req *ModInverseRequest) (*IntegerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModInverse not implemented")
+}
+func (*UnimplementedNumberTheoryServer) EulerPhi(ctx context.Context, req *IntegerRequest) (*IntegerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EulerPhi not implemented")
+}
+func (*UnimplementedNumberTheoryServer) NextPrime(ctx context.Context, req *IntegerRequest) (*IntegerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextPrime not implemented")
+}
+
+func RegisterNumberTheoryServer(s *grpc.Server, srv NumberTheoryServer) {
+	s.RegisterService(&_NumberTheory_serviceDesc, srv)
+}
+
+func _NumberTheory_IsPrime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NumberTheoryServer).IsPrime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NumberTheory/IsPrime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NumberTheoryServer).IsPrime(ctx, req.(*IntegerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type mathClient struct {
-	cc *grpc.ClientConn
+func _NumberTheory_Factorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NumberTheoryServer).Factorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NumberTheory/Factorize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NumberTheoryServer).Factorize(ctx, req.(*IntegerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewMathClient(cc *grpc.ClientConn) MathClient {
-	return &mathClient{cc}
+func _NumberTheory_ModPow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModPowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NumberTheoryServer).ModPow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NumberTheory/ModPow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NumberTheoryServer).ModPow(ctx, req.(*ModPowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mathClient) Divide(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
-	out := new(MathOpReply)
-	err := c.cc.Invoke(ctx, "/pb.Math/Divide", in, out, opts...)
-	if err != nil {
+func _NumberTheory_ModInverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModInverseRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NumberTheoryServer).ModInverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NumberTheory/ModInverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NumberTheoryServer).ModInverse(ctx, req.(*ModInverseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mathClient) Max(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
-	out := new(MathOpReply)
-	err := c.cc.Invoke(ctx, "/pb.Math/Max", in, out, opts...)
-	if err != nil {
+func _NumberTheory_EulerPhi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NumberTheoryServer).EulerPhi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NumberTheory/EulerPhi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NumberTheoryServer).EulerPhi(ctx, req.(*IntegerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mathClient) Min(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
-	out := new(MathOpReply)
-	err := c.cc.Invoke(ctx, "/pb.Math/Min", in, out, opts...)
-	if err != nil {
+func _NumberTheory_NextPrime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(NumberTheoryServer).NextPrime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NumberTheory/NextPrime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NumberTheoryServer).NextPrime(ctx, req.(*IntegerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mathClient) Multiply(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
-	out := new(MathOpReply)
-	err := c.cc.Invoke(ctx, "/pb.Math/Multiply", in, out, opts...)
+var _NumberTheory_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.NumberTheory",
+	HandlerType: (*NumberTheoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IsPrime",
+			Handler:    _NumberTheory_IsPrime_Handler,
+		},
+		{
+			MethodName: "Factorize",
+			Handler:    _NumberTheory_Factorize_Handler,
+		},
+		{
+			MethodName: "ModPow",
+			Handler:    _NumberTheory_ModPow_Handler,
+		},
+		{
+			MethodName: "ModInverse",
+			Handler:    _NumberTheory_ModInverse_Handler,
+		},
+		{
+			MethodName: "EulerPhi",
+			Handler:    _NumberTheory_EulerPhi_Handler,
+		},
+		{
+			MethodName: "NextPrime",
+			Handler:    _NumberTheory_NextPrime_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mathsvc.proto",
+}
+
+// CalculusClient is the client API for Calculus service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CalculusClient interface {
+	// Integrate returns the integral of the expression from a to b, using
+	// adaptive Gauss-Kronrod quadrature unless the method is SIMPSON
+	Integrate(ctx context.Context, in *CalculusRequest, opts ...grpc.CallOption) (*CalculusReply, error)
+	// Differentiate returns the derivative of the expression at x, using
+	// Richardson extrapolation of central differences
+	Differentiate(ctx context.Context, in *CalculusRequest, opts ...grpc.CallOption) (*CalculusReply, error)
+	// FindRoot returns a root of the expression between a and b, where it
+	// must change sign, using Brent's method unless the method is BISECTION or
+	// NEWTON
+	FindRoot(ctx context.Context, in *CalculusRequest, opts ...grpc.CallOption) (*CalculusReply, error)
+	// Minimize returns where the expression has a local minimum between a and
+	// b, using golden-section search
+	Minimize(ctx context.Context, in *CalculusRequest, opts ...grpc.CallOption) (*CalculusReply, error)
+}
+
+type calculusClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCalculusClient(cc *grpc.ClientConn) CalculusClient {
+	return &calculusClient{cc}
+}
+
+func (c *calculusClient) Integrate(ctx context.Context, in *CalculusRequest, opts ...grpc.CallOption) (*CalculusReply, error) {
+	out := new(CalculusReply)
+	err := c.cc.Invoke(ctx, "/pb.Calculus/Integrate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mathClient) Pow(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
-	out := new(MathOpReply)
-	err := c.cc.Invoke(ctx, "/pb.Math/Pow", in, out, opts...)
+func (c *calculusClient) Differentiate(ctx context.Context, in *CalculusRequest, opts ...grpc.CallOption) (*CalculusReply, error) {
+	out := new(CalculusReply)
+	err := c.cc.Invoke(ctx, "/pb.Calculus/Differentiate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mathClient) Subtract(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
-	out := new(MathOpReply)
-	err := c.cc.Invoke(ctx, "/pb.Math/Subtract", in, out, opts...)
+func (c *calculusClient) FindRoot(ctx context.Context, in *CalculusRequest, opts ...grpc.CallOption) (*CalculusReply, error) {
+	out := new(CalculusReply)
+	err := c.cc.Invoke(ctx, "/pb.Calculus/FindRoot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mathClient) Sum(ctx context.Context, in *MathOpRequest, opts ...grpc.CallOption) (*MathOpReply, error) {
-	out := new(MathOpReply)
-	err := c.cc.Invoke(ctx, "/pb.Math/Sum", in, out, opts...)
+func (c *calculusClient) Minimize(ctx context.Context, in *CalculusRequest, opts ...grpc.CallOption) (*CalculusReply, error) {
+	out := new(CalculusReply)
+	err := c.cc.Invoke(ctx, "/pb.Calculus/Minimize", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// MathServer is the server API for Math service.
-type MathServer interface {
-	// Divide two integers, a/b
-	Divide(context.Context, *MathOpRequest) (*MathOpReply, error)
-	// Max two integers, returns the greater value of a and b
-	Max(context.Context, *MathOpRequest) (*MathOpReply, error)
-	// Min two integers, returns the lesser value of a and b
-	Min(context.Context, *MathOpRequest) (*MathOpReply, error)
-	// Multiply two integers, a*b
-	Multiply(context.Context, *MathOpRequest) (*MathOpReply, error)
-	// Pow two integers, a^b
-	Pow(context.Context, *MathOpRequest) (*MathOpReply, error)
-	// Subtract two integers, a-b
-	Subtract(context.Context, *MathOpRequest) (*MathOpReply, error)
-	// Sums two integers. a+b
-	Sum(context.Context, *MathOpRequest) (*MathOpReply, error)
+// CalculusServer is the server API for Calculus service.
+type CalculusServer interface {
+	// Integrate returns the integral of the expression from a to b, using
+	// adaptive Gauss-Kronrod quadrature unless the method is SIMPSON
+	Integrate(context.Context, *CalculusRequest) (*CalculusReply, error)
+	// Differentiate returns the derivative of the expression at x, using
+	// Richardson extrapolation of central differences
+	Differentiate(context.Context, *CalculusRequest) (*CalculusReply, error)
+	// FindRoot returns a root of the expression between a and b, where it
+	// must change sign, using Brent's method unless the method is BISECTION or
+	// NEWTON
+	FindRoot(context.Context, *CalculusRequest) (*CalculusReply, error)
+	// Minimize returns where the expression has a local minimum between a and
+	// b, using golden-section search
+	Minimize(context.Context, *CalculusRequest) (*CalculusReply, error)
 }
 
-// UnimplementedMathServer can be embedded to have forward compatible implementations.
-type UnimplementedMathServer struct {
+// UnimplementedCalculusServer can be embedded to have forward compatible implementations.
+type UnimplementedCalculusServer struct {
 }
 
-func (*UnimplementedMathServer) Divide(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Divide not implemented")
+func (*UnimplementedCalculusServer) Integrate(ctx context.Context, req *CalculusRequest) (*CalculusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Integrate not implemented")
 }
-func (*UnimplementedMathServer) Max(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Max not implemented")
-}
-func (*UnimplementedMathServer) Min(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Min not implemented")
-}
-func (*UnimplementedMathServer) Multiply(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Multiply not implemented")
+func (*UnimplementedCalculusServer) Differentiate(ctx context.Context, req *CalculusRequest) (*CalculusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Differentiate not implemented")
 }
-func (*UnimplementedMathServer) Pow(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pow not implemented")
-}
-func (*UnimplementedMathServer) Subtract(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Subtract not implemented")
+func (*UnimplementedCalculusServer) FindRoot(ctx context.Context, req *CalculusRequest) (*CalculusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRoot not implemented")
 }
-func (*UnimplementedMathServer) Sum(ctx context.Context, req *MathOpRequest) (*MathOpReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sum not implemented")
+func (*UnimplementedCalculusServer) Minimize(ctx context.Context, req *CalculusRequest) (*CalculusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minimize not implemented")
 }
 
-func RegisterMathServer(s *grpc.Server, srv MathServer) {
-	s.RegisterService(&_Math_serviceDesc, srv)
+func RegisterCalculusServer(s *grpc.Server, srv CalculusServer) {
+	s.RegisterService(&_Calculus_serviceDesc, srv)
 }
 
-func _Math_Divide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MathOpRequest)
+func _Calculus_Integrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MathServer).Divide(ctx, in)
+		return srv.(CalculusServer).Integrate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Math/Divide",
+		FullMethod: "/pb.Calculus/Integrate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MathServer).Divide(ctx, req.(*MathOpRequest))
+		return srv.(CalculusServer).Integrate(ctx, req.(*CalculusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Math_Max_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MathOpRequest)
+func _Calculus_Differentiate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MathServer).Max(ctx, in)
+		return srv.(CalculusServer).Differentiate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Math/Max",
+		FullMethod: "/pb.Calculus/Differentiate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MathServer).Max(ctx, req.(*MathOpRequest))
+		return srv.(CalculusServer).Differentiate(ctx, req.(*CalculusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Math_Min_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MathOpRequest)
+func _Calculus_FindRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MathServer).Min(ctx, in)
+		return srv.(CalculusServer).FindRoot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Math/Min",
+		FullMethod: "/pb.Calculus/FindRoot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MathServer).Min(ctx, req.(*MathOpRequest))
+		return srv.(CalculusServer).FindRoot(ctx, req.(*CalculusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Math_Multiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MathOpRequest)
+func _Calculus_Minimize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MathServer).Multiply(ctx, in)
+		return srv.(CalculusServer).Minimize(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Math/Multiply",
+		FullMethod: "/pb.Calculus/Minimize",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MathServer).Multiply(ctx, req.(*MathOpRequest))
+		return srv.(CalculusServer).Minimize(ctx, req.(*CalculusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Math_Pow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MathOpRequest)
+var _Calculus_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Calculus",
+	HandlerType: (*CalculusServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Integrate",
+			Handler:    _Calculus_Integrate_Handler,
+		},
+		{
+			MethodName: "Differentiate",
+			Handler:    _Calculus_Differentiate_Handler,
+		},
+		{
+			MethodName: "FindRoot",
+			Handler:    _Calculus_FindRoot_Handler,
+		},
+		{
+			MethodName: "Minimize",
+			Handler:    _Calculus_Minimize_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mathsvc.proto",
+}
+
+// SymbolicClient is the client API for Symbolic service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SymbolicClient interface {
+	// Format returns the expression as it's written
+	Format(ctx context.Context, in *SymbolicRequest, opts ...grpc.CallOption) (*SymbolicReply, error)
+	// Simplify returns the expression with its constant parts folded, as long
+	// as that's exact, and identities such as x*1 and x+0 eliminated
+	Simplify(ctx context.Context, in *SymbolicRequest, opts ...grpc.CallOption) (*SymbolicReply, error)
+	// Differentiate returns the simplified derivative of the expression with
+	// respect to the variable
+	Differentiate(ctx context.Context, in *SymbolicRequest, opts ...grpc.CallOption) (*SymbolicReply, error)
+}
+
+type symbolicClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSymbolicClient(cc *grpc.ClientConn) SymbolicClient {
+	return &symbolicClient{cc}
+}
+
+func (c *symbolicClient) Format(ctx context.Context, in *SymbolicRequest, opts ...grpc.CallOption) (*SymbolicReply, error) {
+	out := new(SymbolicReply)
+	err := c.cc.Invoke(ctx, "/pb.Symbolic/Format", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolicClient) Simplify(ctx context.Context, in *SymbolicRequest, opts ...grpc.CallOption) (*SymbolicReply, error) {
+	out := new(SymbolicReply)
+	err := c.cc.Invoke(ctx, "/pb.Symbolic/Simplify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolicClient) Differentiate(ctx context.Context, in *SymbolicRequest, opts ...grpc.CallOption) (*SymbolicReply, error) {
+	out := new(SymbolicReply)
+	err := c.cc.Invoke(ctx, "/pb.Symbolic/Differentiate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SymbolicServer is the server API for Symbolic service.
+type SymbolicServer interface {
+	// Format returns the expression as it's written
+	Format(context.Context, *SymbolicRequest) (*SymbolicReply, error)
+	// Simplify returns the expression with its constant parts folded, as long
+	// as that's exact, and identities such as x*1 and x+0 eliminated
+	Simplify(context.Context, *SymbolicRequest) (*SymbolicReply, error)
+	// Differentiate returns the simplified derivative of the expression with
+	// respect to the variable
+	Differentiate(context.Context, *SymbolicRequest) (*SymbolicReply, error)
+}
+
+// UnimplementedSymbolicServer can be embedded to have forward compatible implementations.
+type UnimplementedSymbolicServer struct {
+}
+
+func (*UnimplementedSymbolicServer) Format(ctx context.Context, req *SymbolicRequest) (*SymbolicReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Format not implemented")
+}
+func (*UnimplementedSymbolicServer) Simplify(ctx context.Context, req *SymbolicRequest) (*SymbolicReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simplify not implemented")
+}
+func (*UnimplementedSymbolicServer) Differentiate(ctx context.Context, req *SymbolicRequest) (*SymbolicReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Differentiate not implemented")
+}
+
+func RegisterSymbolicServer(s *grpc.Server, srv SymbolicServer) {
+	s.RegisterService(&_Symbolic_serviceDesc, srv)
+}
+
+func _Symbolic_Format_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolicRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MathServer).Pow(ctx, in)
+		return srv.(SymbolicServer).Format(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Math/Pow",
+		FullMethod: "/pb.Symbolic/Format",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MathServer).Pow(ctx, req.(*MathOpRequest))
+		return srv.(SymbolicServer).Format(ctx, req.(*SymbolicRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Math_Subtract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MathOpRequest)
+func _Symbolic_Simplify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolicRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MathServer).Subtract(ctx, in)
+		return srv.(SymbolicServer).Simplify(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Math/Subtract",
+		FullMethod: "/pb.Symbolic/Simplify",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MathServer).Subtract(ctx, req.(*MathOpRequest))
+		return srv.(SymbolicServer).Simplify(ctx, req.(*SymbolicRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Math_Sum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MathOpRequest)
+func _Symbolic_Differentiate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolicRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MathServer).Sum(ctx, in)
+		return srv.(SymbolicServer).Differentiate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Math/Sum",
+		FullMethod: "/pb.Symbolic/Differentiate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MathServer).Sum(ctx, req.(*MathOpRequest))
+		return srv.(SymbolicServer).Differentiate(ctx, req.(*SymbolicRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Math_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Math",
-	HandlerType: (*MathServer)(nil),
+var _Symbolic_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Symbolic",
+	HandlerType: (*SymbolicServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Divide",
-			Handler:    _Math_Divide_Handler,
-		},
-		{
-			MethodName: "Max",
-			Handler:    _Math_Max_Handler,
-		},
-		{
-			MethodName: "Min",
-			Handler:    _Math_Min_Handler,
-		},
-		{
-			MethodName: "Multiply",
-			Handler:    _Math_Multiply_Handler,
-		},
-		{
-			MethodName: "Pow",
-			Handler:    _Math_Pow_Handler,
+			MethodName: "Format",
+			Handler:    _Symbolic_Format_Handler,
 		},
 		{
-			MethodName: "Subtract",
-			Handler:    _Math_Subtract_Handler,
+			MethodName: "Simplify",
+			Handler:    _Symbolic_Simplify_Handler,
 		},
 		{
-			MethodName: "Sum",
-			Handler:    _Math_Sum_Handler,
+			MethodName: "Differentiate",
+			Handler:    _Symbolic_Differentiate_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,219 @@
+// Package bench measures the throughput, latency and allocations of the
+// server implementations. Servers are started in-process, see Serve, and
+// driven by Run through the same clients used by package conformance.
+package bench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/conformance"
+	"github.com/jwenz723/mathserver/pkg/variants"
+	"google.golang.org/grpc"
+)
+
+// Transports are the transports a server can be benchmarked over.
+const (
+	GRPC = "grpc"
+	HTTP = "http"
+)
+
+// Config controls how a server is driven.
+type Config struct {
+	// Concurrency is the number of calls in flight at once, at least 1.
+	Concurrency int
+	// QPS limits the rate at which calls are started, 0 means no limit.
+	QPS float64
+	// Duration stops the run once it has elapsed, 0 means no limit.
+	Duration time.Duration
+	// Requests stops the run once that many calls were made, 0 means no limit.
+	// One of Duration and Requests must be set.
+	Requests int
+}
+
+// Result describes a single run. Latencies and elapsed time are encoded in
+// nanoseconds. Allocations are counted for the whole process, so they include
+// both the client and the in-process server.
+type Result struct {
+	Variant          string        `json:"variant"`
+	Transport        string        `json:"transport"`
+	Concurrency      int           `json:"concurrency"`
+	Requests         int           `json:"requests"`
+	Errors           int           `json:"errors"`
+	Elapsed          time.Duration `json:"elapsed_ns"`
+	Throughput       float64       `json:"requests_per_second"`
+	P50              time.Duration `json:"p50_ns"`
+	P95              time.Duration `json:"p95_ns"`
+	P99              time.Duration `json:"p99_ns"`
+	AllocsPerRequest float64       `json:"allocs_per_request"`
+	BytesPerRequest  float64       `json:"bytes_per_request"`
+	// Relative is the throughput relative to the fastest run, it's set by
+	// Compare.
+	Relative float64 `json:"relative_throughput"`
+}
+
+// Serve starts the server of v for transport on a loopback port and returns a
+// client connected to it, along with a function that stops the server.
+func Serve(v variants.Variant, transport string, statusErrors bool) (conformance.Client, func(), error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, nil, err
+	}
+	switch transport {
+	case GRPC:
+		s := grpc.NewServer(v.GRPCOptions...)
+		pb.RegisterMathServer(s, v.NewGRPCServer(statusErrors))
+		go s.Serve(lis)
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+		if err != nil {
+			s.Stop()
+			return nil, nil, err
+		}
+		return conformance.NewGRPCClient(conn), func() {
+			conn.Close()
+			s.Stop()
+		}, nil
+	case HTTP:
+		if v.HTTPHandler == nil {
+			lis.Close()
+			return nil, nil, fmt.Errorf("%s doesn't serve HTTP", v.Name)
+		}
+		s := &http.Server{Handler: v.HTTPHandler}
+		go s.Serve(lis)
+		client := &http.Client{Transport: &http.Transport{MaxIdleConnsPerHost: 1024}}
+		return conformance.NewHTTPClient("http://"+lis.Addr().String(), client), func() {
+			client.CloseIdleConnections()
+			s.Close()
+		}, nil
+	}
+	lis.Close()
+	return nil, nil, fmt.Errorf("unknown transport %q", transport)
+}
+
+// Run makes call using c as configured by cfg and measures the outcome. A
+// call that fails with an error returned by the service still counts as a
+// request, only calls that couldn't be made count as errors. Run fails
+// without making any call if cfg sets neither Duration nor Requests, as the
+// run would never stop.
+func Run(ctx context.Context, c conformance.Client, call conformance.Case, cfg Config) (Result, error) {
+	if cfg.Duration <= 0 && cfg.Requests <= 0 {
+		return Result{}, errors.New("one of Duration and Requests must be set")
+	}
+	if cfg.Concurrency < 1 {
+		cfg.Concurrency = 1
+	}
+	if cfg.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Duration)
+		defer cancel()
+	}
+
+	var tokens <-chan time.Time
+	if cfg.QPS > 0 {
+		t := time.NewTicker(time.Duration(float64(time.Second) / cfg.QPS))
+		defer t.Stop()
+		tokens = t.C
+	}
+
+	var (
+		wg        sync.WaitGroup
+		issued    int64
+		errs      int64
+		latencies = make([][]time.Duration, cfg.Concurrency)
+		before    runtime.MemStats
+		after     runtime.MemStats
+	)
+	runtime.ReadMemStats(&before)
+	begin := time.Now()
+	for i := 0; i < cfg.Concurrency; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for {
+				if tokens != nil {
+					select {
+					case <-tokens:
+					case <-ctx.Done():
+						return
+					}
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				if cfg.Requests > 0 && atomic.AddInt64(&issued, 1) > int64(cfg.Requests) {
+					return
+				}
+				start := time.Now()
+				_, err := c.Do(ctx, call)
+				if err != nil {
+					if ctx.Err() != nil {
+						// the call was cut short by the end of the run
+						return
+					}
+					atomic.AddInt64(&errs, 1)
+					continue
+				}
+				latencies[i] = append(latencies[i], time.Since(start))
+			}
+		}(i)
+	}
+	wg.Wait()
+	elapsed := time.Since(begin)
+	runtime.ReadMemStats(&after)
+
+	var all []time.Duration
+	for _, l := range latencies {
+		all = append(all, l...)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i] < all[j] })
+
+	r := Result{
+		Concurrency: cfg.Concurrency,
+		Requests:    len(all),
+		Errors:      int(errs),
+		Elapsed:     elapsed,
+		P50:         percentile(all, 50),
+		P95:         percentile(all, 95),
+		P99:         percentile(all, 99),
+	}
+	if n := float64(r.Requests + r.Errors); n > 0 {
+		r.Throughput = float64(r.Requests) / elapsed.Seconds()
+		r.AllocsPerRequest = float64(after.Mallocs-before.Mallocs) / n
+		r.BytesPerRequest = float64(after.TotalAlloc-before.TotalAlloc) / n
+	}
+	return r, nil
+}
+
+// percentile returns the p-th percentile of the sorted latencies using the
+// nearest-rank method.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// Compare sorts results from the highest throughput to the lowest and sets
+// their Relative throughput.
+func Compare(results []Result) {
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Throughput > results[j].Throughput })
+	if len(results) == 0 || results[0].Throughput == 0 {
+		return
+	}
+	for i := range results {
+		results[i].Relative = results[i].Throughput / results[0].Throughput
+	}
+}
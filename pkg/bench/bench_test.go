@@ -0,0 +1,82 @@
+package bench_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jwenz723/mathserver/pkg/bench"
+	"github.com/jwenz723/mathserver/pkg/conformance"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/variants"
+)
+
+var calls = []conformance.Case{
+	{Name: "divide", Method: "Divide", A: 7, B: 2},
+	{Name: "mean", Method: "Mean", Values: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+	{Name: "evaluate", Method: "Evaluate", Expression: "(3+4)*2^5/7"},
+}
+
+// BenchmarkVariants measures a call to every implementation over every
+// transport it serves. Run with -cpu to vary the number of concurrent calls.
+func BenchmarkVariants(b *testing.B) {
+	for _, v := range variants.All(precision.Precision{Mode: precision.Float64}, mathservice.PassNonFinite) {
+		for _, transport := range []string{bench.GRPC, bench.HTTP} {
+			if transport == bench.HTTP && v.HTTPHandler == nil {
+				continue
+			}
+			c, stop, err := bench.Serve(v, transport, false)
+			if err != nil {
+				b.Fatal(err)
+			}
+			for _, call := range calls {
+				call := call
+				b.Run(v.Name+"/"+transport+"/"+call.Name, func(b *testing.B) {
+					b.ReportAllocs()
+					b.RunParallel(func(pb *testing.PB) {
+						for pb.Next() {
+							if _, err := c.Do(context.Background(), call); err != nil {
+								b.Error(err)
+								return
+							}
+						}
+					})
+				})
+			}
+			stop()
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	v := variants.All(precision.Precision{Mode: precision.Float64}, mathservice.PassNonFinite)[0]
+	c, stop, err := bench.Serve(v, bench.GRPC, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stop()
+
+	r, err := bench.Run(context.Background(), c, calls[0], bench.Config{Concurrency: 4, Requests: 200})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Requests != 200 || r.Errors != 0 {
+		t.Errorf("got %d requests and %d errors, want 200 and 0", r.Requests, r.Errors)
+	}
+	if r.P50 <= 0 || r.P50 > r.P95 || r.P95 > r.P99 {
+		t.Errorf("latencies out of order: p50 %v, p95 %v, p99 %v", r.P50, r.P95, r.P99)
+	}
+
+	r, err = bench.Run(context.Background(), c, calls[0], bench.Config{Concurrency: 2, QPS: 100, Duration: 200 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Requests < 5 || r.Requests > 30 {
+		t.Errorf("got %d requests at 100 QPS over 200ms, want about 20", r.Requests)
+	}
+
+	if _, err := bench.Run(context.Background(), c, calls[0], bench.Config{Concurrency: 2}); err == nil {
+		t.Error("a run with neither a duration nor a number of requests didn't fail")
+	}
+}
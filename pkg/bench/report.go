@@ -0,0 +1,84 @@
+package bench
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// Formats are the formats results can be written in.
+const (
+	Text = "text"
+	CSV  = "csv"
+	JSON = "json"
+)
+
+// Write writes results to w in format.
+func Write(w io.Writer, format string, results []Result) error {
+	switch format {
+	case Text:
+		return WriteText(w, results)
+	case CSV:
+		return WriteCSV(w, results)
+	case JSON:
+		return WriteJSON(w, results)
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
+
+// WriteText writes results to w as an aligned comparison table.
+func WriteText(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(tw, "VARIANT\tTRANSPORT\tCONCURRENCY\tREQUESTS\tERRORS\tREQ/S\tP50\tP95\tP99\tALLOCS/REQ\tB/REQ\tRELATIVE")
+	for _, r := range results {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%.0f\t%s\t%s\t%s\t%.1f\t%.0f\t%.2f\n",
+			r.Variant, r.Transport, r.Concurrency, r.Requests, r.Errors, r.Throughput,
+			round(r.P50), round(r.P95), round(r.P99), r.AllocsPerRequest, r.BytesPerRequest, r.Relative)
+	}
+	return tw.Flush()
+}
+
+// WriteCSV writes results to w as CSV with a header row. Latencies are in
+// microseconds.
+func WriteCSV(w io.Writer, results []Result) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"variant", "transport", "concurrency", "requests", "errors", "requests_per_second",
+		"p50_us", "p95_us", "p99_us", "allocs_per_request", "bytes_per_request", "relative_throughput"})
+	for _, r := range results {
+		cw.Write([]string{
+			r.Variant,
+			r.Transport,
+			strconv.Itoa(r.Concurrency),
+			strconv.Itoa(r.Requests),
+			strconv.Itoa(r.Errors),
+			strconv.FormatFloat(r.Throughput, 'f', 1, 64),
+			micros(r.P50),
+			micros(r.P95),
+			micros(r.P99),
+			strconv.FormatFloat(r.AllocsPerRequest, 'f', 1, 64),
+			strconv.FormatFloat(r.BytesPerRequest, 'f', 0, 64),
+			strconv.FormatFloat(r.Relative, 'f', 3, 64),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes results to w as a JSON array.
+func WriteJSON(w io.Writer, results []Result) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(results)
+}
+
+func round(d time.Duration) time.Duration {
+	return d.Round(time.Microsecond)
+}
+
+func micros(d time.Duration) string {
+	return strconv.FormatFloat(float64(d)/float64(time.Microsecond), 'f', 1, 64)
+}
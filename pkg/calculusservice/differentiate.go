@@ -0,0 +1,100 @@
+package calculusservice
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Ridders' method shrinks the step by stepRatio at every iteration, and starts
+// over once the error grows by more than a factor divergence over the best
+// result.
+const (
+	stepRatio  = 1.4
+	divergence = 2
+)
+
+// differentiate returns the derivative at x with Ridders' method: central
+// differences with steps shrinking at every iteration are extrapolated to a
+// step of zero by Richardson's tableau, whose successive entries estimate the
+// error. When the extrapolation starts diverging before the tolerance is met,
+// as it does when the first step is too large for a fast changing function,
+// the tableau starts over from the last step. Every central difference
+// counts as an iteration.
+func (f *function) differentiate(x float64) (Result, error) {
+	if _, err := f.at(x); err != nil {
+		return Result{}, err
+	}
+	var (
+		h     = 0.1 * math.Max(1, math.Abs(x))
+		d     float64
+		err   error
+		tries int
+	)
+	// the first step is shrunk until it stays within the domain of the
+	// function, such as for ln(x) at 0.001
+	for d, err = f.centralDifference(x, h); errors.Is(err, ErrNonFiniteFunction) && tries < 20; tries++ {
+		h /= 10
+		d, err = f.centralDifference(x, h)
+	}
+	if err != nil {
+		return Result{}, err
+	}
+	// previous is the last row of the tableau, holding the difference with
+	// step h followed by its extrapolations
+	var (
+		previous = []float64{d}
+		best     = d
+		e        = math.Inf(1)
+	)
+	for i := 2; ; i++ {
+		if e <= f.allowed(best) {
+			return Result{V: best, Iterations: i - 1, ErrorEstimate: e}, nil
+		}
+		if err := f.iterate(i, best, e); err != nil {
+			return Result{}, err
+		}
+		h /= stepRatio
+		if x+h == x {
+			return Result{}, f.noConvergence(best, e)
+		}
+		d, err := f.centralDifference(x, h)
+		if err != nil {
+			return Result{}, err
+		}
+		current := make([]float64, len(previous)+1)
+		current[0] = d
+		factor := stepRatio * stepRatio
+		for j := 1; j < len(current); j++ {
+			current[j] = (current[j-1]*factor - previous[j-1]) / (factor - 1)
+			factor *= stepRatio * stepRatio
+			errj := math.Max(math.Abs(current[j]-current[j-1]), math.Abs(current[j]-previous[j-1]))
+			if errj <= e {
+				e, best = errj, current[j]
+			}
+		}
+		if n := len(previous); math.Abs(current[n]-previous[n-1]) >= divergence*e {
+			// higher orders only add rounding errors from here on, the
+			// tableau starts over from this step
+			current = current[:1]
+		}
+		previous = current
+	}
+}
+
+// centralDifference approximates the derivative at x with a step of h.
+func (f *function) centralDifference(x, h float64) (float64, error) {
+	upper, err := f.at(x + h)
+	if err != nil {
+		return 0, err
+	}
+	lower, err := f.at(x - h)
+	if err != nil {
+		return 0, err
+	}
+	d := (upper - lower) / ((x + h) - (x - h))
+	if math.IsInf(d, 0) {
+		return 0, fmt.Errorf("%w, its slope overflows at x=%g", ErrNonFiniteFunction, x)
+	}
+	return d, nil
+}
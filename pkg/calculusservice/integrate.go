@@ -0,0 +1,223 @@
+package calculusservice
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+)
+
+// The nodes and weights of the 15 point Kronrod rule on [-1, 1], and of the
+// 7 point Gauss rule whose nodes are the odd ones, as in QUADPACK. Only the
+// nodes in [0, 1] are listed, the rules being symmetric.
+var (
+	kronrodNodes = [8]float64{
+		0.991455371120812639206854697526329,
+		0.949107912342758524526189684047851,
+		0.864864423359769072789712788640926,
+		0.741531185599394439863864773280788,
+		0.586087235467691130294144845693013,
+		0.405845151377397166906606412076961,
+		0.207784955007898467600689403773245,
+		0,
+	}
+	kronrodWeights = [8]float64{
+		0.022935322010529224963732008058970,
+		0.063092092629978553290700663189204,
+		0.104790010322250183839876322541518,
+		0.140653259715525918745189590510238,
+		0.169004726639267902826583426598550,
+		0.190350578064785409913256402421014,
+		0.204432940075298892414161999234649,
+		0.209482141084727828012999174891714,
+	}
+	gaussWeights = [4]float64{
+		0.129484966168869693270611432679082,
+		0.279705391489276667901467771423780,
+		0.381830050505118944950369775488975,
+		0.417959183673469387755102040816327,
+	}
+)
+
+// subinterval is a part of the interval of integration along with its
+// integral and the estimated error of it.
+type subinterval struct {
+	a, b, v, err float64
+}
+
+// subintervals is a max-heap of subintervals by estimated error.
+type subintervals []subinterval
+
+func (s subintervals) Len() int            { return len(s) }
+func (s subintervals) Less(i, j int) bool  { return s[i].err > s[j].err }
+func (s subintervals) Swap(i, j int)       { s[i], s[j] = s[j], s[i] }
+func (s *subintervals) Push(x interface{}) { *s = append(*s, x.(subinterval)) }
+func (s *subintervals) Pop() interface{} {
+	old := *s
+	x := old[len(old)-1]
+	*s = old[:len(old)-1]
+	return x
+}
+
+// integrate integrates adaptively from a to b: the subinterval with the
+// largest error estimate is bisected until the estimates add up to less than
+// the error allowed. Every subinterval counts as an iteration.
+func (f *function) integrate(a, b float64) (Result, error) {
+	if a == b {
+		return Result{}, nil
+	}
+	sign := 1.0
+	if a > b {
+		a, b, sign = b, a, -1
+	}
+	rule := f.gaussKronrod
+	if f.method == Simpson {
+		rule = f.simpson
+	}
+
+	whole, err := rule(a, b)
+	if err != nil {
+		return Result{}, err
+	}
+	if err := overflows(whole.v); err != nil {
+		return Result{}, err
+	}
+	var (
+		parts = subintervals{whole}
+		v     = whole.v
+		e     = whole.err
+	)
+	for e > f.allowed(v) {
+		if err := f.iterate(len(parts)+1, sign*v, e); err != nil {
+			return Result{}, err
+		}
+		worst := heap.Pop(&parts).(subinterval)
+		m := worst.a + (worst.b-worst.a)/2
+		if m <= worst.a || m >= worst.b {
+			// the subinterval can't be split any further, rounding errors
+			// keep the tolerance out of reach
+			return Result{}, f.noConvergence(sign*v, e)
+		}
+		left, err := rule(worst.a, m)
+		if err != nil {
+			return Result{}, err
+		}
+		right, err := rule(m, worst.b)
+		if err != nil {
+			return Result{}, err
+		}
+		heap.Push(&parts, left)
+		heap.Push(&parts, right)
+		v += left.v + right.v - worst.v
+		e += left.err + right.err - worst.err
+		if err := overflows(v); err != nil {
+			return Result{}, err
+		}
+	}
+	// the running sums are only used to decide when to stop, the result is
+	// summed afresh to leave out their rounding errors
+	v, e = 0, 0
+	for _, p := range parts {
+		v += p.v
+		e += p.err
+	}
+	return Result{V: sign * v, Iterations: len(parts), ErrorEstimate: e}, nil
+}
+
+// overflows fails with ErrNonFiniteFunction if the integral v, of a function
+// whose values are all finite, isn't.
+func overflows(v float64) error {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return fmt.Errorf("%w, its integral overflows", ErrNonFiniteFunction)
+	}
+	return nil
+}
+
+// gaussKronrod integrates from a to b with the 15 point Kronrod rule, and
+// estimates its error from the difference with the 7 point Gauss rule, the
+// way QUADPACK does.
+func (f *function) gaussKronrod(a, b float64) (subinterval, error) {
+	var (
+		center     = a + (b-a)/2
+		halfLength = (b - a) / 2
+		// values holds the function at the nodes in pairs, the center twice
+		values  [16]float64
+		kronrod float64
+		gauss   float64
+		abs     float64
+	)
+	for i, node := range kronrodNodes {
+		x := halfLength * node
+		lower, err := f.at(center - x)
+		if err != nil {
+			return subinterval{}, err
+		}
+		upper := lower
+		if node != 0 {
+			if upper, err = f.at(center + x); err != nil {
+				return subinterval{}, err
+			}
+		}
+		values[2*i], values[2*i+1] = lower, upper
+		w := kronrodWeights[i]
+		if node == 0 {
+			kronrod += w * lower
+			abs += w * math.Abs(lower)
+		} else {
+			kronrod += w * (lower + upper)
+			abs += w * (math.Abs(lower) + math.Abs(upper))
+		}
+		if i%2 == 1 {
+			if node == 0 {
+				gauss += gaussWeights[i/2] * lower
+			} else {
+				gauss += gaussWeights[i/2] * (lower + upper)
+			}
+		}
+	}
+	mean := kronrod / 2
+	var asc float64
+	for i, w := range kronrodWeights {
+		if kronrodNodes[i] == 0 {
+			asc += w * math.Abs(values[2*i]-mean)
+		} else {
+			asc += w * (math.Abs(values[2*i]-mean) + math.Abs(values[2*i+1]-mean))
+		}
+	}
+
+	kronrod *= halfLength
+	abs *= math.Abs(halfLength)
+	asc *= math.Abs(halfLength)
+	e := math.Abs(kronrod - gauss*halfLength)
+	if asc != 0 && e != 0 {
+		e = asc * math.Min(1, math.Pow(200*e/asc, 1.5))
+	}
+	if roundoff := 50 * epsilon * abs; abs > math.SmallestNonzeroFloat64/(50*epsilon) && roundoff > e {
+		e = roundoff
+	}
+	return subinterval{a: a, b: b, v: kronrod, err: e}, nil
+}
+
+// simpson integrates from a to b with Simpson's rule on both halves of the
+// interval, and improves the result by Richardson extrapolation with the
+// rule on the whole interval, whose difference estimates the error.
+func (f *function) simpson(a, b float64) (subinterval, error) {
+	var values [5]float64
+	for i := range values {
+		x := a + (b-a)*float64(i)/4
+		if i == 4 {
+			x = b
+		}
+		v, err := f.at(x)
+		if err != nil {
+			return subinterval{}, err
+		}
+		values[i] = v
+	}
+	h := b - a
+	whole := h / 6 * (values[0] + 4*values[2] + values[4])
+	halves := h / 12 * (values[0] + 4*values[1] + 2*values[2] + 4*values[3] + values[4])
+	return subinterval{a: a, b: b, v: halves + (halves-whole)/15, err: math.Abs(halves-whole) / 15}, nil
+}
+
+// epsilon is the difference between 1 and the next float64.
+const epsilon = 2.220446049250313e-16
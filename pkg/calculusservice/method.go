@@ -0,0 +1,75 @@
+package calculusservice
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Method selects the algorithm of a method of the Service.
+type Method int
+
+const (
+	// Default picks the algorithm each method uses unless told otherwise:
+	// GaussKronrod for Integrate, Brent for FindRoot and GoldenSection for
+	// Minimize. Differentiate always uses Richardson extrapolation.
+	Default Method = iota
+	// GaussKronrod integrates with the 7 point Gauss and 15 point Kronrod
+	// rules, bisecting the subinterval with the largest error estimate.
+	GaussKronrod
+	// Simpson integrates like GaussKronrod, with Simpson's rule and its
+	// Richardson extrapolation.
+	Simpson
+	// Brent finds a root with Brent's method, combining bisection, the secant
+	// method and inverse quadratic interpolation.
+	Brent
+	// Bisection finds a root by halving the interval.
+	Bisection
+	// Newton finds a root with Newton's method, using a numerical derivative
+	// and bisecting instead when a step would leave the bracket.
+	Newton
+	// GoldenSection minimizes by narrowing the interval by the golden ratio.
+	GoldenSection
+)
+
+var methodNames = map[Method]string{
+	Default:       "default",
+	GaussKronrod:  "gauss_kronrod",
+	Simpson:       "simpson",
+	Brent:         "brent",
+	Bisection:     "bisection",
+	Newton:        "newton",
+	GoldenSection: "golden_section",
+}
+
+func (m Method) String() string {
+	if s, ok := methodNames[m]; ok {
+		return s
+	}
+	return fmt.Sprintf("Method(%d)", int(m))
+}
+
+// ParseMethod returns the Method named by s, e.g. "simpson".
+func ParseMethod(s string) (Method, error) {
+	for m, name := range methodNames {
+		if strings.EqualFold(s, name) {
+			return m, nil
+		}
+	}
+	return Default, fmt.Errorf("unknown method %q", s)
+}
+
+// MarshalText implements encoding.TextMarshaler so methods are encoded by
+// name in JSON.
+func (m Method) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *Method) UnmarshalText(text []byte) error {
+	v, err := ParseMethod(string(text))
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
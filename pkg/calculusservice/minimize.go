@@ -0,0 +1,52 @@
+package calculusservice
+
+import (
+	"math"
+)
+
+// invPhi is the inverse of the golden ratio.
+var invPhi = (math.Sqrt(5) - 1) / 2
+
+// minimize returns where the function has a local minimum between a and b
+// with golden-section search: of the two points dividing the interval in the
+// golden ratio, the one with the larger value becomes an end of the interval,
+// so that the other divides the new interval in the same ratio. Every
+// narrowing of the interval counts as an iteration.
+func (f *function) minimize(a, b float64) (Result, error) {
+	x1 := b - invPhi*(b-a)
+	x2 := a + invPhi*(b-a)
+	f1, err := f.at(x1)
+	if err != nil {
+		return Result{}, err
+	}
+	f2, err := f.at(x2)
+	if err != nil {
+		return Result{}, err
+	}
+	for i := 1; ; i++ {
+		x, fx := x1, f1
+		if f2 < f1 {
+			x, fx = x2, f2
+		}
+		e := (b - a) / 2
+		if e <= f.allowed(x) {
+			return Result{V: x, FX: fx, Iterations: i - 1, ErrorEstimate: e}, nil
+		}
+		if err := f.iterate(i, x, e); err != nil {
+			return Result{}, err
+		}
+		if f1 <= f2 {
+			b, x2, f2 = x2, x1, f1
+			x1 = b - invPhi*(b-a)
+			if f1, err = f.at(x1); err != nil {
+				return Result{}, err
+			}
+		} else {
+			a, x1, f1 = x1, x2, f2
+			x2 = a + invPhi*(b-a)
+			if f2, err = f.at(x2); err != nil {
+				return Result{}, err
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package calculusservice
+
+import (
+	"github.com/jwenz723/mathserver/pb"
+)
+
+// ProblemFromProto converts a gRPC calculus request to a Problem.
+func ProblemFromProto(r *pb.CalculusRequest) Problem {
+	return Problem{
+		Expression:    r.GetExpression(),
+		A:             r.GetA(),
+		B:             r.GetB(),
+		X:             r.GetX(),
+		Method:        Method(r.GetMethod()),
+		Tolerance:     r.GetTolerance(),
+		MaxIterations: int(r.GetMaxIterations()),
+	}
+}
+
+// Proto converts p to a gRPC calculus request. A negative MaxIterations
+// can't be sent and becomes the largest uint32, which is more than allowed.
+func (p Problem) Proto() *pb.CalculusRequest {
+	return &pb.CalculusRequest{
+		Expression:    p.Expression,
+		A:             p.A,
+		B:             p.B,
+		X:             p.X,
+		Method:        pb.CalculusRequest_Method(p.Method),
+		Tolerance:     p.Tolerance,
+		MaxIterations: uint32(p.MaxIterations),
+	}
+}
+
+// ResultFromProto converts the result of a gRPC calculus reply to a Result.
+func ResultFromProto(r *pb.CalculusReply) Result {
+	return Result{
+		V:             r.GetV(),
+		FX:            r.GetFx(),
+		Iterations:    int(r.GetIterations()),
+		ErrorEstimate: r.GetErrorEstimate(),
+	}
+}
+
+// Proto converts r to a gRPC calculus reply without an error.
+func (r Result) Proto() *pb.CalculusReply {
+	return &pb.CalculusReply{
+		V:             r.V,
+		Fx:            r.FX,
+		Iterations:    uint32(r.Iterations),
+		ErrorEstimate: r.ErrorEstimate,
+	}
+}
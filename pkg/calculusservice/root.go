@@ -0,0 +1,203 @@
+package calculusservice
+
+import (
+	"fmt"
+	"math"
+)
+
+// findRoot returns a root between a and b, where the function must change
+// sign, with the method of f. Every evaluation of the function but those at a
+// and b counts as an iteration, except for Newton's method where it's every
+// step.
+func (f *function) findRoot(a, b float64) (Result, error) {
+	fa, err := f.at(a)
+	if err != nil {
+		return Result{}, err
+	}
+	fb, err := f.at(b)
+	if err != nil {
+		return Result{}, err
+	}
+	switch {
+	case fa == 0:
+		return Result{V: a}, nil
+	case fb == 0:
+		return Result{V: b}, nil
+	case math.Signbit(fa) == math.Signbit(fb):
+		return Result{}, fmt.Errorf("%w, it's %g at %g and %g at %g", ErrNoBracket, fa, a, fb, b)
+	}
+	switch f.method {
+	case Bisection:
+		return f.bisection(a, b, fa)
+	case Newton:
+		return f.newton(a, b, fa)
+	}
+	return f.brent(a, b, fa, fb)
+}
+
+func (f *function) bisection(a, b, fa float64) (Result, error) {
+	for i := 1; ; i++ {
+		m := a + (b-a)/2
+		e := (b - a) / 2
+		if e <= f.allowed(m) || m <= a || m >= b {
+			fm, err := f.at(m)
+			return Result{V: m, FX: fm, Iterations: i, ErrorEstimate: e}, err
+		}
+		if err := f.iterate(i, m, e); err != nil {
+			return Result{}, err
+		}
+		fm, err := f.at(m)
+		if err != nil {
+			return Result{}, err
+		}
+		if fm == 0 {
+			return Result{V: m, Iterations: i}, nil
+		}
+		if math.Signbit(fm) == math.Signbit(fa) {
+			a, fa = m, fm
+		} else {
+			b = m
+		}
+	}
+}
+
+// brent is Brent's method as described in Numerical Recipes: b is the best
+// estimate of the root, which is bracketed by b and c, and a is the previous
+// estimate.
+func (f *function) brent(a, b, fa, fb float64) (Result, error) {
+	c, fc := b, fb
+	var d, e float64
+	for i := 1; ; i++ {
+		if math.Signbit(fb) == math.Signbit(fc) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		tol := 2*epsilon*math.Abs(b) + f.allowed(b)/2
+		m := (c - b) / 2
+		if math.Abs(m) <= tol || fb == 0 {
+			return Result{V: b, FX: fb, Iterations: i - 1, ErrorEstimate: math.Abs(m)}, nil
+		}
+		if err := f.iterate(i, b, math.Abs(m)); err != nil {
+			return Result{}, err
+		}
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			// interpolate, with the secant method when a and c are the same
+			// point and inverse quadratic interpolation otherwise
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*m*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = math.Abs(p)
+			if 2*p < math.Min(3*m*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e, d = d, p/q
+			} else {
+				d, e = m, m
+			}
+		} else {
+			d, e = m, m
+		}
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, m)
+		}
+		var err error
+		if fb, err = f.at(b); err != nil {
+			return Result{}, err
+		}
+	}
+}
+
+// newton is Newton's method safeguarded by bisection as described in
+// Numerical Recipes: a step leaving the bracket [low, high], or not shrinking
+// fast enough, is replaced by bisecting the bracket. As the derivative is
+// numerical, a step small enough is only taken for convergence once the
+// function is seen changing sign within the tolerance around the root.
+func (f *function) newton(a, b, fa float64) (Result, error) {
+	low, high := a, b
+	if fa > 0 {
+		low, high = b, a
+	}
+	var (
+		x      = a + (b-a)/2
+		dxPrev = b - a
+		dx     = dxPrev
+		bisect bool
+	)
+	fx, err := f.at(x)
+	if err != nil {
+		return Result{}, err
+	}
+	for i := 1; ; i++ {
+		if fx == 0 {
+			return Result{V: x, Iterations: i - 1}, nil
+		}
+		if err := f.iterate(i, x, math.Abs(dx)); err != nil {
+			return Result{}, err
+		}
+		df, err := f.centralDifference(x, math.Cbrt(epsilon)*math.Max(1, math.Abs(x)))
+		if err != nil {
+			return Result{}, err
+		}
+		if bisect || ((x-high)*df-fx)*((x-low)*df-fx) > 0 || math.Abs(2*fx) > math.Abs(dxPrev*df) {
+			dxPrev, dx = dx, (high-low)/2
+			x = low + dx
+		} else {
+			dxPrev, dx = dx, fx/df
+			x -= dx
+		}
+		if fx, err = f.at(x); err != nil {
+			return Result{}, err
+		}
+		if fx < 0 {
+			low = x
+		} else {
+			high = x
+		}
+		bisect = false
+		if math.Abs(dx) <= f.allowed(x) {
+			ok, err := f.changesSign(x, fx, f.allowed(x))
+			if err != nil {
+				return Result{}, err
+			}
+			if ok {
+				return Result{V: x, FX: fx, Iterations: i, ErrorEstimate: f.allowed(x)}, nil
+			}
+			bisect = true
+		}
+	}
+}
+
+// changesSign reports whether the function, which is fx at x, is zero at x or
+// changes sign between x-delta and x+delta.
+func (f *function) changesSign(x, fx, delta float64) (bool, error) {
+	if fx == 0 {
+		return true, nil
+	}
+	for _, y := range []float64{x - delta, x + delta} {
+		fy, err := f.at(y)
+		if err != nil {
+			return false, err
+		}
+		if fy == 0 || math.Signbit(fy) != math.Signbit(fx) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
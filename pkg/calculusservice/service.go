@@ -0,0 +1,239 @@
+// Package calculusservice is the core of the Calculus service, the numerical
+// calculus of a function written as an expression of x.
+package calculusservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jwenz723/mathserver/pkg/expr"
+)
+
+// Service describes a service that computes numerically with functions.
+// Implementations may be wrapped by a Middleware, e.g. to log and measure each
+// call.
+//
+// Functions are parsed with package expr and evaluated with expr.Float, so
+// that the thousands of evaluations a method may need aren't each observed
+// as a call of the Math service. Every method stops after the iterations
+// allowed by the request, or when the context of the call is done.
+type Service interface {
+	// Integrate returns the integral of the function from A to B
+	Integrate(ctx context.Context, p Problem) (Result, error)
+	// Differentiate returns the derivative of the function at X
+	Differentiate(ctx context.Context, p Problem) (Result, error)
+	// FindRoot returns a root of the function between A and B
+	FindRoot(ctx context.Context, p Problem) (Result, error)
+	// Minimize returns where the function has a local minimum between A and B
+	Minimize(ctx context.Context, p Problem) (Result, error)
+}
+
+// Middleware describes a service (as opposed to endpoint) middleware.
+type Middleware func(Service) Service
+
+// Errors returned by the basic Service, which transports map to their wire
+// representation, see pb.ErrorCode. A Problem whose expression can't be
+// parsed fails with an expr.SyntaxError, and a call whose context is done
+// with the error of the context.
+var (
+	ErrNoConvergence     = errors.New("did not converge")
+	ErrInvalidTolerance  = errors.New("tolerance must not be negative or NaN")
+	ErrInvalidIterations = fmt.Errorf("max iterations must be between 0 and %d", MaxIterations)
+	ErrInvalidInterval   = errors.New("invalid interval")
+	ErrNoBracket         = errors.New("the function must have opposite signs at a and b")
+	ErrNonFiniteFunction = errors.New("the function is not finite")
+	ErrInvalidMethod     = errors.New("invalid method")
+)
+
+const (
+	// DefaultTolerance is the tolerance of a Problem that has none.
+	DefaultTolerance = 1e-10
+	// DefaultMaxIterations is the iterations allowed to a Problem that
+	// doesn't set them.
+	DefaultMaxIterations = 1000
+	// MaxIterations is the most iterations a Problem may allow.
+	MaxIterations = 100000
+)
+
+// Problem is the operands of every method. Expression is a function of x.
+// Integrate, FindRoot and Minimize work on the interval from A to B and
+// Differentiate at X. Tolerance is the error allowed in the result, relative
+// to its magnitude when that's more than 1, DefaultTolerance when it's 0.
+// MaxIterations is the most iterations the method may use,
+// DefaultMaxIterations when it's 0.
+type Problem struct {
+	Expression    string  `json:"expression"`
+	A             float64 `json:"a"`
+	B             float64 `json:"b"`
+	X             float64 `json:"x"`
+	Method        Method  `json:"method"`
+	Tolerance     float64 `json:"tolerance"`
+	MaxIterations int     `json:"max_iterations"`
+}
+
+// Result is the result V of a method: the integral, the derivative, the root
+// or where the minimum is. FX is the value of the function at V for FindRoot
+// and Minimize. ErrorEstimate estimates the absolute error of V.
+type Result struct {
+	V             float64 `json:"v"`
+	FX            float64 `json:"fx"`
+	Iterations    int     `json:"iterations"`
+	ErrorEstimate float64 `json:"error_estimate"`
+}
+
+// NewBasicService returns a naïve, stateless implementation of Service.
+func NewBasicService() Service {
+	return basicService{}
+}
+
+type basicService struct{}
+
+func (s basicService) Integrate(ctx context.Context, p Problem) (Result, error) {
+	f, err := newFunction(ctx, p, Default, GaussKronrod, Simpson)
+	if err != nil {
+		return Result{}, err
+	}
+	if err := finite(p.A, p.B); err != nil {
+		return Result{}, err
+	}
+	return f.integrate(p.A, p.B)
+}
+
+func (s basicService) Differentiate(ctx context.Context, p Problem) (Result, error) {
+	f, err := newFunction(ctx, p, Default)
+	if err != nil {
+		return Result{}, err
+	}
+	if math.IsNaN(p.X) || math.IsInf(p.X, 0) {
+		return Result{}, fmt.Errorf("%w, x must be finite", ErrInvalidInterval)
+	}
+	return f.differentiate(p.X)
+}
+
+func (s basicService) FindRoot(ctx context.Context, p Problem) (Result, error) {
+	f, err := newFunction(ctx, p, Default, Brent, Bisection, Newton)
+	if err != nil {
+		return Result{}, err
+	}
+	if err := ordered(p.A, p.B); err != nil {
+		return Result{}, err
+	}
+	return f.findRoot(p.A, p.B)
+}
+
+func (s basicService) Minimize(ctx context.Context, p Problem) (Result, error) {
+	f, err := newFunction(ctx, p, Default, GoldenSection)
+	if err != nil {
+		return Result{}, err
+	}
+	if err := ordered(p.A, p.B); err != nil {
+		return Result{}, err
+	}
+	return f.minimize(p.A, p.B)
+}
+
+// finite fails with ErrInvalidInterval unless a and b are finite.
+func finite(a, b float64) error {
+	for _, v := range []float64{a, b} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("%w, a and b must be finite", ErrInvalidInterval)
+		}
+	}
+	return nil
+}
+
+// ordered fails with ErrInvalidInterval unless a and b are finite and a is
+// less than b.
+func ordered(a, b float64) error {
+	if err := finite(a, b); err != nil {
+		return err
+	}
+	if !(a < b) {
+		return fmt.Errorf("%w, a must be less than b", ErrInvalidInterval)
+	}
+	return nil
+}
+
+// function is the function of a Problem along with the settings of the
+// method computing with it.
+type function struct {
+	ctx           context.Context
+	f             *expr.Func
+	method        Method
+	tolerance     float64
+	maxIterations int
+}
+
+// newFunction parses the expression of p and checks its settings, the
+// method must be one of methods.
+func newFunction(ctx context.Context, p Problem, methods ...Method) (*function, error) {
+	ok := false
+	for _, m := range methods {
+		ok = ok || p.Method == m
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w %v, this method uses one of %v", ErrInvalidMethod, p.Method, methods[1:])
+	}
+	if p.Tolerance < 0 || math.IsNaN(p.Tolerance) {
+		return nil, ErrInvalidTolerance
+	}
+	if p.MaxIterations < 0 || p.MaxIterations > MaxIterations {
+		return nil, ErrInvalidIterations
+	}
+	f, err := expr.ParseFunc(p.Expression, "x")
+	if err != nil {
+		return nil, err
+	}
+	fn := &function{
+		ctx:           ctx,
+		f:             f,
+		method:        p.Method,
+		tolerance:     p.Tolerance,
+		maxIterations: p.MaxIterations,
+	}
+	if fn.tolerance == 0 {
+		fn.tolerance = DefaultTolerance
+	}
+	if fn.maxIterations == 0 {
+		fn.maxIterations = DefaultMaxIterations
+	}
+	return fn, nil
+}
+
+// at returns the value of the function at x, failing with
+// ErrNonFiniteFunction if it's NaN or infinite.
+func (f *function) at(x float64) (float64, error) {
+	v, err := f.f.Eval(f.ctx, expr.Float, x)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("%w at x=%g, it's %v", ErrNonFiniteFunction, x, v)
+	}
+	return v, nil
+}
+
+// allowed returns the error allowed in a result of magnitude v.
+func (f *function) allowed(v float64) float64 {
+	return f.tolerance * math.Max(1, math.Abs(v))
+}
+
+// iterate starts iteration i, failing once the call is canceled or i is more
+// than the iterations allowed.
+func (f *function) iterate(i int, estimate, errorEstimate float64) error {
+	if err := f.ctx.Err(); err != nil {
+		return fmt.Errorf("%w after %d iterations", err, i-1)
+	}
+	if i > f.maxIterations {
+		return f.noConvergence(estimate, errorEstimate)
+	}
+	return nil
+}
+
+// noConvergence returns the ErrNoConvergence of a method whose best result
+// is estimate, with the given error estimate.
+func (f *function) noConvergence(estimate, errorEstimate float64) error {
+	return fmt.Errorf("%w within %d iterations, the best estimate %g has an estimated error of %g", ErrNoConvergence, f.maxIterations, estimate, errorEstimate)
+}
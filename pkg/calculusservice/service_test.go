@@ -0,0 +1,62 @@
+package calculusservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pkg/calculusservice"
+	"github.com/jwenz723/mathserver/pkg/expr"
+)
+
+func TestService(t *testing.T) {
+	svc := calculusservice.NewBasicService()
+	on := func(expression string, a, b float64, m calculusservice.Method) calculusservice.Problem {
+		return calculusservice.Problem{Expression: expression, A: a, B: b, Method: m}
+	}
+	for _, tc := range []struct {
+		name string
+		call func(context.Context, calculusservice.Problem) (calculusservice.Result, error)
+		p    calculusservice.Problem
+		want float64
+		err  error
+	}{
+		{"integrate", svc.Integrate, on("x^2", 0, 3, calculusservice.Default), 9, nil},
+		{"integrate simpson", svc.Integrate, on("sin(x)", 0, math.Pi, calculusservice.Simpson), 2, nil},
+		{"integrate reversed", svc.Integrate, on("x", 2, 0, calculusservice.GaussKronrod), -2, nil},
+		{"integrate infinite interval", svc.Integrate, on("x", 0, math.Inf(1), calculusservice.Default), 0, calculusservice.ErrInvalidInterval},
+		{"integrate with brent", svc.Integrate, on("x", 0, 1, calculusservice.Brent), 0, calculusservice.ErrInvalidMethod},
+		{"differentiate", svc.Differentiate, calculusservice.Problem{Expression: "x^3", X: 2}, 12, nil},
+		{"differentiate nan", svc.Differentiate, calculusservice.Problem{Expression: "x", X: math.NaN()}, 0, calculusservice.ErrInvalidInterval},
+		{"find root", svc.FindRoot, on("x^2-2", 0, 2, calculusservice.Default), math.Sqrt2, nil},
+		{"find root bisection", svc.FindRoot, on("x^2-2", 0, 2, calculusservice.Bisection), math.Sqrt2, nil},
+		{"find root newton", svc.FindRoot, on("x^2-2", 0, 2, calculusservice.Newton), math.Sqrt2, nil},
+		{"find root no bracket", svc.FindRoot, on("x^2+1", -1, 1, calculusservice.Default), 0, calculusservice.ErrNoBracket},
+		{"find root unordered", svc.FindRoot, on("x", 1, -1, calculusservice.Default), 0, calculusservice.ErrInvalidInterval},
+		{"minimize", svc.Minimize, on("(x-1)^2", -3, 4, calculusservice.Default), 1, nil},
+		{"invalid tolerance", svc.Integrate, calculusservice.Problem{Expression: "x", B: 1, Tolerance: -1}, 0, calculusservice.ErrInvalidTolerance},
+		{"invalid iterations", svc.Integrate, calculusservice.Problem{Expression: "x", B: 1, MaxIterations: calculusservice.MaxIterations + 1}, 0, calculusservice.ErrInvalidIterations},
+		{"too few iterations", svc.FindRoot, calculusservice.Problem{Expression: "x^2-2", B: 2, Method: calculusservice.Bisection, MaxIterations: 3}, 0, calculusservice.ErrNoConvergence},
+		{"syntax error", svc.Integrate, on("x+", 0, 1, calculusservice.Default), 0, expr.ErrSyntax},
+	} {
+		r, err := tc.call(context.Background(), tc.p)
+		switch {
+		case !errors.Is(err, tc.err) || (tc.err == nil && err != nil):
+			t.Errorf("%s: got %v, want %v", tc.name, err, tc.err)
+		case err == nil && math.Abs(r.V-tc.want) > 1e-8*math.Max(1, math.Abs(tc.want)):
+			t.Errorf("%s: got %v, want %v", tc.name, r.V, tc.want)
+		}
+	}
+}
+
+func TestParseMethod(t *testing.T) {
+	for _, m := range []calculusservice.Method{calculusservice.Default, calculusservice.GaussKronrod, calculusservice.Simpson, calculusservice.Brent, calculusservice.Bisection, calculusservice.Newton, calculusservice.GoldenSection} {
+		if got, err := calculusservice.ParseMethod(m.String()); err != nil || got != m {
+			t.Errorf("ParseMethod(%q) = %v, %v", m.String(), got, err)
+		}
+	}
+	if _, err := calculusservice.ParseMethod("trapezoid"); err == nil {
+		t.Error("ParseMethod(\"trapezoid\") succeeded")
+	}
+}
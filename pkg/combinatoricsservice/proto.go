@@ -0,0 +1,15 @@
+package combinatoricsservice
+
+import (
+	"github.com/jwenz723/mathserver/pb"
+)
+
+// OperandsFromProto converts a gRPC combinatorics request to Operands.
+func OperandsFromProto(r *pb.CombinatoricsRequest) Operands {
+	return Operands{N: r.GetN(), K: r.GetK()}
+}
+
+// Proto converts o to a gRPC combinatorics request.
+func (o Operands) Proto() *pb.CombinatoricsRequest {
+	return &pb.CombinatoricsRequest{N: o.N, K: o.K}
+}
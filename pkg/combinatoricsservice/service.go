@@ -0,0 +1,149 @@
+// Package combinatoricsservice is the core of the Combinatorics service, exact
+// counting.
+package combinatoricsservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Service describes a service that counts exactly. Implementations may be
+// wrapped by a Middleware, e.g. to log and measure each call.
+//
+// Results are computed with big.Int and returned as decimal strings, since
+// they quickly outgrow a float64: 171! already does. The number of digits of
+// a result is limited by the server, and a result that would be too long is
+// rejected before it's computed.
+type Service interface {
+	// Factorial returns n!
+	Factorial(ctx context.Context, o Operands) (string, error)
+	// Binomial returns the number of ways of choosing k of n items
+	Binomial(ctx context.Context, o Operands) (string, error)
+	// Permutations returns the number of ordered arrangements of k of n items
+	Permutations(ctx context.Context, o Operands) (string, error)
+}
+
+// Middleware describes a service (as opposed to endpoint) middleware.
+type Middleware func(Service) Service
+
+// Errors returned by the basic Service, which transports map to their wire
+// representation, see pb.ErrorCode.
+var (
+	ErrNegativeOperand = errors.New("n and k must not be negative")
+	ErrTooManyDigits   = errors.New("result has too many digits")
+)
+
+// DefaultMaxDigits is the number of digits a result may have when the server
+// doesn't configure it, enough for 3248!.
+const DefaultMaxDigits = 10000
+
+// Operands are the operands of every method, Factorial ignores K.
+type Operands struct {
+	N int64 `json:"n"`
+	K int64 `json:"k"`
+}
+
+// NewBasicService returns a naïve, stateless implementation of Service whose
+// results have at most maxDigits decimal digits, DefaultMaxDigits if it's 0
+// or less.
+func NewBasicService(maxDigits int) Service {
+	if maxDigits <= 0 {
+		maxDigits = DefaultMaxDigits
+	}
+	return basicService{maxDigits: maxDigits}
+}
+
+type basicService struct {
+	maxDigits int
+}
+
+func (s basicService) Factorial(ctx context.Context, o Operands) (string, error) {
+	if o.N < 0 {
+		return "", ErrNegativeOperand
+	}
+	if err := s.estimate(lnFactorial(o.N)); err != nil {
+		return "", err
+	}
+	return s.result(new(big.Int).MulRange(1, o.N))
+}
+
+// Binomial is 0 when k is greater than n, there's no way of choosing more
+// items than there are.
+func (s basicService) Binomial(ctx context.Context, o Operands) (string, error) {
+	if o.N < 0 || o.K < 0 {
+		return "", ErrNegativeOperand
+	}
+	if o.K > o.N {
+		return "0", nil
+	}
+	j := o.K
+	if o.N-o.K < j {
+		j = o.N - o.K
+	}
+	if err := s.estimate(lnFactorialRatio(o.N, o.N-j) - lnFactorial(j)); err != nil {
+		return "", err
+	}
+	return s.result(new(big.Int).Binomial(o.N, o.K))
+}
+
+// Permutations is 0 when k is greater than n, like Binomial.
+func (s basicService) Permutations(ctx context.Context, o Operands) (string, error) {
+	if o.N < 0 || o.K < 0 {
+		return "", ErrNegativeOperand
+	}
+	if o.K > o.N {
+		return "0", nil
+	}
+	if err := s.estimate(lnFactorialRatio(o.N, o.N-o.K)); err != nil {
+		return "", err
+	}
+	return s.result(new(big.Int).MulRange(o.N-o.K+1, o.N))
+}
+
+// estimate fails with ErrTooManyDigits if a result whose natural logarithm
+// is ln clearly has more digits than allowed, so that it isn't computed at
+// all. The estimate is off by a little for large results, those close to the
+// limit are checked exactly by result.
+func (s basicService) estimate(ln float64) error {
+	if digits := math.Floor(ln/math.Ln10) + 1; digits > float64(s.maxDigits)+1 {
+		return fmt.Errorf("%w, it has about %.0f digits and at most %d are allowed", ErrTooManyDigits, digits, s.maxDigits)
+	}
+	return nil
+}
+
+// result returns z as a decimal string, or ErrTooManyDigits if it has more
+// digits than allowed.
+func (s basicService) result(z *big.Int) (string, error) {
+	v := z.String()
+	if len(v) > s.maxDigits {
+		return "", fmt.Errorf("%w, it has %d digits and at most %d are allowed", ErrTooManyDigits, len(v), s.maxDigits)
+	}
+	return v, nil
+}
+
+// lnFactorial returns the natural logarithm of n!.
+func lnFactorial(n int64) float64 {
+	v, _ := math.Lgamma(float64(n) + 1)
+	return v
+}
+
+// lnFactorialRatio returns the natural logarithm of n!/m!, m being at most
+// n. Once m is large the logarithms of n! and m! are too close to be
+// subtracted, their difference is then taken from Stirling's series.
+func lnFactorialRatio(n, m int64) float64 {
+	if m < 1e4 {
+		return lnFactorial(n) - lnFactorial(m)
+	}
+	x, d := float64(m)+1, float64(n-m)
+	return (x-0.5)*math.Log1p(d/x) + d*math.Log(x+d) - d + stirling(x+d) - stirling(x)
+}
+
+// stirling returns the first terms of the correction of Stirling's
+// approximation of the logarithm of Γ(x), which are enough for x of 1e4 and
+// more.
+func stirling(x float64) float64 {
+	return 1/(12*x) - 1/(360*x*x*x)
+}
@@ -0,0 +1,96 @@
+// Package complexservice is the core of the Complex service, the arithmetic on
+// complex numbers.
+package complexservice
+
+import (
+	"context"
+	"math/cmplx"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+)
+
+// Service describes a service that performs arithmetic on complex numbers.
+// Implementations may be wrapped by a Middleware, e.g. to log and measure
+// each call.
+type Service interface {
+	// Sum returns a+b
+	Sum(ctx context.Context, a, b complex128) (complex128, error)
+	// Subtract returns a-b
+	Subtract(ctx context.Context, a, b complex128) (complex128, error)
+	// Multiply returns a*b
+	Multiply(ctx context.Context, a, b complex128) (complex128, error)
+	// Divide returns a/b
+	Divide(ctx context.Context, a, b complex128) (complex128, error)
+	// Pow returns a^b
+	Pow(ctx context.Context, a, b complex128) (complex128, error)
+	// Abs returns the absolute value, or modulus, of a
+	Abs(ctx context.Context, a complex128) (float64, error)
+	// Phase returns the phase, or argument, of a in the range [-Pi, Pi]
+	Phase(ctx context.Context, a complex128) (float64, error)
+	// Conj returns the complex conjugate of a
+	Conj(ctx context.Context, a complex128) (complex128, error)
+	// Sqrt returns the square root of a, with a non-negative real part
+	Sqrt(ctx context.Context, a complex128) (complex128, error)
+}
+
+// Middleware describes a service (as opposed to endpoint) middleware.
+type Middleware func(Service) Service
+
+// NewBasicService returns a naïve, stateless implementation of Service backed
+// by math/cmplx. Dividing by zero fails with mathservice.ErrDivideByZero, so
+// that transports report it with the same code as the Math service.
+func NewBasicService() Service {
+	return basicService{}
+}
+
+type basicService struct{}
+
+func (basicService) Sum(_ context.Context, a, b complex128) (complex128, error) {
+	return a + b, nil
+}
+
+func (basicService) Subtract(_ context.Context, a, b complex128) (complex128, error) {
+	return a - b, nil
+}
+
+func (basicService) Multiply(_ context.Context, a, b complex128) (complex128, error) {
+	return a * b, nil
+}
+
+func (basicService) Divide(_ context.Context, a, b complex128) (complex128, error) {
+	if b == 0 {
+		return 0, mathservice.ErrDivideByZero
+	}
+	return a / b, nil
+}
+
+func (basicService) Pow(_ context.Context, a, b complex128) (complex128, error) {
+	return cmplx.Pow(a, b), nil
+}
+
+func (basicService) Abs(_ context.Context, a complex128) (float64, error) {
+	return cmplx.Abs(a), nil
+}
+
+func (basicService) Phase(_ context.Context, a complex128) (float64, error) {
+	return cmplx.Phase(a), nil
+}
+
+func (basicService) Conj(_ context.Context, a complex128) (complex128, error) {
+	return cmplx.Conj(a), nil
+}
+
+func (basicService) Sqrt(_ context.Context, a complex128) (complex128, error) {
+	return cmplx.Sqrt(a), nil
+}
+
+// FromProto returns the complex number c, nil is zero.
+func FromProto(c *pb.ComplexNumber) complex128 {
+	return complex(c.GetReal(), c.GetImag())
+}
+
+// Proto returns the protobuf representation of v.
+func Proto(v complex128) *pb.ComplexNumber {
+	return &pb.ComplexNumber{Real: real(v), Imag: imag(v)}
+}
@@ -0,0 +1,98 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"google.golang.org/grpc"
+)
+
+// MaxBatchConcurrency is the maximum number of items of a batch performed at
+// once, whatever concurrency the BatchRequest asks for.
+const MaxBatchConcurrency = 16
+
+// MaxBatchItems is the maximum number of items of a batch.
+const MaxBatchItems = 1000
+
+// Batch performs the n items of a batch by calling perform with the index of
+// each, on a pool of up to MaxBatchConcurrency workers as requested by
+// concurrency. Once ctx is done no more items are dispatched, and canceled is
+// called instead with the index of each item left and the error of ctx. Batch
+// returns when every item was performed or canceled.
+//
+// Batch fails with mathservice.ErrTooManyItems, without performing any item,
+// if n is more than MaxBatchItems.
+func Batch(ctx context.Context, n, concurrency int, perform func(i int), canceled func(i int, err error)) error {
+	if n > MaxBatchItems {
+		return fmt.Errorf("%w, at most %d are allowed in a batch", mathservice.ErrTooManyItems, MaxBatchItems)
+	}
+
+	workers := concurrency
+	if workers > MaxBatchConcurrency {
+		workers = MaxBatchConcurrency
+	}
+	if workers > n {
+		workers = n
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	var (
+		wg    sync.WaitGroup
+		items = make(chan int)
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range items {
+				// the item may have been dispatched along with ctx being done
+				if err := ctx.Err(); err != nil {
+					canceled(i, err)
+					continue
+				}
+				perform(i)
+			}
+		}()
+	}
+	i := 0
+dispatch:
+	for ; i < n && ctx.Err() == nil; i++ {
+		select {
+		case items <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(items)
+	for ; i < n; i++ {
+		canceled(i, ctx.Err())
+	}
+	wg.Wait()
+	return nil
+}
+
+// ServeBatch performs the items of req using the unary methods of srv,
+// passing each through interceptor if it isn't nil like Serve does. The error
+// of an item is only returned in its own result, the items left once ctx is
+// done fail with its error. ServeBatch fails like Batch does.
+func ServeBatch(ctx context.Context, req *pb.BatchRequest, srv pb.MathServer, interceptor grpc.UnaryServerInterceptor) (*pb.BatchReply, error) {
+	reply := &pb.BatchReply{Results: make([]*pb.ComputeReply, len(req.Items))}
+	err := Batch(ctx, len(req.Items), int(req.Concurrency),
+		func(i int) {
+			item := req.Items[i]
+			reply.Results[i] = &pb.ComputeReply{Id: item.Id, Reply: perform(ctx, srv, interceptor, item)}
+		},
+		func(i int, err error) {
+			reply.Results[i] = &pb.ComputeReply{Id: req.Items[i].Id, Reply: &pb.MathOpReply{Err: err.Error(), Code: pb.ErrorCode_UNKNOWN}}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
@@ -0,0 +1,131 @@
+package compute
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/jwenz723/mathserver/pb"
+)
+
+// ErrClosed is returned by Do once the client was closed.
+var ErrClosed = errors.New("compute stream closed")
+
+// Client performs operations concurrently over a single Compute stream,
+// matching each reply to its request by correlation ID.
+type Client struct {
+	stream pb.Math_ComputeClient
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// sendMtx serializes sends, a stream may only be sent to by one
+	// goroutine at a time.
+	sendMtx sync.Mutex
+
+	mtx     sync.Mutex
+	nextID  uint64
+	pending map[uint64]chan *pb.MathOpReply
+	err     error
+}
+
+// NewClient opens a Compute stream using c. The stream lives until Close is
+// called or ctx is canceled.
+func NewClient(ctx context.Context, c pb.MathClient) (*Client, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.Compute(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	cl := &Client{
+		stream:  stream,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		pending: map[uint64]chan *pb.MathOpReply{},
+	}
+	go cl.receive()
+	return cl, nil
+}
+
+// Do performs the operation requested by r, whose ID is assigned by Do, and
+// waits for its reply. Errors of the operation itself are returned in the
+// reply, the error is only set when the stream failed or ctx is done.
+func (c *Client) Do(ctx context.Context, r *pb.ComputeRequest) (*pb.MathOpReply, error) {
+	ch := make(chan *pb.MathOpReply, 1)
+	c.mtx.Lock()
+	if c.err != nil {
+		c.mtx.Unlock()
+		return nil, c.err
+	}
+	c.nextID++
+	id := c.nextID
+	c.pending[id] = ch
+	c.mtx.Unlock()
+
+	req := *r
+	req.Id = id
+	c.sendMtx.Lock()
+	err := c.stream.Send(&req)
+	c.sendMtx.Unlock()
+	if err != nil {
+		c.forget(id)
+		// the actual error is reported by Recv
+		<-c.done
+		return nil, c.err
+	}
+
+	select {
+	case reply := <-ch:
+		return reply, nil
+	case <-c.done:
+		return nil, c.err
+	case <-ctx.Done():
+		// the reply is dropped when it arrives
+		c.forget(id)
+		return nil, ctx.Err()
+	}
+}
+
+// Close closes the stream once the replies of the operations in progress were
+// received.
+func (c *Client) Close() error {
+	c.sendMtx.Lock()
+	c.stream.CloseSend()
+	c.sendMtx.Unlock()
+	<-c.done
+	c.cancel()
+	if c.err == ErrClosed {
+		return nil
+	}
+	return c.err
+}
+
+func (c *Client) forget(id uint64) {
+	c.mtx.Lock()
+	delete(c.pending, id)
+	c.mtx.Unlock()
+}
+
+func (c *Client) receive() {
+	for {
+		r, err := c.stream.Recv()
+		c.mtx.Lock()
+		if err != nil {
+			c.err = err
+			if err == io.EOF {
+				c.err = ErrClosed
+			}
+			c.pending = nil
+			c.mtx.Unlock()
+			close(c.done)
+			return
+		}
+		ch := c.pending[r.Id]
+		delete(c.pending, r.Id)
+		c.mtx.Unlock()
+		if ch != nil {
+			ch <- r.Reply
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package compute_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/compute"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// divider divides, blocking each division with a zero dividend until release
+// is closed.
+type divider struct {
+	pb.UnimplementedMathServer
+	interceptor grpc.UnaryServerInterceptor
+	release     chan struct{}
+}
+
+func (d *divider) Divide(ctx context.Context, req *pb.MathOpRequest) (*pb.MathOpReply, error) {
+	if req.A == 0 {
+		select {
+		case <-d.release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if req.B == 0 {
+		return &pb.MathOpReply{Err: "can't divide by zero", Code: pb.ErrorCode_DIVIDE_BY_ZERO}, nil
+	}
+	return &pb.MathOpReply{V: req.A / req.B}, nil
+}
+
+func (d *divider) Compute(stream pb.Math_ComputeServer) error {
+	return compute.Serve(stream, d, d.interceptor)
+}
+
+func serve(ctx context.Context, t *testing.T, d *divider) (*compute.Client, func()) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	pb.RegisterMathServer(s, d)
+	go s.Serve(lis)
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := compute.NewClient(ctx, pb.NewMathClient(conn))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, func() {
+		c.Close()
+		conn.Close()
+		s.Stop()
+	}
+}
+
+func TestConcurrentOperations(t *testing.T) {
+	var calls int64
+	d := &divider{
+		release: make(chan struct{}),
+		interceptor: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if info.FullMethod == "/pb.Math/Divide" {
+				atomic.AddInt64(&calls, 1)
+			}
+			return handler(ctx, req)
+		},
+	}
+	close(d.release)
+	c, stop := serve(context.Background(), t, d)
+	defer stop()
+
+	const n = 4 * compute.Window
+	var wg sync.WaitGroup
+	for i := 1; i <= n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r, err := c.Do(context.Background(), &pb.ComputeRequest{Op: pb.ComputeRequest_DIVIDE, A: float64(2 * i), B: 2})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if r.V != float64(i) {
+				t.Errorf("%d/2: got %v, want %v", 2*i, r.V, i)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if calls != n {
+		t.Errorf("interceptor saw %d divisions, want %d", calls, n)
+	}
+
+	r, err := c.Do(context.Background(), &pb.ComputeRequest{Op: pb.ComputeRequest_DIVIDE, A: 1})
+	if err != nil || r.Code != pb.ErrorCode_DIVIDE_BY_ZERO {
+		t.Errorf("1/0: got %v, %v, want DIVIDE_BY_ZERO", r, err)
+	}
+	r, err = c.Do(context.Background(), &pb.ComputeRequest{Op: pb.ComputeRequest_MAX, A: 1})
+	if err != nil || r.Code != pb.ErrorCode_UNKNOWN {
+		t.Errorf("unimplemented Max: got %v, %v, want UNKNOWN", r, err)
+	}
+}
+
+func TestOutOfOrderReplies(t *testing.T) {
+	d := &divider{release: make(chan struct{})}
+	c, stop := serve(context.Background(), t, d)
+	defer stop()
+
+	blocked := make(chan error, 1)
+	go func() {
+		_, err := c.Do(context.Background(), &pb.ComputeRequest{Op: pb.ComputeRequest_DIVIDE, A: 0, B: 1})
+		blocked <- err
+	}()
+	// a later operation completes while the first one is still blocked
+	r, err := c.Do(context.Background(), &pb.ComputeRequest{Op: pb.ComputeRequest_DIVIDE, A: 6, B: 3})
+	if err != nil || r.V != 2 {
+		t.Fatalf("6/3: got %v, %v, want 2", r, err)
+	}
+
+	// giving up on an operation doesn't affect the stream
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.Do(ctx, &pb.ComputeRequest{Op: pb.ComputeRequest_DIVIDE, A: 0, B: 1}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	close(d.release)
+	if err := <-blocked; err != nil {
+		t.Errorf("0/1: %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Errorf("closing: %v", err)
+	}
+	if _, err := c.Do(context.Background(), &pb.ComputeRequest{Op: pb.ComputeRequest_DIVIDE, A: 1, B: 1}); err != compute.ErrClosed {
+		t.Errorf("after close: got %v, want %v", err, compute.ErrClosed)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	d := &divider{release: make(chan struct{})}
+	ctx, cancel := context.WithCancel(context.Background())
+	c, stop := serve(ctx, t, d)
+	defer stop()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.Do(context.Background(), &pb.ComputeRequest{Op: pb.ComputeRequest_DIVIDE, A: 0, B: 1})
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("got no error after canceling the stream")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("operation still blocked after canceling the stream")
+	}
+}
+
+func TestBatch(t *testing.T) {
+	var inFlight, most int64
+	d := &divider{
+		release: make(chan struct{}),
+		interceptor: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			n := atomic.AddInt64(&inFlight, 1)
+			defer atomic.AddInt64(&inFlight, -1)
+			for {
+				m := atomic.LoadInt64(&most)
+				if n <= m || atomic.CompareAndSwapInt64(&most, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			return handler(ctx, req)
+		},
+	}
+	close(d.release)
+
+	req := &pb.BatchRequest{Concurrency: 1000}
+	for i := 1; i <= 100; i++ {
+		req.Items = append(req.Items, &pb.ComputeRequest{Id: uint64(i), Op: pb.ComputeRequest_DIVIDE, A: float64(i), B: float64(i % 10)})
+	}
+	req.Items = append(req.Items, &pb.ComputeRequest{Id: 101})
+
+	reply, err := compute.ServeBatch(context.Background(), req, d, d.interceptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.Results) != len(req.Items) {
+		t.Fatalf("got %d results, want %d", len(reply.Results), len(req.Items))
+	}
+	for i, r := range reply.Results[:100] {
+		item := req.Items[i]
+		switch {
+		case r.Id != item.Id:
+			t.Errorf("result %d: got id %d, want %d", i, r.Id, item.Id)
+		case item.B == 0 && r.Reply.Code != pb.ErrorCode_DIVIDE_BY_ZERO:
+			t.Errorf("%v/0: got %v, want DIVIDE_BY_ZERO", item.A, r.Reply)
+		case item.B != 0 && r.Reply.V != item.A/item.B:
+			t.Errorf("%v/%v: got %v, want %v", item.A, item.B, r.Reply, item.A/item.B)
+		}
+	}
+	if r := reply.Results[100]; r.Id != 101 || r.Reply.Code != pb.ErrorCode_UNKNOWN_OPERATION {
+		t.Errorf("unknown operation: got %v, want UNKNOWN_OPERATION", r)
+	}
+	if most > compute.MaxBatchConcurrency {
+		t.Errorf("%d items performed at once, want at most %d", most, compute.MaxBatchConcurrency)
+	}
+}
+
+func TestBatchTooManyItems(t *testing.T) {
+	req := &pb.BatchRequest{Items: make([]*pb.ComputeRequest, compute.MaxBatchItems+1)}
+	if _, err := compute.ServeBatch(context.Background(), req, &divider{}, nil); !errors.Is(err, mathservice.ErrTooManyItems) {
+		t.Errorf("got %v, want %v", err, mathservice.ErrTooManyItems)
+	}
+}
+
+func TestBatchCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls int64
+	d := &divider{
+		release: make(chan struct{}),
+		interceptor: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			atomic.AddInt64(&calls, 1)
+			cancel()
+			return handler(ctx, req)
+		},
+	}
+
+	req := &pb.BatchRequest{}
+	for i := 1; i <= 10; i++ {
+		req.Items = append(req.Items, &pb.ComputeRequest{Id: uint64(i), Op: pb.ComputeRequest_DIVIDE, A: 0, B: 1})
+	}
+	reply, err := compute.ServeBatch(ctx, req, d, d.interceptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("%d items performed, want 1", calls)
+	}
+	for i, r := range reply.Results {
+		if r.Id != req.Items[i].Id || r.Reply.Err != context.Canceled.Error() {
+			t.Errorf("result %d: got %v, want item %d canceled", i, r, req.Items[i].Id)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Package compute implements the Compute streaming RPC on top of the unary
+// methods of a MathServer, so that each operation of a stream is performed
+// exactly like the equivalent unary call, including any middleware the
+// server applies to it.
+package compute
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+)
+
+// Window is the maximum number of operations of a single stream performed at
+// once. Once it's reached the server stops receiving from the stream until an
+// operation completes, so a client sending faster than the server computes is
+// slowed down by gRPC flow control rather than queueing unbounded work.
+const Window = 64
+
+// Serve performs the operations received on stream using the unary methods of
+// srv and sends each reply as soon as its operation completes. It returns when
+// the client has closed its side of the stream and every reply was sent, or
+// when the stream fails or is canceled, in which case the operations still
+// running are canceled.
+//
+// If interceptor isn't nil each operation is passed through it as a unary
+// call of the corresponding method, e.g. /pb.Math/Divide, so that logging and
+// metrics interceptors observe every operation.
+func Serve(stream pb.Math_ComputeServer, srv pb.MathServer, interceptor grpc.UnaryServerInterceptor) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		window  = make(chan struct{}, Window)
+		replies = make(chan *pb.ComputeReply, Window)
+		sent    = make(chan error, 1)
+	)
+	// a stream may only be sent to by one goroutine at a time
+	go func() {
+		for r := range replies {
+			if err := stream.Send(r); err != nil {
+				cancel()
+				for range replies {
+				}
+				sent <- err
+				return
+			}
+		}
+		sent <- nil
+	}()
+
+	var err error
+	for {
+		var req *pb.ComputeRequest
+		if req, err = stream.Recv(); err != nil {
+			break
+		}
+		select {
+		case window <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			replies <- &pb.ComputeReply{Id: req.Id, Reply: perform(ctx, srv, interceptor, req)}
+			<-window
+		}()
+	}
+	wg.Wait()
+	close(replies)
+	sendErr := <-sent
+	if err != io.EOF {
+		return err
+	}
+	return sendErr
+}
+
+// perform performs the operation requested by r. Errors are always returned
+// in the reply, even when srv reports them as gRPC statuses, so that a failed
+// operation doesn't end the stream.
+func perform(ctx context.Context, srv pb.MathServer, interceptor grpc.UnaryServerInterceptor, r *pb.ComputeRequest) *pb.MathOpReply {
+	method, req, handler := operation(srv, r)
+	if handler == nil {
+		return &pb.MathOpReply{Err: mathservice.ErrUnknownOperation.Error(), Code: pb.ErrorCode_UNKNOWN_OPERATION}
+	}
+
+	var (
+		resp interface{}
+		err  error
+	)
+	if interceptor != nil {
+		resp, err = interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/pb.Math/" + method}, handler)
+	} else {
+		resp, err = handler(ctx, req)
+	}
+	if code, msg, ok := rpcstatus.Parse(err); ok {
+		return &pb.MathOpReply{Err: msg, Code: code}
+	}
+	if err != nil {
+		return &pb.MathOpReply{Err: err.Error(), Code: pb.ErrorCode_UNKNOWN}
+	}
+	return resp.(*pb.MathOpReply)
+}
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind compute -o operation_gen.go
+
+type binaryMethod func(context.Context, *pb.MathOpRequest) (*pb.MathOpReply, error)
+
+type listMethod func(context.Context, *pb.MathListRequest) (*pb.MathOpReply, error)
+
+type unaryMethod func(context.Context, *pb.UnaryOpRequest) (*pb.MathOpReply, error)
+
+// operation returns the name of the unary method of srv that performs r, the
+// request to call it with and a handler calling it. The handler is nil if the
+// operation is unknown.
+func operation(srv pb.MathServer, r *pb.ComputeRequest) (string, interface{}, grpc.UnaryHandler) {
+	if r.Op == pb.ComputeRequest_EVALUATE {
+		req := &pb.EvaluateRequest{Expression: r.Expression, Precision: r.Precision}
+		return "Evaluate", req, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Evaluate(ctx, req.(*pb.EvaluateRequest))
+		}
+	}
+	return mathOperation(srv, r)
+}
+
+// binary returns the name, request and handler of the operation requested by r
+// when it's performed by m, the method called name.
+func binary(r *pb.ComputeRequest, name string, m binaryMethod) (string, interface{}, grpc.UnaryHandler) {
+	req := &pb.MathOpRequest{A: r.A, B: r.B, Precision: r.Precision, Division: r.Division}
+	return name, req, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return m(ctx, req.(*pb.MathOpRequest))
+	}
+}
+
+// list is binary for the methods taking a list of values.
+func list(r *pb.ComputeRequest, name string, m listMethod) (string, interface{}, grpc.UnaryHandler) {
+	req := &pb.MathListRequest{Values: r.Values, Precision: r.Precision}
+	return name, req, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return m(ctx, req.(*pb.MathListRequest))
+	}
+}
+
+// unary is binary for the methods taking a single operand, which is r.A.
+func unary(r *pb.ComputeRequest, name string, m unaryMethod) (string, interface{}, grpc.UnaryHandler) {
+	req := &pb.UnaryOpRequest{X: r.A, Precision: r.Precision}
+	return name, req, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return m(ctx, req.(*pb.UnaryOpRequest))
+	}
+}
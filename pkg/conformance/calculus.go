@@ -0,0 +1,123 @@
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/calculusservice"
+)
+
+// calcProblem is the operand of the methods of the Calculus service.
+type calcProblem calculusservice.Problem
+
+func (o calcProblem) grpcRequest(method string) (req, reply proto.Message) {
+	switch method {
+	case "Integrate", "Differentiate", "FindRoot", "Minimize":
+		return calculusservice.Problem(o).Proto(), new(pb.CalculusReply)
+	}
+	return nil, nil
+}
+
+func (o calcProblem) grpcValue(method string, reply proto.Message) interface{} {
+	r := reply.(*pb.CalculusReply)
+	return estimate{V: r.V, Iterations: int(r.Iterations)}
+}
+
+func (o calcProblem) httpRequest(method string) interface{} {
+	return calculusservice.Problem(o)
+}
+
+func (o calcProblem) httpResult(method string, result json.RawMessage) (interface{}, error) {
+	var r calculusservice.Result
+	err := json.Unmarshal(result, &r)
+	return estimate{V: r.V, Iterations: r.Iterations}, err
+}
+
+// calculusTolerance is the relative difference allowed between the result of
+// a call and the one wanted, which is known to fewer digits than computed.
+const calculusTolerance = 1e-9
+
+// estimate is the result of a call of the Calculus service.
+type estimate struct {
+	V          float64
+	Iterations int
+}
+
+// matches reports whether e is the estimate want, up to calculusTolerance.
+// The iterations are only compared when both estimates have some, as the ones
+// wanted by a case are often left out.
+func (e estimate) matches(want interface{}) bool {
+	w, ok := want.(estimate)
+	if !ok {
+		return false
+	}
+	if e.Iterations != 0 && w.Iterations != 0 && e.Iterations != w.Iterations {
+		return false
+	}
+	return math.Abs(e.V-w.V) <= calculusTolerance*math.Max(1, math.Abs(w.V))
+}
+
+// String returns e with every digit, so that implementations disagreeing in
+// any way are reported by RunCases.
+func (e estimate) String() string {
+	return fmt.Sprintf("%v in %d iterations", e.V, e.Iterations)
+}
+
+// on is shorthand for a Problem over the interval from a to b.
+func on(expression string, a, b float64, m calculusservice.Method) calcProblem {
+	return calcProblem{Expression: expression, A: a, B: b, Method: m}
+}
+
+// at is shorthand for a Problem at x.
+func at(expression string, x float64) calcProblem {
+	return calcProblem{Expression: expression, X: x}
+}
+
+// CalculusCases is the table of cases every implementation of the Calculus
+// service must pass.
+var CalculusCases = []ServiceCase{
+	{Name: "integrate polynomial", Method: "Integrate", In: on("x^12", -1, 1, calculusservice.Default), Want: Reply{V: estimate{V: 2.0 / 13, Iterations: 1}}},
+	{Name: "integrate sin", Method: "Integrate", In: on("sin(x)", 0, pi, calculusservice.GaussKronrod), Want: Reply{V: estimate{V: 2}}},
+	{Name: "integrate reversed", Method: "Integrate", In: on("sin(x)", pi, 0, calculusservice.GaussKronrod), Want: Reply{V: estimate{V: -2}}},
+	{Name: "integrate gaussian", Method: "Integrate", In: on("exp(-x^2)", -10, 10, calculusservice.Default), Want: Reply{V: estimate{V: math.Sqrt(pi)}}},
+	{Name: "integrate singular endpoint", Method: "Integrate", In: on("1/sqrt(x)", 0, 1, calculusservice.Default), Want: Reply{V: estimate{V: 2}}},
+	{Name: "integrate simpson", Method: "Integrate", In: on("exp(x)", 0, 1, calculusservice.Simpson), Want: Reply{V: estimate{V: math.E - 1}}},
+	{Name: "integrate empty", Method: "Integrate", In: on("x", 3, 3, calculusservice.Default), Want: Reply{V: estimate{V: 0}}},
+	{Name: "integrate pole", Method: "Integrate", In: on("1/x", -1, 2, calculusservice.Default), Want: Failure(pb.ErrorCode_CALCULUS_NO_CONVERGENCE)},
+	{Name: "integrate through a pole", Method: "Integrate", In: on("1/x", -1, 1, calculusservice.Simpson), Want: Failure(pb.ErrorCode_NON_FINITE_FUNCTION)},
+	{Name: "integrate infinite interval", Method: "Integrate", In: on("x", 0, math.MaxFloat64, calculusservice.Default), Want: Failure(pb.ErrorCode_NON_FINITE_FUNCTION)},
+	{Name: "integrate root method", Method: "Integrate", In: on("x", 0, 1, calculusservice.Brent), Want: Failure(pb.ErrorCode_INVALID_METHOD)},
+	{Name: "integrate too few iterations", Method: "Integrate", In: calcProblem{Expression: "sin(1/x)", A: 0.001, B: 1, MaxIterations: 2}, Want: Failure(pb.ErrorCode_CALCULUS_NO_CONVERGENCE)},
+	{Name: "integrate syntax error", Method: "Integrate", In: on("y^2", 0, 1, calculusservice.Default), Want: Failure(pb.ErrorCode_SYNTAX_ERROR)},
+
+	{Name: "differentiate sin", Method: "Differentiate", In: at("sin(x)", 1), Want: Reply{V: estimate{V: math.Cos(1)}}},
+	{Name: "differentiate cubic", Method: "Differentiate", In: at("x^3", 2), Want: Reply{V: estimate{V: 12}}},
+	{Name: "differentiate near domain edge", Method: "Differentiate", In: at("ln(x)", 0.001), Want: Reply{V: estimate{V: 1000}}},
+	{Name: "differentiate fast oscillation", Method: "Differentiate", In: at("sin(1000*x)", 0.5), Want: Reply{V: estimate{V: 1000 * math.Cos(500)}}},
+	{Name: "differentiate outside domain", Method: "Differentiate", In: at("sqrt(x)", -1), Want: Failure(pb.ErrorCode_NON_FINITE_FUNCTION)},
+	{Name: "differentiate negative tolerance", Method: "Differentiate", In: calcProblem{Expression: "x", Tolerance: -1}, Want: Failure(pb.ErrorCode_CALCULUS_INVALID_TOLERANCE)},
+	{Name: "differentiate too many iterations", Method: "Differentiate", In: calcProblem{Expression: "x", MaxIterations: calculusservice.MaxIterations + 1}, Want: Failure(pb.ErrorCode_INVALID_ITERATIONS)},
+
+	{Name: "find root brent", Method: "FindRoot", In: on("x^2-2", 0, 2, calculusservice.Default), Want: Reply{V: estimate{V: math.Sqrt2, Iterations: 7}}},
+	{Name: "find root bisection", Method: "FindRoot", In: on("x^2-2", 0, 2, calculusservice.Bisection), Want: Reply{V: estimate{V: math.Sqrt2, Iterations: 34}}},
+	{Name: "find root newton", Method: "FindRoot", In: on("x^2-2", 0, 2, calculusservice.Newton), Want: Reply{V: estimate{V: math.Sqrt2}}},
+	{Name: "find root transcendental", Method: "FindRoot", In: on("cos(x)-x", 0, 1, calculusservice.Brent), Want: Reply{V: estimate{V: 0.7390851332151607}}},
+	{Name: "find root at an end", Method: "FindRoot", In: on("x-1", 1, 3, calculusservice.Default), Want: Reply{V: estimate{V: 1}}},
+	{Name: "find root no bracket", Method: "FindRoot", In: on("x^2+1", 0, 2, calculusservice.Default), Want: Failure(pb.ErrorCode_NO_BRACKET)},
+	{Name: "find root integration method", Method: "FindRoot", In: on("x", -1, 1, calculusservice.Simpson), Want: Failure(pb.ErrorCode_INVALID_METHOD)},
+	{Name: "find root too few iterations", Method: "FindRoot", In: calcProblem{Expression: "x^2-2", A: 0, B: 2, Method: calculusservice.Bisection, MaxIterations: 10}, Want: Failure(pb.ErrorCode_CALCULUS_NO_CONVERGENCE)},
+
+	// the minimum of a smooth function is only found to about the square
+	// root of the precision, as the function is flat around it
+	{Name: "minimize parabola", Method: "Minimize", In: on("(x-2)^2+1", 0, 5, calculusservice.Default), Want: Reply{V: estimate{V: 1.9999999894671263}}},
+	{Name: "minimize cos", Method: "Minimize", In: on("cos(x)", 2, 4, calculusservice.GoldenSection), Want: Reply{V: estimate{V: 3.1415926431667587}}},
+	{Name: "minimize kink", Method: "Minimize", In: on("abs(x-0.3)", -1, 1, calculusservice.Default), Want: Reply{V: estimate{V: 0.3}}},
+	{Name: "minimize empty interval", Method: "Minimize", In: on("x", 1, 0, calculusservice.Default), Want: Failure(pb.ErrorCode_INVALID_INTERVAL)},
+	{Name: "minimize newton", Method: "Minimize", In: on("x", 0, 1, calculusservice.Newton), Want: Failure(pb.ErrorCode_INVALID_METHOD)},
+}
+
+// pi is math.Pi, short enough for a table.
+const pi = math.Pi
@@ -0,0 +1,69 @@
+package conformance
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+)
+
+// nkOps are the operands of the methods of the Combinatorics service, whose
+// decimal results are compared as strings.
+type nkOps combinatoricsservice.Operands
+
+func (o nkOps) grpcRequest(method string) (req, reply proto.Message) {
+	switch method {
+	case "Factorial", "Binomial", "Permutations":
+		return combinatoricsservice.Operands(o).Proto(), new(pb.IntegerReply)
+	}
+	return nil, nil
+}
+
+func (o nkOps) grpcValue(method string, reply proto.Message) interface{} {
+	return reply.(*pb.IntegerReply).V
+}
+
+func (o nkOps) httpRequest(method string) interface{} {
+	return combinatoricsservice.Operands(o)
+}
+
+func (o nkOps) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	var s string
+	err := json.Unmarshal(v, &s)
+	return s, err
+}
+
+// nk is shorthand for the operands of a Combinatorics method.
+func nk(n, k int64) nkOps {
+	return nkOps{N: n, K: k}
+}
+
+// CombinatoricsCases is the table of cases every implementation of the
+// Combinatorics service must pass, allowing the default number of digits.
+var CombinatoricsCases = []ServiceCase{
+	{Name: "factorial", Method: "Factorial", In: nk(5, 0), Want: Reply{V: "120"}},
+	{Name: "factorial zero", Method: "Factorial", In: nk(0, 0), Want: Reply{V: "1"}},
+	{Name: "factorial largest int64", Method: "Factorial", In: nk(20, 0), Want: Reply{V: "2432902008176640000"}},
+	{Name: "factorial past int64", Method: "Factorial", In: nk(25, 0), Want: Reply{V: "15511210043330985984000000"}},
+	{Name: "factorial too many digits", Method: "Factorial", In: nk(3249, 0), Want: Failure(pb.ErrorCode_TOO_MANY_DIGITS)},
+	{Name: "factorial far too many digits", Method: "Factorial", In: nk(math.MaxInt64, 0), Want: Failure(pb.ErrorCode_TOO_MANY_DIGITS)},
+	{Name: "factorial negative", Method: "Factorial", In: nk(-1, 0), Want: Failure(pb.ErrorCode_NEGATIVE_OPERAND)},
+
+	{Name: "binomial", Method: "Binomial", In: nk(5, 2), Want: Reply{V: "10"}},
+	{Name: "binomial central", Method: "Binomial", In: nk(100, 50), Want: Reply{V: "100891344545564193334812497256"}},
+	{Name: "binomial k zero", Method: "Binomial", In: nk(5, 0), Want: Reply{V: "1"}},
+	{Name: "binomial k past n", Method: "Binomial", In: nk(5, 7), Want: Reply{V: "0"}},
+	{Name: "binomial huge n", Method: "Binomial", In: nk(1e18, 2), Want: Reply{V: "499999999999999999500000000000000000"}},
+	{Name: "binomial k near n", Method: "Binomial", In: nk(math.MaxInt64, math.MaxInt64-1), Want: Reply{V: "9223372036854775807"}},
+	{Name: "binomial too many digits", Method: "Binomial", In: nk(1e18, 1e9), Want: Failure(pb.ErrorCode_TOO_MANY_DIGITS)},
+	{Name: "binomial negative", Method: "Binomial", In: nk(5, -2), Want: Failure(pb.ErrorCode_NEGATIVE_OPERAND)},
+
+	{Name: "permutations", Method: "Permutations", In: nk(5, 2), Want: Reply{V: "20"}},
+	{Name: "permutations all", Method: "Permutations", In: nk(5, 5), Want: Reply{V: "120"}},
+	{Name: "permutations k past n", Method: "Permutations", In: nk(5, 7), Want: Reply{V: "0"}},
+	{Name: "permutations huge n", Method: "Permutations", In: nk(1e18, 2), Want: Reply{V: "999999999999999999000000000000000000"}},
+	{Name: "permutations too many digits", Method: "Permutations", In: nk(1e6, 5000), Want: Failure(pb.ErrorCode_TOO_MANY_DIGITS)},
+	{Name: "permutations negative", Method: "Permutations", In: nk(-5, 2), Want: Failure(pb.ErrorCode_NEGATIVE_OPERAND)},
+}
@@ -0,0 +1,100 @@
+package conformance
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/complexservice"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+)
+
+// complexOps are the operands of the methods of the Complex service. Abs,
+// Phase, Conj and Sqrt only use A.
+type complexOps struct {
+	A, B complex128
+}
+
+// realResult reports whether method returns a real number rather than a
+// complex one.
+func realResult(method string) bool {
+	return method == "Abs" || method == "Phase"
+}
+
+func (o complexOps) grpcRequest(method string) (req, reply proto.Message) {
+	req = &pb.ComplexOpRequest{A: complexservice.Proto(o.A), B: complexservice.Proto(o.B)}
+	switch method {
+	case "Sum", "Subtract", "Multiply", "Divide", "Pow", "Conj", "Sqrt":
+		return req, new(pb.ComplexOpReply)
+	case "Abs", "Phase":
+		return req, new(pb.MathOpReply)
+	}
+	return nil, nil
+}
+
+func (o complexOps) grpcValue(method string, reply proto.Message) interface{} {
+	if realResult(method) {
+		return reply.(*pb.MathOpReply).V
+	}
+	return complexservice.FromProto(reply.(*pb.ComplexOpReply).V)
+}
+
+func (o complexOps) httpRequest(method string) interface{} {
+	return struct {
+		A jsonfloat.Complex128 `json:"a"`
+		B jsonfloat.Complex128 `json:"b"`
+	}{jsonfloat.Complex128(o.A), jsonfloat.Complex128(o.B)}
+}
+
+func (o complexOps) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	if realResult(method) {
+		var f jsonfloat.Float64
+		err := json.Unmarshal(v, &f)
+		return float64(f), err
+	}
+	var c jsonfloat.Complex128
+	err := json.Unmarshal(v, &c)
+	return complex128(c), err
+}
+
+// ComplexCases is the table of cases every implementation of the Complex
+// service must pass.
+var ComplexCases = []ServiceCase{
+	{Name: "sum", Method: "Sum", In: complexOps{1 + 2i, 3 - 4i}, Want: Reply{V: 4 - 2i}},
+	{Name: "sum nan", Method: "Sum", In: complexOps{complex(nan, 1), 1i}, Want: Reply{V: complex(nan, 2)}},
+	{Name: "sum inf", Method: "Sum", In: complexOps{complex(inf, 0), complex(-1, -inf)}, Want: Reply{V: complex(inf, -inf)}},
+
+	{Name: "subtract", Method: "Subtract", In: complexOps{1 + 2i, 3 - 4i}, Want: Reply{V: -2 + 6i}},
+	{Name: "subtract to negative zero", Method: "Subtract", In: complexOps{complex(negZero, 1), 1i}, Want: Reply{V: complex(negZero, 0)}},
+
+	{Name: "multiply", Method: "Multiply", In: complexOps{1 + 2i, 3 - 4i}, Want: Reply{V: 11 + 2i}},
+	{Name: "multiply i by i", Method: "Multiply", In: complexOps{1i, 1i}, Want: Reply{V: complex(-1, 0)}},
+	{Name: "multiply overflow", Method: "Multiply", In: complexOps{complex(math.MaxFloat64, 0), 2}, Want: Reply{V: complex(inf, 0)}},
+
+	{Name: "divide", Method: "Divide", In: complexOps{11 + 2i, 3 - 4i}, Want: Reply{V: 1 + 2i}},
+	{Name: "divide by zero", Method: "Divide", In: complexOps{1 + 1i, 0}, Want: Failure(pb.ErrorCode_DIVIDE_BY_ZERO)},
+	{Name: "divide by negative zero", Method: "Divide", In: complexOps{1, complex(negZero, negZero)}, Want: Failure(pb.ErrorCode_DIVIDE_BY_ZERO)},
+	{Name: "divide by inf", Method: "Divide", In: complexOps{1 + 2i, complex(inf, 0)}, Want: Reply{V: complex(0, 0)}},
+	{Name: "divide by imaginary", Method: "Divide", In: complexOps{1, 1i}, Want: Reply{V: complex(0, -1)}},
+
+	{Name: "pow", Method: "Pow", In: complexOps{2, 3}, Want: Reply{V: complex(8, 0)}},
+	{Name: "pow i squared", Method: "Pow", In: complexOps{1i, 2}, Want: Reply{V: complex(-1, 1.2246467991473515e-16)}},
+	{Name: "pow cube root of negative", Method: "Pow", In: complexOps{-8, 1.0 / 3}, Want: Reply{V: 1 + 1.732050807568877i}},
+	{Name: "pow zero to zero", Method: "Pow", In: complexOps{0, 0}, Want: Reply{V: complex(1, 0)}},
+	{Name: "pow zero to negative", Method: "Pow", In: complexOps{0, -1}, Want: Reply{V: complex(inf, 0)}},
+
+	{Name: "abs", Method: "Abs", In: complexOps{A: 3 + 4i}, Want: Reply{V: 5.0}},
+	{Name: "abs inf nan", Method: "Abs", In: complexOps{A: complex(inf, nan)}, Want: Reply{V: inf}},
+
+	{Name: "phase", Method: "Phase", In: complexOps{A: 1i}, Want: Reply{V: math.Pi / 2}},
+	{Name: "phase negative real", Method: "Phase", In: complexOps{A: -1}, Want: Reply{V: math.Pi}},
+	{Name: "phase negative real negative zero", Method: "Phase", In: complexOps{A: complex(-1, negZero)}, Want: Reply{V: -math.Pi}},
+
+	{Name: "conj", Method: "Conj", In: complexOps{A: 1 + 2i}, Want: Reply{V: 1 - 2i}},
+	{Name: "conj real", Method: "Conj", In: complexOps{A: 1}, Want: Reply{V: complex(1, negZero)}},
+
+	{Name: "sqrt", Method: "Sqrt", In: complexOps{A: 3 + 4i}, Want: Reply{V: 2 + 1i}},
+	{Name: "sqrt negative", Method: "Sqrt", In: complexOps{A: -4}, Want: Reply{V: 2i}},
+	{Name: "sqrt negative negative zero", Method: "Sqrt", In: complexOps{A: complex(-4, negZero)}, Want: Reply{V: -2i}},
+}
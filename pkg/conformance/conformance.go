@@ -0,0 +1,330 @@
+// Package conformance checks that the server implementations behave the same.
+// Each implementation is driven through its transport by a Client, every Case
+// is run against every implementation, and an implementation that doesn't
+// produce the expected Outcome is reported along with how the implementations
+// differ from one another.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+)
+
+// ErrUnsupported is returned by a Client for a Case its transport can't
+// express, such as a NaN operand sent as JSON. The Case is skipped for that
+// implementation.
+var ErrUnsupported = errors.New("case not supported by transport")
+
+// Case is a single call made to every implementation. Method is the name of
+// the gRPC method, e.g. "Divide". Binary methods use A and B, unary methods
+// use A as their operand x, list methods use Values and Evaluate uses
+// Expression. Division is only used by IntDivide and Remainder.
+type Case struct {
+	Name       string
+	Method     string
+	A, B       float64
+	Values     []float64
+	Expression string
+	Precision  precision.Precision
+	Division   mathservice.Division
+	Want       Outcome
+}
+
+// Outcome is the observable result of a call. V and Exact are only compared
+// when Code is NO_ERROR.
+type Outcome struct {
+	V     float64
+	Exact string
+	Code  pb.ErrorCode
+}
+
+// Fail returns the Outcome of a call failing with code.
+func Fail(code pb.ErrorCode) Outcome {
+	return Outcome{Code: code}
+}
+
+// Equal reports whether o and other are the same outcome. Unlike ==, NaN is
+// equal to NaN and -0 isn't equal to 0.
+func (o Outcome) Equal(other Outcome) bool {
+	if o.Code != other.Code {
+		return false
+	}
+	if o.Code != pb.ErrorCode_NO_ERROR {
+		return true
+	}
+	if o.Exact != other.Exact {
+		return false
+	}
+	if math.IsNaN(o.V) || math.IsNaN(other.V) {
+		return math.IsNaN(o.V) && math.IsNaN(other.V)
+	}
+	return o.V == other.V && math.Signbit(o.V) == math.Signbit(other.V)
+}
+
+// Matches implements Result.
+func (o Outcome) Matches(want Result) bool {
+	w, ok := want.(Outcome)
+	return ok && o.Equal(w)
+}
+
+func (o Outcome) String() string {
+	if o.Code != pb.ErrorCode_NO_ERROR {
+		return "error " + o.Code.String()
+	}
+	s := fmt.Sprint(o.V)
+	if o.V == 0 && math.Signbit(o.V) {
+		s = "-0"
+	}
+	if o.Exact != "" {
+		s += " (exact " + o.Exact + ")"
+	}
+	return s
+}
+
+// CaseName implements TestCase.
+func (c Case) CaseName() string { return c.Name }
+
+// Expected implements TestCase.
+func (c Case) Expected() Result { return c.Want }
+
+// finite reports whether every operand of c is finite. Results may be
+// non-finite, the HTTP servers encode them as strings.
+func (c Case) finite() bool {
+	for _, v := range append([]float64{c.A, c.B}, c.Values...) {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// Client performs the call described by a Case against one implementation.
+// Errors returned by the service are part of the Outcome, Do only returns an
+// error when the call couldn't be made.
+type Client interface {
+	Do(ctx context.Context, c Case) (Outcome, error)
+}
+
+// Implementation names a Client connected to one server implementation.
+type Implementation struct {
+	Name   string
+	Client Client
+}
+
+// Run runs every case against every implementation with RunCases.
+func Run(t *testing.T, impls []Implementation, cases []Case) {
+	targets := make([]Target, len(impls))
+	for i, impl := range impls {
+		targets[i] = Target{Name: impl.Name, Caller: clientCaller{impl.Client}}
+	}
+	RunCases(t, targets, TestCases(cases))
+}
+
+// clientCaller is the Caller of a Client.
+type clientCaller struct {
+	Client
+}
+
+func (c clientCaller) Call(ctx context.Context, tc TestCase) (Result, error) {
+	return c.Do(ctx, tc.(Case))
+}
+
+var (
+	nan     = math.NaN()
+	inf     = math.Inf(1)
+	negZero = math.Copysign(0, -1)
+)
+
+// Cases is the table of cases every implementation must pass.
+var Cases = []Case{
+	{Name: "divide", Method: "Divide", A: 7, B: 2, Want: Outcome{V: 3.5}},
+	{Name: "divide by zero", Method: "Divide", A: 1, B: 0, Want: Fail(pb.ErrorCode_DIVIDE_BY_ZERO)},
+	{Name: "divide by negative zero", Method: "Divide", A: 1, B: negZero, Want: Fail(pb.ErrorCode_DIVIDE_BY_ZERO)},
+	{Name: "divide zero by zero", Method: "Divide", A: 0, B: 0, Want: Fail(pb.ErrorCode_DIVIDE_BY_ZERO)},
+	{Name: "divide by inf", Method: "Divide", A: -1, B: inf, Want: Outcome{V: negZero}},
+	{Name: "divide inf by inf", Method: "Divide", A: inf, B: inf, Want: Outcome{V: nan}},
+	{Name: "divide nan", Method: "Divide", A: nan, B: 1, Want: Outcome{V: nan}},
+	{Name: "divide overflow", Method: "Divide", A: math.MaxFloat64, B: 0.5, Want: Outcome{V: inf}},
+	{Name: "divide rational", Method: "Divide", A: 1, B: 3, Precision: precision.Precision{Mode: precision.Rational}, Want: Outcome{V: 1.0 / 3, Exact: "1/3"}},
+	{Name: "divide bigfloat too many bits", Method: "Divide", A: 1, B: 3, Precision: precision.Precision{Mode: precision.BigFloat, Bits: precision.MaxBits + 1}, Want: Fail(pb.ErrorCode_INVALID_PRECISION)},
+
+	{Name: "max", Method: "Max", A: 1, B: 2, Want: Outcome{V: 2}},
+	{Name: "max equal", Method: "Max", A: 2, B: 2, Want: Fail(pb.ErrorCode_NO_MAX)},
+	{Name: "max equal zeros", Method: "Max", A: negZero, B: 0, Want: Fail(pb.ErrorCode_NO_MAX)},
+	{Name: "max equal infs", Method: "Max", A: inf, B: inf, Want: Fail(pb.ErrorCode_NO_MAX)},
+	{Name: "max inf", Method: "Max", A: -inf, B: inf, Want: Outcome{V: inf}},
+	{Name: "max nan", Method: "Max", A: nan, B: 1, Want: Outcome{V: nan}},
+	{Name: "max nans", Method: "Max", A: nan, B: nan, Want: Outcome{V: nan}},
+
+	{Name: "min", Method: "Min", A: 1, B: 2, Want: Outcome{V: 1}},
+	{Name: "min equal", Method: "Min", A: -3, B: -3, Want: Fail(pb.ErrorCode_NO_MIN)},
+	{Name: "min equal zeros", Method: "Min", A: 0, B: negZero, Want: Fail(pb.ErrorCode_NO_MIN)},
+	{Name: "min inf", Method: "Min", A: -inf, B: 1, Want: Outcome{V: -inf}},
+	{Name: "min nan", Method: "Min", A: 1, B: nan, Want: Outcome{V: nan}},
+
+	{Name: "multiply", Method: "Multiply", A: 3, B: -4, Want: Outcome{V: -12}},
+	{Name: "multiply negative zero", Method: "Multiply", A: negZero, B: 5, Want: Outcome{V: negZero}},
+	{Name: "multiply inf by zero", Method: "Multiply", A: inf, B: 0, Want: Outcome{V: nan}},
+	{Name: "multiply rational", Method: "Multiply", A: 0.1, B: 3, Precision: precision.Precision{Mode: precision.Rational}, Want: Outcome{V: 0.3, Exact: "0.3"}},
+
+	{Name: "pow", Method: "Pow", A: 2, B: 10, Want: Outcome{V: 1024}},
+	{Name: "pow zero to negative", Method: "Pow", A: 0, B: -1, Want: Outcome{V: inf}},
+	{Name: "pow negative zero to negative", Method: "Pow", A: negZero, B: -1, Want: Outcome{V: -inf}},
+	{Name: "pow nan to zero", Method: "Pow", A: nan, B: 0, Want: Outcome{V: 1}},
+	{Name: "pow rational non-integer exponent", Method: "Pow", A: 2, B: 0.5, Precision: precision.Precision{Mode: precision.Rational}, Want: Fail(pb.ErrorCode_NON_INTEGER_EXPONENT)},
+
+	{Name: "subtract", Method: "Subtract", A: 1, B: 3, Want: Outcome{V: -2}},
+	{Name: "subtract infs", Method: "Subtract", A: inf, B: inf, Want: Outcome{V: nan}},
+	{Name: "subtract to negative zero", Method: "Subtract", A: negZero, B: 0, Want: Outcome{V: negZero}},
+
+	{Name: "sum", Method: "Sum", A: 1, B: 2, Want: Outcome{V: 3}},
+	{Name: "sum negative zeros", Method: "Sum", A: negZero, B: negZero, Want: Outcome{V: negZero}},
+	{Name: "sum overflow", Method: "Sum", A: math.MaxFloat64, B: math.MaxFloat64, Want: Outcome{V: inf}},
+	{Name: "sum nan", Method: "Sum", A: nan, B: 1, Want: Outcome{V: nan}},
+
+	{Name: "mod", Method: "Mod", A: 7, B: 3, Want: Outcome{V: 1}},
+	{Name: "mod negative dividend", Method: "Mod", A: -7, B: 3, Want: Outcome{V: -1}},
+	{Name: "mod negative divisor", Method: "Mod", A: 7, B: -3, Want: Outcome{V: 1}},
+	{Name: "mod floored negative dividend", Method: "Mod", A: -7, B: 3, Division: mathservice.Floored, Want: Outcome{V: 2}},
+	{Name: "mod floored negative divisor", Method: "Mod", A: 7, B: -3, Division: mathservice.Floored, Want: Outcome{V: -2}},
+	{Name: "mod by zero", Method: "Mod", A: 7, B: 0, Want: Fail(pb.ErrorCode_MODULO_BY_ZERO)},
+	{Name: "mod non-integer", Method: "Mod", A: 7.5, B: 2, Want: Fail(pb.ErrorCode_NOT_INTEGER)},
+	{Name: "mod nan", Method: "Mod", A: nan, B: 2, Want: Fail(pb.ErrorCode_NOT_INTEGER)},
+	{Name: "mod inf", Method: "Mod", A: 1, B: inf, Want: Fail(pb.ErrorCode_NOT_INTEGER)},
+
+	{Name: "intdivide", Method: "IntDivide", A: 7, B: 2, Want: Outcome{V: 3}},
+	{Name: "intdivide truncated", Method: "IntDivide", A: -7, B: 2, Want: Outcome{V: -3}},
+	{Name: "intdivide floored", Method: "IntDivide", A: -7, B: 2, Division: mathservice.Floored, Want: Outcome{V: -4}},
+	{Name: "intdivide floored exact", Method: "IntDivide", A: -8, B: 2, Division: mathservice.Floored, Want: Outcome{V: -4}},
+	{Name: "intdivide by zero", Method: "IntDivide", A: 7, B: 0, Want: Fail(pb.ErrorCode_DIVIDE_BY_ZERO)},
+	{Name: "intdivide non-integer", Method: "IntDivide", A: 7, B: 0.5, Want: Fail(pb.ErrorCode_NOT_INTEGER)},
+	{Name: "intdivide rational", Method: "IntDivide", A: 1e20, B: 7, Precision: precision.Precision{Mode: precision.Rational}, Want: Outcome{V: 14285714285714285714, Exact: "14285714285714285714"}},
+
+	{Name: "remainder truncated", Method: "Remainder", A: -7, B: 2, Want: Outcome{V: -1}},
+	{Name: "remainder floored", Method: "Remainder", A: -7, B: 2, Division: mathservice.Floored, Want: Outcome{V: 1}},
+	{Name: "remainder floored negative divisor", Method: "Remainder", A: 7, B: -2, Division: mathservice.Floored, Want: Outcome{V: -1}},
+	{Name: "remainder by zero", Method: "Remainder", A: 7, B: 0, Want: Fail(pb.ErrorCode_MODULO_BY_ZERO)},
+
+	{Name: "gcd", Method: "Gcd", A: 12, B: 18, Want: Outcome{V: 6}},
+	{Name: "gcd negative", Method: "Gcd", A: -4, B: 6, Want: Outcome{V: 2}},
+	{Name: "gcd zero", Method: "Gcd", A: 0, B: -5, Want: Outcome{V: 5}},
+	{Name: "gcd zeros", Method: "Gcd", A: 0, B: 0, Want: Outcome{V: 0}},
+	{Name: "gcd non-integer", Method: "Gcd", A: 2.5, B: 5, Want: Fail(pb.ErrorCode_NOT_INTEGER)},
+	{Name: "lcm", Method: "Lcm", A: 4, B: 6, Want: Outcome{V: 12}},
+	{Name: "lcm negative", Method: "Lcm", A: -4, B: 6, Want: Outcome{V: 12}},
+	{Name: "lcm zero", Method: "Lcm", A: 0, B: 5, Want: Outcome{V: 0}},
+	{Name: "lcm rational", Method: "Lcm", A: 123456789012, B: 987654321098, Precision: precision.Precision{Mode: precision.Rational}, Want: Outcome{V: 60966315568292943087588, Exact: "60966315568292943087588"}},
+
+	{Name: "sqrt", Method: "Sqrt", A: 16, Want: Outcome{V: 4}},
+	{Name: "sqrt negative", Method: "Sqrt", A: -4, Want: Fail(pb.ErrorCode_NEGATIVE_SQRT)},
+	{Name: "sqrt negative zero", Method: "Sqrt", A: negZero, Want: Outcome{V: negZero}},
+	{Name: "sqrt inf", Method: "Sqrt", A: inf, Want: Outcome{V: inf}},
+	{Name: "sqrt nan", Method: "Sqrt", A: nan, Want: Outcome{V: nan}},
+	{Name: "sqrt rational", Method: "Sqrt", A: 0.25, Precision: precision.Precision{Mode: precision.Rational}, Want: Outcome{V: 0.5, Exact: "0.5"}},
+	{Name: "sqrt rational irrational", Method: "Sqrt", A: 2, Precision: precision.Precision{Mode: precision.Rational}, Want: Fail(pb.ErrorCode_NOT_REPRESENTABLE)},
+	{Name: "abs", Method: "Abs", A: -3.5, Want: Outcome{V: 3.5}},
+	{Name: "abs negative zero", Method: "Abs", A: negZero, Want: Outcome{V: 0}},
+	{Name: "abs rational", Method: "Abs", A: -0.1, Precision: precision.Precision{Mode: precision.Rational}, Want: Outcome{V: 0.1, Exact: "0.1"}},
+	{Name: "negate", Method: "Negate", A: 2, Want: Outcome{V: -2}},
+	{Name: "negate zero", Method: "Negate", A: 0, Want: Outcome{V: negZero}},
+	{Name: "negate inf", Method: "Negate", A: -inf, Want: Outcome{V: inf}},
+	{Name: "exp", Method: "Exp", A: 0, Want: Outcome{V: 1}},
+	{Name: "exp overflow", Method: "Exp", A: 1000, Want: Outcome{V: inf}},
+	{Name: "exp rational", Method: "Exp", A: 1, Precision: precision.Precision{Mode: precision.Rational}, Want: Fail(pb.ErrorCode_NOT_REPRESENTABLE)},
+	{Name: "ln", Method: "Ln", A: math.E, Want: Outcome{V: 1}},
+	{Name: "ln zero", Method: "Ln", A: 0, Want: Fail(pb.ErrorCode_NON_POSITIVE_LOG)},
+	{Name: "ln negative", Method: "Ln", A: -1, Want: Fail(pb.ErrorCode_NON_POSITIVE_LOG)},
+	{Name: "ln inf", Method: "Ln", A: inf, Want: Outcome{V: inf}},
+	{Name: "log10", Method: "Log10", A: 1000, Want: Outcome{V: 3}},
+	{Name: "log10 negative zero", Method: "Log10", A: negZero, Want: Fail(pb.ErrorCode_NON_POSITIVE_LOG)},
+	{Name: "log2", Method: "Log2", A: 0.125, Want: Outcome{V: -3}},
+	{Name: "log2 negative", Method: "Log2", A: -8, Want: Fail(pb.ErrorCode_NON_POSITIVE_LOG)},
+	{Name: "floor", Method: "Floor", A: -2.5, Want: Outcome{V: -3}},
+	{Name: "floor inf", Method: "Floor", A: -inf, Want: Outcome{V: -inf}},
+	{Name: "floor rational", Method: "Floor", A: -0.1, Precision: precision.Precision{Mode: precision.Rational}, Want: Outcome{V: -1, Exact: "-1"}},
+	{Name: "ceil", Method: "Ceil", A: -2.5, Want: Outcome{V: -2}},
+	{Name: "ceil bigfloat", Method: "Ceil", A: 2.1, Precision: precision.Precision{Mode: precision.BigFloat, Bits: 128}, Want: Outcome{V: 3, Exact: "3"}},
+	{Name: "round half", Method: "Round", A: 2.5, Want: Outcome{V: 3}},
+	{Name: "round negative half", Method: "Round", A: -2.5, Want: Outcome{V: -3}},
+	{Name: "round rational", Method: "Round", A: 0.45, Precision: precision.Precision{Mode: precision.Rational}, Want: Outcome{V: 0, Exact: "0"}},
+	{Name: "trunc", Method: "Trunc", A: -2.7, Want: Outcome{V: -2}},
+	{Name: "trunc nan", Method: "Trunc", A: nan, Want: Outcome{V: nan}},
+	{Name: "trunc bigfloat", Method: "Trunc", A: 1e30, Precision: precision.Precision{Mode: precision.BigFloat, Bits: 128}, Want: Outcome{V: 1e30, Exact: "1e+30"}},
+	{Name: "sin", Method: "Sin", A: math.Pi / 2, Want: Outcome{V: 1}},
+	{Name: "sin inf", Method: "Sin", A: inf, Want: Outcome{V: nan}},
+	{Name: "cos", Method: "Cos", A: 0, Want: Outcome{V: 1}},
+	{Name: "tan", Method: "Tan", A: 0, Want: Outcome{V: 0}},
+	{Name: "tan bigfloat", Method: "Tan", A: 1, Precision: precision.Precision{Mode: precision.BigFloat, Bits: 128}, Want: Fail(pb.ErrorCode_NOT_REPRESENTABLE)},
+	{Name: "asin", Method: "Asin", A: 1, Want: Outcome{V: math.Pi / 2}},
+	{Name: "asin out of domain", Method: "Asin", A: 1.5, Want: Fail(pb.ErrorCode_OUT_OF_DOMAIN)},
+	{Name: "acos", Method: "Acos", A: -1, Want: Outcome{V: math.Pi}},
+	{Name: "acos out of domain", Method: "Acos", A: -inf, Want: Fail(pb.ErrorCode_OUT_OF_DOMAIN)},
+	{Name: "atan", Method: "Atan", A: inf, Want: Outcome{V: math.Pi / 2}},
+	{Name: "gamma", Method: "Gamma", A: 5, Want: Outcome{V: 24}},
+	{Name: "gamma half", Method: "Gamma", A: 0.5, Want: Outcome{V: math.Sqrt(math.Pi)}},
+	{Name: "gamma overflow", Method: "Gamma", A: 172, Want: Outcome{V: inf}},
+	{Name: "gamma pole", Method: "Gamma", A: -2, Want: Fail(pb.ErrorCode_POLE)},
+	{Name: "gamma zero", Method: "Gamma", A: 0, Want: Fail(pb.ErrorCode_POLE)},
+	{Name: "gamma rational", Method: "Gamma", A: 5, Precision: precision.Precision{Mode: precision.Rational}, Want: Fail(pb.ErrorCode_NOT_REPRESENTABLE)},
+	{Name: "loggamma", Method: "LogGamma", A: 1, Want: Outcome{V: 0}},
+	{Name: "loggamma past gamma overflow", Method: "LogGamma", A: 172, Want: Outcome{V: 711.71472580229}},
+	{Name: "loggamma pole", Method: "LogGamma", A: -1, Want: Fail(pb.ErrorCode_POLE)},
+	{Name: "beta", Method: "Beta", A: 2, B: 3, Want: Outcome{V: 1.0 / 12}},
+	{Name: "beta ones", Method: "Beta", A: 1, B: 1, Want: Outcome{V: 1}},
+	{Name: "beta large", Method: "Beta", A: 1e300, B: 1, Want: Outcome{V: 1.0000000000000237e-300}},
+	{Name: "beta pole", Method: "Beta", A: 0, B: 1, Want: Fail(pb.ErrorCode_POLE)},
+	{Name: "erf", Method: "Erf", A: 0, Want: Outcome{V: 0}},
+	{Name: "erf large", Method: "Erf", A: -10, Want: Outcome{V: -1}},
+	{Name: "erf inf", Method: "Erf", A: inf, Want: Outcome{V: 1}},
+	{Name: "erfc", Method: "Erfc", A: 0, Want: Outcome{V: 1}},
+	{Name: "erfc large", Method: "Erfc", A: 10, Want: Outcome{V: 2.0884875837625446e-45}},
+	{Name: "besselj", Method: "BesselJ", A: 0, B: 0, Want: Outcome{V: 1}},
+	{Name: "besselj order one", Method: "BesselJ", A: 1, B: 0, Want: Outcome{V: 0}},
+	{Name: "besselj negative order", Method: "BesselJ", A: -1, B: 1, Want: Outcome{V: -math.J1(1)}},
+	{Name: "besselj fractional order", Method: "BesselJ", A: 1.5, B: 1, Want: Fail(pb.ErrorCode_INVALID_ORDER)},
+	{Name: "besselj order too large", Method: "BesselJ", A: 1e9, B: 1, Want: Fail(pb.ErrorCode_INVALID_ORDER)},
+	{Name: "bessely", Method: "BesselY", A: 0, B: 1, Want: Outcome{V: math.Y0(1)}},
+	{Name: "bessely zero", Method: "BesselY", A: 0, B: 0, Want: Fail(pb.ErrorCode_NON_POSITIVE_ARGUMENT)},
+	{Name: "bessely bigfloat", Method: "BesselY", A: 0, B: 1, Precision: precision.Precision{Mode: precision.BigFloat, Bits: 128}, Want: Fail(pb.ErrorCode_NOT_REPRESENTABLE)},
+
+	{Name: "sumall", Method: "SumAll", Values: []float64{1e16, 1, -1e16, 4}, Want: Outcome{V: 5}},
+	{Name: "sumall empty", Method: "SumAll", Want: Outcome{V: 0}},
+	{Name: "sumall infs", Method: "SumAll", Values: []float64{inf, -inf}, Want: Outcome{V: nan}},
+	{Name: "product", Method: "Product", Values: []float64{2, 3, 4}, Want: Outcome{V: 24}},
+	{Name: "product empty", Method: "Product", Want: Outcome{V: 1}},
+	{Name: "mean", Method: "Mean", Values: []float64{1, 2, 3, 4}, Want: Outcome{V: 2.5}},
+	{Name: "mean empty", Method: "Mean", Want: Fail(pb.ErrorCode_NO_VALUES)},
+	{Name: "median", Method: "Median", Values: []float64{3, 1, 2}, Want: Outcome{V: 2}},
+	{Name: "median even", Method: "Median", Values: []float64{4, 1, 3, 2}, Want: Outcome{V: 2.5}},
+	{Name: "median empty", Method: "Median", Want: Fail(pb.ErrorCode_NO_VALUES)},
+	{Name: "variance", Method: "Variance", Values: []float64{1, 2, 3, 4}, Want: Outcome{V: 1.25}},
+	{Name: "variance empty", Method: "Variance", Want: Fail(pb.ErrorCode_NO_VALUES)},
+	{Name: "stddev", Method: "StdDev", Values: []float64{2, 4, 4, 4, 5, 5, 7, 9}, Want: Outcome{V: 2}},
+	{Name: "stddev empty", Method: "StdDev", Want: Fail(pb.ErrorCode_NO_VALUES)},
+
+	{Name: "evaluate", Method: "Evaluate", Expression: "(3+4)*2^5/7", Want: Outcome{V: 32}},
+	{Name: "evaluate unary minus", Method: "Evaluate", Expression: "-2^2", Want: Outcome{V: -4}},
+	{Name: "evaluate functions", Method: "Evaluate", Expression: "max(1, min(5, 3))", Want: Outcome{V: 3}},
+	{Name: "evaluate unary functions", Method: "Evaluate", Expression: "sqrt(16)+abs(-2)", Want: Outcome{V: 6}},
+	{Name: "evaluate constants", Method: "Evaluate", Expression: "cos(pi)*ln(e)", Want: Outcome{V: -1}},
+	{Name: "evaluate function without call", Method: "Evaluate", Expression: "sqrt+1", Want: Fail(pb.ErrorCode_SYNTAX_ERROR)},
+	{Name: "evaluate unknown name", Method: "Evaluate", Expression: "x+1", Want: Fail(pb.ErrorCode_SYNTAX_ERROR)},
+	{Name: "evaluate divide by zero", Method: "Evaluate", Expression: "1/(2-2)", Want: Fail(pb.ErrorCode_DIVIDE_BY_ZERO)},
+	{Name: "evaluate equal max", Method: "Evaluate", Expression: "max(2, 2)", Want: Fail(pb.ErrorCode_NO_MAX)},
+	{Name: "evaluate syntax error", Method: "Evaluate", Expression: "1+", Want: Fail(pb.ErrorCode_SYNTAX_ERROR)},
+	{Name: "evaluate too deep", Method: "Evaluate", Expression: strings.Repeat("(", 100000) + "1" + strings.Repeat(")", 100000), Want: Fail(pb.ErrorCode_SYNTAX_ERROR)},
+	{Name: "evaluate rational", Method: "Evaluate", Expression: "1/3+1/6", Precision: precision.Precision{Mode: precision.Rational}, Want: Outcome{V: 0.5, Exact: "0.5"}},
+	{Name: "evaluate rational literal operand", Method: "Evaluate", Expression: "1/3 - 0.3333333333333333", Precision: precision.Precision{Mode: precision.Rational}, Want: Outcome{V: 1.0 / 30000000000000000, Exact: "1/30000000000000000"}},
+	{Name: "evaluate rational computed operand", Method: "Evaluate", Expression: "0.3333333333333333 - 1/3", Precision: precision.Precision{Mode: precision.Rational}, Want: Outcome{V: -1.0 / 30000000000000000, Exact: "-1/30000000000000000"}},
+	{Name: "evaluate rational overflow", Method: "Evaluate", Expression: "10^400/10^399", Precision: precision.Precision{Mode: precision.Rational}, Want: Outcome{V: 10, Exact: "10"}},
+	{Name: "evaluate rational chained pow", Method: "Evaluate", Expression: "(10^16384)^16384", Precision: precision.Precision{Mode: precision.Rational}, Want: Fail(pb.ErrorCode_EXPONENT_TOO_LARGE)},
+	{Name: "evaluate bigfloat too many bits", Method: "Evaluate", Expression: "1/3", Precision: precision.Precision{Mode: precision.BigFloat, Bits: 1 << 30}, Want: Fail(pb.ErrorCode_INVALID_PRECISION)},
+}
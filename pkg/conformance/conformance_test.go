@@ -0,0 +1,227 @@
+package conformance_test
+
+import (
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/conformance"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/variants"
+	"google.golang.org/grpc"
+)
+
+func TestImplementations(t *testing.T) {
+	impls, stop := serve(t, mathservice.PassNonFinite)
+	defer stop()
+
+	conformance.Run(t, impls, conformance.Cases)
+}
+
+func TestNonFinitePolicies(t *testing.T) {
+	var (
+		inf      = math.Inf(1)
+		nan      = math.NaN()
+		rational = precision.Precision{Mode: precision.Rational}
+		tenTo400 = "1" + strings.Repeat("0", 400)
+	)
+	tests := []struct {
+		policy mathservice.NonFinitePolicy
+		cases  []conformance.Case
+	}{
+		{mathservice.RejectNonFinite, []conformance.Case{
+			{Name: "pow nan", Method: "Pow", A: -8, B: 1.0 / 3, Want: conformance.Fail(pb.ErrorCode_NON_FINITE)},
+			{Name: "pow overflow", Method: "Pow", A: 10, B: 400, Want: conformance.Fail(pb.ErrorCode_NON_FINITE)},
+			{Name: "pow overflow rational", Method: "Pow", A: 10, B: 400, Precision: rational, Want: conformance.Outcome{V: inf, Exact: tenTo400}},
+			{Name: "evaluate overflow", Method: "Evaluate", Expression: "1/10^400", Want: conformance.Fail(pb.ErrorCode_NON_FINITE)},
+			{Name: "sum", Method: "Sum", A: 1, B: 2, Want: conformance.Outcome{V: 3}},
+		}},
+		{mathservice.StringNonFinite, []conformance.Case{
+			{Name: "pow nan", Method: "Pow", A: -8, B: 1.0 / 3, Want: conformance.Outcome{V: nan, Exact: "NaN"}},
+			{Name: "pow overflow", Method: "Pow", A: 10, B: 400, Want: conformance.Outcome{V: inf, Exact: "Infinity"}},
+			{Name: "multiply overflow", Method: "Multiply", A: -math.MaxFloat64, B: 2, Want: conformance.Outcome{V: -inf, Exact: "-Infinity"}},
+			{Name: "pow overflow rational", Method: "Pow", A: 10, B: 400, Precision: rational, Want: conformance.Outcome{V: inf, Exact: tenTo400}},
+			{Name: "evaluate overflow", Method: "Evaluate", Expression: "1/10^400", Want: conformance.Outcome{V: 0}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.policy.String(), func(t *testing.T) {
+			impls, stop := serve(t, tt.policy)
+			defer stop()
+
+			conformance.Run(t, impls, tt.cases)
+		})
+	}
+}
+
+// serve serves every implementation over each of its transports, applying
+// nonFinite to the results.
+func serve(t *testing.T, nonFinite mathservice.NonFinitePolicy) ([]conformance.Implementation, func()) {
+	var (
+		impls []conformance.Implementation
+		stops []func()
+	)
+	add := func(name string, c conformance.Client, stop func()) {
+		impls = append(impls, conformance.Implementation{Name: name, Client: c})
+		stops = append(stops, stop)
+	}
+	for _, v := range variants.All(precision.Precision{Mode: precision.Float64}, nonFinite) {
+		c, stop := conformance.ServeGRPC(t, v.NewGRPCServer(false), v.GRPCOptions...)
+		add(v.Name+" gRPC", c, stop)
+
+		c, stop = conformance.ServeGRPC(t, v.NewGRPCServer(true), v.GRPCOptions...)
+		add(v.Name+" gRPC status errors", c, stop)
+
+		c, stop = conformance.ServeCompute(t, v.NewGRPCServer(true), v.GRPCOptions...)
+		add(v.Name+" gRPC Compute", c, stop)
+
+		c, stop = conformance.ServeBatch(t, v.NewGRPCServer(true), v.GRPCOptions...)
+		add(v.Name+" gRPC Batch", c, stop)
+
+		if v.HTTPHandler != nil {
+			c, stop = conformance.ServeHTTP(v.HTTPHandler)
+			add(v.Name+" HTTP", c, stop)
+		}
+		if v.HTTPBatch {
+			c, stop = conformance.ServeHTTPBatch(v.HTTPHandler)
+			add(v.Name+" HTTP Batch", c, stop)
+		}
+	}
+	return impls, func() {
+		for _, stop := range stops {
+			stop()
+		}
+	}
+}
+
+// TestServices runs the cases of every service but Math against every
+// implementation of it, over gRPC with and without status errors and over
+// HTTP when the implementation serves it.
+func TestServices(t *testing.T) {
+	services := []struct {
+		name  string
+		cases []conformance.TestCase
+		// grpc serves the gRPC server of v, it returns a nil Caller when v
+		// doesn't implement the service.
+		grpc func(t *testing.T, v variants.Variant, statusErrors bool) (conformance.Caller, func())
+		http func(h http.Handler) (conformance.Caller, func())
+	}{
+		{"Complex", conformance.TestCases(conformance.ComplexCases), func(t *testing.T, v variants.Variant, statusErrors bool) (conformance.Caller, func()) {
+			if v.NewComplexGRPCServer == nil {
+				return nil, nil
+			}
+			srv := v.NewComplexGRPCServer(statusErrors)
+			return conformance.ServeServiceGRPC(t, "Complex", func(s *grpc.Server) { pb.RegisterComplexServer(s, srv) }, v.GRPCOptions...)
+		}, func(h http.Handler) (conformance.Caller, func()) {
+			return conformance.ServeServiceHTTP(h, "Complex")
+		}},
+		{"LinearAlgebra", conformance.TestCases(conformance.LinearAlgebraCases), func(t *testing.T, v variants.Variant, statusErrors bool) (conformance.Caller, func()) {
+			if v.NewLinearAlgebraGRPCServer == nil {
+				return nil, nil
+			}
+			srv := v.NewLinearAlgebraGRPCServer(statusErrors)
+			return conformance.ServeServiceGRPC(t, "LinearAlgebra", func(s *grpc.Server) { pb.RegisterLinearAlgebraServer(s, srv) }, v.GRPCOptions...)
+		}, func(h http.Handler) (conformance.Caller, func()) {
+			return conformance.ServeServiceHTTP(h, "LinearAlgebra")
+		}},
+		{"Polynomial", conformance.TestCases(conformance.PolynomialCases), func(t *testing.T, v variants.Variant, statusErrors bool) (conformance.Caller, func()) {
+			if v.NewPolynomialGRPCServer == nil {
+				return nil, nil
+			}
+			srv := v.NewPolynomialGRPCServer(statusErrors)
+			return conformance.ServeServiceGRPC(t, "Polynomial", func(s *grpc.Server) { pb.RegisterPolynomialServer(s, srv) }, v.GRPCOptions...)
+		}, func(h http.Handler) (conformance.Caller, func()) {
+			return conformance.ServeServiceHTTP(h, "Polynomial")
+		}},
+		{"Statistics", conformance.TestCases(conformance.StatisticsCases), func(t *testing.T, v variants.Variant, statusErrors bool) (conformance.Caller, func()) {
+			if v.NewStatisticsGRPCServer == nil {
+				return nil, nil
+			}
+			srv := v.NewStatisticsGRPCServer(statusErrors)
+			return conformance.ServeServiceGRPC(t, "Statistics", func(s *grpc.Server) { pb.RegisterStatisticsServer(s, srv) }, v.GRPCOptions...)
+		}, nil},
+		{"Units", conformance.TestCases(conformance.UnitsCases), func(t *testing.T, v variants.Variant, statusErrors bool) (conformance.Caller, func()) {
+			if v.NewUnitsGRPCServer == nil {
+				return nil, nil
+			}
+			srv := v.NewUnitsGRPCServer(statusErrors)
+			return conformance.ServeServiceGRPC(t, "Units", func(s *grpc.Server) { pb.RegisterUnitsServer(s, srv) }, v.GRPCOptions...)
+		}, func(h http.Handler) (conformance.Caller, func()) {
+			return conformance.ServeServiceHTTP(h, "Units")
+		}},
+		{"Finance", conformance.TestCases(conformance.FinanceCases), func(t *testing.T, v variants.Variant, statusErrors bool) (conformance.Caller, func()) {
+			if v.NewFinanceGRPCServer == nil {
+				return nil, nil
+			}
+			srv := v.NewFinanceGRPCServer(statusErrors)
+			return conformance.ServeServiceGRPC(t, "Finance", func(s *grpc.Server) { pb.RegisterFinanceServer(s, srv) }, v.GRPCOptions...)
+		}, func(h http.Handler) (conformance.Caller, func()) {
+			return conformance.ServeServiceHTTP(h, "Finance")
+		}},
+		{"NumberTheory", conformance.TestCases(conformance.NumberTheoryCases), func(t *testing.T, v variants.Variant, statusErrors bool) (conformance.Caller, func()) {
+			if v.NewNumberTheoryGRPCServer == nil {
+				return nil, nil
+			}
+			srv := v.NewNumberTheoryGRPCServer(statusErrors)
+			return conformance.ServeServiceGRPC(t, "NumberTheory", func(s *grpc.Server) { pb.RegisterNumberTheoryServer(s, srv) }, v.GRPCOptions...)
+		}, func(h http.Handler) (conformance.Caller, func()) {
+			return conformance.ServeServiceHTTP(h, "NumberTheory")
+		}},
+		{"Combinatorics", conformance.TestCases(conformance.CombinatoricsCases), func(t *testing.T, v variants.Variant, statusErrors bool) (conformance.Caller, func()) {
+			if v.NewCombinatoricsGRPCServer == nil {
+				return nil, nil
+			}
+			srv := v.NewCombinatoricsGRPCServer(statusErrors)
+			return conformance.ServeServiceGRPC(t, "Combinatorics", func(s *grpc.Server) { pb.RegisterCombinatoricsServer(s, srv) }, v.GRPCOptions...)
+		}, func(h http.Handler) (conformance.Caller, func()) {
+			return conformance.ServeServiceHTTP(h, "Combinatorics")
+		}},
+		{"Calculus", conformance.TestCases(conformance.CalculusCases), func(t *testing.T, v variants.Variant, statusErrors bool) (conformance.Caller, func()) {
+			if v.NewCalculusGRPCServer == nil {
+				return nil, nil
+			}
+			srv := v.NewCalculusGRPCServer(statusErrors)
+			return conformance.ServeServiceGRPC(t, "Calculus", func(s *grpc.Server) { pb.RegisterCalculusServer(s, srv) }, v.GRPCOptions...)
+		}, func(h http.Handler) (conformance.Caller, func()) {
+			return conformance.ServeServiceHTTP(h, "Calculus")
+		}},
+		{"Symbolic", conformance.TestCases(conformance.SymbolicCases), func(t *testing.T, v variants.Variant, statusErrors bool) (conformance.Caller, func()) {
+			if v.NewSymbolicGRPCServer == nil {
+				return nil, nil
+			}
+			srv := v.NewSymbolicGRPCServer(statusErrors)
+			return conformance.ServeServiceGRPC(t, "Symbolic", func(s *grpc.Server) { pb.RegisterSymbolicServer(s, srv) }, v.GRPCOptions...)
+		}, func(h http.Handler) (conformance.Caller, func()) {
+			return conformance.ServeServiceHTTP(h, "Symbolic")
+		}},
+	}
+	for _, s := range services {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			var targets []conformance.Target
+			for _, v := range variants.All(precision.Precision{Mode: precision.Float64}, mathservice.PassNonFinite) {
+				c, stop := s.grpc(t, v, false)
+				if c == nil {
+					continue
+				}
+				defer stop()
+				targets = append(targets, conformance.Target{Name: v.Name + " gRPC", Caller: c})
+
+				c, stop = s.grpc(t, v, true)
+				defer stop()
+				targets = append(targets, conformance.Target{Name: v.Name + " gRPC status errors", Caller: c})
+
+				if s.http != nil && v.HTTPHandler != nil {
+					c, stop = s.http(v.HTTPHandler)
+					defer stop()
+					targets = append(targets, conformance.Target{Name: v.Name + " HTTP", Caller: c})
+				}
+			}
+
+			conformance.RunCases(t, targets, s.cases)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/financeservice"
+	"google.golang.org/grpc"
+)
+
+// cashFlows are the operands of NPV and IRR.
+type cashFlows financeservice.CashFlows
+
+func (o cashFlows) grpcRequest(method string) (req, reply proto.Message) {
+	switch method {
+	case "NPV", "IRR":
+		return financeservice.CashFlows(o).Proto(), new(pb.DecimalReply)
+	}
+	return nil, nil
+}
+
+func (o cashFlows) grpcValue(method string, reply proto.Message) interface{} {
+	return decimalValue(reply)
+}
+
+func (o cashFlows) httpRequest(method string) interface{} {
+	return financeservice.CashFlows(o)
+}
+
+func (o cashFlows) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	return decodeDecimal(v)
+}
+
+// timeValue are the operands of PMT, FV and PV.
+type timeValue financeservice.TimeValue
+
+func (o timeValue) grpcRequest(method string) (req, reply proto.Message) {
+	switch method {
+	case "PMT", "FV", "PV":
+		return financeservice.TimeValue(o).Proto(), new(pb.DecimalReply)
+	}
+	return nil, nil
+}
+
+func (o timeValue) grpcValue(method string, reply proto.Message) interface{} {
+	return decimalValue(reply)
+}
+
+func (o timeValue) httpRequest(method string) interface{} {
+	return financeservice.TimeValue(o)
+}
+
+func (o timeValue) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	return decodeDecimal(v)
+}
+
+// compounding are the operands of CompoundInterest.
+type compounding financeservice.Compounding
+
+func (o compounding) grpcRequest(method string) (req, reply proto.Message) {
+	if method != "CompoundInterest" {
+		return nil, nil
+	}
+	return financeservice.Compounding(o).Proto(), new(pb.DecimalReply)
+}
+
+func (o compounding) grpcValue(method string, reply proto.Message) interface{} {
+	return decimalValue(reply)
+}
+
+func (o compounding) httpRequest(method string) interface{} {
+	return financeservice.Compounding(o)
+}
+
+func (o compounding) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	return decodeDecimal(v)
+}
+
+// loan are the operands of AmortizationSchedule, whose rows are read until
+// the end of the stream over gRPC.
+type loan financeservice.Loan
+
+// stream calls AmortizationSchedule, which fails either with a status or
+// with a last row holding the error.
+func (o loan) stream(ctx context.Context, conn *grpc.ClientConn, method string) (Reply, error) {
+	if method != "AmortizationSchedule" {
+		return Reply{}, fmt.Errorf("unknown method %q", method)
+	}
+	stream, err := pb.NewFinanceClient(conn).AmortizationSchedule(ctx, financeservice.Loan(o).Proto())
+	if err != nil {
+		return Reply{}, err
+	}
+	var rows []financeservice.AmortizationRow
+	failed, err := financeservice.ReceiveSchedule(stream.Recv, func(row financeservice.AmortizationRow) error {
+		rows = append(rows, row)
+		return nil
+	})
+	if err != nil {
+		return Reply{}, err
+	}
+	if failed != nil {
+		return Failure(failed.Code), nil
+	}
+	return Reply{V: rows}, nil
+}
+
+func (o loan) httpRequest(method string) interface{} {
+	return financeservice.Loan(o)
+}
+
+func (o loan) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	var rows []financeservice.AmortizationRow
+	err := json.Unmarshal(v, &rows)
+	return rows, err
+}
+
+// decimalValue returns the decimal held by a reply of the Finance service.
+func decimalValue(reply proto.Message) interface{} {
+	return financeservice.Decimal(reply.(*pb.DecimalReply).V)
+}
+
+// decodeDecimal decodes the decimal returned by the Finance service over
+// HTTP.
+func decodeDecimal(v json.RawMessage) (interface{}, error) {
+	var d financeservice.Decimal
+	err := json.Unmarshal(v, &d)
+	return d, err
+}
+
+// flows is shorthand for the cash flows values discounted at rate.
+func flows(rate financeservice.Decimal, values ...financeservice.Decimal) cashFlows {
+	return cashFlows{Rate: rate, Values: values}
+}
+
+// FinanceCases is the table of cases every implementation of the Finance
+// service must pass. Results are compared as strings, so they must be
+// rounded the same way everywhere.
+var FinanceCases = []ServiceCase{
+	{Name: "npv", Method: "NPV", In: flows("0.1", "-1000", "300", "400", "500"), Want: Reply{V: "-21.04"}},
+	{Name: "npv scale", Method: "NPV", In: cashFlows{Rate: "0.1", Values: []financeservice.Decimal{"-1000", "300", "400", "500"}, Scale: financeservice.Places(10)}, Want: Reply{V: "-21.0368144252"}},
+	{Name: "npv decimal syntax", Method: "NPV", In: cashFlows{Rate: ".5", Values: []financeservice.Decimal{"5.", "+1.5e1"}, Scale: financeservice.Places(4)}, Want: Reply{V: "15.0000"}},
+	{Name: "npv no decimal places", Method: "NPV", In: cashFlows{Rate: "0.1", Values: []financeservice.Decimal{"-1000", "300", "400", "500"}, Scale: financeservice.Places(0)}, Want: Reply{V: "-21"}},
+	{Name: "npv zero rate", Method: "NPV", In: flows("", "-1000", "300", "400", "500"), Want: Reply{V: "200.00"}},
+	{Name: "npv no values", Method: "NPV", In: flows("0.1"), Want: Failure(pb.ErrorCode_NO_VALUES)},
+	{Name: "npv invalid decimal", Method: "NPV", In: flows("0.1", "-1000", "1,000"), Want: Failure(pb.ErrorCode_INVALID_DECIMAL)},
+	{Name: "npv too many digits", Method: "NPV", In: flows("0.1", financeservice.Decimal("1"+strings.Repeat("0", financeservice.MaxDigits))), Want: Failure(pb.ErrorCode_INVALID_DECIMAL)},
+	{Name: "npv huge exponent", Method: "NPV", In: flows("0.1", "1e999999999"), Want: Failure(pb.ErrorCode_INVALID_DECIMAL)},
+	{Name: "npv rate of -100%", Method: "NPV", In: flows("-1", "1"), Want: Failure(pb.ErrorCode_INVALID_RATE)},
+	{Name: "npv scale too large", Method: "NPV", In: cashFlows{Rate: "0.1", Values: []financeservice.Decimal{"1"}, Scale: financeservice.Places(51)}, Want: Failure(pb.ErrorCode_INVALID_SCALE)},
+
+	{Name: "irr", Method: "IRR", In: flows("", "-1000", "300", "400", "500"), Want: Reply{V: "0.08896339"}},
+	{Name: "irr of nothing gained", Method: "IRR", In: flows("", "1", "-1"), Want: Reply{V: "0.00000000"}},
+	{Name: "irr several sign changes", Method: "IRR", In: flows("", "100", "-200", "150", "-60"), Want: Reply{V: "0.14898965"}},
+	{Name: "irr nearest the guess", Method: "IRR", In: flows("", "-100", "230", "-132"), Want: Reply{V: "0.10000000"}},
+	{Name: "irr other root", Method: "IRR", In: flows("0.15", "-100", "230", "-132"), Want: Reply{V: "0.20000000"}},
+	{Name: "irr no sign change", Method: "IRR", In: flows("", "1", "2", "3"), Want: Failure(pb.ErrorCode_NO_SIGN_CHANGE)},
+	{Name: "irr no convergence", Method: "IRR", In: flows("5", "-100", "230", "-132"), Want: Failure(pb.ErrorCode_IRR_NO_CONVERGENCE)},
+	{Name: "irr no values", Method: "IRR", In: flows(""), Want: Failure(pb.ErrorCode_NO_VALUES)},
+
+	{Name: "pmt", Method: "PMT", In: timeValue{Rate: "0.005", Periods: 360, PV: "200000"}, Want: Reply{V: "-1199.10"}},
+	{Name: "pmt zero rate", Method: "PMT", In: timeValue{Periods: 12, PV: "1200"}, Want: Reply{V: "-100.00"}},
+	{Name: "pmt tiny rate", Method: "PMT", In: timeValue{Rate: "1e-400", Periods: 10000, PV: "10000"}, Want: Reply{V: "-1.00"}},
+	{Name: "pmt no periods", Method: "PMT", In: timeValue{Rate: "0.01", PV: "1200"}, Want: Failure(pb.ErrorCode_INVALID_PERIODS)},
+
+	{Name: "fv", Method: "FV", In: timeValue{Rate: "0.05", Periods: 10, PV: "-1000", PMT: "-100"}, Want: Reply{V: "2886.68"}},
+	{Name: "fv no periods", Method: "FV", In: timeValue{Rate: "0.05", PV: "-1000"}, Want: Reply{V: "1000.00"}},
+	{Name: "fv too many periods", Method: "FV", In: timeValue{Rate: "0.05", Periods: 10001, PV: "-1000"}, Want: Failure(pb.ErrorCode_INVALID_PERIODS)},
+
+	{Name: "pv", Method: "PV", In: timeValue{Rate: "0.05", Periods: 10, FV: "1628.89"}, Want: Reply{V: "-1000.00"}},
+	{Name: "pv invalid rate", Method: "PV", In: timeValue{Rate: "-1.5", Periods: 10, FV: "1628.89"}, Want: Failure(pb.ErrorCode_INVALID_RATE)},
+
+	{Name: "compound interest", Method: "CompoundInterest", In: compounding{Principal: "1000", Rate: "0.05", Periods: 10}, Want: Reply{V: "1628.89"}},
+	{Name: "compound interest monthly", Method: "CompoundInterest", In: compounding{Principal: "1000", Rate: "0.05", Periods: 10, Frequency: 12}, Want: Reply{V: "1647.01"}},
+	{Name: "compound interest too often", Method: "CompoundInterest", In: compounding{Principal: "1000", Rate: "0.05", Periods: 100, Frequency: 365}, Want: Failure(pb.ErrorCode_INVALID_PERIODS)},
+
+	{Name: "amortization schedule", Method: "AmortizationSchedule", In: loan{Principal: "1000", Rate: "0.01", Periods: 3}, Want: Reply{V: []financeservice.AmortizationRow{
+		{Period: 1, Payment: "340.02", Interest: "10.00", Principal: "330.02", Balance: "669.98"},
+		{Period: 2, Payment: "340.02", Interest: "6.70", Principal: "333.32", Balance: "336.66"},
+		{Period: 3, Payment: "340.03", Interest: "3.37", Principal: "336.66", Balance: "0.00"},
+	}}},
+	{Name: "amortization schedule zero rate", Method: "AmortizationSchedule", In: loan{Principal: "300", Periods: 3}, Want: Reply{V: []financeservice.AmortizationRow{
+		{Period: 1, Payment: "100.00", Interest: "0.00", Principal: "100.00", Balance: "200.00"},
+		{Period: 2, Payment: "100.00", Interest: "0.00", Principal: "100.00", Balance: "100.00"},
+		{Period: 3, Payment: "100.00", Interest: "0.00", Principal: "100.00", Balance: "0.00"},
+	}}},
+	{Name: "amortization schedule no decimal places", Method: "AmortizationSchedule", In: loan{Principal: "1000", Rate: "0.01", Periods: 3, Scale: financeservice.Places(0)}, Want: Reply{V: []financeservice.AmortizationRow{
+		{Period: 1, Payment: "340", Interest: "10", Principal: "330", Balance: "670"},
+		{Period: 2, Payment: "340", Interest: "7", Principal: "333", Balance: "337"},
+		{Period: 3, Payment: "340", Interest: "3", Principal: "337", Balance: "0"},
+	}}},
+	{Name: "amortization schedule no periods", Method: "AmortizationSchedule", In: loan{Principal: "300"}, Want: Failure(pb.ErrorCode_INVALID_PERIODS)},
+	{Name: "amortization schedule invalid principal", Method: "AmortizationSchedule", In: loan{Principal: "lots", Periods: 3}, Want: Failure(pb.ErrorCode_INVALID_DECIMAL)},
+}
@@ -0,0 +1,261 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/compute"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// ServeGRPC serves srv in-process over a bufconn listener and returns a Client
+// connected to it, along with a function that stops the server.
+func ServeGRPC(t testing.TB, srv pb.MathServer, opts ...grpc.ServerOption) (Client, func()) {
+	conn, stop := serveBufconn(t, srv, opts...)
+	return NewGRPCClient(conn), stop
+}
+
+// ServeCompute is like ServeGRPC but the returned Client performs every case
+// over a single Compute stream.
+func ServeCompute(t testing.TB, srv pb.MathServer, opts ...grpc.ServerOption) (Client, func()) {
+	conn, stop := serveBufconn(t, srv, opts...)
+	c, err := compute.NewClient(context.Background(), pb.NewMathClient(conn))
+	if err != nil {
+		stop()
+		t.Fatalf("opening compute stream: %v", err)
+	}
+	return NewComputeClient(c), func() {
+		c.Close()
+		stop()
+	}
+}
+
+// ServeBatch is like ServeGRPC but the returned Client performs every case as
+// the single item of a Batch call.
+func ServeBatch(t testing.TB, srv pb.MathServer, opts ...grpc.ServerOption) (Client, func()) {
+	conn, stop := serveBufconn(t, srv, opts...)
+	return NewBatchClient(conn), stop
+}
+
+func serveBufconn(t testing.TB, srv pb.MathServer, opts ...grpc.ServerOption) (*grpc.ClientConn, func()) {
+	return serveBufconnWith(t, func(s *grpc.Server) { pb.RegisterMathServer(s, srv) }, opts...)
+}
+
+// serveBufconnWith is serveBufconn for the services registered by register.
+func serveBufconnWith(t testing.TB, register func(*grpc.Server), opts ...grpc.ServerOption) (*grpc.ClientConn, func()) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer(opts...)
+	register(s)
+	go s.Serve(lis)
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+	)
+	if err != nil {
+		t.Fatalf("dialing bufconn: %v", err)
+	}
+	return conn, func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+// NewGRPCClient returns a Client that calls the Math service at the other end
+// of conn. Errors are read from the reply, or from the status of the call for
+// servers that report errors using gRPC status codes.
+func NewGRPCClient(conn *grpc.ClientConn) Client {
+	return grpcClient{pb.NewMathClient(conn)}
+}
+
+type grpcClient struct {
+	c pb.MathClient
+}
+
+func (g grpcClient) Do(ctx context.Context, c Case) (Outcome, error) {
+	var (
+		op   = &pb.MathOpRequest{A: c.A, B: c.B, Precision: c.Precision.Proto(), Division: c.Division.Proto()}
+		list = &pb.MathListRequest{Values: c.Values, Precision: c.Precision.Proto()}
+		un   = &pb.UnaryOpRequest{X: c.A, Precision: c.Precision.Proto()}
+		r    *pb.MathOpReply
+		err  error
+	)
+	switch c.Method {
+	case "Divide":
+		r, err = g.c.Divide(ctx, op)
+	case "Max":
+		r, err = g.c.Max(ctx, op)
+	case "Min":
+		r, err = g.c.Min(ctx, op)
+	case "Multiply":
+		r, err = g.c.Multiply(ctx, op)
+	case "Pow":
+		r, err = g.c.Pow(ctx, op)
+	case "Subtract":
+		r, err = g.c.Subtract(ctx, op)
+	case "Sum":
+		r, err = g.c.Sum(ctx, op)
+	case "Mod":
+		r, err = g.c.Mod(ctx, op)
+	case "IntDivide":
+		r, err = g.c.IntDivide(ctx, op)
+	case "Remainder":
+		r, err = g.c.Remainder(ctx, op)
+	case "Gcd":
+		r, err = g.c.Gcd(ctx, op)
+	case "Lcm":
+		r, err = g.c.Lcm(ctx, op)
+	case "Sqrt":
+		r, err = g.c.Sqrt(ctx, un)
+	case "Abs":
+		r, err = g.c.Abs(ctx, un)
+	case "Negate":
+		r, err = g.c.Negate(ctx, un)
+	case "Exp":
+		r, err = g.c.Exp(ctx, un)
+	case "Ln":
+		r, err = g.c.Ln(ctx, un)
+	case "Log10":
+		r, err = g.c.Log10(ctx, un)
+	case "Log2":
+		r, err = g.c.Log2(ctx, un)
+	case "Floor":
+		r, err = g.c.Floor(ctx, un)
+	case "Ceil":
+		r, err = g.c.Ceil(ctx, un)
+	case "Round":
+		r, err = g.c.Round(ctx, un)
+	case "Trunc":
+		r, err = g.c.Trunc(ctx, un)
+	case "Sin":
+		r, err = g.c.Sin(ctx, un)
+	case "Cos":
+		r, err = g.c.Cos(ctx, un)
+	case "Tan":
+		r, err = g.c.Tan(ctx, un)
+	case "Asin":
+		r, err = g.c.Asin(ctx, un)
+	case "Acos":
+		r, err = g.c.Acos(ctx, un)
+	case "Atan":
+		r, err = g.c.Atan(ctx, un)
+	case "Gamma":
+		r, err = g.c.Gamma(ctx, un)
+	case "LogGamma":
+		r, err = g.c.LogGamma(ctx, un)
+	case "Beta":
+		r, err = g.c.Beta(ctx, op)
+	case "Erf":
+		r, err = g.c.Erf(ctx, un)
+	case "Erfc":
+		r, err = g.c.Erfc(ctx, un)
+	case "BesselJ":
+		r, err = g.c.BesselJ(ctx, op)
+	case "BesselY":
+		r, err = g.c.BesselY(ctx, op)
+	case "SumAll":
+		r, err = g.c.SumAll(ctx, list)
+	case "Product":
+		r, err = g.c.Product(ctx, list)
+	case "Mean":
+		r, err = g.c.Mean(ctx, list)
+	case "Median":
+		r, err = g.c.Median(ctx, list)
+	case "Variance":
+		r, err = g.c.Variance(ctx, list)
+	case "StdDev":
+		r, err = g.c.StdDev(ctx, list)
+	case "Evaluate":
+		r, err = g.c.Evaluate(ctx, &pb.EvaluateRequest{Expression: c.Expression, Precision: c.Precision.Proto()})
+	default:
+		return Outcome{}, fmt.Errorf("unknown method %q", c.Method)
+	}
+	if code, _, ok := rpcstatus.Parse(err); ok {
+		return Fail(code), nil
+	}
+	if err != nil {
+		return Outcome{}, err
+	}
+	if r.Code != pb.ErrorCode_NO_ERROR {
+		return Fail(r.Code), nil
+	}
+	return Outcome{V: r.V, Exact: r.Exact}, nil
+}
+
+// NewComputeClient returns a Client that performs each case as an operation
+// of the Compute stream of c.
+func NewComputeClient(c *compute.Client) Client {
+	return computeClient{c}
+}
+
+type computeClient struct {
+	c *compute.Client
+}
+
+func (cc computeClient) Do(ctx context.Context, c Case) (Outcome, error) {
+	req, err := computeRequest(c)
+	if err != nil {
+		return Outcome{}, err
+	}
+	r, err := cc.c.Do(ctx, req)
+	if err != nil {
+		return Outcome{}, err
+	}
+	return replyOutcome(r), nil
+}
+
+// NewBatchClient returns a Client that performs each case as the single item
+// of a Batch call to the Math service at the other end of conn.
+func NewBatchClient(conn *grpc.ClientConn) Client {
+	return batchClient{pb.NewMathClient(conn)}
+}
+
+type batchClient struct {
+	c pb.MathClient
+}
+
+func (b batchClient) Do(ctx context.Context, c Case) (Outcome, error) {
+	req, err := computeRequest(c)
+	if err != nil {
+		return Outcome{}, err
+	}
+	req.Id = 1
+	r, err := b.c.Batch(ctx, &pb.BatchRequest{Items: []*pb.ComputeRequest{req}})
+	if err != nil {
+		return Outcome{}, err
+	}
+	if len(r.Results) != 1 || r.Results[0].Id != req.Id {
+		return Outcome{}, fmt.Errorf("got %d results, want a single result with id %d", len(r.Results), req.Id)
+	}
+	return replyOutcome(r.Results[0].Reply), nil
+}
+
+// computeRequest returns the ComputeRequest performing c.
+func computeRequest(c Case) (*pb.ComputeRequest, error) {
+	op, ok := pb.ComputeRequest_Op_value[strings.ToUpper(c.Method)]
+	if !ok {
+		return nil, fmt.Errorf("unknown method %q", c.Method)
+	}
+	return &pb.ComputeRequest{
+		Op:         pb.ComputeRequest_Op(op),
+		A:          c.A,
+		B:          c.B,
+		Values:     c.Values,
+		Expression: c.Expression,
+		Precision:  c.Precision.Proto(),
+		Division:   c.Division.Proto(),
+	}, nil
+}
+
+func replyOutcome(r *pb.MathOpReply) Outcome {
+	if r.Code != pb.ErrorCode_NO_ERROR {
+		return Fail(r.Code)
+	}
+	return Outcome{V: r.V, Exact: r.Exact}
+}
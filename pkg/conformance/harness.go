@@ -0,0 +1,329 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/problem"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc"
+)
+
+// TestCase is a single call made to every implementation of a service, such
+// as a Case or a ServiceCase.
+type TestCase interface {
+	CaseName() string
+	Expected() Result
+}
+
+// Result is the observable result of a call of a service, such as an Outcome
+// or a Reply.
+type Result interface {
+	String() string
+	// Matches reports whether the result is want, a result of the same
+	// service, the way the Equal method of the result compares them.
+	Matches(want Result) bool
+}
+
+// Caller performs the call described by a TestCase against one implementation
+// of a service. Errors returned by the service are part of the Result, Call
+// only returns an error when the call couldn't be made.
+type Caller interface {
+	Call(ctx context.Context, c TestCase) (Result, error)
+}
+
+// Target names a Caller connected to one server implementation.
+type Target struct {
+	Name   string
+	Caller Caller
+}
+
+// TestCases returns the cases of a table such as ComplexCases as TestCases.
+// cases must be a slice of a type implementing TestCase.
+func TestCases(cases interface{}) []TestCase {
+	v := reflect.ValueOf(cases)
+	tcs := make([]TestCase, v.Len())
+	for i := range tcs {
+		tcs[i] = v.Index(i).Interface().(TestCase)
+	}
+	return tcs
+}
+
+// RunCases runs every case against every target as a subtest named after the
+// case. A target that doesn't produce the expected result is reported, and so
+// are targets producing results that aren't identical, even when they all
+// match the expected one.
+func RunCases(t *testing.T, targets []Target, cases []TestCase) {
+	for _, c := range cases {
+		c := c
+		t.Run(c.CaseName(), func(t *testing.T) {
+			got := make(map[string]Result)
+			for _, target := range targets {
+				r, err := target.Caller.Call(context.Background(), c)
+				if errors.Is(err, ErrUnsupported) {
+					t.Logf("%s: skipped: %v", target.Name, err)
+					continue
+				}
+				if err != nil {
+					t.Errorf("%s: %v", target.Name, err)
+					continue
+				}
+				got[target.Name] = r
+				if !r.Matches(c.Expected()) {
+					t.Errorf("%s: got %v, want %v", target.Name, r, c.Expected())
+				}
+			}
+			if d := Differences(got); d != "" {
+				t.Errorf("implementations disagree:\n%s", d)
+			}
+		})
+	}
+}
+
+// Differences describes how the results produced by the named targets
+// differ, it returns "" if they are all the same. Results are the same when
+// their String methods are, so that results matching the expected one up to
+// a tolerance are still told apart.
+func Differences(results map[string]Result) string {
+	names := make(map[string][]string)
+	for name, r := range results {
+		names[r.String()] = append(names[r.String()], name)
+	}
+	if len(names) < 2 {
+		return ""
+	}
+	var lines []string
+	for r, n := range names {
+		sort.Strings(n)
+		lines = append(lines, fmt.Sprintf("\t%s: %s", r, strings.Join(n, ", ")))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+// ServiceCase is a single call made to every implementation of a service
+// other than Math. Method is the name of the gRPC method, e.g. "Divide", and
+// In holds the operands of the call, a value of one of the operand types
+// declared next to the table of cases of the service.
+type ServiceCase struct {
+	Name   string
+	Method string
+	In     interface{}
+	Want   Reply
+}
+
+// CaseName implements TestCase.
+func (c ServiceCase) CaseName() string { return c.Name }
+
+// Expected implements TestCase.
+func (c ServiceCase) Expected() Result { return c.Want }
+
+// Reply is the observable result of a ServiceCase. V is the value returned by
+// the call and is only compared when Code is NO_ERROR.
+type Reply struct {
+	V    interface{}
+	Code pb.ErrorCode
+}
+
+// Failure returns the Reply of a call failing with code.
+func Failure(code pb.ErrorCode) Reply {
+	return Reply{Code: code}
+}
+
+// Matches implements Result. Values having a matches method are compared by
+// it, the others by their String, so that like Outcome.Equal NaN is equal to
+// NaN and -0 isn't equal to 0.
+func (r Reply) Matches(want Result) bool {
+	w, ok := want.(Reply)
+	if !ok || r.Code != w.Code {
+		return false
+	}
+	if r.Code != pb.ErrorCode_NO_ERROR {
+		return true
+	}
+	if m, ok := r.V.(matcher); ok {
+		return m.matches(w.V)
+	}
+	return r.String() == w.String()
+}
+
+func (r Reply) String() string {
+	if r.Code != pb.ErrorCode_NO_ERROR {
+		return "error " + r.Code.String()
+	}
+	// fmt keeps the sign of zeroes, formats every NaN the same and an empty
+	// slice the same whether it's nil or not
+	return fmt.Sprint(r.V)
+}
+
+// matcher is implemented by the values of a Reply that match the expected
+// value up to a tolerance, or only in the parts both of them hold.
+type matcher interface {
+	matches(want interface{}) bool
+}
+
+// grpcOperands are the operands of the unary gRPC methods of a service.
+type grpcOperands interface {
+	// grpcRequest returns the request method is called with and the reply
+	// it's answered with, or nil if method doesn't take these operands.
+	grpcRequest(method string) (req, reply proto.Message)
+	// grpcValue returns the value held by reply, a reply returned by
+	// grpcRequest that doesn't hold an error.
+	grpcValue(method string, reply proto.Message) interface{}
+}
+
+// streamOperands are the operands of the streaming gRPC methods of a service,
+// which send the request or receive the reply in several messages.
+type streamOperands interface {
+	stream(ctx context.Context, conn *grpc.ClientConn, method string) (Reply, error)
+}
+
+// httpOperands are the operands of the HTTP methods of a service. They're
+// also httpValuers, or httpResulters for methods returning more than a value.
+type httpOperands interface {
+	// httpRequest returns the request body method is called with, which is
+	// encoded to JSON.
+	httpRequest(method string) interface{}
+}
+
+type httpValuer interface {
+	// httpValue decodes v, the "v" field of the response to method.
+	httpValue(method string, v json.RawMessage) (interface{}, error)
+}
+
+type httpResulter interface {
+	// httpResult decodes result, the whole response to method.
+	httpResult(method string, result json.RawMessage) (interface{}, error)
+}
+
+// ServeServiceGRPC serves the services registered by register in-process over
+// a bufconn listener and returns a Caller of the ServiceCases of service, the
+// name of a service of package pb such as "Complex", along with a function
+// that stops the server.
+func ServeServiceGRPC(t testing.TB, service string, register func(*grpc.Server), opts ...grpc.ServerOption) (Caller, func()) {
+	conn, stop := serveBufconnWith(t, register, opts...)
+	return NewServiceGRPCClient(conn, service), stop
+}
+
+// NewServiceGRPCClient returns a Caller of the ServiceCases of service at the
+// other end of conn. Like NewGRPCClient, errors are read from the reply or
+// from the status of the call.
+func NewServiceGRPCClient(conn *grpc.ClientConn, service string) Caller {
+	return serviceGRPCClient{conn: conn, service: service}
+}
+
+type serviceGRPCClient struct {
+	conn    *grpc.ClientConn
+	service string
+}
+
+func (g serviceGRPCClient) Call(ctx context.Context, tc TestCase) (Result, error) {
+	c := tc.(ServiceCase)
+	r, err := g.call(ctx, c)
+	if code, _, ok := rpcstatus.Parse(err); ok {
+		return Failure(code), nil
+	}
+	return r, err
+}
+
+func (g serviceGRPCClient) call(ctx context.Context, c ServiceCase) (Reply, error) {
+	if s, ok := c.In.(streamOperands); ok {
+		return s.stream(ctx, g.conn, c.Method)
+	}
+	in, ok := c.In.(grpcOperands)
+	if !ok {
+		return Reply{}, fmt.Errorf("%w: %T over gRPC", ErrUnsupported, c.In)
+	}
+	req, reply := in.grpcRequest(c.Method)
+	if req == nil {
+		return Reply{}, fmt.Errorf("unknown method %q", c.Method)
+	}
+	if err := g.conn.Invoke(ctx, "/pb."+g.service+"/"+c.Method, req, reply); err != nil {
+		return Reply{}, err
+	}
+	if r, ok := reply.(interface{ GetCode() pb.ErrorCode }); ok && r.GetCode() != pb.ErrorCode_NO_ERROR {
+		return Failure(r.GetCode()), nil
+	}
+	return Reply{V: in.grpcValue(c.Method, reply)}, nil
+}
+
+// ServeServiceHTTP serves h in-process using httptest and returns a Caller of
+// the ServiceCases of service, along with a function that stops the server.
+func ServeServiceHTTP(h http.Handler, service string) (Caller, func()) {
+	srv := httptest.NewServer(h)
+	return NewServiceHTTPClient(srv.URL, srv.Client(), service), srv.Close
+}
+
+// NewServiceHTTPClient returns a Caller of the ServiceCases of service on the
+// HTTP server at baseURL. Each method is served on its lower-cased name under
+// the lower-cased name of the service, e.g. /complex/divide, and errors are
+// read from problem details responses.
+func NewServiceHTTPClient(baseURL string, client *http.Client, service string) Caller {
+	return serviceHTTPClient{baseURL: baseURL + "/" + strings.ToLower(service) + "/", client: client}
+}
+
+type serviceHTTPClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+func (h serviceHTTPClient) Call(ctx context.Context, tc TestCase) (Result, error) {
+	c := tc.(ServiceCase)
+	in, ok := c.In.(httpOperands)
+	if !ok {
+		return Reply{}, fmt.Errorf("%w: %T over HTTP", ErrUnsupported, c.In)
+	}
+	req := in.httpRequest(c.Method)
+	if req == nil {
+		return Reply{}, fmt.Errorf("unknown method %q", c.Method)
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return Reply{}, err
+	}
+	r, err := http.NewRequest("POST", h.baseURL+strings.ToLower(c.Method), bytes.NewReader(body))
+	if err != nil {
+		return Reply{}, err
+	}
+	r.Header.Set("Content-Type", "application/json")
+	resp, err := h.client.Do(r.WithContext(ctx))
+	if err != nil {
+		return Reply{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		p := problem.Read(resp)
+		if p.Code == "" {
+			return Reply{}, fmt.Errorf("%s: %s", resp.Status, p.Detail)
+		}
+		return Failure(p.ErrorCode()), nil
+	}
+	var result json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return Reply{}, err
+	}
+	if r, ok := in.(httpResulter); ok {
+		v, err := r.httpResult(c.Method, result)
+		return Reply{V: v}, err
+	}
+	var o struct {
+		V json.RawMessage `json:"v"`
+	}
+	if err := json.Unmarshal(result, &o); err != nil {
+		return Reply{}, err
+	}
+	v, err := in.(httpValuer).httpValue(c.Method, o.V)
+	return Reply{V: v}, err
+}
@@ -0,0 +1,173 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/problem"
+)
+
+// ServeHTTP serves h in-process using httptest and returns a Client connected
+// to it, along with a function that stops the server.
+func ServeHTTP(h http.Handler) (Client, func()) {
+	srv := httptest.NewServer(h)
+	return NewHTTPClient(srv.URL, srv.Client()), srv.Close
+}
+
+// ServeHTTPBatch is like ServeHTTP but the returned Client performs every case
+// as the single item of a POST /batch request.
+func ServeHTTPBatch(h http.Handler) (Client, func()) {
+	srv := httptest.NewServer(h)
+	return NewHTTPBatchClient(srv.URL, srv.Client()), srv.Close
+}
+
+// NewHTTPClient returns a Client that calls the HTTP server at baseURL. Each
+// method is served on its lower-cased name, e.g. /divide, and errors are read
+// from problem details responses.
+func NewHTTPClient(baseURL string, client *http.Client) Client {
+	return httpClient{baseURL: baseURL, client: client}
+}
+
+type httpClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+func (h httpClient) Do(ctx context.Context, c Case) (Outcome, error) {
+	if !c.finite() {
+		return Outcome{}, fmt.Errorf("%w: JSON can't represent NaN or Inf operands", ErrUnsupported)
+	}
+	var req interface{}
+	switch c.Method {
+	case "Divide", "Max", "Min", "Multiply", "Pow", "Subtract", "Sum",
+		"Mod", "IntDivide", "Remainder", "Gcd", "Lcm", "Beta", "BesselJ", "BesselY":
+		req = struct {
+			A, B      float64
+			Precision precision.Precision  `json:"precision"`
+			Division  mathservice.Division `json:"division"`
+		}{c.A, c.B, c.Precision, c.Division}
+	case "Sqrt", "Abs", "Negate", "Exp", "Ln", "Log10", "Log2", "Floor", "Ceil",
+		"Round", "Trunc", "Sin", "Cos", "Tan", "Asin", "Acos", "Atan",
+		"Gamma", "LogGamma", "Erf", "Erfc":
+		req = struct {
+			X         float64             `json:"x"`
+			Precision precision.Precision `json:"precision"`
+		}{c.A, c.Precision}
+	case "SumAll", "Product", "Mean", "Median", "Variance", "StdDev":
+		req = struct {
+			Values    []float64           `json:"values"`
+			Precision precision.Precision `json:"precision"`
+		}{c.Values, c.Precision}
+	case "Evaluate":
+		req = struct {
+			Expression string              `json:"expression"`
+			Precision  precision.Precision `json:"precision"`
+		}{c.Expression, c.Precision}
+	default:
+		return Outcome{}, fmt.Errorf("unknown method %q", c.Method)
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return Outcome{}, err
+	}
+	r, err := http.NewRequest("POST", h.baseURL+"/"+strings.ToLower(c.Method), bytes.NewReader(body))
+	if err != nil {
+		return Outcome{}, err
+	}
+	r.Header.Set("Content-Type", "application/json")
+	resp, err := h.client.Do(r.WithContext(ctx))
+	if err != nil {
+		return Outcome{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		p := problem.Read(resp)
+		if p.Code == "" {
+			return Outcome{}, fmt.Errorf("%s: %s", resp.Status, p.Detail)
+		}
+		return Fail(p.ErrorCode()), nil
+	}
+	var o struct {
+		V     jsonfloat.Float64 `json:"v"`
+		Exact string            `json:"exact"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&o); err != nil {
+		return Outcome{}, err
+	}
+	return Outcome{V: float64(o.V), Exact: o.Exact}, nil
+}
+
+// NewHTTPBatchClient returns a Client that performs each case as the single
+// item of a POST /batch request to the HTTP server at baseURL.
+func NewHTTPBatchClient(baseURL string, client *http.Client) Client {
+	return httpBatchClient{baseURL: baseURL, client: client}
+}
+
+type httpBatchClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+func (h httpBatchClient) Do(ctx context.Context, c Case) (Outcome, error) {
+	if !c.finite() {
+		return Outcome{}, fmt.Errorf("%w: JSON can't represent NaN or Inf operands", ErrUnsupported)
+	}
+	type item struct {
+		ID         uint64               `json:"id"`
+		Op         string               `json:"op"`
+		A          float64              `json:"a"`
+		B          float64              `json:"b"`
+		Values     []float64            `json:"values,omitempty"`
+		Expression string               `json:"expression,omitempty"`
+		Precision  precision.Precision  `json:"precision"`
+		Division   mathservice.Division `json:"division"`
+	}
+	body, err := json.Marshal(struct {
+		Items []item `json:"items"`
+	}{[]item{{1, strings.ToLower(c.Method), c.A, c.B, c.Values, c.Expression, c.Precision, c.Division}}})
+	if err != nil {
+		return Outcome{}, err
+	}
+	r, err := http.NewRequest("POST", h.baseURL+"/batch", bytes.NewReader(body))
+	if err != nil {
+		return Outcome{}, err
+	}
+	r.Header.Set("Content-Type", "application/json")
+	resp, err := h.client.Do(r.WithContext(ctx))
+	if err != nil {
+		return Outcome{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Outcome{}, fmt.Errorf("%s: %s", resp.Status, problem.Read(resp).Detail)
+	}
+	var o struct {
+		Results []struct {
+			ID    uint64            `json:"id"`
+			V     jsonfloat.Float64 `json:"v"`
+			Exact string            `json:"exact"`
+			Error *problem.Problem  `json:"error"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&o); err != nil {
+		return Outcome{}, err
+	}
+	if len(o.Results) != 1 || o.Results[0].ID != 1 {
+		return Outcome{}, fmt.Errorf("got %d results, want a single result with id 1", len(o.Results))
+	}
+	res := o.Results[0]
+	if res.Error != nil {
+		return Fail(res.Error.ErrorCode()), nil
+	}
+	return Outcome{V: float64(res.V), Exact: res.Exact}, nil
+}
@@ -0,0 +1,188 @@
+package conformance
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+	"github.com/jwenz723/mathserver/pkg/linalgservice"
+)
+
+// vectors are the operands of Dot, Cross and Norm, which only uses X.
+type vectors struct {
+	X, Y []float64
+}
+
+func (o vectors) grpcRequest(method string) (req, reply proto.Message) {
+	req = &pb.VectorOpRequest{A: o.X, B: o.Y}
+	switch method {
+	case "Dot", "Norm":
+		return req, new(pb.MathOpReply)
+	case "Cross":
+		return req, new(pb.VectorReply)
+	}
+	return nil, nil
+}
+
+func (o vectors) grpcValue(method string, reply proto.Message) interface{} {
+	return linalgGRPCValue(reply)
+}
+
+func (o vectors) httpRequest(method string) interface{} {
+	return struct {
+		A jsonfloat.Slice `json:"a"`
+		B jsonfloat.Slice `json:"b"`
+	}{o.X, o.Y}
+}
+
+func (o vectors) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	return linalgHTTPValue(method, v)
+}
+
+// matrices are the operands of the matrix methods but Solve. Transpose,
+// Determinant and Inverse only use A.
+type matrices struct {
+	A, B linalgservice.Matrix
+}
+
+func (o matrices) grpcRequest(method string) (req, reply proto.Message) {
+	req = &pb.MatrixOpRequest{A: o.A.Proto(), B: o.B.Proto()}
+	switch method {
+	case "Add", "Multiply", "Transpose", "Inverse":
+		return req, new(pb.MatrixReply)
+	case "Determinant":
+		return req, new(pb.MathOpReply)
+	}
+	return nil, nil
+}
+
+func (o matrices) grpcValue(method string, reply proto.Message) interface{} {
+	return linalgGRPCValue(reply)
+}
+
+func (o matrices) httpRequest(method string) interface{} {
+	return struct {
+		A linalgservice.Matrix `json:"a"`
+		B linalgservice.Matrix `json:"b"`
+	}{o.A, o.B}
+}
+
+func (o matrices) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	return linalgHTTPValue(method, v)
+}
+
+// system is the operand of Solve, which solves A·x=B.
+type system struct {
+	A linalgservice.Matrix
+	B []float64
+}
+
+func (o system) grpcRequest(method string) (req, reply proto.Message) {
+	if method != "Solve" {
+		return nil, nil
+	}
+	return &pb.SolveRequest{A: o.A.Proto(), B: o.B}, new(pb.VectorReply)
+}
+
+func (o system) grpcValue(method string, reply proto.Message) interface{} {
+	return linalgGRPCValue(reply)
+}
+
+func (o system) httpRequest(method string) interface{} {
+	return struct {
+		A linalgservice.Matrix `json:"a"`
+		B jsonfloat.Slice      `json:"b"`
+	}{o.A, o.B}
+}
+
+func (o system) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	return linalgHTTPValue(method, v)
+}
+
+// linalgGRPCValue returns the float64, []float64 or linalgservice.Matrix held
+// by a reply of the LinearAlgebra service.
+func linalgGRPCValue(reply proto.Message) interface{} {
+	switch r := reply.(type) {
+	case *pb.MathOpReply:
+		return r.V
+	case *pb.VectorReply:
+		return r.V
+	default:
+		return linalgservice.FromProto(reply.(*pb.MatrixReply).V)
+	}
+}
+
+// linalgHTTPValue decodes the value returned by method like linalgGRPCValue.
+func linalgHTTPValue(method string, v json.RawMessage) (interface{}, error) {
+	switch method {
+	case "Dot", "Norm", "Determinant":
+		var f jsonfloat.Float64
+		err := json.Unmarshal(v, &f)
+		return float64(f), err
+	case "Cross", "Solve":
+		var s jsonfloat.Slice
+		err := json.Unmarshal(v, &s)
+		return []float64(s), err
+	default:
+		var m linalgservice.Matrix
+		err := json.Unmarshal(v, &m)
+		return m, err
+	}
+}
+
+// matrix returns the rows x cols matrix of values, in row-major order.
+func matrix(rows, cols int, values ...float64) linalgservice.Matrix {
+	return linalgservice.Matrix{Rows: rows, Cols: cols, Values: values}
+}
+
+// LinearAlgebraCases is the table of cases every implementation of the
+// LinearAlgebra service must pass.
+var LinearAlgebraCases = []ServiceCase{
+	{Name: "dot", Method: "Dot", In: vectors{[]float64{1, 2, 3}, []float64{4, 5, 6}}, Want: Reply{V: 32.0}},
+	{Name: "dot empty", Method: "Dot", In: vectors{}, Want: Reply{V: 0.0}},
+	{Name: "dot nan", Method: "Dot", In: vectors{[]float64{1, nan}, []float64{1, 1}}, Want: Reply{V: nan}},
+	{Name: "dot dimension mismatch", Method: "Dot", In: vectors{[]float64{1, 2}, []float64{1, 2, 3}}, Want: Failure(pb.ErrorCode_DIMENSION_MISMATCH)},
+
+	{Name: "cross", Method: "Cross", In: vectors{[]float64{1, 0, 0}, []float64{0, 1, 0}}, Want: Reply{V: []float64{0, 0, 1}}},
+	{Name: "cross parallel", Method: "Cross", In: vectors{[]float64{1, 2, 3}, []float64{2, 4, 6}}, Want: Reply{V: []float64{0, 0, 0}}},
+	{Name: "cross 2 dimensional", Method: "Cross", In: vectors{[]float64{1, 0}, []float64{0, 1}}, Want: Failure(pb.ErrorCode_DIMENSION_MISMATCH)},
+
+	{Name: "norm", Method: "Norm", In: vectors{X: []float64{3, 4}}, Want: Reply{V: 5.0}},
+	{Name: "norm empty", Method: "Norm", In: vectors{}, Want: Reply{V: 0.0}},
+	{Name: "norm no overflow", Method: "Norm", In: vectors{X: []float64{1e200, 1e200}}, Want: Reply{V: math.Hypot(1e200, 1e200)}},
+	{Name: "norm inf nan", Method: "Norm", In: vectors{X: []float64{nan, -inf}}, Want: Reply{V: inf}},
+
+	{Name: "add", Method: "Add", In: matrices{matrix(2, 2, 1, 2, 3, 4), matrix(2, 2, 5, 6, 7, 8)}, Want: Reply{V: matrix(2, 2, 6, 8, 10, 12)}},
+	{Name: "add dimension mismatch", Method: "Add", In: matrices{matrix(2, 2, 1, 2, 3, 4), matrix(1, 4, 1, 2, 3, 4)}, Want: Failure(pb.ErrorCode_DIMENSION_MISMATCH)},
+	{Name: "add malformed", Method: "Add", In: matrices{matrix(2, 2, 1, 2, 3), matrix(2, 2, 1, 2, 3, 4)}, Want: Failure(pb.ErrorCode_MALFORMED_MATRIX)},
+
+	{Name: "multiply", Method: "Multiply", In: matrices{matrix(2, 3, 1, 2, 3, 4, 5, 6), matrix(3, 2, 7, 8, 9, 10, 11, 12)}, Want: Reply{V: matrix(2, 2, 58, 64, 139, 154)}},
+	{Name: "multiply row by column", Method: "Multiply", In: matrices{matrix(1, 3, 1, 2, 3), matrix(3, 1, 4, 5, 6)}, Want: Reply{V: matrix(1, 1, 32)}},
+	{Name: "multiply dimension mismatch", Method: "Multiply", In: matrices{matrix(2, 3, 1, 2, 3, 4, 5, 6), matrix(2, 3, 1, 2, 3, 4, 5, 6)}, Want: Failure(pb.ErrorCode_DIMENSION_MISMATCH)},
+	{Name: "multiply malformed", Method: "Multiply", In: matrices{matrix(1, 1, 1), matrix(1, 2, 1)}, Want: Failure(pb.ErrorCode_MALFORMED_MATRIX)},
+
+	{Name: "transpose", Method: "Transpose", In: matrices{A: matrix(2, 3, 1, 2, 3, 4, 5, 6)}, Want: Reply{V: matrix(3, 2, 1, 4, 2, 5, 3, 6)}},
+	{Name: "transpose malformed", Method: "Transpose", In: matrices{A: matrix(2, 3, 1, 2, 3, 4, 5, 6, 7)}, Want: Failure(pb.ErrorCode_MALFORMED_MATRIX)},
+
+	{Name: "determinant", Method: "Determinant", In: matrices{A: matrix(2, 2, 1, 2, 3, 4)}, Want: Reply{V: -2.0}},
+	{Name: "determinant with pivoting", Method: "Determinant", In: matrices{A: matrix(3, 3, 0, 2, 1, 1, 1, 1, 2, 0, 3)}, Want: Reply{V: -4.0}},
+	{Name: "determinant singular", Method: "Determinant", In: matrices{A: matrix(2, 2, 1, 2, 2, 4)}, Want: Reply{V: 0.0}},
+	{Name: "determinant empty", Method: "Determinant", In: matrices{A: matrix(0, 0)}, Want: Reply{V: 1.0}},
+	{Name: "determinant overflow", Method: "Determinant", In: matrices{A: matrix(2, 2, 1e200, 0, 0, 1e200)}, Want: Reply{V: inf}},
+	{Name: "determinant not square", Method: "Determinant", In: matrices{A: matrix(2, 3, 1, 2, 3, 4, 5, 6)}, Want: Failure(pb.ErrorCode_NOT_SQUARE)},
+
+	{Name: "inverse", Method: "Inverse", In: matrices{A: matrix(2, 2, 4, 7, 2, 6)}, Want: Reply{V: matrix(2, 2, 0.6000000000000001, -0.7000000000000001, -0.2, 0.4)}},
+	{Name: "inverse permutation", Method: "Inverse", In: matrices{A: matrix(2, 2, 0, 1, 1, 0)}, Want: Reply{V: matrix(2, 2, 0, 1, 1, 0)}},
+	{Name: "inverse singular", Method: "Inverse", In: matrices{A: matrix(2, 2, 1, 2, 2, 4)}, Want: Failure(pb.ErrorCode_SINGULAR_MATRIX)},
+	{Name: "inverse nearly singular", Method: "Inverse", In: matrices{A: matrix(3, 3, 1, 2, 3, 4, 5, 6, 7, 8, 9)}, Want: Failure(pb.ErrorCode_SINGULAR_MATRIX)},
+	{Name: "inverse not square", Method: "Inverse", In: matrices{A: matrix(1, 2, 1, 2)}, Want: Failure(pb.ErrorCode_NOT_SQUARE)},
+
+	{Name: "solve", Method: "Solve", In: system{matrix(2, 2, 2, 1, 1, 3), []float64{3, 5}}, Want: Reply{V: []float64{0.8, 1.4}}},
+	{Name: "solve 3x3", Method: "Solve", In: system{matrix(3, 3, 2, 1, -1, -3, -1, 2, -2, 1, 2), []float64{8, -11, -3}}, Want: Reply{V: []float64{2, 3.0000000000000004, -0.9999999999999999}}},
+	{Name: "solve singular", Method: "Solve", In: system{matrix(2, 2, 1, 2, 2, 4), []float64{1, 2}}, Want: Failure(pb.ErrorCode_SINGULAR_MATRIX)},
+	{Name: "solve dimension mismatch", Method: "Solve", In: system{matrix(2, 2, 2, 1, 1, 3), []float64{1, 2, 3}}, Want: Failure(pb.ErrorCode_DIMENSION_MISMATCH)},
+	{Name: "solve not square", Method: "Solve", In: system{matrix(2, 1, 1, 2), []float64{1, 2}}, Want: Failure(pb.ErrorCode_NOT_SQUARE)},
+	{Name: "solve malformed", Method: "Solve", In: system{matrix(2, 2, 1), []float64{1, 2}}, Want: Failure(pb.ErrorCode_MALFORMED_MATRIX)},
+}
@@ -0,0 +1,202 @@
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/numtheoryservice"
+)
+
+// operand is the operand of IsPrime, Factorize, EulerPhi and NextPrime.
+type operand numtheoryservice.Operand
+
+func (o operand) grpcRequest(method string) (req, reply proto.Message) {
+	req = numtheoryservice.Operand(o).Proto()
+	switch method {
+	case "IsPrime":
+		return req, new(pb.PrimalityReply)
+	case "Factorize":
+		return req, new(pb.FactorsReply)
+	case "EulerPhi", "NextPrime":
+		return req, new(pb.IntegerReply)
+	}
+	return nil, nil
+}
+
+func (o operand) grpcValue(method string, reply proto.Message) interface{} {
+	return numberTheoryValue(reply)
+}
+
+func (o operand) httpRequest(method string) interface{} {
+	return numtheoryservice.Operand(o)
+}
+
+func (o operand) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	switch method {
+	case "IsPrime":
+		var p numtheoryservice.Primality
+		err := json.Unmarshal(v, &p)
+		return primality(p), err
+	case "Factorize":
+		var f []numtheoryservice.Factor
+		err := json.Unmarshal(v, &f)
+		return factorization(f), err
+	}
+	return decodeInteger(v)
+}
+
+// modPow are the operands of ModPow.
+type modPow numtheoryservice.ModPowOperands
+
+func (o modPow) grpcRequest(method string) (req, reply proto.Message) {
+	if method != "ModPow" {
+		return nil, nil
+	}
+	return numtheoryservice.ModPowOperands(o).Proto(), new(pb.IntegerReply)
+}
+
+func (o modPow) grpcValue(method string, reply proto.Message) interface{} {
+	return numberTheoryValue(reply)
+}
+
+func (o modPow) httpRequest(method string) interface{} {
+	return numtheoryservice.ModPowOperands(o)
+}
+
+func (o modPow) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	return decodeInteger(v)
+}
+
+// modInverse are the operands of ModInverse.
+type modInverse numtheoryservice.ModInverseOperands
+
+func (o modInverse) grpcRequest(method string) (req, reply proto.Message) {
+	if method != "ModInverse" {
+		return nil, nil
+	}
+	return numtheoryservice.ModInverseOperands(o).Proto(), new(pb.IntegerReply)
+}
+
+func (o modInverse) grpcValue(method string, reply proto.Message) interface{} {
+	return numberTheoryValue(reply)
+}
+
+func (o modInverse) httpRequest(method string) interface{} {
+	return numtheoryservice.ModInverseOperands(o)
+}
+
+func (o modInverse) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	return decodeInteger(v)
+}
+
+// numberTheoryValue returns the result held by a reply of the NumberTheory
+// service, written the way primality, factorization and decodeInteger write
+// it.
+func numberTheoryValue(reply proto.Message) interface{} {
+	switch r := reply.(type) {
+	case *pb.PrimalityReply:
+		return primality(numtheoryservice.Primality{Prime: r.Prime, Probable: r.Probable})
+	case *pb.FactorsReply:
+		return factorization(numtheoryservice.FactorsFromProto(r.Factors))
+	default:
+		return reply.(*pb.IntegerReply).V
+	}
+}
+
+// decodeInteger decodes the integer returned over HTTP.
+func decodeInteger(v json.RawMessage) (interface{}, error) {
+	var i numtheoryservice.Integer
+	err := json.Unmarshal(v, &i)
+	return string(i), err
+}
+
+// primality describes the result of IsPrime as "prime", "probable prime" or
+// "composite".
+func primality(p numtheoryservice.Primality) string {
+	switch {
+	case p.Probable:
+		return "probable prime"
+	case p.Prime:
+		return "prime"
+	default:
+		return "composite"
+	}
+}
+
+// factorization describes the result of Factorize as a product, e.g.
+// "2^3 * 3 * 5", or "1" when there are no factors.
+func factorization(factors []numtheoryservice.Factor) string {
+	if len(factors) == 0 {
+		return "1"
+	}
+	terms := make([]string, len(factors))
+	for i, f := range factors {
+		terms[i] = string(f.Prime)
+		if f.Exponent != 1 {
+			terms[i] += fmt.Sprintf("^%d", f.Exponent)
+		}
+	}
+	return strings.Join(terms, " * ")
+}
+
+// n is shorthand for the operand of the methods taking one, with the
+// default compute budget.
+func n(v numtheoryservice.Integer) operand {
+	return operand{N: v}
+}
+
+// NumberTheoryCases is the table of cases every implementation of the
+// NumberTheory service must pass.
+var NumberTheoryCases = []ServiceCase{
+	{Name: "is prime", Method: "IsPrime", In: n("97"), Want: Reply{V: "prime"}},
+	{Name: "is prime one", Method: "IsPrime", In: n("1"), Want: Reply{V: "composite"}},
+	{Name: "is prime negative", Method: "IsPrime", In: n("-7"), Want: Reply{V: "composite"}},
+	{Name: "is prime carmichael number", Method: "IsPrime", In: n("561"), Want: Reply{V: "composite"}},
+	{Name: "is prime strong pseudoprime", Method: "IsPrime", In: n("3825123056546413051"), Want: Reply{V: "composite"}},
+	{Name: "is prime largest 64-bit prime", Method: "IsPrime", In: n("18446744073709551557"), Want: Reply{V: "prime"}},
+	{Name: "is prime mersenne", Method: "IsPrime", In: n("170141183460469231731687303715884105727"), Want: Reply{V: "probable prime"}},
+	{Name: "is prime big composite", Method: "IsPrime", In: n("170141183460469231731687303715884105729"), Want: Reply{V: "composite"}},
+	{Name: "is prime invalid integer", Method: "IsPrime", In: n("1e5"), Want: Failure(pb.ErrorCode_INVALID_INTEGER)},
+	{Name: "is prime too large", Method: "IsPrime", In: n(numtheoryservice.Integer("1" + strings.Repeat("0", 1300))), Want: Failure(pb.ErrorCode_OPERAND_TOO_LARGE)},
+	{Name: "is prime invalid budget", Method: "IsPrime", In: operand{N: "97", BudgetMS: 10001}, Want: Failure(pb.ErrorCode_INVALID_BUDGET)},
+
+	{Name: "factorize", Method: "Factorize", In: n("360"), Want: Reply{V: "2^3 * 3^2 * 5"}},
+	{Name: "factorize one", Method: "Factorize", In: n("1"), Want: Reply{V: "1"}},
+	{Name: "factorize prime", Method: "Factorize", In: n("9999999967"), Want: Reply{V: "9999999967"}},
+	{Name: "factorize small factors", Method: "Factorize", In: n("600851475143"), Want: Reply{V: "71 * 839 * 1471 * 6857"}},
+	{Name: "factorize 2^64-1", Method: "Factorize", In: n("18446744073709551615"), Want: Reply{V: "3 * 5 * 17 * 257 * 641 * 65537 * 6700417"}},
+	{Name: "factorize semiprime", Method: "Factorize", In: n("1000000016000000063"), Want: Reply{V: "1000000007 * 1000000009"}},
+	{Name: "factorize prime square", Method: "Factorize", In: n("4611686014132420609"), Want: Reply{V: "2147483647^2"}},
+	{Name: "factorize zero", Method: "Factorize", In: n("0"), Want: Failure(pb.ErrorCode_NOT_POSITIVE)},
+	{Name: "factorize over budget", Method: "Factorize", In: operand{N: "340282366920938463463374607431768211457", BudgetMS: 1}, Want: Failure(pb.ErrorCode_BUDGET_EXCEEDED)},
+
+	{Name: "mod pow", Method: "ModPow", In: modPow{Base: "4", Exponent: "13", Modulus: "497"}, Want: Reply{V: "445"}},
+	{Name: "mod pow negative base", Method: "ModPow", In: modPow{Base: "-2", Exponent: "3", Modulus: "5"}, Want: Reply{V: "2"}},
+	{Name: "mod pow negative exponent", Method: "ModPow", In: modPow{Base: "3", Exponent: "-1", Modulus: "11"}, Want: Reply{V: "4"}},
+	{Name: "mod pow modulus one", Method: "ModPow", In: modPow{Base: "5", Exponent: "0", Modulus: "1"}, Want: Reply{V: "0"}},
+	{Name: "mod pow fermat", Method: "ModPow", In: modPow{Base: "2", Exponent: "170141183460469231731687303715884105726", Modulus: "170141183460469231731687303715884105727"}, Want: Reply{V: "1"}},
+	{Name: "mod pow no inverse", Method: "ModPow", In: modPow{Base: "2", Exponent: "-1", Modulus: "10"}, Want: Failure(pb.ErrorCode_NO_INVERSE)},
+	{Name: "mod pow zero modulus", Method: "ModPow", In: modPow{Base: "5", Exponent: "3"}, Want: Failure(pb.ErrorCode_NOT_POSITIVE)},
+
+	{Name: "mod inverse", Method: "ModInverse", In: modInverse{A: "3", Modulus: "11"}, Want: Reply{V: "4"}},
+	{Name: "mod inverse negative", Method: "ModInverse", In: modInverse{A: "-3", Modulus: "11"}, Want: Reply{V: "7"}},
+	{Name: "mod inverse not coprime", Method: "ModInverse", In: modInverse{A: "6", Modulus: "9"}, Want: Failure(pb.ErrorCode_NO_INVERSE)},
+	{Name: "mod inverse negative modulus", Method: "ModInverse", In: modInverse{A: "3", Modulus: "-11"}, Want: Failure(pb.ErrorCode_NOT_POSITIVE)},
+
+	{Name: "euler phi", Method: "EulerPhi", In: n("36"), Want: Reply{V: "12"}},
+	{Name: "euler phi one", Method: "EulerPhi", In: n("1"), Want: Reply{V: "1"}},
+	{Name: "euler phi prime", Method: "EulerPhi", In: n("97"), Want: Reply{V: "96"}},
+	{Name: "euler phi semiprime", Method: "EulerPhi", In: n("1000000016000000063"), Want: Reply{V: "1000000014000000048"}},
+	{Name: "euler phi negative", Method: "EulerPhi", In: n("-4"), Want: Failure(pb.ErrorCode_NOT_POSITIVE)},
+	{Name: "euler phi over budget", Method: "EulerPhi", In: operand{N: "340282366920938463463374607431768211457", BudgetMS: 1}, Want: Failure(pb.ErrorCode_BUDGET_EXCEEDED)},
+
+	{Name: "next prime", Method: "NextPrime", In: n("89"), Want: Reply{V: "97"}},
+	{Name: "next prime of a prime", Method: "NextPrime", In: n("13"), Want: Reply{V: "17"}},
+	{Name: "next prime of two", Method: "NextPrime", In: n("2"), Want: Reply{V: "3"}},
+	{Name: "next prime negative", Method: "NextPrime", In: n("-10"), Want: Reply{V: "2"}},
+	{Name: "next prime past 64 bits", Method: "NextPrime", In: n("18446744073709551557"), Want: Reply{V: "18446744073709551629"}},
+	{Name: "next prime invalid integer", Method: "NextPrime", In: n("twelve"), Want: Failure(pb.ErrorCode_INVALID_INTEGER)},
+}
@@ -0,0 +1,122 @@
+package conformance
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/complexservice"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+)
+
+// polyOps are the operands of the methods of the Polynomial service.
+// Derivative, Integral and Roots only use A, Evaluate also uses X and Roots
+// Tolerance.
+type polyOps struct {
+	A, B      []float64
+	X         float64
+	Tolerance float64
+}
+
+func (o polyOps) grpcRequest(method string) (req, reply proto.Message) {
+	switch method {
+	case "Evaluate":
+		return &pb.PolynomialEvaluateRequest{A: o.A, X: o.X}, new(pb.MathOpReply)
+	case "Add", "Multiply", "Derivative", "Integral":
+		return &pb.PolynomialOpRequest{A: o.A, B: o.B}, new(pb.PolynomialReply)
+	case "Roots":
+		return &pb.RootsRequest{A: o.A, Tolerance: o.Tolerance}, new(pb.RootsReply)
+	}
+	return nil, nil
+}
+
+// grpcValue returns a float64 for Evaluate, a []complex128 for Roots and a
+// []float64 for the other methods.
+func (o polyOps) grpcValue(method string, reply proto.Message) interface{} {
+	switch r := reply.(type) {
+	case *pb.MathOpReply:
+		return r.V
+	case *pb.PolynomialReply:
+		return r.V
+	default:
+		roots := reply.(*pb.RootsReply).V
+		v := make([]complex128, len(roots))
+		for i, z := range roots {
+			v[i] = complexservice.FromProto(z)
+		}
+		return v
+	}
+}
+
+func (o polyOps) httpRequest(method string) interface{} {
+	return struct {
+		A         jsonfloat.Slice   `json:"a"`
+		B         jsonfloat.Slice   `json:"b"`
+		X         jsonfloat.Float64 `json:"x"`
+		Tolerance jsonfloat.Float64 `json:"tolerance"`
+	}{o.A, o.B, jsonfloat.Float64(o.X), jsonfloat.Float64(o.Tolerance)}
+}
+
+func (o polyOps) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	switch method {
+	case "Evaluate":
+		var f jsonfloat.Float64
+		err := json.Unmarshal(v, &f)
+		return float64(f), err
+	case "Roots":
+		var roots []jsonfloat.Complex128
+		err := json.Unmarshal(v, &roots)
+		c := make([]complex128, len(roots))
+		for i, z := range roots {
+			c[i] = complex128(z)
+		}
+		return c, err
+	default:
+		var p jsonfloat.Slice
+		err := json.Unmarshal(v, &p)
+		return []float64(p), err
+	}
+}
+
+// PolynomialCases is the table of cases every implementation of the
+// Polynomial service must pass.
+var PolynomialCases = []ServiceCase{
+	{Name: "evaluate", Method: "Evaluate", In: polyOps{A: []float64{1, 0, 2}, X: 3}, Want: Reply{V: 19.0}},
+	{Name: "evaluate constant at nan", Method: "Evaluate", In: polyOps{A: []float64{5}, X: nan}, Want: Reply{V: 5.0}},
+	{Name: "evaluate nan coefficient", Method: "Evaluate", In: polyOps{A: []float64{1, nan}, X: 0}, Want: Reply{V: nan}},
+	{Name: "evaluate zero polynomial", Method: "Evaluate", In: polyOps{X: 2}, Want: Reply{V: 0.0}},
+	{Name: "evaluate at negative", Method: "Evaluate", In: polyOps{A: []float64{-6, 11, -6, 1}, X: -1}, Want: Reply{V: -24.0}},
+	{Name: "evaluate at inf", Method: "Evaluate", In: polyOps{A: []float64{0, 0, 1}, X: -inf}, Want: Reply{V: inf}},
+
+	{Name: "add", Method: "Add", In: polyOps{A: []float64{1, 2, 3}, B: []float64{4, 5}}, Want: Reply{V: []float64{5, 7, 3}}},
+	{Name: "add cancels leading term", Method: "Add", In: polyOps{A: []float64{1, 2, 3}, B: []float64{0, 0, -3}}, Want: Reply{V: []float64{1, 2}}},
+	{Name: "add to zero", Method: "Add", In: polyOps{A: []float64{1, -1}, B: []float64{-1, 1}}, Want: Reply{V: []float64{}}},
+	{Name: "add trailing zeros", Method: "Add", In: polyOps{A: []float64{1, 0, 0}}, Want: Reply{V: []float64{1}}},
+
+	{Name: "multiply", Method: "Multiply", In: polyOps{A: []float64{1, 1}, B: []float64{-1, 1}}, Want: Reply{V: []float64{-1, 0, 1}}},
+	{Name: "multiply by constant", Method: "Multiply", In: polyOps{A: []float64{1, 2, 3}, B: []float64{2}}, Want: Reply{V: []float64{2, 4, 6}}},
+	{Name: "multiply by zero polynomial", Method: "Multiply", In: polyOps{A: []float64{1, 2, 3}, B: []float64{0}}, Want: Reply{V: []float64{}}},
+
+	{Name: "derivative", Method: "Derivative", In: polyOps{A: []float64{1, 2, 3}}, Want: Reply{V: []float64{2, 6}}},
+	{Name: "derivative constant", Method: "Derivative", In: polyOps{A: []float64{5}}, Want: Reply{V: []float64{}}},
+	{Name: "derivative zero polynomial", Method: "Derivative", In: polyOps{}, Want: Reply{V: []float64{}}},
+
+	{Name: "integral", Method: "Integral", In: polyOps{A: []float64{1, 2, 3}}, Want: Reply{V: []float64{0, 1, 1, 1}}},
+	{Name: "integral fractions", Method: "Integral", In: polyOps{A: []float64{0, 0, 1}}, Want: Reply{V: []float64{0, 0, 0, 1.0 / 3}}},
+	{Name: "integral zero polynomial", Method: "Integral", In: polyOps{A: []float64{0}}, Want: Reply{V: []float64{}}},
+
+	{Name: "roots real", Method: "Roots", In: polyOps{A: []float64{-1, 0, 1}}, Want: Reply{V: []complex128{-1, 1}}},
+	{Name: "roots complex", Method: "Roots", In: polyOps{A: []float64{1, 0, 1}}, Want: Reply{V: []complex128{-1i, 1i}}},
+	{Name: "roots cubic", Method: "Roots", In: polyOps{A: []float64{-6, 11, -6, 1}}, Want: Reply{V: []complex128{0.9999999999999997, 2, 3}}},
+	{Name: "roots cube roots of 8", Method: "Roots", In: polyOps{A: []float64{-8, 0, 0, 1}}, Want: Reply{V: []complex128{-1 - 1.7320508075688774i, -1 + 1.7320508075688774i, 2}}},
+	{Name: "roots complex pair", Method: "Roots", In: polyOps{A: []float64{1, 2, 5}}, Want: Reply{V: []complex128{-0.2 - 0.39999999999999997i, -0.2 + 0.4i}}},
+	{Name: "roots with tolerance", Method: "Roots", In: polyOps{A: []float64{-2, 0, 1}, Tolerance: 1e-12}, Want: Reply{V: []complex128{-1.414213562373095, 1.4142135623730951}}},
+	{Name: "roots at zero", Method: "Roots", In: polyOps{A: []float64{0, 0, -2, 0, 1}}, Want: Reply{V: []complex128{-1.414213562373095, 0, 0, 1.4142135623730951}}},
+	{Name: "roots linear", Method: "Roots", In: polyOps{A: []float64{2, -4}}, Want: Reply{V: []complex128{0.5}}},
+	{Name: "roots trailing zeros", Method: "Roots", In: polyOps{A: []float64{-1, 0, 1, 0, 0}}, Want: Reply{V: []complex128{-1, 1}}},
+	{Name: "roots constant", Method: "Roots", In: polyOps{A: []float64{5}}, Want: Reply{V: []complex128{}}},
+	{Name: "roots zero polynomial", Method: "Roots", In: polyOps{A: []float64{0, 0}}, Want: Failure(pb.ErrorCode_ZERO_POLYNOMIAL)},
+	{Name: "roots negative tolerance", Method: "Roots", In: polyOps{A: []float64{1, 1}, Tolerance: -1}, Want: Failure(pb.ErrorCode_INVALID_TOLERANCE)},
+	{Name: "roots nan tolerance", Method: "Roots", In: polyOps{A: []float64{1, 1}, Tolerance: nan}, Want: Failure(pb.ErrorCode_INVALID_TOLERANCE)},
+	{Name: "roots inf coefficient", Method: "Roots", In: polyOps{A: []float64{1, inf}}, Want: Failure(pb.ErrorCode_NO_CONVERGENCE)},
+}
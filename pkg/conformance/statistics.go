@@ -0,0 +1,197 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/statsservice"
+	"google.golang.org/grpc"
+)
+
+// statisticsChunkSize is the number of values the datasets of the Statistics
+// cases are streamed in, small so that most cases span several chunks.
+const statisticsChunkSize = 3
+
+// dataset is the operand of Describe.
+type dataset struct {
+	X       []float64
+	Options statsservice.Options
+}
+
+func (o dataset) stream(ctx context.Context, conn *grpc.ClientConn, method string) (Reply, error) {
+	if method != "Describe" {
+		return Reply{}, fmt.Errorf("unknown method %q", method)
+	}
+	stream, err := pb.NewStatisticsClient(conn).Describe(ctx)
+	if err != nil {
+		return Reply{}, err
+	}
+	if err := statsservice.Send(stream.Send, o.X, nil, o.Options.Proto(), statisticsChunkSize); err != nil {
+		return Reply{}, err
+	}
+	r, err := stream.CloseAndRecv()
+	if err != nil {
+		return Reply{}, err
+	}
+	if r.Code != pb.ErrorCode_NO_ERROR {
+		return Failure(r.Code), nil
+	}
+	return Reply{V: statsservice.DescriptionFromProto(r)}, nil
+}
+
+// pairs are the operands of Correlate.
+type pairs struct {
+	X, Y []float64
+}
+
+func (o pairs) stream(ctx context.Context, conn *grpc.ClientConn, method string) (Reply, error) {
+	if method != "Correlate" {
+		return Reply{}, fmt.Errorf("unknown method %q", method)
+	}
+	stream, err := pb.NewStatisticsClient(conn).Correlate(ctx)
+	if err != nil {
+		return Reply{}, err
+	}
+	if err := statsservice.Send(stream.Send, o.X, o.Y, nil, statisticsChunkSize); err != nil {
+		return Reply{}, err
+	}
+	r, err := stream.CloseAndRecv()
+	if err != nil {
+		return Reply{}, err
+	}
+	if r.Code != pb.ErrorCode_NO_ERROR {
+		return Failure(r.Code), nil
+	}
+	return Reply{V: statsservice.CorrelationFromProto(r)}, nil
+}
+
+// describe returns the Want of a Describe case, the Quantiles and Histogram
+// not given are empty.
+func describe(d statsservice.Description) Reply {
+	if d.Mode == nil {
+		d.Mode = []float64{}
+	}
+	if d.Quantiles == nil {
+		d.Quantiles = []statsservice.Quantile{}
+	}
+	if d.Histogram == nil {
+		d.Histogram = []statsservice.Bucket{}
+	}
+	return Reply{V: d}
+}
+
+// quantiles returns the Quantiles of a Description holding the value v[i] of
+// the quantile q[i].
+func quantiles(q []float64, v ...float64) []statsservice.Quantile {
+	r := make([]statsservice.Quantile, len(q))
+	for i := range q {
+		r[i] = statsservice.Quantile{Q: q[i], V: v[i]}
+	}
+	return r
+}
+
+// series returns the values from 1 to n.
+func series(n int) []float64 {
+	v := make([]float64, n)
+	for i := range v {
+		v[i] = float64(i + 1)
+	}
+	return v
+}
+
+var (
+	defaultQ = statsservice.DefaultQuantiles
+	halfQ    = []float64{0.25, 0.5}
+	byScale  = []float64{1, 2, 3, 4}
+)
+
+// StatisticsCases is the table of cases every implementation of the
+// Statistics service must agree on.
+var StatisticsCases = []ServiceCase{
+	// Describe
+	{Name: "describe", Method: "Describe", In: dataset{X: []float64{2, 4, 4, 4, 5, 5, 7, 9}}, Want: describe(statsservice.Description{
+		Count: 8, Min: 2, Max: 9, Mean: 5, Variance: 4, StdDev: 2, Skewness: 0.65625, Kurtosis: -0.21875,
+		Mode: []float64{4}, Quantiles: quantiles(defaultQ, 4.5, 7.6, 8.86),
+	})},
+	{Name: "describe unsorted", Method: "Describe", In: dataset{X: []float64{9, 5, 4, 2, 7, 4, 5, 4}}, Want: describe(statsservice.Description{
+		Count: 8, Min: 2, Max: 9, Mean: 5, Variance: 4, StdDev: 2, Skewness: 0.65625, Kurtosis: -0.21875,
+		Mode: []float64{4}, Quantiles: quantiles(defaultQ, 4.5, 7.6, 8.86),
+	})},
+	{Name: "describe single value", Method: "Describe", In: dataset{X: []float64{7}}, Want: describe(statsservice.Description{
+		Count: 1, Min: 7, Max: 7, Mean: 7, Skewness: nan, Kurtosis: nan, Quantiles: quantiles(defaultQ, 7, 7, 7),
+	})},
+	{Name: "describe constant", Method: "Describe", In: dataset{X: []float64{3, 3, 3}, Options: statsservice.Options{Buckets: 4}}, Want: describe(statsservice.Description{
+		Count: 3, Min: 3, Max: 3, Mean: 3, Skewness: nan, Kurtosis: nan, Mode: []float64{3},
+		Quantiles: quantiles(defaultQ, 3, 3, 3), Histogram: []statsservice.Bucket{{Lower: 3, Upper: 3, Count: 3}},
+	})},
+	{Name: "describe negative", Method: "Describe", In: dataset{X: []float64{-1, -2, -3}, Options: statsservice.Options{Quantiles: []float64{0, 1}}}, Want: describe(statsservice.Description{
+		Count: 3, Min: -3, Max: -1, Mean: -2, Variance: 2.0 / 3, StdDev: math.Sqrt(2.0 / 3), Kurtosis: -1.5,
+		Quantiles: quantiles([]float64{0, 1}, -3, -1),
+	})},
+	{Name: "describe no mode", Method: "Describe", In: dataset{X: []float64{1, 2, 3}, Options: statsservice.Options{Quantiles: []float64{0.5}}}, Want: describe(statsservice.Description{
+		Count: 3, Min: 1, Max: 3, Mean: 2, Variance: 2.0 / 3, StdDev: math.Sqrt(2.0 / 3), Kurtosis: -1.5,
+		Quantiles: quantiles([]float64{0.5}, 2),
+	})},
+	{Name: "describe multimodal", Method: "Describe", In: dataset{X: []float64{3, 1, 2, 1, 2}, Options: statsservice.Options{Quantiles: []float64{0.5}}}, Want: describe(statsservice.Description{
+		Count: 5, Min: 1, Max: 3, Mean: 1.8, Variance: 0.56, StdDev: math.Sqrt(0.56), Skewness: 0.34362159674454545, Kurtosis: -1.153061224489796,
+		Mode: []float64{1, 2}, Quantiles: quantiles([]float64{0.5}, 2),
+	})},
+	{Name: "describe linear", Method: "Describe", In: dataset{X: byScale, Options: statsservice.Options{Quantiles: halfQ}}, Want: describe(statsservice.Description{
+		Count: 4, Min: 1, Max: 4, Mean: 2.5, Variance: 1.25, StdDev: math.Sqrt(1.25), Kurtosis: -1.36,
+		Quantiles: quantiles(halfQ, 1.75, 2.5),
+	})},
+	{Name: "describe lower", Method: "Describe", In: dataset{X: byScale, Options: statsservice.Options{Quantiles: halfQ, Interpolation: statsservice.Lower}}, Want: describe(statsservice.Description{
+		Count: 4, Min: 1, Max: 4, Mean: 2.5, Variance: 1.25, StdDev: math.Sqrt(1.25), Kurtosis: -1.36,
+		Quantiles: quantiles(halfQ, 1, 2),
+	})},
+	{Name: "describe higher", Method: "Describe", In: dataset{X: byScale, Options: statsservice.Options{Quantiles: halfQ, Interpolation: statsservice.Higher}}, Want: describe(statsservice.Description{
+		Count: 4, Min: 1, Max: 4, Mean: 2.5, Variance: 1.25, StdDev: math.Sqrt(1.25), Kurtosis: -1.36,
+		Quantiles: quantiles(halfQ, 2, 3),
+	})},
+	{Name: "describe nearest", Method: "Describe", In: dataset{X: byScale, Options: statsservice.Options{Quantiles: halfQ, Interpolation: statsservice.Nearest}}, Want: describe(statsservice.Description{
+		Count: 4, Min: 1, Max: 4, Mean: 2.5, Variance: 1.25, StdDev: math.Sqrt(1.25), Kurtosis: -1.36,
+		Quantiles: quantiles(halfQ, 2, 3),
+	})},
+	{Name: "describe midpoint", Method: "Describe", In: dataset{X: byScale, Options: statsservice.Options{Quantiles: halfQ, Interpolation: statsservice.Midpoint}}, Want: describe(statsservice.Description{
+		Count: 4, Min: 1, Max: 4, Mean: 2.5, Variance: 1.25, StdDev: math.Sqrt(1.25), Kurtosis: -1.36,
+		Quantiles: quantiles(halfQ, 1.5, 2.5),
+	})},
+	{Name: "describe histogram", Method: "Describe", In: dataset{X: []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, Options: statsservice.Options{Quantiles: []float64{0.5}, Buckets: 5}}, Want: describe(statsservice.Description{
+		Count: 11, Min: 0, Max: 10, Mean: 5, Variance: 10, StdDev: math.Sqrt(10), Kurtosis: -1.22,
+		Quantiles: quantiles([]float64{0.5}, 5),
+		Histogram: []statsservice.Bucket{{Lower: 0, Upper: 2, Count: 2}, {Lower: 2, Upper: 4, Count: 2}, {Lower: 4, Upper: 6, Count: 2}, {Lower: 6, Upper: 8, Count: 2}, {Lower: 8, Upper: 10, Count: 3}},
+	})},
+	{Name: "describe histogram one bucket", Method: "Describe", In: dataset{X: []float64{1, 2, 3}, Options: statsservice.Options{Quantiles: []float64{0.5}, Buckets: 1}}, Want: describe(statsservice.Description{
+		Count: 3, Min: 1, Max: 3, Mean: 2, Variance: 2.0 / 3, StdDev: math.Sqrt(2.0 / 3), Kurtosis: -1.5,
+		Quantiles: quantiles([]float64{0.5}, 2), Histogram: []statsservice.Bucket{{Lower: 1, Upper: 3, Count: 3}},
+	})},
+	{Name: "describe histogram huge range", Method: "Describe", In: dataset{X: []float64{-math.MaxFloat64, 0, math.MaxFloat64}, Options: statsservice.Options{Quantiles: []float64{0.5}, Buckets: 2}}, Want: describe(statsservice.Description{
+		Count: 3, Min: -math.MaxFloat64, Max: math.MaxFloat64, Mean: 0, Variance: math.Inf(1), StdDev: math.Inf(1), Skewness: nan, Kurtosis: nan,
+		Quantiles: quantiles([]float64{0.5}, 0), Histogram: []statsservice.Bucket{{Lower: -math.MaxFloat64, Upper: 0, Count: 1}, {Lower: 0, Upper: math.MaxFloat64, Count: 2}},
+	})},
+	{Name: "describe many chunks", Method: "Describe", In: dataset{X: series(1000), Options: statsservice.Options{Quantiles: []float64{0, 0.5, 0.9, 0.99, 1}, Buckets: 4}}, Want: describe(statsservice.Description{
+		Count: 1000, Min: 1, Max: 1000, Mean: 500.5, Variance: 83333.25, StdDev: math.Sqrt(83333.25), Kurtosis: -1.2000024000024,
+		Quantiles: quantiles([]float64{0, 0.5, 0.9, 0.99, 1}, 1, 500.5, 900.1, 990.01, 1000),
+		Histogram: []statsservice.Bucket{{Lower: 1, Upper: 250.75, Count: 250}, {Lower: 250.75, Upper: 500.5, Count: 250}, {Lower: 500.5, Upper: 750.25, Count: 250}, {Lower: 750.25, Upper: 1000, Count: 250}},
+	})},
+	{Name: "describe empty", Method: "Describe", In: dataset{}, Want: Failure(pb.ErrorCode_NO_VALUES)},
+	{Name: "describe nan", Method: "Describe", In: dataset{X: []float64{1, nan}}, Want: Failure(pb.ErrorCode_NON_FINITE_VALUE)},
+	{Name: "describe inf", Method: "Describe", In: dataset{X: []float64{1, 2, 3, math.Inf(-1)}}, Want: Failure(pb.ErrorCode_NON_FINITE_VALUE)},
+	{Name: "describe quantile above 1", Method: "Describe", In: dataset{X: []float64{1}, Options: statsservice.Options{Quantiles: []float64{0.5, 1.5}}}, Want: Failure(pb.ErrorCode_INVALID_QUANTILE)},
+	{Name: "describe negative quantile", Method: "Describe", In: dataset{X: []float64{1}, Options: statsservice.Options{Quantiles: []float64{-0.1}}}, Want: Failure(pb.ErrorCode_INVALID_QUANTILE)},
+	{Name: "describe nan quantile", Method: "Describe", In: dataset{X: []float64{1}, Options: statsservice.Options{Quantiles: []float64{nan}}}, Want: Failure(pb.ErrorCode_INVALID_QUANTILE)},
+	{Name: "describe too many buckets", Method: "Describe", In: dataset{X: []float64{1}, Options: statsservice.Options{Buckets: statsservice.MaxBuckets + 1}}, Want: Failure(pb.ErrorCode_TOO_MANY_BUCKETS)},
+
+	// Correlate
+	{Name: "correlate", Method: "Correlate", In: pairs{X: byScale, Y: []float64{2, 4, 6, 8}}, Want: Reply{V: statsservice.Correlation{Count: 4, Covariance: 2.5, Correlation: 1}}},
+	{Name: "correlate negative", Method: "Correlate", In: pairs{X: byScale, Y: []float64{8, 6, 4, 2}}, Want: Reply{V: statsservice.Correlation{Count: 4, Covariance: -2.5, Correlation: -1}}},
+	{Name: "correlate uncorrelated", Method: "Correlate", In: pairs{X: []float64{1, 2, 3, 4, 5}, Y: []float64{1, 3, 5, 3, 1}}, Want: Reply{V: statsservice.Correlation{Count: 5, Covariance: 0, Correlation: 0}}},
+	{Name: "correlate partial", Method: "Correlate", In: pairs{X: []float64{1, 2, 3}, Y: []float64{1, 3, 2}}, Want: Reply{V: statsservice.Correlation{Count: 3, Covariance: 1.0 / 3, Correlation: 0.5}}},
+	{Name: "correlate constant", Method: "Correlate", In: pairs{X: byScale, Y: []float64{5, 5, 5, 5}}, Want: Reply{V: statsservice.Correlation{Count: 4, Covariance: 0, Correlation: nan}}},
+	{Name: "correlate many chunks", Method: "Correlate", In: pairs{X: series(1000), Y: series(1000)}, Want: Reply{V: statsservice.Correlation{Count: 1000, Covariance: 83333.25, Correlation: 1}}},
+	{Name: "correlate length mismatch", Method: "Correlate", In: pairs{X: []float64{1, 2, 3}, Y: []float64{1, 2}}, Want: Failure(pb.ErrorCode_LENGTH_MISMATCH)},
+	{Name: "correlate empty", Method: "Correlate", In: pairs{}, Want: Failure(pb.ErrorCode_NO_VALUES)},
+	{Name: "correlate nan", Method: "Correlate", In: pairs{X: []float64{1, 2}, Y: []float64{nan, 2}}, Want: Failure(pb.ErrorCode_NON_FINITE_VALUE)},
+}
@@ -0,0 +1,154 @@
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/symbolicservice"
+)
+
+// symRequest is the operand of the methods of the Symbolic service.
+type symRequest symbolicservice.Request
+
+func (o symRequest) grpcRequest(method string) (req, reply proto.Message) {
+	switch method {
+	case "Format", "Simplify", "Differentiate":
+		return symbolicservice.Request(o).Proto(), new(pb.SymbolicReply)
+	}
+	return nil, nil
+}
+
+func (o symRequest) grpcValue(method string, reply proto.Message) interface{} {
+	r := reply.(*pb.SymbolicReply)
+	return notations{Infix: r.Infix, LaTeX: r.Latex, MathML: r.Mathml}
+}
+
+func (o symRequest) httpRequest(method string) interface{} {
+	return symbolicservice.Request(o)
+}
+
+func (o symRequest) httpResult(method string, result json.RawMessage) (interface{}, error) {
+	var r symbolicservice.Result
+	err := json.Unmarshal(result, &r)
+	return notations{Infix: r.Infix, LaTeX: r.LaTeX, MathML: r.MathML}, err
+}
+
+// notations is an expression returned by the Symbolic service.
+type notations struct {
+	Infix  string
+	LaTeX  string
+	MathML string
+}
+
+// matches reports whether n is the expression want. LaTeX and MathML are only
+// compared when both have them, as the ones wanted by a case are often left
+// out.
+func (n notations) matches(want interface{}) bool {
+	w, ok := want.(notations)
+	if !ok {
+		return false
+	}
+	if n.LaTeX != "" && w.LaTeX != "" && n.LaTeX != w.LaTeX {
+		return false
+	}
+	if n.MathML != "" && w.MathML != "" && n.MathML != w.MathML {
+		return false
+	}
+	return n.Infix == w.Infix
+}
+
+// String returns n in every notation, so that implementations disagreeing in
+// any way are reported by RunCases.
+func (n notations) String() string {
+	return fmt.Sprintf("%q, %q, %q", n.Infix, n.LaTeX, n.MathML)
+}
+
+// dx is shorthand for the Request of the derivative of expression with
+// respect to x.
+func dx(expression string) symRequest {
+	return symRequest{Expression: expression, Variable: "x"}
+}
+
+// sym is shorthand for a Request without a variable.
+func sym(expression string) symRequest {
+	return symRequest{Expression: expression}
+}
+
+// mathML wraps the MathML elements s in a math element.
+func mathML(s string) string {
+	return `<math xmlns="http://www.w3.org/1998/Math/MathML">` + s + `</math>`
+}
+
+// SymbolicCases is the table of cases every implementation of the Symbolic
+// service must pass.
+var SymbolicCases = []ServiceCase{
+	{Name: "format as written", Method: "Format", In: sym("x*1+0"), Want: Reply{V: notations{Infix: "x*1 + 0"}}},
+	{Name: "format constant", Method: "Format", In: sym("pi*r^2"), Want: Reply{V: notations{
+		Infix:  "pi*r^2",
+		LaTeX:  `\pi \cdot r^{2}`,
+		MathML: mathML(`<mrow><mi>&#x3C0;</mi><mo>&#x22C5;</mo><msup><mi>r</mi><mn>2</mn></msup></mrow>`),
+	}}},
+	{Name: "format fraction in exponent", Method: "Format", In: sym("exp(-x^2/2)"), Want: Reply{V: notations{
+		Infix:  "exp(-x^2/2)",
+		LaTeX:  `e^{\frac{-x^{2}}{2}}`,
+		MathML: mathML(`<msup><mi>e</mi><mfrac><mrow><mo>-</mo><msup><mi>x</mi><mn>2</mn></msup></mrow><mn>2</mn></mfrac></msup>`),
+	}}},
+	{Name: "format abs", Method: "Format", In: sym("abs(x-1)"), Want: Reply{V: notations{
+		Infix:  "abs(x - 1)",
+		LaTeX:  `\left|x - 1\right|`,
+		MathML: mathML(`<mrow><mo>|</mo><mrow><mi>x</mi><mo>-</mo><mn>1</mn></mrow><mo>|</mo></mrow>`),
+	}}},
+	{Name: "format long name", Method: "Format", In: sym("alpha_1+2.5e-7"), Want: Reply{V: notations{
+		Infix: "alpha_1 + 2.5e-07",
+		LaTeX: `\mathrm{alpha\_1} + 2.5\times 10^{-7}`,
+	}}},
+	{Name: "format syntax error", Method: "Format", In: sym("x+"), Want: Failure(pb.ErrorCode_SYNTAX_ERROR)},
+	{Name: "format function as variable", Method: "Format", In: sym("sin+1"), Want: Failure(pb.ErrorCode_SYNTAX_ERROR)},
+	{Name: "format too deep", Method: "Format", In: sym(strings.Repeat("-", 100000) + "x"), Want: Failure(pb.ErrorCode_SYNTAX_ERROR)},
+	{Name: "format too large", Method: "Format", In: sym(strings.Repeat("x+", symbolicservice.MaxNodes) + "x"), Want: Failure(pb.ErrorCode_EXPRESSION_TOO_LARGE)},
+
+	{Name: "simplify folding", Method: "Simplify", In: sym("1+2*3"), Want: Reply{V: notations{Infix: "7"}}},
+	{Name: "simplify inexact", Method: "Simplify", In: sym("0.1+0.2"), Want: Reply{V: notations{Infix: "0.1 + 0.2"}}},
+	{Name: "simplify fraction", Method: "Simplify", In: sym("3/9"), Want: Reply{V: notations{Infix: "1/3"}}},
+	{Name: "simplify identities", Method: "Simplify", In: sym("x*1+0"), Want: Reply{V: notations{Infix: "x"}}},
+	{Name: "simplify powers", Method: "Simplify", In: sym("x*x*x"), Want: Reply{V: notations{Infix: "x^3"}}},
+	{Name: "simplify power of power", Method: "Simplify", In: sym("(x^2)^3"), Want: Reply{V: notations{Infix: "x^6"}}},
+	{Name: "simplify product", Method: "Simplify", In: sym("x*y*2*x"), Want: Reply{V: notations{Infix: "2*x^2*y"}}},
+	{Name: "simplify coefficient", Method: "Simplify", In: sym("12*x/36"), Want: Reply{V: notations{Infix: "x/3", LaTeX: `\frac{x}{3}`}}},
+	{Name: "simplify cancellation", Method: "Simplify", In: sym("x*y/x"), Want: Reply{V: notations{Infix: "y"}}},
+	{Name: "simplify like terms", Method: "Simplify", In: sym("2*x+3*x"), Want: Reply{V: notations{Infix: "5*x"}}},
+	{Name: "simplify negation", Method: "Simplify", In: sym("-(a-b)"), Want: Reply{V: notations{Infix: "b - a"}}},
+	{Name: "simplify sqrt", Method: "Simplify", In: sym("sqrt(16)"), Want: Reply{V: notations{Infix: "4"}}},
+	{Name: "simplify division by zero", Method: "Simplify", In: sym("x/0"), Want: Reply{V: notations{Infix: "x/0"}}},
+
+	{Name: "differentiate power", Method: "Differentiate", In: dx("x^2"), Want: Reply{V: notations{
+		Infix:  "2*x",
+		LaTeX:  "2 x",
+		MathML: mathML(`<mrow><mn>2</mn><mo>&#x2062;</mo><mi>x</mi></mrow>`),
+	}}},
+	{Name: "differentiate polynomial", Method: "Differentiate", In: dx("x^3-2*x+1"), Want: Reply{V: notations{Infix: "3*x^2 - 2"}}},
+	{Name: "differentiate other variables", Method: "Differentiate", In: dx("a*x^2+b*x+c"), Want: Reply{V: notations{Infix: "2*a*x + b"}}},
+	{Name: "differentiate product", Method: "Differentiate", In: dx("x*sin(x)"), Want: Reply{V: notations{Infix: "sin(x) + x*cos(x)"}}},
+	{Name: "differentiate quotient", Method: "Differentiate", In: dx("cos(x)/x"), Want: Reply{V: notations{
+		Infix: "(-x*sin(x) - cos(x))/x^2",
+		LaTeX: `\frac{-x \cdot \sin\left(x\right) - \cos\left(x\right)}{x^{2}}`,
+	}}},
+	{Name: "differentiate reciprocal", Method: "Differentiate", In: dx("1/x"), Want: Reply{V: notations{Infix: "-1/x^2"}}},
+	{Name: "differentiate chain rule", Method: "Differentiate", In: dx("exp(2*x)"), Want: Reply{V: notations{Infix: "2*exp(2*x)"}}},
+	{Name: "differentiate ln", Method: "Differentiate", In: dx("ln(x)"), Want: Reply{V: notations{Infix: "1/x"}}},
+	{Name: "differentiate sqrt", Method: "Differentiate", In: dx("sqrt(x)"), Want: Reply{V: notations{Infix: "1/(2*sqrt(x))"}}},
+	{Name: "differentiate exponential", Method: "Differentiate", In: dx("2^x"), Want: Reply{V: notations{Infix: "2^x*ln(2)"}}},
+	{Name: "differentiate e", Method: "Differentiate", In: dx("e^x"), Want: Reply{V: notations{Infix: "e^x"}}},
+	{Name: "differentiate variable exponent", Method: "Differentiate", In: dx("x^x"), Want: Reply{V: notations{Infix: "x^x*(ln(x) + 1)"}}},
+	{Name: "differentiate other variable", Method: "Differentiate", In: symRequest{Expression: "sin(omega*t)", Variable: "t"}, Want: Reply{V: notations{Infix: "omega*cos(omega*t)"}}},
+	{Name: "differentiate constant max", Method: "Differentiate", In: dx("max(a, 1)"), Want: Reply{V: notations{Infix: "0"}}},
+	{Name: "differentiate max", Method: "Differentiate", In: dx("max(x, 1)"), Want: Failure(pb.ErrorCode_NOT_DIFFERENTIABLE)},
+	{Name: "differentiate no variable", Method: "Differentiate", In: sym("x^2"), Want: Failure(pb.ErrorCode_INVALID_VARIABLE)},
+	{Name: "differentiate constant variable", Method: "Differentiate", In: symRequest{Expression: "x", Variable: "pi"}, Want: Failure(pb.ErrorCode_INVALID_VARIABLE)},
+	{Name: "differentiate function variable", Method: "Differentiate", In: symRequest{Expression: "x", Variable: "sin"}, Want: Failure(pb.ErrorCode_INVALID_VARIABLE)},
+	{Name: "differentiate syntax error", Method: "Differentiate", In: dx("2*"), Want: Failure(pb.ErrorCode_SYNTAX_ERROR)},
+	{Name: "differentiate power tower", Method: "Differentiate", In: dx(strings.Repeat("x^", 249) + "x"), Want: Failure(pb.ErrorCode_EXPRESSION_TOO_LARGE)},
+}
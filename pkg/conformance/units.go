@@ -0,0 +1,149 @@
+package conformance
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
+)
+
+// q is shorthand for the quantity value in unit.
+func q(value float64, unit string) unitservice.Quantity {
+	return unitservice.Quantity{Value: value, Unit: unit}
+}
+
+// quantities are the operands of Sum, Subtract, Multiply and Divide.
+type quantities struct {
+	A, B unitservice.Quantity
+}
+
+func (o quantities) grpcRequest(method string) (req, reply proto.Message) {
+	switch method {
+	case "Sum", "Subtract", "Multiply", "Divide":
+		return &pb.QuantityOpRequest{A: o.A.Proto(), B: o.B.Proto()}, new(pb.QuantityReply)
+	}
+	return nil, nil
+}
+
+func (o quantities) grpcValue(method string, reply proto.Message) interface{} {
+	return quantityValue(reply)
+}
+
+func (o quantities) httpRequest(method string) interface{} {
+	return struct {
+		A unitservice.Quantity `json:"a"`
+		B unitservice.Quantity `json:"b"`
+	}{o.A, o.B}
+}
+
+func (o quantities) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	return decodeQuantity(v)
+}
+
+// quantityPow are the operands of Pow, which raises A to Exponent.
+type quantityPow struct {
+	A        unitservice.Quantity
+	Exponent float64
+}
+
+func (o quantityPow) grpcRequest(method string) (req, reply proto.Message) {
+	if method != "Pow" {
+		return nil, nil
+	}
+	return &pb.QuantityPowRequest{A: o.A.Proto(), B: o.Exponent}, new(pb.QuantityReply)
+}
+
+func (o quantityPow) grpcValue(method string, reply proto.Message) interface{} {
+	return quantityValue(reply)
+}
+
+func (o quantityPow) httpRequest(method string) interface{} {
+	return struct {
+		A unitservice.Quantity `json:"a"`
+		B float64              `json:"b"`
+	}{o.A, o.Exponent}
+}
+
+func (o quantityPow) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	return decodeQuantity(v)
+}
+
+// conversion are the operands of Convert, which converts A to Unit.
+type conversion struct {
+	A    unitservice.Quantity
+	Unit string
+}
+
+func (o conversion) grpcRequest(method string) (req, reply proto.Message) {
+	if method != "Convert" {
+		return nil, nil
+	}
+	return &pb.ConvertRequest{A: o.A.Proto(), Unit: o.Unit}, new(pb.QuantityReply)
+}
+
+func (o conversion) grpcValue(method string, reply proto.Message) interface{} {
+	return quantityValue(reply)
+}
+
+func (o conversion) httpRequest(method string) interface{} {
+	return struct {
+		A    unitservice.Quantity `json:"a"`
+		Unit string               `json:"unit"`
+	}{o.A, o.Unit}
+}
+
+func (o conversion) httpValue(method string, v json.RawMessage) (interface{}, error) {
+	return decodeQuantity(v)
+}
+
+// quantityValue returns the quantity held by a reply of the Units service.
+func quantityValue(reply proto.Message) interface{} {
+	return unitservice.FromProto(reply.(*pb.QuantityReply).V)
+}
+
+// decodeQuantity decodes the quantity returned by the Units service over
+// HTTP.
+func decodeQuantity(v json.RawMessage) (interface{}, error) {
+	var q unitservice.Quantity
+	err := json.Unmarshal(v, &q)
+	return q, err
+}
+
+// UnitsCases is the table of cases every implementation of the Units service
+// must pass. The implementations only know the built-in units.
+var UnitsCases = []ServiceCase{
+	{Name: "sum", Method: "Sum", In: quantities{q(1, "m"), q(2, "m")}, Want: Reply{V: q(3, "m")}},
+	{Name: "sum in unit of a", Method: "Sum", In: quantities{q(1, "km"), q(500, "m")}, Want: Reply{V: q(1.5, "km")}},
+	{Name: "sum derived units", Method: "Sum", In: quantities{q(1, "N"), q(1, "kg*m/s^2")}, Want: Reply{V: q(2, "N")}},
+	{Name: "sum dimensionless", Method: "Sum", In: quantities{q(1, ""), q(50, "%")}, Want: Reply{V: q(1.5, "")}},
+	{Name: "sum incompatible", Method: "Sum", In: quantities{q(1, "m"), q(1, "s")}, Want: Failure(pb.ErrorCode_INCOMPATIBLE_UNITS)},
+	{Name: "sum unknown unit", Method: "Sum", In: quantities{q(1, "m"), q(1, "furlong")}, Want: Failure(pb.ErrorCode_UNKNOWN_UNIT)},
+	{Name: "sum malformed unit", Method: "Sum", In: quantities{q(1, "m^x"), q(1, "m")}, Want: Failure(pb.ErrorCode_UNKNOWN_UNIT)},
+
+	{Name: "subtract", Method: "Subtract", In: quantities{q(1, "h"), q(30, "min")}, Want: Reply{V: q(0.5, "h")}},
+	{Name: "subtract incompatible", Method: "Subtract", In: quantities{q(1, "J"), q(1, "W")}, Want: Failure(pb.ErrorCode_INCOMPATIBLE_UNITS)},
+
+	{Name: "multiply", Method: "Multiply", In: quantities{q(2, "m"), q(3, "m")}, Want: Reply{V: q(6, "m^2")}},
+	{Name: "multiply mixed units", Method: "Multiply", In: quantities{q(2, "kg"), q(9.81, "m/s^2")}, Want: Reply{V: q(19.62, "kg*m/s^2")}},
+	{Name: "multiply cancelling", Method: "Multiply", In: quantities{q(10, "m/s"), q(2, "s")}, Want: Reply{V: q(20, "m")}},
+	{Name: "multiply dimensionless", Method: "Multiply", In: quantities{q(2, ""), q(3, "kWh")}, Want: Reply{V: q(6, "kWh")}},
+
+	{Name: "divide", Method: "Divide", In: quantities{q(10, "m/s"), q(2, "s")}, Want: Reply{V: q(5, "m/s^2")}},
+	{Name: "divide same unit", Method: "Divide", In: quantities{q(6, "km"), q(3, "km")}, Want: Reply{V: q(2, "")}},
+	{Name: "divide reciprocal", Method: "Divide", In: quantities{q(1, ""), q(4, "s")}, Want: Reply{V: q(0.25, "1/s")}},
+	{Name: "divide by zero", Method: "Divide", In: quantities{q(1, "m"), q(0, "s")}, Want: Failure(pb.ErrorCode_DIVIDE_BY_ZERO)},
+
+	{Name: "pow", Method: "Pow", In: quantityPow{q(3, "m"), 2}, Want: Reply{V: q(9, "m^2")}},
+	{Name: "pow negative", Method: "Pow", In: quantityPow{q(2, "s"), -1}, Want: Reply{V: q(0.5, "1/s")}},
+	{Name: "pow root", Method: "Pow", In: quantityPow{q(16, "m^2/s^2"), 0.5}, Want: Reply{V: q(4, "m/s")}},
+	{Name: "pow zero", Method: "Pow", In: quantityPow{q(5, "kg"), 0}, Want: Reply{V: q(1, "")}},
+	{Name: "pow fractional dimension", Method: "Pow", In: quantityPow{q(4, "m"), 0.5}, Want: Failure(pb.ErrorCode_FRACTIONAL_DIMENSION)},
+
+	{Name: "convert", Method: "Convert", In: conversion{q(1, "mi"), "km"}, Want: Reply{V: q(1.609344, "km")}},
+	{Name: "convert derived", Method: "Convert", In: conversion{q(1, "kWh"), "J"}, Want: Reply{V: q(3.6e6, "J")}},
+	{Name: "convert compound", Method: "Convert", In: conversion{q(36, "km/h"), "m/s"}, Want: Reply{V: q(10, "m/s")}},
+	{Name: "convert to base units", Method: "Convert", In: conversion{q(1, "N"), "kg*m/s^2"}, Want: Reply{V: q(1, "kg*m/s^2")}},
+	{Name: "convert incompatible", Method: "Convert", In: conversion{q(1, "km"), "J"}, Want: Failure(pb.ErrorCode_INCOMPATIBLE_UNITS)},
+	{Name: "convert unknown unit", Method: "Convert", In: conversion{q(1, "km"), "league"}, Want: Failure(pb.ErrorCode_UNKNOWN_UNIT)},
+}
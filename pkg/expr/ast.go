@@ -0,0 +1,261 @@
+// Package expr parses and evaluates arithmetic expressions such as
+// "(3+4)*2^5/7". Evaluation doesn't do any arithmetic itself, every operation
+// is delegated to a Calculator so that a math service, along with any
+// middleware wrapping it, observes each individual operation.
+//
+// An expression may also be a function of a variable, such as "sin(x)/x",
+// see ParseFunc, or use any number of variables, see ParseVars.
+package expr
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Calculator is the set of operations an expression is evaluated with.
+// Every mathservice.Service implements it.
+type Calculator interface {
+	Divide(ctx context.Context, a, b float64) (float64, error)
+	Max(ctx context.Context, a, b float64) (float64, error)
+	Min(ctx context.Context, a, b float64) (float64, error)
+	Multiply(ctx context.Context, a, b float64) (float64, error)
+	Pow(ctx context.Context, a, b float64) (float64, error)
+	Subtract(ctx context.Context, a, b float64) (float64, error)
+	Sum(ctx context.Context, a, b float64) (float64, error)
+	Abs(ctx context.Context, x float64) (float64, error)
+	Sqrt(ctx context.Context, x float64) (float64, error)
+	Exp(ctx context.Context, x float64) (float64, error)
+	Ln(ctx context.Context, x float64) (float64, error)
+	Sin(ctx context.Context, x float64) (float64, error)
+	Cos(ctx context.Context, x float64) (float64, error)
+	Tan(ctx context.Context, x float64) (float64, error)
+}
+
+// functions maps the callable function names to their arity.
+var functions = map[string]int{
+	"max":  2,
+	"min":  2,
+	"abs":  1,
+	"sqrt": 1,
+	"exp":  1,
+	"ln":   1,
+	"sin":  1,
+	"cos":  1,
+	"tan":  1,
+}
+
+// constants maps the names of the known constants to their value.
+var constants = map[string]float64{
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+// Evaluate parses s and evaluates it using c.
+func Evaluate(ctx context.Context, c Calculator, s string) (float64, error) {
+	n, err := Parse(s)
+	if err != nil {
+		return 0, err
+	}
+	return n.Eval(ctx, c)
+}
+
+// Operation is the operation of an expression that a Calculator method is
+// called for, see OperationFromContext.
+type Operation struct {
+	// Node is the node whose value the operation computes.
+	Node Node
+	// Operands are the nodes whose values are the operands of the method,
+	// in order. An operand that no operation computed, such as a number, is
+	// nil.
+	Operands []Node
+}
+
+type operationKey struct{}
+
+// OperationFromContext returns the operation of an expression that a
+// Calculator method is called for with ctx. A Calculator that computes beyond
+// float64 precision uses it to take each operand from the exact value of the
+// operation that computed it, rather than from its float64 value.
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	op, ok := ctx.Value(operationKey{}).(Operation)
+	return op, ok
+}
+
+// withOperation returns ctx carrying the operation of n on operands.
+func withOperation(ctx context.Context, n Node, operands ...Node) context.Context {
+	op := Operation{Node: n, Operands: make([]Node, len(operands))}
+	for i, o := range operands {
+		op.Operands[i] = computedBy(o)
+	}
+	return context.WithValue(ctx, operationKey{}, op)
+}
+
+// computedBy returns the node whose operation computes the value of n, which
+// is nil when no operation does, as for numbers and variables.
+func computedBy(n Node) Node {
+	switch m := n.(type) {
+	case *Unary:
+		if m.Op == '+' {
+			return computedBy(m.X)
+		}
+		return m
+	case *Binary, *Call:
+		return m
+	}
+	return nil
+}
+
+// Node is an element of a parsed expression.
+type Node interface {
+	// Eval computes the value of the node using c.
+	Eval(ctx context.Context, c Calculator) (float64, error)
+	// String returns the node as a fully parenthesized expression.
+	String() string
+}
+
+// Number is a numeric literal, or one of the constants when Name is set.
+type Number struct {
+	Value  float64
+	Name   string
+	Column int
+}
+
+func (n *Number) Eval(ctx context.Context, c Calculator) (float64, error) {
+	return n.Value, nil
+}
+
+func (n *Number) String() string {
+	if n.Name != "" {
+		return n.Name
+	}
+	return strconv.FormatFloat(n.Value, 'g', -1, 64)
+}
+
+// Variable is an occurrence of a variable, see ParseFunc and ParseVars. It
+// evaluates to Value, which Func.Eval sets.
+type Variable struct {
+	Name   string
+	Value  float64
+	Column int
+}
+
+func (n *Variable) Eval(ctx context.Context, c Calculator) (float64, error) {
+	return n.Value, nil
+}
+
+func (n *Variable) String() string {
+	return n.Name
+}
+
+// Unary is a prefix operation, Op is either '+' or '-'.
+type Unary struct {
+	Op     byte
+	X      Node
+	Column int
+}
+
+func (n *Unary) Eval(ctx context.Context, c Calculator) (float64, error) {
+	x, err := n.X.Eval(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	if n.Op == '-' {
+		// negation is delegated like any other operation, so a Calculator
+		// that tracks results beyond float64 precision sees it.
+		return c.Multiply(withOperation(ctx, n, nil, n.X), -1, x)
+	}
+	return x, nil
+}
+
+func (n *Unary) String() string {
+	return fmt.Sprintf("(%c%s)", n.Op, n.X)
+}
+
+// Binary is an infix operation, Op is one of '+', '-', '*', '/' or '^'.
+type Binary struct {
+	Op     byte
+	X, Y   Node
+	Column int
+}
+
+func (n *Binary) Eval(ctx context.Context, c Calculator) (float64, error) {
+	x, err := n.X.Eval(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	y, err := n.Y.Eval(ctx, c)
+	if err != nil {
+		return 0, err
+	}
+	ctx = withOperation(ctx, n, n.X, n.Y)
+	switch n.Op {
+	case '+':
+		return c.Sum(ctx, x, y)
+	case '-':
+		return c.Subtract(ctx, x, y)
+	case '*':
+		return c.Multiply(ctx, x, y)
+	case '/':
+		return c.Divide(ctx, x, y)
+	case '^':
+		return c.Pow(ctx, x, y)
+	}
+	return 0, fmt.Errorf("unknown operator %q", n.Op)
+}
+
+func (n *Binary) String() string {
+	return fmt.Sprintf("(%s %c %s)", n.X, n.Op, n.Y)
+}
+
+// Call is a function call such as max(a, b).
+type Call struct {
+	Func   string
+	Args   []Node
+	Column int
+}
+
+func (n *Call) Eval(ctx context.Context, c Calculator) (float64, error) {
+	args := make([]float64, len(n.Args))
+	for i, a := range n.Args {
+		v, err := a.Eval(ctx, c)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = v
+	}
+	ctx = withOperation(ctx, n, n.Args...)
+	switch n.Func {
+	case "max":
+		return c.Max(ctx, args[0], args[1])
+	case "min":
+		return c.Min(ctx, args[0], args[1])
+	case "abs":
+		return c.Abs(ctx, args[0])
+	case "sqrt":
+		return c.Sqrt(ctx, args[0])
+	case "exp":
+		return c.Exp(ctx, args[0])
+	case "ln":
+		return c.Ln(ctx, args[0])
+	case "sin":
+		return c.Sin(ctx, args[0])
+	case "cos":
+		return c.Cos(ctx, args[0])
+	case "tan":
+		return c.Tan(ctx, args[0])
+	}
+	return 0, fmt.Errorf("unknown function %q", n.Func)
+}
+
+func (n *Call) String() string {
+	s := n.Func + "("
+	for i, a := range n.Args {
+		if i > 0 {
+			s += ", "
+		}
+		s += a.String()
+	}
+	return s + ")"
+}
@@ -0,0 +1,71 @@
+package expr
+
+import (
+	"context"
+	"math"
+)
+
+// Float is a Calculator computing with float64 that never fails. Operations
+// outside their domain, such as sqrt(-1) or 1/0, return NaN or an infinity
+// like the functions of package math, and max and min of equal operands
+// return that operand. It suits evaluating a function many times, where
+// observing every operation would cost more than computing it.
+var Float Calculator = floatCalculator{}
+
+type floatCalculator struct{}
+
+func (floatCalculator) Divide(ctx context.Context, a, b float64) (float64, error) {
+	return a / b, nil
+}
+
+func (floatCalculator) Max(ctx context.Context, a, b float64) (float64, error) {
+	return math.Max(a, b), nil
+}
+
+func (floatCalculator) Min(ctx context.Context, a, b float64) (float64, error) {
+	return math.Min(a, b), nil
+}
+
+func (floatCalculator) Multiply(ctx context.Context, a, b float64) (float64, error) {
+	return a * b, nil
+}
+
+func (floatCalculator) Pow(ctx context.Context, a, b float64) (float64, error) {
+	return math.Pow(a, b), nil
+}
+
+func (floatCalculator) Subtract(ctx context.Context, a, b float64) (float64, error) {
+	return a - b, nil
+}
+
+func (floatCalculator) Sum(ctx context.Context, a, b float64) (float64, error) {
+	return a + b, nil
+}
+
+func (floatCalculator) Abs(ctx context.Context, x float64) (float64, error) {
+	return math.Abs(x), nil
+}
+
+func (floatCalculator) Sqrt(ctx context.Context, x float64) (float64, error) {
+	return math.Sqrt(x), nil
+}
+
+func (floatCalculator) Exp(ctx context.Context, x float64) (float64, error) {
+	return math.Exp(x), nil
+}
+
+func (floatCalculator) Ln(ctx context.Context, x float64) (float64, error) {
+	return math.Log(x), nil
+}
+
+func (floatCalculator) Sin(ctx context.Context, x float64) (float64, error) {
+	return math.Sin(x), nil
+}
+
+func (floatCalculator) Cos(ctx context.Context, x float64) (float64, error) {
+	return math.Cos(x), nil
+}
+
+func (floatCalculator) Tan(ctx context.Context, x float64) (float64, error) {
+	return math.Tan(x), nil
+}
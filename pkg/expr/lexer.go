@@ -0,0 +1,135 @@
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokIdent
+	tokPlus
+	tokMinus
+	tokStar
+	tokSlash
+	tokCaret
+	tokLParen
+	tokRParen
+	tokComma
+)
+
+// token is a single lexical element of an expression. col is the 1-based
+// column of the first character of the token.
+type token struct {
+	kind tokenKind
+	text string
+	num  float64
+	col  int
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// ErrSyntax matches every *SyntaxError when compared using errors.Is.
+var ErrSyntax = errors.New("syntax error")
+
+// SyntaxError is returned when an expression can't be parsed. Column is the
+// 1-based position of the offending token within the expression.
+type SyntaxError struct {
+	Column int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at column %d: %s", e.Column, e.Msg)
+}
+
+// Is reports whether target is ErrSyntax.
+func (e *SyntaxError) Is(target error) bool {
+	return target == ErrSyntax
+}
+
+// lex splits s into tokens. The returned slice always ends with a tokEOF.
+func lex(s string) ([]token, error) {
+	var (
+		toks []token
+		rs   = []rune(s)
+	)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		col := i + 1
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case unicode.IsDigit(r) || r == '.':
+			j := i
+			for j < len(rs) && (unicode.IsDigit(rs[j]) || rs[j] == '.') {
+				j++
+			}
+			// optional exponent, e.g. 1e-3
+			if j < len(rs) && (rs[j] == 'e' || rs[j] == 'E') {
+				k := j + 1
+				if k < len(rs) && (rs[k] == '+' || rs[k] == '-') {
+					k++
+				}
+				if k < len(rs) && unicode.IsDigit(rs[k]) {
+					for k < len(rs) && unicode.IsDigit(rs[k]) {
+						k++
+					}
+					j = k
+				}
+			}
+			text := string(rs[i:j])
+			v, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, &SyntaxError{Column: col, Msg: fmt.Sprintf("invalid number %q", text)}
+			}
+			toks = append(toks, token{kind: tokNumber, text: text, num: v, col: col})
+			i = j
+			continue
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for j < len(rs) && (unicode.IsLetter(rs[j]) || unicode.IsDigit(rs[j]) || rs[j] == '_') {
+				j++
+			}
+			toks = append(toks, token{kind: tokIdent, text: string(rs[i:j]), col: col})
+			i = j
+			continue
+		}
+
+		var kind tokenKind
+		switch r {
+		case '+':
+			kind = tokPlus
+		case '-':
+			kind = tokMinus
+		case '*':
+			kind = tokStar
+		case '/':
+			kind = tokSlash
+		case '^':
+			kind = tokCaret
+		case '(':
+			kind = tokLParen
+		case ')':
+			kind = tokRParen
+		case ',':
+			kind = tokComma
+		default:
+			return nil, &SyntaxError{Column: col, Msg: fmt.Sprintf("unexpected character %q", r)}
+		}
+		toks = append(toks, token{kind: kind, text: string(r), col: col})
+		i++
+	}
+	return append(toks, token{kind: tokEOF, col: len(rs) + 1}), nil
+}
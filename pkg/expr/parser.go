@@ -0,0 +1,298 @@
+package expr
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Parse parses an arithmetic expression into its abstract syntax tree.
+//
+// The grammar, from lowest to highest precedence, is:
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/") unary }
+//	unary   = ("-" | "+") unary | power
+//	power   = primary [ "^" unary ]
+//	primary = number | ident | "(" expr ")" | ident "(" expr { "," expr } ")"
+//
+// Exponentiation is right associative and binds tighter than unary minus, so
+// -2^2 is -(2^2). An identifier that isn't called is one of the constants pi
+// and e. An expression longer than MaxLength, or nested deeper than MaxDepth,
+// is a syntax error.
+func Parse(s string) (Node, error) {
+	return parse(s, "")
+}
+
+// Func is a parsed function of a single variable. It isn't safe for
+// concurrent use, as evaluating it sets the variable.
+type Func struct {
+	Root Node
+	vars []*Variable
+}
+
+// ParseFunc parses an expression that, besides the constants, may use the
+// variable named variable, e.g. "x". The grammar is that of Parse.
+func ParseFunc(s, variable string) (*Func, error) {
+	p, err := newParser(s, variable)
+	if err != nil {
+		return nil, err
+	}
+	n, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	return &Func{Root: n, vars: p.vars}, nil
+}
+
+// Eval computes the value of f where its variable is x, using c.
+func (f *Func) Eval(ctx context.Context, c Calculator, x float64) (float64, error) {
+	for _, v := range f.vars {
+		v.Value = x
+	}
+	return f.Root.Eval(ctx, c)
+}
+
+func (f *Func) String() string {
+	return f.Root.String()
+}
+
+// ParseVars parses an expression in which every name that is neither a
+// function nor a constant is a variable, e.g. "a*x^2 + b". The grammar is that
+// of Parse.
+func ParseVars(s string) (Node, error) {
+	p, err := newParser(s, "")
+	if err != nil {
+		return nil, err
+	}
+	p.anyVariable = true
+	return p.parse()
+}
+
+func parse(s, variable string) (Node, error) {
+	p, err := newParser(s, variable)
+	if err != nil {
+		return nil, err
+	}
+	return p.parse()
+}
+
+// MaxLength is the most bytes an expression may have, and MaxDepth the most
+// operands, parentheses, signs, exponents and calls it may nest within one
+// another. They bound the recursion of parsing and evaluating an expression,
+// which would otherwise overflow the stack on inputs such as a million
+// opening parentheses.
+const (
+	MaxLength = 1 << 16
+	MaxDepth  = 256
+)
+
+type parser struct {
+	toks []token
+	pos  int
+	// depth is the number of operands being parsed within one another
+	depth int
+	// variable is the name of the variable of a function, vars collects its
+	// occurrences. When anyVariable is set every unknown name is a variable.
+	variable    string
+	vars        []*Variable
+	anyVariable bool
+}
+
+func newParser(s, variable string) (*parser, error) {
+	if len(s) > MaxLength {
+		col := utf8.RuneCountInString(s[:MaxLength]) + 1
+		return nil, &SyntaxError{Column: col, Msg: fmt.Sprintf("expression longer than %d bytes", MaxLength)}
+	}
+	toks, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+	return &parser{toks: toks, variable: variable}, nil
+}
+
+func (p *parser) parse() (Node, error) {
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, p.errorf(t, "unexpected %s", t)
+	}
+	return n, nil
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	return &SyntaxError{Column: t.col, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) expect(kind tokenKind, what string) (token, error) {
+	t := p.next()
+	if t.kind != kind {
+		return t, p.errorf(t, "expected %s, found %s", what, t)
+	}
+	return t, nil
+}
+
+func (p *parser) expr() (Node, error) {
+	x, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != tokPlus && t.kind != tokMinus {
+			return x, nil
+		}
+		p.next()
+		y, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		x = &Binary{Op: t.text[0], X: x, Y: y, Column: t.col}
+	}
+}
+
+func (p *parser) term() (Node, error) {
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != tokStar && t.kind != tokSlash {
+			return x, nil
+		}
+		p.next()
+		y, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		x = &Binary{Op: t.text[0], X: x, Y: y, Column: t.col}
+	}
+}
+
+func (p *parser) unary() (Node, error) {
+	t := p.peek()
+	// every recursion of the grammar goes through unary
+	if p.depth++; p.depth > MaxDepth {
+		return nil, p.errorf(t, "expression nested more than %d levels deep", MaxDepth)
+	}
+	defer func() { p.depth-- }()
+	if t.kind != tokPlus && t.kind != tokMinus {
+		return p.power()
+	}
+	p.next()
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	if n, ok := x.(*Number); ok && n.Name == "" {
+		// fold signed literals so -3 doesn't cost an operation
+		if t.kind == tokMinus {
+			n.Value = -n.Value
+		}
+		n.Column = t.col
+		return n, nil
+	}
+	return &Unary{Op: t.text[0], X: x, Column: t.col}, nil
+}
+
+func (p *parser) power() (Node, error) {
+	x, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	if t.kind != tokCaret {
+		return x, nil
+	}
+	p.next()
+	y, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	return &Binary{Op: '^', X: x, Y: y, Column: t.col}, nil
+}
+
+func (p *parser) primary() (Node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokNumber:
+		return &Number{Value: t.num, Column: t.col}, nil
+	case tokLParen:
+		x, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokRParen, `")"`); err != nil {
+			return nil, err
+		}
+		return x, nil
+	case tokIdent:
+		if p.peek().kind == tokLParen {
+			return p.call(t)
+		}
+		return p.ident(t)
+	}
+	return nil, p.errorf(t, "unexpected %s", t)
+}
+
+func (p *parser) ident(t token) (Node, error) {
+	if t.text == p.variable {
+		v := &Variable{Name: t.text, Column: t.col}
+		p.vars = append(p.vars, v)
+		return v, nil
+	}
+	if v, ok := constants[t.text]; ok {
+		return &Number{Value: v, Name: t.text, Column: t.col}, nil
+	}
+	if _, ok := functions[t.text]; ok {
+		return nil, p.errorf(t, "expected \"(\" after %s", t.text)
+	}
+	if p.anyVariable {
+		return &Variable{Name: t.text, Column: t.col}, nil
+	}
+	return nil, p.errorf(t, "unknown name %q", t.text)
+}
+
+func (p *parser) call(name token) (Node, error) {
+	arity, ok := functions[name.text]
+	if !ok {
+		return nil, p.errorf(name, "unknown function %q", name.text)
+	}
+	if _, err := p.expect(tokLParen, `"("`); err != nil {
+		return nil, err
+	}
+	var args []Node
+	for {
+		x, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, x)
+		if p.peek().kind != tokComma {
+			break
+		}
+		p.next()
+	}
+	if _, err := p.expect(tokRParen, `")"`); err != nil {
+		return nil, err
+	}
+	if len(args) != arity {
+		return nil, p.errorf(name, "%s expects %d arguments, got %d", name.text, arity, len(args))
+	}
+	return &Call{Func: name.text, Args: args, Column: name.col}, nil
+}
@@ -0,0 +1,187 @@
+package expr_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pkg/expr"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{"1+2*3", "(1 + (2 * 3))"},
+		{"(1+2)*3", "((1 + 2) * 3)"},
+		{"8-4-2", "((8 - 4) - 2)"},
+		{"2^3^2", "(2 ^ (3 ^ 2))"},
+		{"-2^2", "(-(2 ^ 2))"},
+		{"-3*2", "(-3 * 2)"},
+		{"2^-1", "(2 ^ -1)"},
+		{"--1", "1"},
+		{"-pi", "(-pi)"},
+		{"max(1, 2)+sqrt(4)", "(max(1, 2) + sqrt(4))"},
+		{" 1e-3 * 2E+2 ", "(0.001 * 200)"},
+	}
+	for _, c := range cases {
+		n, err := expr.Parse(c.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", c.in, err)
+			continue
+		}
+		if got := n.String(); got != c.want {
+			t.Errorf("Parse(%q) = %s, want %s", c.in, got, c.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		in     string
+		column int
+		msg    string
+	}{
+		{"", 1, "unexpected end of expression"},
+		{"1+", 3, "unexpected end of expression"},
+		{"1 + * 2", 5, `unexpected "*"`},
+		{"(1+2", 5, `expected ")", found end of expression`},
+		{"1 2", 3, `unexpected "2"`},
+		{"2 # 3", 3, `unexpected character '#'`},
+		{"1..2", 1, `invalid number "1..2"`},
+		{"x+1", 1, `unknown name "x"`},
+		{"foo(1)", 1, `unknown function "foo"`},
+		{"sin+1", 1, `expected "(" after sin`},
+		{"max(1)", 1, "max expects 2 arguments, got 1"},
+		{"π+é", 1, `unknown name "π"`},
+		{"1.5+é", 5, `unknown name "é"`},
+	}
+	for _, c := range cases {
+		_, err := expr.Parse(c.in)
+		var se *expr.SyntaxError
+		if !errors.As(err, &se) {
+			t.Errorf("Parse(%q) = %v, want a *SyntaxError", c.in, err)
+			continue
+		}
+		if !errors.Is(err, expr.ErrSyntax) {
+			t.Errorf("Parse(%q): %v isn't ErrSyntax", c.in, err)
+		}
+		if se.Column != c.column || se.Msg != c.msg {
+			t.Errorf("Parse(%q) failed at column %d with %q, want column %d with %q", c.in, se.Column, se.Msg, c.column, c.msg)
+		}
+		if want := fmt.Sprintf("syntax error at column %d: %s", c.column, c.msg); err.Error() != want {
+			t.Errorf("Parse(%q): got message %q, want %q", c.in, err.Error(), want)
+		}
+	}
+}
+
+func TestParseLimits(t *testing.T) {
+	// nested just within and just beyond the limits, along with inputs deep
+	// enough to overflow the stack of a parser without them
+	var (
+		parens = strings.Repeat("(", expr.MaxDepth-1) + "1" + strings.Repeat(")", expr.MaxDepth-1)
+		chain  = strings.Repeat("1+", expr.MaxLength/2-1) + "1"
+	)
+	cases := []struct {
+		name string
+		in   string
+		ok   bool
+	}{
+		{"parentheses at the limit", parens, true},
+		{"parentheses beyond the limit", "(" + parens + ")", false},
+		{"signs at the limit", strings.Repeat("-", expr.MaxDepth-1) + "1", true},
+		{"signs beyond the limit", strings.Repeat("-", expr.MaxDepth) + "1", false},
+		{"exponents beyond the limit", strings.Repeat("2^", expr.MaxDepth) + "2", false},
+		{"calls beyond the limit", strings.Repeat("abs(", expr.MaxDepth) + "1" + strings.Repeat(")", expr.MaxDepth), false},
+		{"length at the limit", chain, true},
+		{"length beyond the limit", chain + "+1", false},
+		{"megabytes of parentheses", strings.Repeat("(", 1900000) + "1" + strings.Repeat(")", 1900000), false},
+		{"megabytes of signs", strings.Repeat("-", 3800000) + "1", false},
+	}
+	for _, c := range cases {
+		n, err := expr.Parse(c.in)
+		switch {
+		case c.ok && err != nil:
+			t.Errorf("%s: %v", c.name, err)
+		case !c.ok && !errors.Is(err, expr.ErrSyntax):
+			t.Errorf("%s: got %v, want a syntax error", c.name, err)
+		case c.ok:
+			if _, err := n.Eval(context.Background(), expr.Float); err != nil {
+				t.Errorf("%s: %v", c.name, err)
+			}
+		}
+	}
+	for _, parse := range []func(string) error{
+		func(s string) error { _, err := expr.ParseFunc(s, "x"); return err },
+		func(s string) error { _, err := expr.ParseVars(s); return err },
+	} {
+		if err := parse(strings.Repeat("(", 1900000) + "x" + strings.Repeat(")", 1900000)); !errors.Is(err, expr.ErrSyntax) {
+			t.Errorf("got %v, want a syntax error", err)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	cases := []struct {
+		in   string
+		want float64
+	}{
+		{"(3+4)*2^5/7", 32},
+		{"-2^2", -4},
+		{"2^3^2", 512},
+		{"min(3, max(1, 2)) - abs(-1)", 1},
+		{"ln(e) + cos(0)", 2},
+		{"1/0", math.Inf(1)},
+	}
+	for _, c := range cases {
+		got, err := expr.Evaluate(context.Background(), expr.Float, c.in)
+		if err != nil {
+			t.Errorf("Evaluate(%q): %v", c.in, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("Evaluate(%q) = %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestParseFunc(t *testing.T) {
+	f, err := expr.ParseFunc("x^2 + 2*x", "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for x, want := range map[float64]float64{0: 0, 1: 3, -2: 0, 3: 15} {
+		got, err := f.Eval(context.Background(), expr.Float, x)
+		if err != nil || got != want {
+			t.Errorf("f(%v) = %v, %v, want %v", x, got, err, want)
+		}
+	}
+	if _, err := expr.ParseFunc("x + y", "x"); !errors.Is(err, expr.ErrSyntax) {
+		t.Errorf("got %v, want a syntax error for y", err)
+	}
+}
+
+func TestParseVars(t *testing.T) {
+	for _, tc := range []struct {
+		in, want string
+	}{
+		{"a*x^2 + b - pi", "(((a * (x ^ 2)) + b) - pi)"},
+		{"e^y", "(e ^ y)"},
+		{"sin(theta) + x_1", "(sin(theta) + x_1)"},
+		{"sin * 2", ""},
+		{"max(x)", ""},
+	} {
+		n, err := expr.ParseVars(tc.in)
+		switch {
+		case tc.want == "" && !errors.Is(err, expr.ErrSyntax):
+			t.Errorf("ParseVars(%q): got %v, want a syntax error", tc.in, err)
+		case tc.want != "" && err != nil:
+			t.Errorf("ParseVars(%q): %v", tc.in, err)
+		case tc.want != "" && n.String() != tc.want:
+			t.Errorf("ParseVars(%q) = %s, want %s", tc.in, n.String(), tc.want)
+		}
+	}
+}
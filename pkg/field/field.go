@@ -0,0 +1,35 @@
+// Package field names the request field at fault for the errors returned by
+// the services, so that the transports can report it to clients, see
+// rpcstatus.Error.
+package field
+
+import "errors"
+
+// Error returns err, caused by the request field name. name is the name of
+// the field in the proto, indexed if the field is repeated, e.g. values[2].
+// The returned error matches err using errors.Is and has the same message.
+func Error(name string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return fieldError{name: name, err: err}
+}
+
+// Name returns the name of the request field at fault for err, ok is false
+// if err doesn't name one.
+func Name(err error) (name string, ok bool) {
+	var f fieldError
+	if !errors.As(err, &f) {
+		return "", false
+	}
+	return f.name, true
+}
+
+type fieldError struct {
+	name string
+	err  error
+}
+
+func (e fieldError) Error() string { return e.err.Error() }
+
+func (e fieldError) Unwrap() error { return e.err }
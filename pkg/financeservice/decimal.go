@@ -0,0 +1,122 @@
+package financeservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jwenz723/mathserver/pkg/field"
+)
+
+// Decimal is a decimal number written the way strconv.ParseFloat accepts it,
+// without the special values, e.g. "1000.50", "-0.05" or "1e6". The empty
+// Decimal is 0.
+type Decimal string
+
+// maxExponent bounds the exponent of a Decimal, so that parsing "1e999999999"
+// doesn't allocate a billion digits.
+const maxExponent = 400
+
+// MaxDigits is the most digits a Decimal may be written with, leading zeros
+// aside, so that its numerator and denominator stay small.
+const MaxDigits = 100
+
+var decimalSyntax = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE]([+-]?\d+))?$`)
+
+// UnmarshalJSON implements json.Unmarshaler. A Decimal may be encoded as a
+// JSON string or, since its digits are kept as they're written, as a number.
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*d = Decimal(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	*d = Decimal(n)
+	return nil
+}
+
+// parse returns the exact value of d, name is the request field holding d.
+func (d Decimal) parse(name string) (*big.Rat, error) {
+	s := strings.TrimSpace(string(d))
+	if s == "" {
+		return new(big.Rat), nil
+	}
+	m := decimalSyntax.FindStringSubmatch(s)
+	if m == nil {
+		return nil, field.Error(name, fmt.Errorf("%w %s %q", ErrInvalidDecimal, name, string(d)))
+	}
+	if digits := strings.TrimLeft(strings.Replace(m[1], ".", "", 1), "0"); len(digits) > MaxDigits {
+		return nil, field.Error(name, fmt.Errorf("%w %s %q: it may have at most %d digits", ErrInvalidDecimal, name, string(d), MaxDigits))
+	}
+	if m[3] != "" {
+		if exp, err := strconv.Atoi(m[3]); err != nil || exp > maxExponent || exp < -maxExponent {
+			return nil, field.Error(name, fmt.Errorf("%w %s %q: the exponent must be between -%d and %d", ErrInvalidDecimal, name, string(d), maxExponent, maxExponent))
+		}
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, field.Error(name, fmt.Errorf("%w %s %q", ErrInvalidDecimal, name, string(d)))
+	}
+	return r, nil
+}
+
+// round formats r with scale decimal places, rounding half away from zero. A
+// result rounded to zero has no sign.
+func round(r *big.Rat, scale int) Decimal {
+	s := r.FloatString(scale)
+	if strings.HasPrefix(s, "-") && strings.Trim(s, "-0.") == "" {
+		s = s[1:]
+	}
+	return Decimal(s)
+}
+
+// maxBits is the most bits the numerator and denominator of an intermediate
+// result may have before it's rounded to roundBits, far more than the digits
+// of any result need. Intermediate results are otherwise exact, but those
+// compounding a rate of many digits over many periods would grow to millions
+// of digits.
+const (
+	maxBits   = 1 << 14
+	roundBits = 1 << 12
+)
+
+// bound returns r, rounded to roundBits if it has more than maxBits.
+func bound(r *big.Rat) *big.Rat {
+	if r.Num().BitLen()+r.Denom().BitLen() <= maxBits {
+		return r
+	}
+	r, _ = new(big.Float).SetPrec(roundBits).SetRat(r).Rat(r)
+	return r
+}
+
+// pow returns x^n for n >= 0, bounding each intermediate power.
+func pow(ctx context.Context, x *big.Rat, n int64) (*big.Rat, error) {
+	z := big.NewRat(1, 1)
+	x = new(big.Rat).Set(x)
+	for ; n > 0; n >>= 1 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if n&1 == 1 {
+			z = bound(z.Mul(z, x))
+		}
+		if n > 1 {
+			x = bound(x.Mul(x, x))
+		}
+	}
+	return z, nil
+}
@@ -0,0 +1,158 @@
+package financeservice
+
+import (
+	"io"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/jwenz723/mathserver/pb"
+)
+
+// CashFlowsFromProto converts a gRPC cash flow request to CashFlows.
+func CashFlowsFromProto(r *pb.CashFlowRequest) CashFlows {
+	values := make([]Decimal, len(r.GetValues()))
+	for i, v := range r.GetValues() {
+		values[i] = Decimal(v)
+	}
+	return CashFlows{Rate: Decimal(r.GetRate()), Values: values, Scale: scaleFromProto(r.GetScale())}
+}
+
+// Proto converts c to a gRPC cash flow request.
+func (c CashFlows) Proto() *pb.CashFlowRequest {
+	values := make([]string, len(c.Values))
+	for i, v := range c.Values {
+		values[i] = string(v)
+	}
+	return &pb.CashFlowRequest{Rate: string(c.Rate), Values: values, Scale: scaleProto(c.Scale)}
+}
+
+// TimeValueFromProto converts a gRPC time value request to a TimeValue.
+func TimeValueFromProto(r *pb.TimeValueRequest) TimeValue {
+	return TimeValue{
+		Rate:    Decimal(r.GetRate()),
+		Periods: r.GetPeriods(),
+		PV:      Decimal(r.GetPv()),
+		FV:      Decimal(r.GetFv()),
+		PMT:     Decimal(r.GetPmt()),
+		Scale:   scaleFromProto(r.GetScale()),
+	}
+}
+
+// Proto converts tv to a gRPC time value request.
+func (tv TimeValue) Proto() *pb.TimeValueRequest {
+	return &pb.TimeValueRequest{
+		Rate:    string(tv.Rate),
+		Periods: tv.Periods,
+		Pv:      string(tv.PV),
+		Fv:      string(tv.FV),
+		Pmt:     string(tv.PMT),
+		Scale:   scaleProto(tv.Scale),
+	}
+}
+
+// CompoundingFromProto converts a gRPC compound interest request to a
+// Compounding.
+func CompoundingFromProto(r *pb.CompoundInterestRequest) Compounding {
+	return Compounding{
+		Principal: Decimal(r.GetPrincipal()),
+		Rate:      Decimal(r.GetRate()),
+		Periods:   r.GetPeriods(),
+		Frequency: r.GetFrequency(),
+		Scale:     scaleFromProto(r.GetScale()),
+	}
+}
+
+// Proto converts c to a gRPC compound interest request.
+func (c Compounding) Proto() *pb.CompoundInterestRequest {
+	return &pb.CompoundInterestRequest{
+		Principal: string(c.Principal),
+		Rate:      string(c.Rate),
+		Periods:   c.Periods,
+		Frequency: c.Frequency,
+		Scale:     scaleProto(c.Scale),
+	}
+}
+
+// LoanFromProto converts a gRPC amortization request to a Loan.
+func LoanFromProto(r *pb.AmortizationRequest) Loan {
+	return Loan{
+		Principal: Decimal(r.GetPrincipal()),
+		Rate:      Decimal(r.GetRate()),
+		Periods:   r.GetPeriods(),
+		Scale:     scaleFromProto(r.GetScale()),
+	}
+}
+
+// Proto converts l to a gRPC amortization request.
+func (l Loan) Proto() *pb.AmortizationRequest {
+	return &pb.AmortizationRequest{
+		Principal: string(l.Principal),
+		Rate:      string(l.Rate),
+		Periods:   l.Periods,
+		Scale:     scaleProto(l.Scale),
+	}
+}
+
+// RowFromProto converts a gRPC amortization row to an AmortizationRow,
+// ignoring its error.
+func RowFromProto(r *pb.AmortizationRow) AmortizationRow {
+	return AmortizationRow{
+		Period:    r.GetPeriod(),
+		Payment:   Decimal(r.GetPayment()),
+		Interest:  Decimal(r.GetInterest()),
+		Principal: Decimal(r.GetPrincipal()),
+		Balance:   Decimal(r.GetBalance()),
+	}
+}
+
+// Proto converts r to a gRPC amortization row.
+func (r AmortizationRow) Proto() *pb.AmortizationRow {
+	return &pb.AmortizationRow{
+		Period:    r.Period,
+		Payment:   string(r.Payment),
+		Interest:  string(r.Interest),
+		Principal: string(r.Principal),
+		Balance:   string(r.Balance),
+	}
+}
+
+// scaleFromProto converts the scale of a gRPC request, nil when it's unset.
+func scaleFromProto(v *wrappers.UInt32Value) *int {
+	if v == nil {
+		return nil
+	}
+	return Places(int(v.GetValue()))
+}
+
+// scaleProto converts a scale to that of a gRPC request. A negative scale,
+// which is invalid, becomes one too large to be valid.
+func scaleProto(scale *int) *wrappers.UInt32Value {
+	switch {
+	case scale == nil:
+		return nil
+	case *scale < 0:
+		return &wrappers.UInt32Value{Value: MaxScale + 1}
+	}
+	return &wrappers.UInt32Value{Value: uint32(*scale)}
+}
+
+// ReceiveSchedule receives the rows of an AmortizationSchedule stream from
+// recv, e.g. the Recv method of the client stream, and calls send with each
+// of them until the server closes it. A request that fails is answered by a
+// last row holding the error, which is returned rather than sent.
+func ReceiveSchedule(recv func() (*pb.AmortizationRow, error), send func(AmortizationRow) error) (*pb.AmortizationRow, error) {
+	for {
+		row, err := recv()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if row.GetCode() != pb.ErrorCode_NO_ERROR {
+			return row, nil
+		}
+		if err := send(RowFromProto(row)); err != nil {
+			return nil, err
+		}
+	}
+}
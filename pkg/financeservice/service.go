@@ -0,0 +1,434 @@
+// Package financeservice is the core of the Finance service, the time value of
+// money.
+package financeservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+)
+
+// Service describes a service that computes the time value of money. Rates
+// are per period and payments are made at the end of each period.
+// Implementations may be wrapped by a Middleware, e.g. to log and measure
+// each call.
+//
+// Amounts and rates are Decimals, computed exactly with big.Rat and rounded
+// to the scale of the request only once the result is known. Intermediate
+// results that would grow to thousands of digits, such as a rate of many
+// digits compounded over many periods, are rounded to 4096 bits first. IRR is
+// the exception, as a rate of return generally isn't rational: it's searched
+// for with Newton's method on 512-bit floats, then rounded the same way.
+type Service interface {
+	// NPV returns the net present value of the cash flows
+	NPV(ctx context.Context, c CashFlows) (Decimal, error)
+	// IRR returns the internal rate of return of the cash flows
+	IRR(ctx context.Context, c CashFlows) (Decimal, error)
+	// PMT returns the payment per period paying off PV and leaving FV
+	PMT(ctx context.Context, tv TimeValue) (Decimal, error)
+	// FV returns the future value of PV and PMT
+	FV(ctx context.Context, tv TimeValue) (Decimal, error)
+	// PV returns the present value of FV and PMT
+	PV(ctx context.Context, tv TimeValue) (Decimal, error)
+	// CompoundInterest returns the balance of a deposit earning interest
+	CompoundInterest(ctx context.Context, c Compounding) (Decimal, error)
+	// AmortizationSchedule calls send with a row per period of the
+	// repayment of a loan, as soon as each one is computed
+	AmortizationSchedule(ctx context.Context, l Loan, send func(AmortizationRow) error) error
+}
+
+// Middleware describes a service (as opposed to endpoint) middleware.
+type Middleware func(Service) Service
+
+// Errors returned by the basic Service, which transports map to their wire
+// representation, see pb.ErrorCode. NPV and IRR of no cash flows fail with
+// mathservice.ErrNoValues, like the list operations of the Math service.
+var (
+	ErrInvalidDecimal = errors.New("invalid decimal")
+	ErrInvalidRate    = errors.New("rate must be greater than -1")
+	ErrInvalidPeriods = errors.New("invalid number of periods")
+	ErrInvalidScale   = errors.New("invalid scale")
+	ErrNoSignChange   = errors.New("cash flows must change sign to have an internal rate of return")
+	ErrNoConvergence  = errors.New("internal rate of return did not converge")
+)
+
+const (
+	// DefaultScale is the number of decimal places of the results when a
+	// request has no scale.
+	DefaultScale = 2
+	// DefaultRateScale is DefaultScale for IRR.
+	DefaultRateScale = 8
+	// MaxScale is the largest number of decimal places of the results.
+	MaxScale = 50
+	// MaxPeriods is the largest number of periods of a request, and of
+	// compoundings of CompoundInterest.
+	MaxPeriods = 10000
+	// MaxIterations is the largest number of steps IRR takes to find a rate.
+	MaxIterations = 100
+	// DefaultGuess is the rate IRR starts its search from when CashFlows
+	// has none.
+	DefaultGuess = "0.1"
+)
+
+// CashFlows are Values received, positive, or paid, negative, a period apart,
+// the first one now. NPV discounts them at Rate, IRR starts its search from
+// Rate, DefaultGuess when it's empty. The result has Scale decimal places,
+// DefaultScale, or DefaultRateScale for IRR, when it's nil.
+type CashFlows struct {
+	Rate   Decimal   `json:"rate"`
+	Values []Decimal `json:"values"`
+	Scale  *int      `json:"scale,omitempty"`
+}
+
+// TimeValue is an annuity of Periods payments of PMT at Rate, worth PV now
+// and FV after the last payment. Each method computes one of PV, FV and PMT
+// from the others, ignoring its own, with the sign convention of
+// spreadsheets: amounts paid are negative and amounts received positive. The
+// result has Scale decimal places, DefaultScale when it's nil.
+type TimeValue struct {
+	Rate    Decimal `json:"rate"`
+	Periods int64   `json:"periods"`
+	PV      Decimal `json:"pv"`
+	FV      Decimal `json:"fv"`
+	PMT     Decimal `json:"pmt"`
+	Scale   *int    `json:"scale,omitempty"`
+}
+
+// Compounding is a deposit of Principal earning Rate per period, compounded
+// Frequency times per period at Rate/Frequency, once when Frequency is 0,
+// over Periods. The result has Scale decimal places, DefaultScale when it's
+// nil.
+type Compounding struct {
+	Principal Decimal `json:"principal"`
+	Rate      Decimal `json:"rate"`
+	Periods   int64   `json:"periods"`
+	Frequency int64   `json:"frequency"`
+	Scale     *int    `json:"scale,omitempty"`
+}
+
+// Loan is a loan of Principal at Rate per period repaid in Periods equal
+// payments, rounded to Scale decimal places, DefaultScale when it's nil.
+type Loan struct {
+	Principal Decimal `json:"principal"`
+	Rate      Decimal `json:"rate"`
+	Periods   int64   `json:"periods"`
+	Scale     *int    `json:"scale,omitempty"`
+}
+
+// Places returns a pointer to n, to set the Scale of a request.
+func Places(n int) *int {
+	return &n
+}
+
+// AmortizationRow is a Period of an amortization schedule, numbered from 1:
+// the Payment made, split into the Interest accrued over the period and the
+// Principal it repays, and the Balance left to repay.
+type AmortizationRow struct {
+	Period    int64   `json:"period"`
+	Payment   Decimal `json:"payment"`
+	Interest  Decimal `json:"interest"`
+	Principal Decimal `json:"principal"`
+	Balance   Decimal `json:"balance"`
+}
+
+// NewBasicService returns a naïve, stateless implementation of Service.
+func NewBasicService() Service {
+	return basicService{}
+}
+
+type basicService struct{}
+
+// NPV discounts each value by (1+rate)^t, where t is its period, the first
+// value being period 0.
+func (basicService) NPV(ctx context.Context, c CashFlows) (Decimal, error) {
+	scale, err := checkScale(c.Scale, DefaultScale)
+	if err != nil {
+		return "", err
+	}
+	rate, err := parseRate(c.Rate)
+	if err != nil {
+		return "", err
+	}
+	values, err := parseValues(c.Values)
+	if err != nil {
+		return "", err
+	}
+	// Horner's method in 1/(1+rate), from the last value
+	x := new(big.Rat).Inv(onePlus(rate))
+	npv := new(big.Rat)
+	for i := len(values) - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		npv = bound(npv.Add(npv.Mul(npv, x), values[i]))
+	}
+	return round(npv, scale), nil
+}
+
+// IRR uses Newton's method until a step moves the rate by less than a
+// hundredth of the last decimal place of the result.
+func (basicService) IRR(ctx context.Context, c CashFlows) (Decimal, error) {
+	scale, err := checkScale(c.Scale, DefaultRateScale)
+	if err != nil {
+		return "", err
+	}
+	guess := c.Rate
+	if guess == "" {
+		guess = DefaultGuess
+	}
+	start, err := parseRate(guess)
+	if err != nil {
+		return "", err
+	}
+	values, err := parseValues(c.Values)
+	if err != nil {
+		return "", err
+	}
+	var positive, negative bool
+	for _, v := range values {
+		positive = positive || v.Sign() > 0
+		negative = negative || v.Sign() < 0
+	}
+	if !positive || !negative {
+		return "", ErrNoSignChange
+	}
+
+	const prec = 512
+	newFloat := func() *big.Float { return new(big.Float).SetPrec(prec) }
+	var (
+		rate     = newFloat().SetRat(start)
+		one      = newFloat().SetInt64(1)
+		minusOne = newFloat().SetInt64(-1)
+		tol      = newFloat().SetRat(new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale+2)), nil)))
+		fv       = make([]*big.Float, len(values))
+	)
+	for i, v := range values {
+		fv[i] = newFloat().SetRat(v)
+	}
+	for i := 0; i < MaxIterations; i++ {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		// f = Σ v_t x^t and f' = -x Σ t v_t x^t, where x = 1/(1+rate)
+		x := newFloat().Quo(one, newFloat().Add(one, rate))
+		f, d := newFloat(), newFloat()
+		for t := len(fv) - 1; t >= 0; t-- {
+			f.Mul(f, x)
+			f.Add(f, fv[t])
+			d.Mul(d, x)
+			d.Add(d, newFloat().Mul(fv[t], newFloat().SetInt64(int64(t))))
+		}
+		d.Mul(d, x)
+		d.Neg(d)
+		if d.Sign() == 0 {
+			return "", fmt.Errorf("%w: the NPV is flat at rate %s", ErrNoConvergence, rate.Text('g', 10))
+		}
+		step := newFloat().Quo(f, d)
+		rate.Sub(rate, step)
+		if rate.Cmp(minusOne) <= 0 {
+			return "", fmt.Errorf("%w: the search from %s left the rates greater than -1", ErrNoConvergence, guess)
+		}
+		if step.Abs(step).Cmp(tol) < 0 {
+			r, _ := rate.Rat(nil)
+			return round(r, scale), nil
+		}
+	}
+	return "", fmt.Errorf("%w after %d steps from %s", ErrNoConvergence, MaxIterations, guess)
+}
+
+// PMT solves pv*g + pmt*(g-1)/rate + fv = 0 for pmt, where g = (1+rate)^n.
+func (basicService) PMT(ctx context.Context, tv TimeValue) (Decimal, error) {
+	t, err := parseTimeValue(ctx, tv, 1)
+	if err != nil {
+		return "", err
+	}
+	sum := new(big.Rat).Add(new(big.Rat).Mul(t.pv, t.growth), t.fv)
+	pmt := new(big.Rat).Neg(sum)
+	pmt.Quo(pmt, t.annuity)
+	return round(pmt, t.scale), nil
+}
+
+// FV solves the equation of PMT for fv.
+func (basicService) FV(ctx context.Context, tv TimeValue) (Decimal, error) {
+	t, err := parseTimeValue(ctx, tv, 0)
+	if err != nil {
+		return "", err
+	}
+	sum := new(big.Rat).Add(new(big.Rat).Mul(t.pv, t.growth), new(big.Rat).Mul(t.pmt, t.annuity))
+	return round(sum.Neg(sum), t.scale), nil
+}
+
+// PV solves the equation of PMT for pv.
+func (basicService) PV(ctx context.Context, tv TimeValue) (Decimal, error) {
+	t, err := parseTimeValue(ctx, tv, 0)
+	if err != nil {
+		return "", err
+	}
+	sum := new(big.Rat).Add(t.fv, new(big.Rat).Mul(t.pmt, t.annuity))
+	pv := new(big.Rat).Neg(sum)
+	pv.Quo(pv, t.growth)
+	return round(pv, t.scale), nil
+}
+
+func (basicService) CompoundInterest(ctx context.Context, c Compounding) (Decimal, error) {
+	scale, err := checkScale(c.Scale, DefaultScale)
+	if err != nil {
+		return "", err
+	}
+	frequency := c.Frequency
+	if frequency == 0 {
+		frequency = 1
+	}
+	if frequency < 0 || frequency > MaxPeriods {
+		return "", fmt.Errorf("%w: the frequency must be between 1 and %d, it is %d", ErrInvalidPeriods, MaxPeriods, c.Frequency)
+	}
+	if c.Periods < 0 || c.Periods > MaxPeriods/frequency {
+		return "", fmt.Errorf("%w: %d periods compounded %d times each, there may be at most %d compoundings", ErrInvalidPeriods, c.Periods, frequency, MaxPeriods)
+	}
+	principal, err := c.Principal.parse("principal")
+	if err != nil {
+		return "", err
+	}
+	rate, err := c.Rate.parse("rate")
+	if err != nil {
+		return "", err
+	}
+	rate.Quo(rate, new(big.Rat).SetInt64(frequency))
+	if rate.Cmp(big.NewRat(-1, 1)) <= 0 {
+		return "", fmt.Errorf("%w, %s/%d is not", ErrInvalidRate, c.Rate, frequency)
+	}
+	balance, err := pow(ctx, onePlus(rate), c.Periods*frequency)
+	if err != nil {
+		return "", err
+	}
+	return round(balance.Mul(balance, principal), scale), nil
+}
+
+// AmortizationSchedule rounds the payment and the interest of each period to
+// the scale of l. The last payment settles the balance left by the rounding,
+// so it may differ slightly from the others. It stops with the error of send,
+// or of ctx once it's done.
+func (basicService) AmortizationSchedule(ctx context.Context, l Loan, send func(AmortizationRow) error) error {
+	t, err := parseTimeValue(ctx, TimeValue{Rate: l.Rate, Periods: l.Periods, PV: l.Principal, Scale: l.Scale}, 1)
+	if err != nil {
+		return err
+	}
+	// the payment is PMT with the opposite sign, so that a loan received is
+	// repaid with positive payments
+	exact := new(big.Rat).Mul(t.pv, t.growth)
+	exact.Quo(exact, t.annuity)
+	payment, _ := new(big.Rat).SetString(string(round(exact, t.scale)))
+
+	balance := new(big.Rat).Set(t.pv)
+	for period := int64(1); period <= l.Periods; period++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		interest, _ := new(big.Rat).SetString(string(round(new(big.Rat).Mul(balance, t.rate), t.scale)))
+		principal := new(big.Rat).Sub(payment, interest)
+		if period == l.Periods {
+			principal.Set(balance)
+			payment = new(big.Rat).Add(interest, principal)
+		}
+		balance.Sub(balance, principal)
+		err := send(AmortizationRow{
+			Period:    period,
+			Payment:   round(payment, t.scale),
+			Interest:  round(interest, t.scale),
+			Principal: round(principal, t.scale),
+			Balance:   round(balance, t.scale),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// timeValue is a parsed TimeValue. growth is (1+rate)^periods and annuity is
+// (growth-1)/rate, the value after the last payment of a payment of 1 per
+// period, or periods when rate is 0.
+type timeValue struct {
+	rate, pv, fv, pmt *big.Rat
+	growth, annuity   *big.Rat
+	scale             int
+}
+
+// parseTimeValue parses tv, whose periods must be at least minPeriods.
+func parseTimeValue(ctx context.Context, tv TimeValue, minPeriods int64) (timeValue, error) {
+	var t timeValue
+	var err error
+	if t.scale, err = checkScale(tv.Scale, DefaultScale); err != nil {
+		return t, err
+	}
+	if tv.Periods < minPeriods || tv.Periods > MaxPeriods {
+		return t, fmt.Errorf("%w %d, it must be between %d and %d", ErrInvalidPeriods, tv.Periods, minPeriods, MaxPeriods)
+	}
+	if t.rate, err = parseRate(tv.Rate); err != nil {
+		return t, err
+	}
+	if t.pv, err = tv.PV.parse("pv"); err != nil {
+		return t, err
+	}
+	if t.fv, err = tv.FV.parse("fv"); err != nil {
+		return t, err
+	}
+	if t.pmt, err = tv.PMT.parse("pmt"); err != nil {
+		return t, err
+	}
+	if t.growth, err = pow(ctx, onePlus(t.rate), tv.Periods); err != nil {
+		return t, err
+	}
+	if t.rate.Sign() == 0 {
+		t.annuity = new(big.Rat).SetInt64(tv.Periods)
+	} else {
+		t.annuity = new(big.Rat).Sub(t.growth, big.NewRat(1, 1))
+		t.annuity.Quo(t.annuity, t.rate)
+	}
+	return t, nil
+}
+
+// checkScale returns the scale of a request, def when it's nil.
+func checkScale(scale *int, def int) (int, error) {
+	if scale == nil {
+		return def, nil
+	}
+	if *scale < 0 || *scale > MaxScale {
+		return 0, fmt.Errorf("%w %d, it must be between 0 and %d", ErrInvalidScale, *scale, MaxScale)
+	}
+	return *scale, nil
+}
+
+func parseRate(d Decimal) (*big.Rat, error) {
+	rate, err := d.parse("rate")
+	if err != nil {
+		return nil, err
+	}
+	if rate.Cmp(big.NewRat(-1, 1)) <= 0 {
+		return nil, fmt.Errorf("%w, it is %s", ErrInvalidRate, d)
+	}
+	return rate, nil
+}
+
+// parseValues parses cash flows, of which there must be at least one.
+func parseValues(ds []Decimal) ([]*big.Rat, error) {
+	if len(ds) == 0 {
+		return nil, mathservice.ErrNoValues
+	}
+	values := make([]*big.Rat, len(ds))
+	for i, d := range ds {
+		v, err := d.parse(fmt.Sprintf("values[%d]", i))
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func onePlus(r *big.Rat) *big.Rat {
+	return new(big.Rat).Add(big.NewRat(1, 1), r)
+}
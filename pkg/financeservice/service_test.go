@@ -0,0 +1,171 @@
+package financeservice_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jwenz723/mathserver/pkg/financeservice"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+)
+
+// TestLimits checks that the requests whose exact results grow the most, many
+// digits compounded over many periods, are either computed quickly or
+// rejected.
+func TestLimits(t *testing.T) {
+	digits := financeservice.Decimal("0." + strings.Repeat("7", financeservice.MaxDigits))
+	svc := financeservice.NewBasicService()
+	for _, tc := range []struct {
+		name string
+		call func(ctx context.Context) error
+		want error
+	}{
+		{"pmt tiny rate", func(ctx context.Context) error {
+			_, err := svc.PMT(ctx, financeservice.TimeValue{Rate: "1e-400", Periods: financeservice.MaxPeriods, PV: "10000"})
+			return err
+		}, nil},
+		{"compound interest many digits", func(ctx context.Context) error {
+			_, err := svc.CompoundInterest(ctx, financeservice.Compounding{Principal: digits, Rate: digits, Periods: financeservice.MaxPeriods, Scale: financeservice.Places(financeservice.MaxScale)})
+			return err
+		}, nil},
+		{"npv many digits", func(ctx context.Context) error {
+			values := make([]financeservice.Decimal, financeservice.MaxPeriods)
+			for i := range values {
+				values[i] = digits
+			}
+			_, err := svc.NPV(ctx, financeservice.CashFlows{Rate: digits, Values: values})
+			return err
+		}, nil},
+		{"amortization schedule many digits", func(ctx context.Context) error {
+			return svc.AmortizationSchedule(ctx, financeservice.Loan{Principal: digits, Rate: "1e-400", Periods: financeservice.MaxPeriods}, func(financeservice.AmortizationRow) error { return nil })
+		}, nil},
+		{"too many digits", func(ctx context.Context) error {
+			_, err := svc.NPV(ctx, financeservice.CashFlows{Values: []financeservice.Decimal{digits + "7"}})
+			return err
+		}, financeservice.ErrInvalidDecimal},
+	} {
+		start := time.Now()
+		if err := tc.call(context.Background()); !errors.Is(err, tc.want) || (tc.want == nil && err != nil) {
+			t.Errorf("%s: got %v, want %v", tc.name, err, tc.want)
+		}
+		if d := time.Since(start); d > 10*time.Second {
+			t.Errorf("%s: took %v", tc.name, d)
+		}
+	}
+}
+
+func TestService(t *testing.T) {
+	svc := financeservice.NewBasicService()
+	flows := func(rate financeservice.Decimal, values ...financeservice.Decimal) financeservice.CashFlows {
+		return financeservice.CashFlows{Rate: rate, Values: values}
+	}
+	for _, tc := range []struct {
+		name string
+		call func(ctx context.Context) (financeservice.Decimal, error)
+		want financeservice.Decimal
+		err  error
+	}{
+		{"npv", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.NPV(ctx, flows("0.1", "-100", "60", "60"))
+		}, "4.13", nil},
+		{"npv zero rate", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.NPV(ctx, flows("0", "-100", "60", "60"))
+		}, "20.00", nil},
+		{"npv no values", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.NPV(ctx, flows("0.1"))
+		}, "", mathservice.ErrNoValues},
+		{"npv rate of -1", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.NPV(ctx, flows("-1", "-100", "60"))
+		}, "", financeservice.ErrInvalidRate},
+		{"irr", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.IRR(ctx, flows("", "-100", "60", "60"))
+		}, "0.13066239", nil},
+		{"irr no sign change", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.IRR(ctx, flows("", "100", "60"))
+		}, "", financeservice.ErrNoSignChange},
+		{"pmt", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.PMT(ctx, financeservice.TimeValue{Rate: "0.01", Periods: 12, PV: "1000"})
+		}, "-88.85", nil},
+		{"pmt zero rate", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.PMT(ctx, financeservice.TimeValue{Rate: "0", Periods: 10, PV: "1000"})
+		}, "-100.00", nil},
+		{"pmt no periods", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.PMT(ctx, financeservice.TimeValue{Rate: "0.01", PV: "1000"})
+		}, "", financeservice.ErrInvalidPeriods},
+		{"fv", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.FV(ctx, financeservice.TimeValue{Rate: "0.05", Periods: 10, PMT: "-100"})
+		}, "1257.79", nil},
+		{"pv", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.PV(ctx, financeservice.TimeValue{Rate: "0.05", Periods: 10, PMT: "-100"})
+		}, "772.17", nil},
+		{"compound interest monthly", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.CompoundInterest(ctx, financeservice.Compounding{Principal: "1000", Rate: "0.12", Periods: 1, Frequency: 12})
+		}, "1126.83", nil},
+		{"invalid decimal", func(ctx context.Context) (financeservice.Decimal, error) {
+			return svc.CompoundInterest(ctx, financeservice.Compounding{Principal: "1e", Rate: "0.12", Periods: 1})
+		}, "", financeservice.ErrInvalidDecimal},
+	} {
+		v, err := tc.call(context.Background())
+		if v != tc.want || !errors.Is(err, tc.err) || (tc.err == nil && err != nil) {
+			t.Errorf("%s: got %q, %v, want %q, %v", tc.name, v, err, tc.want, tc.err)
+		}
+	}
+}
+
+func TestScale(t *testing.T) {
+	svc := financeservice.NewBasicService()
+	for _, tc := range []struct {
+		scale *int
+		want  financeservice.Decimal
+		err   error
+	}{
+		{nil, "1628.89", nil},
+		{financeservice.Places(0), "1629", nil},
+		{financeservice.Places(4), "1628.8946", nil},
+		{financeservice.Places(-1), "", financeservice.ErrInvalidScale},
+		{financeservice.Places(financeservice.MaxScale + 1), "", financeservice.ErrInvalidScale},
+	} {
+		v, err := svc.CompoundInterest(context.Background(), financeservice.Compounding{Principal: "1000", Rate: "0.05", Periods: 10, Scale: tc.scale})
+		if v != tc.want || !errors.Is(err, tc.err) || (tc.err == nil && err != nil) {
+			t.Errorf("scale %v: got %q, %v, want %q, %v", tc.scale, v, err, tc.want, tc.err)
+		}
+	}
+}
+
+// TestAmortizationScheduleStreams checks that the rows are sent as they're
+// computed, so that a failed send stops the schedule.
+func TestAmortizationScheduleStreams(t *testing.T) {
+	errStop := errors.New("stop")
+	var rows []financeservice.AmortizationRow
+	err := financeservice.NewBasicService().AmortizationSchedule(context.Background(), financeservice.Loan{Principal: "1000", Rate: "0.01", Periods: 12}, func(row financeservice.AmortizationRow) error {
+		rows = append(rows, row)
+		if len(rows) == 3 {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop || len(rows) != 3 {
+		t.Errorf("got %d rows, %v, want 3 rows, %v", len(rows), err, errStop)
+	}
+}
+
+func TestCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	svc := financeservice.NewBasicService()
+	if _, err := svc.NPV(ctx, financeservice.CashFlows{Rate: "0.1", Values: []financeservice.Decimal{"-1", "2"}}); !errors.Is(err, context.Canceled) {
+		t.Errorf("NPV: got %v, want %v", err, context.Canceled)
+	}
+	if _, err := svc.IRR(ctx, financeservice.CashFlows{Values: []financeservice.Decimal{"-1", "2"}}); !errors.Is(err, context.Canceled) {
+		t.Errorf("IRR: got %v, want %v", err, context.Canceled)
+	}
+	if _, err := svc.FV(ctx, financeservice.TimeValue{Rate: "0.1", Periods: 10, PV: "-1"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("FV: got %v, want %v", err, context.Canceled)
+	}
+	err := svc.AmortizationSchedule(ctx, financeservice.Loan{Principal: "1000", Periods: 12}, func(financeservice.AmortizationRow) error { return nil })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("AmortizationSchedule: got %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,105 @@
+package mathendpoint
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jwenz723/mathserver/pkg/compute"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+)
+
+// Batch performs the items of a batch. Set doesn't implement it as part of
+// the service interface, it's provided so Set may be used to call the Batch
+// endpoint from a client library.
+func (s Set) Batch(ctx context.Context, items []BatchItem, concurrency int) ([]BatchResult, error) {
+	resp, err := s.BatchEndpoint(ctx, BatchRequest{Items: items, Concurrency: concurrency})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(BatchResponse).Results, nil
+}
+
+// MakeBatchEndpoint constructs a Batch endpoint that performs each item by
+// calling the corresponding endpoint of s, so that items go through the same
+// middlewares as individual requests. Items are performed by compute.Batch,
+// so the endpoint fails with mathservice.ErrTooManyItems for a batch of more
+// than compute.MaxBatchItems items, and the items left once ctx is done fail
+// with its error.
+func MakeBatchEndpoint(s Set) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(BatchRequest)
+		results := make([]BatchResult, len(req.Items))
+		err = compute.Batch(ctx, len(req.Items), req.Concurrency,
+			func(i int) {
+				results[i] = s.perform(ctx, req.Items[i])
+			},
+			func(i int, err error) {
+				results[i] = BatchResult{ID: req.Items[i].ID, MathOpResponse: MathOpResponse{Err: err}}
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		return BatchResponse{Results: results}, nil
+	}
+}
+
+// perform performs item using the endpoint of its operation.
+func (s Set) perform(ctx context.Context, item BatchItem) BatchResult {
+	e, request := s.operation(item)
+	if e == nil {
+		return BatchResult{ID: item.ID, MathOpResponse: MathOpResponse{Err: mathservice2.ErrUnknownOperation}}
+	}
+	resp, err := e(ctx, request)
+	if err != nil {
+		return BatchResult{ID: item.ID, MathOpResponse: MathOpResponse{Err: err}}
+	}
+	return BatchResult{ID: item.ID, MathOpResponse: resp.(MathOpResponse)}
+}
+
+// operation returns the endpoint performing item along with its request, or
+// a nil endpoint if the operation doesn't exist.
+func (s Set) operation(item BatchItem) (endpoint.Endpoint, interface{}) {
+	if strings.ToLower(item.Op) == "evaluate" {
+		return s.EvaluateEndpoint, EvaluateRequest{Expression: item.Expression, Precision: item.Precision}
+	}
+	return s.Operations.operation(item)
+}
+
+// BatchItem is a single operation of a BatchRequest. Op names the operation
+// the way the HTTP paths do, e.g. "divide" or "sumall", and ID is returned in
+// its result so that clients can correlate the two. A is also the operand of
+// the unary operations.
+type BatchItem struct {
+	ID         uint64                `json:"id"`
+	Op         string                `json:"op"`
+	A          float64               `json:"a"`
+	B          float64               `json:"b"`
+	Values     []float64             `json:"values,omitempty"`
+	Expression string                `json:"expression,omitempty"`
+	Precision  precision.Precision   `json:"precision"`
+	Division   mathservice2.Division `json:"division,omitempty"`
+}
+
+// BatchRequest collects the request parameters for the Batch method.
+// Concurrency is the number of items performed at once, 0 and 1 perform them
+// one after the other.
+type BatchRequest struct {
+	Items       []BatchItem `json:"items"`
+	Concurrency int         `json:"concurrency,omitempty"`
+}
+
+// BatchResult is the outcome of a BatchItem. Its Err is the error of that
+// item alone.
+type BatchResult struct {
+	ID uint64
+	MathOpResponse
+}
+
+// BatchResponse collects the response values for the Batch method, with a
+// result for each item in the same order as the request.
+type BatchResponse struct {
+	Results []BatchResult
+}
@@ -0,0 +1,83 @@
+package mathendpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jwenz723/mathserver/pkg/calculusservice"
+)
+
+// CalculusSet collects the endpoints of the Calculus service, see Set. The
+// requests are calculusservice.Problems.
+type CalculusSet struct {
+	IntegrateEndpoint     endpoint.Endpoint
+	DifferentiateEndpoint endpoint.Endpoint
+	FindRootEndpoint      endpoint.Endpoint
+	MinimizeEndpoint      endpoint.Endpoint
+}
+
+// NewCalculus returns a CalculusSet that wraps the provided service.
+func NewCalculus(svc calculusservice.Service) CalculusSet {
+	return CalculusSet{
+		IntegrateEndpoint:     makeCalculusEndpoint(svc.Integrate),
+		DifferentiateEndpoint: makeCalculusEndpoint(svc.Differentiate),
+		FindRootEndpoint:      makeCalculusEndpoint(svc.FindRoot),
+		MinimizeEndpoint:      makeCalculusEndpoint(svc.Minimize),
+	}
+}
+
+// compile time assertions for CalculusSet implementing the service interface.
+var (
+	_ calculusservice.Service = CalculusSet{}
+)
+
+// Integrate implements the service interface, so CalculusSet may be used as
+// a service. This is primarily useful in the context of a client library.
+func (s CalculusSet) Integrate(ctx context.Context, p calculusservice.Problem) (calculusservice.Result, error) {
+	return calculusResult(s.IntegrateEndpoint(ctx, p))
+}
+
+// Differentiate implements the service interface.
+func (s CalculusSet) Differentiate(ctx context.Context, p calculusservice.Problem) (calculusservice.Result, error) {
+	return calculusResult(s.DifferentiateEndpoint(ctx, p))
+}
+
+// FindRoot implements the service interface.
+func (s CalculusSet) FindRoot(ctx context.Context, p calculusservice.Problem) (calculusservice.Result, error) {
+	return calculusResult(s.FindRootEndpoint(ctx, p))
+}
+
+// Minimize implements the service interface.
+func (s CalculusSet) Minimize(ctx context.Context, p calculusservice.Problem) (calculusservice.Result, error) {
+	return calculusResult(s.MinimizeEndpoint(ctx, p))
+}
+
+func makeCalculusEndpoint(op func(ctx context.Context, p calculusservice.Problem) (calculusservice.Result, error)) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		r, err := op(ctx, request.(calculusservice.Problem))
+		return CalculusResponse{Result: r, Err: err}, nil
+	}
+}
+
+func calculusResult(response interface{}, err error) (calculusservice.Result, error) {
+	if err != nil {
+		return calculusservice.Result{}, err
+	}
+	resp := response.(CalculusResponse)
+	return resp.Result, resp.Err
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = CalculusResponse{}
+)
+
+// CalculusResponse collects the response values for the methods of the
+// Calculus service.
+type CalculusResponse struct {
+	Result calculusservice.Result
+	Err    error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r CalculusResponse) Failed() error { return r.Err }
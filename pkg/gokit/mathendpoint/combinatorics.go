@@ -0,0 +1,79 @@
+package mathendpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+)
+
+// CombinatoricsSet collects the endpoints of the Combinatorics service, see
+// Set. The requests are combinatoricsservice.Operands.
+type CombinatoricsSet struct {
+	FactorialEndpoint    endpoint.Endpoint
+	BinomialEndpoint     endpoint.Endpoint
+	PermutationsEndpoint endpoint.Endpoint
+}
+
+// NewCombinatorics returns a CombinatoricsSet that wraps the provided
+// service.
+func NewCombinatorics(svc combinatoricsservice.Service) CombinatoricsSet {
+	return CombinatoricsSet{
+		FactorialEndpoint:    makeCombinatoricsEndpoint(svc.Factorial),
+		BinomialEndpoint:     makeCombinatoricsEndpoint(svc.Binomial),
+		PermutationsEndpoint: makeCombinatoricsEndpoint(svc.Permutations),
+	}
+}
+
+// compile time assertions for CombinatoricsSet implementing the service
+// interface.
+var (
+	_ combinatoricsservice.Service = CombinatoricsSet{}
+)
+
+// Factorial implements the service interface, so CombinatoricsSet may be
+// used as a service. This is primarily useful in the context of a client
+// library.
+func (s CombinatoricsSet) Factorial(ctx context.Context, o combinatoricsservice.Operands) (string, error) {
+	return combinatoricsResult(s.FactorialEndpoint(ctx, o))
+}
+
+// Binomial implements the service interface.
+func (s CombinatoricsSet) Binomial(ctx context.Context, o combinatoricsservice.Operands) (string, error) {
+	return combinatoricsResult(s.BinomialEndpoint(ctx, o))
+}
+
+// Permutations implements the service interface.
+func (s CombinatoricsSet) Permutations(ctx context.Context, o combinatoricsservice.Operands) (string, error) {
+	return combinatoricsResult(s.PermutationsEndpoint(ctx, o))
+}
+
+func makeCombinatoricsEndpoint(op func(ctx context.Context, o combinatoricsservice.Operands) (string, error)) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		v, err := op(ctx, request.(combinatoricsservice.Operands))
+		return CombinatoricsResponse{V: v, Err: err}, nil
+	}
+}
+
+func combinatoricsResult(response interface{}, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	resp := response.(CombinatoricsResponse)
+	return resp.V, resp.Err
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = CombinatoricsResponse{}
+)
+
+// CombinatoricsResponse collects the response values for the methods of the
+// Combinatorics service, V is the result written in decimal.
+type CombinatoricsResponse struct {
+	V   string
+	Err error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r CombinatoricsResponse) Failed() error { return r.Err }
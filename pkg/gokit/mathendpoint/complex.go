@@ -0,0 +1,155 @@
+package mathendpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jwenz723/mathserver/pkg/complexservice"
+)
+
+// ComplexSet collects the endpoints of the Complex service, see Set.
+type ComplexSet struct {
+	SumEndpoint      endpoint.Endpoint
+	SubtractEndpoint endpoint.Endpoint
+	MultiplyEndpoint endpoint.Endpoint
+	DivideEndpoint   endpoint.Endpoint
+	PowEndpoint      endpoint.Endpoint
+	AbsEndpoint      endpoint.Endpoint
+	PhaseEndpoint    endpoint.Endpoint
+	ConjEndpoint     endpoint.Endpoint
+	SqrtEndpoint     endpoint.Endpoint
+}
+
+// NewComplex returns a ComplexSet that wraps the provided service.
+func NewComplex(svc complexservice.Service) ComplexSet {
+	return ComplexSet{
+		SumEndpoint:      MakeComplexOpEndpoint(svc.Sum),
+		SubtractEndpoint: MakeComplexOpEndpoint(svc.Subtract),
+		MultiplyEndpoint: MakeComplexOpEndpoint(svc.Multiply),
+		DivideEndpoint:   MakeComplexOpEndpoint(svc.Divide),
+		PowEndpoint:      MakeComplexOpEndpoint(svc.Pow),
+		AbsEndpoint:      MakeComplexRealEndpoint(svc.Abs),
+		PhaseEndpoint:    MakeComplexRealEndpoint(svc.Phase),
+		ConjEndpoint:     MakeComplexUnaryEndpoint(svc.Conj),
+		SqrtEndpoint:     MakeComplexUnaryEndpoint(svc.Sqrt),
+	}
+}
+
+// compile time assertions for ComplexSet implementing the service interface.
+var (
+	_ complexservice.Service = ComplexSet{}
+)
+
+// Sum implements the service interface, so ComplexSet may be used as a
+// service. This is primarily useful in the context of a client library.
+func (s ComplexSet) Sum(ctx context.Context, a, b complex128) (complex128, error) {
+	return complexResult(s.SumEndpoint(ctx, ComplexOpRequest{A: a, B: b}))
+}
+
+// Subtract implements the service interface.
+func (s ComplexSet) Subtract(ctx context.Context, a, b complex128) (complex128, error) {
+	return complexResult(s.SubtractEndpoint(ctx, ComplexOpRequest{A: a, B: b}))
+}
+
+// Multiply implements the service interface.
+func (s ComplexSet) Multiply(ctx context.Context, a, b complex128) (complex128, error) {
+	return complexResult(s.MultiplyEndpoint(ctx, ComplexOpRequest{A: a, B: b}))
+}
+
+// Divide implements the service interface.
+func (s ComplexSet) Divide(ctx context.Context, a, b complex128) (complex128, error) {
+	return complexResult(s.DivideEndpoint(ctx, ComplexOpRequest{A: a, B: b}))
+}
+
+// Pow implements the service interface.
+func (s ComplexSet) Pow(ctx context.Context, a, b complex128) (complex128, error) {
+	return complexResult(s.PowEndpoint(ctx, ComplexOpRequest{A: a, B: b}))
+}
+
+// Abs implements the service interface.
+func (s ComplexSet) Abs(ctx context.Context, a complex128) (float64, error) {
+	return realResult(s.AbsEndpoint(ctx, ComplexOpRequest{A: a}))
+}
+
+// Phase implements the service interface.
+func (s ComplexSet) Phase(ctx context.Context, a complex128) (float64, error) {
+	return realResult(s.PhaseEndpoint(ctx, ComplexOpRequest{A: a}))
+}
+
+// Conj implements the service interface.
+func (s ComplexSet) Conj(ctx context.Context, a complex128) (complex128, error) {
+	return complexResult(s.ConjEndpoint(ctx, ComplexOpRequest{A: a}))
+}
+
+// Sqrt implements the service interface.
+func (s ComplexSet) Sqrt(ctx context.Context, a complex128) (complex128, error) {
+	return complexResult(s.SqrtEndpoint(ctx, ComplexOpRequest{A: a}))
+}
+
+// MakeComplexOpEndpoint constructs an endpoint calling op, a method of the
+// service taking a pair of complex operands.
+func MakeComplexOpEndpoint(op func(ctx context.Context, a, b complex128) (complex128, error)) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ComplexOpRequest)
+		v, err := op(ctx, req.A, req.B)
+		return ComplexOpResponse{V: v, Err: err}, nil
+	}
+}
+
+// MakeComplexUnaryEndpoint constructs an endpoint calling op, a method of the
+// service taking a single complex operand, A.
+func MakeComplexUnaryEndpoint(op func(ctx context.Context, a complex128) (complex128, error)) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ComplexOpRequest)
+		v, err := op(ctx, req.A)
+		return ComplexOpResponse{V: v, Err: err}, nil
+	}
+}
+
+// MakeComplexRealEndpoint constructs an endpoint calling op, a method of the
+// service taking a single complex operand, A, and returning a real number.
+func MakeComplexRealEndpoint(op func(ctx context.Context, a complex128) (float64, error)) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(ComplexOpRequest)
+		v, err := op(ctx, req.A)
+		return MathOpResponse{V: v, Err: err}, nil
+	}
+}
+
+func complexResult(response interface{}, err error) (complex128, error) {
+	if err != nil {
+		return 0, err
+	}
+	resp := response.(ComplexOpResponse)
+	return resp.V, resp.Err
+}
+
+func realResult(response interface{}, err error) (float64, error) {
+	if err != nil {
+		return 0, err
+	}
+	resp := response.(MathOpResponse)
+	return resp.V, resp.Err
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = ComplexOpResponse{}
+)
+
+// ComplexOpRequest collects the request parameters for the methods of the
+// Complex service. B is only used by the methods taking a pair of operands.
+type ComplexOpRequest struct {
+	A, B complex128
+}
+
+// ComplexOpResponse collects the response values for the methods of the
+// Complex service returning a complex number. Abs and Phase return a
+// MathOpResponse.
+type ComplexOpResponse struct {
+	V   complex128
+	Err error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r ComplexOpResponse) Failed() error { return r.Err }
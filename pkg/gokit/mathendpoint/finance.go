@@ -0,0 +1,160 @@
+package mathendpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jwenz723/mathserver/pkg/financeservice"
+)
+
+// FinanceSet collects the endpoints of the Finance service, see Set. The
+// requests are the financeservice types the methods take, e.g. a
+// financeservice.CashFlows for NPV, but for AmortizationSchedule's
+// AmortizationRequest.
+type FinanceSet struct {
+	NPVEndpoint                  endpoint.Endpoint
+	IRREndpoint                  endpoint.Endpoint
+	PMTEndpoint                  endpoint.Endpoint
+	FVEndpoint                   endpoint.Endpoint
+	PVEndpoint                   endpoint.Endpoint
+	CompoundInterestEndpoint     endpoint.Endpoint
+	AmortizationScheduleEndpoint endpoint.Endpoint
+}
+
+// NewFinance returns a FinanceSet that wraps the provided service.
+func NewFinance(svc financeservice.Service) FinanceSet {
+	return FinanceSet{
+		NPVEndpoint: makeCashFlowEndpoint(svc.NPV),
+		IRREndpoint: makeCashFlowEndpoint(svc.IRR),
+		PMTEndpoint: makeTimeValueEndpoint(svc.PMT),
+		FVEndpoint:  makeTimeValueEndpoint(svc.FV),
+		PVEndpoint:  makeTimeValueEndpoint(svc.PV),
+		CompoundInterestEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			v, err := svc.CompoundInterest(ctx, request.(financeservice.Compounding))
+			return DecimalResponse{V: v, Err: err}, nil
+		},
+		AmortizationScheduleEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(AmortizationRequest)
+			var rows []financeservice.AmortizationRow
+			send := req.Send
+			if send == nil {
+				send = func(row financeservice.AmortizationRow) error {
+					rows = append(rows, row)
+					return nil
+				}
+			}
+			err := svc.AmortizationSchedule(ctx, req.Loan, send)
+			return AmortizationResponse{Rows: rows, Err: err}, nil
+		},
+	}
+}
+
+// compile time assertions for FinanceSet implementing the service interface.
+var (
+	_ financeservice.Service = FinanceSet{}
+)
+
+// NPV implements the service interface, so FinanceSet may be used as a
+// service. This is primarily useful in the context of a client library.
+func (s FinanceSet) NPV(ctx context.Context, c financeservice.CashFlows) (financeservice.Decimal, error) {
+	return decimalResult(s.NPVEndpoint(ctx, c))
+}
+
+// IRR implements the service interface.
+func (s FinanceSet) IRR(ctx context.Context, c financeservice.CashFlows) (financeservice.Decimal, error) {
+	return decimalResult(s.IRREndpoint(ctx, c))
+}
+
+// PMT implements the service interface.
+func (s FinanceSet) PMT(ctx context.Context, tv financeservice.TimeValue) (financeservice.Decimal, error) {
+	return decimalResult(s.PMTEndpoint(ctx, tv))
+}
+
+// FV implements the service interface.
+func (s FinanceSet) FV(ctx context.Context, tv financeservice.TimeValue) (financeservice.Decimal, error) {
+	return decimalResult(s.FVEndpoint(ctx, tv))
+}
+
+// PV implements the service interface.
+func (s FinanceSet) PV(ctx context.Context, tv financeservice.TimeValue) (financeservice.Decimal, error) {
+	return decimalResult(s.PVEndpoint(ctx, tv))
+}
+
+// CompoundInterest implements the service interface.
+func (s FinanceSet) CompoundInterest(ctx context.Context, c financeservice.Compounding) (financeservice.Decimal, error) {
+	return decimalResult(s.CompoundInterestEndpoint(ctx, c))
+}
+
+// AmortizationSchedule implements the service interface. The rows returned
+// at once by a transport that can't stream them, such as HTTP, are sent once
+// the response is received.
+func (s FinanceSet) AmortizationSchedule(ctx context.Context, l financeservice.Loan, send func(financeservice.AmortizationRow) error) error {
+	response, err := s.AmortizationScheduleEndpoint(ctx, AmortizationRequest{Loan: l, Send: send})
+	if err != nil {
+		return err
+	}
+	resp := response.(AmortizationResponse)
+	for _, row := range resp.Rows {
+		if err := send(row); err != nil {
+			return err
+		}
+	}
+	return resp.Err
+}
+
+func makeCashFlowEndpoint(op func(ctx context.Context, c financeservice.CashFlows) (financeservice.Decimal, error)) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		v, err := op(ctx, request.(financeservice.CashFlows))
+		return DecimalResponse{V: v, Err: err}, nil
+	}
+}
+
+func makeTimeValueEndpoint(op func(ctx context.Context, tv financeservice.TimeValue) (financeservice.Decimal, error)) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		v, err := op(ctx, request.(financeservice.TimeValue))
+		return DecimalResponse{V: v, Err: err}, nil
+	}
+}
+
+func decimalResult(response interface{}, err error) (financeservice.Decimal, error) {
+	if err != nil {
+		return "", err
+	}
+	resp := response.(DecimalResponse)
+	return resp.V, resp.Err
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = DecimalResponse{}
+	_ endpoint.Failer = AmortizationResponse{}
+)
+
+// DecimalResponse collects the response values for the methods of the
+// Finance service returning a decimal.
+type DecimalResponse struct {
+	V   financeservice.Decimal
+	Err error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r DecimalResponse) Failed() error { return r.Err }
+
+// AmortizationRequest collects the request parameters for the
+// AmortizationSchedule method. Send is called with each row as soon as it's
+// computed, when it's nil the rows are collected in the response instead.
+type AmortizationRequest struct {
+	financeservice.Loan
+	Send func(financeservice.AmortizationRow) error `json:"-"`
+}
+
+// AmortizationResponse collects the response values for the
+// AmortizationSchedule method, the Rows that weren't sent as they were
+// computed.
+type AmortizationResponse struct {
+	Rows []financeservice.AmortizationRow
+	Err  error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r AmortizationResponse) Failed() error { return r.Err }
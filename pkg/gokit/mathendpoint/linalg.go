@@ -0,0 +1,216 @@
+package mathendpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jwenz723/mathserver/pkg/linalgservice"
+)
+
+// LinearAlgebraSet collects the endpoints of the LinearAlgebra service, see
+// Set.
+type LinearAlgebraSet struct {
+	DotEndpoint         endpoint.Endpoint
+	CrossEndpoint       endpoint.Endpoint
+	NormEndpoint        endpoint.Endpoint
+	AddEndpoint         endpoint.Endpoint
+	MultiplyEndpoint    endpoint.Endpoint
+	TransposeEndpoint   endpoint.Endpoint
+	DeterminantEndpoint endpoint.Endpoint
+	InverseEndpoint     endpoint.Endpoint
+	SolveEndpoint       endpoint.Endpoint
+}
+
+// NewLinearAlgebra returns a LinearAlgebraSet that wraps the provided
+// service.
+func NewLinearAlgebra(svc linalgservice.Service) LinearAlgebraSet {
+	return LinearAlgebraSet{
+		DotEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(VectorOpRequest)
+			v, err := svc.Dot(ctx, req.A, req.B)
+			return MathOpResponse{V: v, Err: err}, nil
+		},
+		CrossEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(VectorOpRequest)
+			v, err := svc.Cross(ctx, req.A, req.B)
+			return VectorResponse{V: v, Err: err}, nil
+		},
+		NormEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(VectorOpRequest)
+			v, err := svc.Norm(ctx, req.A)
+			return MathOpResponse{V: v, Err: err}, nil
+		},
+		AddEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(MatrixOpRequest)
+			v, err := svc.Add(ctx, req.A, req.B)
+			return MatrixResponse{V: v, Err: err}, nil
+		},
+		MultiplyEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(MatrixOpRequest)
+			v, err := svc.Multiply(ctx, req.A, req.B)
+			return MatrixResponse{V: v, Err: err}, nil
+		},
+		TransposeEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(MatrixOpRequest)
+			v, err := svc.Transpose(ctx, req.A)
+			return MatrixResponse{V: v, Err: err}, nil
+		},
+		DeterminantEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(MatrixOpRequest)
+			v, err := svc.Determinant(ctx, req.A)
+			return MathOpResponse{V: v, Err: err}, nil
+		},
+		InverseEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(MatrixOpRequest)
+			v, err := svc.Inverse(ctx, req.A)
+			return MatrixResponse{V: v, Err: err}, nil
+		},
+		SolveEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(SolveRequest)
+			v, err := svc.Solve(ctx, req.A, req.B)
+			return VectorResponse{V: v, Err: err}, nil
+		},
+	}
+}
+
+// compile time assertions for LinearAlgebraSet implementing the service
+// interface.
+var (
+	_ linalgservice.Service = LinearAlgebraSet{}
+)
+
+// Dot implements the service interface, so LinearAlgebraSet may be used
+// as a service. This is primarily useful in the context of a client library.
+func (s LinearAlgebraSet) Dot(ctx context.Context, a, b []float64) (float64, error) {
+	resp, err := s.DotEndpoint(ctx, VectorOpRequest{A: a, B: b})
+	if err != nil {
+		return 0, err
+	}
+	r := resp.(MathOpResponse)
+	return r.V, r.Err
+}
+
+// Cross implements the service interface.
+func (s LinearAlgebraSet) Cross(ctx context.Context, a, b []float64) ([]float64, error) {
+	resp, err := s.CrossEndpoint(ctx, VectorOpRequest{A: a, B: b})
+	if err != nil {
+		return nil, err
+	}
+	r := resp.(VectorResponse)
+	return r.V, r.Err
+}
+
+// Norm implements the service interface.
+func (s LinearAlgebraSet) Norm(ctx context.Context, a []float64) (float64, error) {
+	resp, err := s.NormEndpoint(ctx, VectorOpRequest{A: a})
+	if err != nil {
+		return 0, err
+	}
+	r := resp.(MathOpResponse)
+	return r.V, r.Err
+}
+
+// Add implements the service interface.
+func (s LinearAlgebraSet) Add(ctx context.Context, a, b linalgservice.Matrix) (linalgservice.Matrix, error) {
+	resp, err := s.AddEndpoint(ctx, MatrixOpRequest{A: a, B: b})
+	if err != nil {
+		return linalgservice.Matrix{}, err
+	}
+	r := resp.(MatrixResponse)
+	return r.V, r.Err
+}
+
+// Multiply implements the service interface.
+func (s LinearAlgebraSet) Multiply(ctx context.Context, a, b linalgservice.Matrix) (linalgservice.Matrix, error) {
+	resp, err := s.MultiplyEndpoint(ctx, MatrixOpRequest{A: a, B: b})
+	if err != nil {
+		return linalgservice.Matrix{}, err
+	}
+	r := resp.(MatrixResponse)
+	return r.V, r.Err
+}
+
+// Transpose implements the service interface.
+func (s LinearAlgebraSet) Transpose(ctx context.Context, a linalgservice.Matrix) (linalgservice.Matrix, error) {
+	resp, err := s.TransposeEndpoint(ctx, MatrixOpRequest{A: a})
+	if err != nil {
+		return linalgservice.Matrix{}, err
+	}
+	r := resp.(MatrixResponse)
+	return r.V, r.Err
+}
+
+// Determinant implements the service interface.
+func (s LinearAlgebraSet) Determinant(ctx context.Context, a linalgservice.Matrix) (float64, error) {
+	resp, err := s.DeterminantEndpoint(ctx, MatrixOpRequest{A: a})
+	if err != nil {
+		return 0, err
+	}
+	r := resp.(MathOpResponse)
+	return r.V, r.Err
+}
+
+// Inverse implements the service interface.
+func (s LinearAlgebraSet) Inverse(ctx context.Context, a linalgservice.Matrix) (linalgservice.Matrix, error) {
+	resp, err := s.InverseEndpoint(ctx, MatrixOpRequest{A: a})
+	if err != nil {
+		return linalgservice.Matrix{}, err
+	}
+	r := resp.(MatrixResponse)
+	return r.V, r.Err
+}
+
+// Solve implements the service interface.
+func (s LinearAlgebraSet) Solve(ctx context.Context, a linalgservice.Matrix, b []float64) ([]float64, error) {
+	resp, err := s.SolveEndpoint(ctx, SolveRequest{A: a, B: b})
+	if err != nil {
+		return nil, err
+	}
+	r := resp.(VectorResponse)
+	return r.V, r.Err
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = VectorResponse{}
+	_ endpoint.Failer = MatrixResponse{}
+)
+
+// VectorOpRequest collects the request parameters for the vector methods of
+// the LinearAlgebra service. Norm only uses A.
+type VectorOpRequest struct {
+	A, B []float64
+}
+
+// MatrixOpRequest collects the request parameters for the matrix methods of
+// the LinearAlgebra service. Transpose, Determinant and Inverse only use A.
+type MatrixOpRequest struct {
+	A, B linalgservice.Matrix
+}
+
+// SolveRequest collects the request parameters for the Solve method.
+type SolveRequest struct {
+	A linalgservice.Matrix
+	B []float64
+}
+
+// VectorResponse collects the response values for the methods of the
+// LinearAlgebra service returning a vector. The methods returning a number
+// respond with a MathOpResponse.
+type VectorResponse struct {
+	V   []float64
+	Err error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r VectorResponse) Failed() error { return r.Err }
+
+// MatrixResponse collects the response values for the methods of the
+// LinearAlgebra service returning a matrix.
+type MatrixResponse struct {
+	V   linalgservice.Matrix
+	Err error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r MatrixResponse) Failed() error { return r.Err }
@@ -19,4 +19,4 @@ func InstrumentingMiddleware(duration metrics.Histogram) endpoint.Middleware {
 			return next(ctx, request)
 		}
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,141 @@
+package mathendpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jwenz723/mathserver/pkg/numtheoryservice"
+)
+
+// NumberTheorySet collects the endpoints of the NumberTheory service, see
+// Set. The requests are the numtheoryservice types the methods take, e.g. a
+// numtheoryservice.Operand for IsPrime.
+type NumberTheorySet struct {
+	IsPrimeEndpoint    endpoint.Endpoint
+	FactorizeEndpoint  endpoint.Endpoint
+	ModPowEndpoint     endpoint.Endpoint
+	ModInverseEndpoint endpoint.Endpoint
+	EulerPhiEndpoint   endpoint.Endpoint
+	NextPrimeEndpoint  endpoint.Endpoint
+}
+
+// NewNumberTheory returns a NumberTheorySet that wraps the provided service.
+func NewNumberTheory(svc numtheoryservice.Service) NumberTheorySet {
+	return NumberTheorySet{
+		IsPrimeEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			p, err := svc.IsPrime(ctx, request.(numtheoryservice.Operand))
+			return PrimalityResponse{V: p, Err: err}, nil
+		},
+		FactorizeEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			factors, err := svc.Factorize(ctx, request.(numtheoryservice.Operand))
+			return FactorsResponse{Factors: factors, Err: err}, nil
+		},
+		ModPowEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			v, err := svc.ModPow(ctx, request.(numtheoryservice.ModPowOperands))
+			return IntegerResponse{V: v, Err: err}, nil
+		},
+		ModInverseEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			v, err := svc.ModInverse(ctx, request.(numtheoryservice.ModInverseOperands))
+			return IntegerResponse{V: v, Err: err}, nil
+		},
+		EulerPhiEndpoint:  makeOperandEndpoint(svc.EulerPhi),
+		NextPrimeEndpoint: makeOperandEndpoint(svc.NextPrime),
+	}
+}
+
+// compile time assertions for NumberTheorySet implementing the service
+// interface.
+var (
+	_ numtheoryservice.Service = NumberTheorySet{}
+)
+
+// IsPrime implements the service interface, so NumberTheorySet may be used
+// as a service. This is primarily useful in the context of a client library.
+func (s NumberTheorySet) IsPrime(ctx context.Context, o numtheoryservice.Operand) (numtheoryservice.Primality, error) {
+	response, err := s.IsPrimeEndpoint(ctx, o)
+	if err != nil {
+		return numtheoryservice.Primality{}, err
+	}
+	resp := response.(PrimalityResponse)
+	return resp.V, resp.Err
+}
+
+// Factorize implements the service interface.
+func (s NumberTheorySet) Factorize(ctx context.Context, o numtheoryservice.Operand) ([]numtheoryservice.Factor, error) {
+	response, err := s.FactorizeEndpoint(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(FactorsResponse)
+	return resp.Factors, resp.Err
+}
+
+// ModPow implements the service interface.
+func (s NumberTheorySet) ModPow(ctx context.Context, o numtheoryservice.ModPowOperands) (numtheoryservice.Integer, error) {
+	return integerResult(s.ModPowEndpoint(ctx, o))
+}
+
+// ModInverse implements the service interface.
+func (s NumberTheorySet) ModInverse(ctx context.Context, o numtheoryservice.ModInverseOperands) (numtheoryservice.Integer, error) {
+	return integerResult(s.ModInverseEndpoint(ctx, o))
+}
+
+// EulerPhi implements the service interface.
+func (s NumberTheorySet) EulerPhi(ctx context.Context, o numtheoryservice.Operand) (numtheoryservice.Integer, error) {
+	return integerResult(s.EulerPhiEndpoint(ctx, o))
+}
+
+// NextPrime implements the service interface.
+func (s NumberTheorySet) NextPrime(ctx context.Context, o numtheoryservice.Operand) (numtheoryservice.Integer, error) {
+	return integerResult(s.NextPrimeEndpoint(ctx, o))
+}
+
+func makeOperandEndpoint(op func(ctx context.Context, o numtheoryservice.Operand) (numtheoryservice.Integer, error)) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		v, err := op(ctx, request.(numtheoryservice.Operand))
+		return IntegerResponse{V: v, Err: err}, nil
+	}
+}
+
+func integerResult(response interface{}, err error) (numtheoryservice.Integer, error) {
+	if err != nil {
+		return "", err
+	}
+	resp := response.(IntegerResponse)
+	return resp.V, resp.Err
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = IntegerResponse{}
+	_ endpoint.Failer = PrimalityResponse{}
+	_ endpoint.Failer = FactorsResponse{}
+)
+
+// IntegerResponse collects the response values for the methods of the
+// NumberTheory service returning an integer.
+type IntegerResponse struct {
+	V   numtheoryservice.Integer
+	Err error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r IntegerResponse) Failed() error { return r.Err }
+
+// PrimalityResponse collects the response values for the IsPrime method.
+type PrimalityResponse struct {
+	V   numtheoryservice.Primality
+	Err error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r PrimalityResponse) Failed() error { return r.Err }
+
+// FactorsResponse collects the response values for the Factorize method.
+type FactorsResponse struct {
+	Factors []numtheoryservice.Factor
+	Err     error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r FactorsResponse) Failed() error { return r.Err }
@@ -0,0 +1,157 @@
+package mathendpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jwenz723/mathserver/pkg/polyservice"
+)
+
+// PolynomialSet collects the endpoints of the Polynomial service, see Set.
+type PolynomialSet struct {
+	EvaluateEndpoint   endpoint.Endpoint
+	AddEndpoint        endpoint.Endpoint
+	MultiplyEndpoint   endpoint.Endpoint
+	DerivativeEndpoint endpoint.Endpoint
+	IntegralEndpoint   endpoint.Endpoint
+	RootsEndpoint      endpoint.Endpoint
+}
+
+// NewPolynomial returns a PolynomialSet that wraps the provided service.
+func NewPolynomial(svc polyservice.Service) PolynomialSet {
+	return PolynomialSet{
+		EvaluateEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(PolynomialEvaluateRequest)
+			v, err := svc.Evaluate(ctx, req.A, req.X)
+			return MathOpResponse{V: v, Err: err}, nil
+		},
+		AddEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(PolynomialOpRequest)
+			v, err := svc.Add(ctx, req.A, req.B)
+			return PolynomialResponse{V: v, Err: err}, nil
+		},
+		MultiplyEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(PolynomialOpRequest)
+			v, err := svc.Multiply(ctx, req.A, req.B)
+			return PolynomialResponse{V: v, Err: err}, nil
+		},
+		DerivativeEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(PolynomialOpRequest)
+			v, err := svc.Derivative(ctx, req.A)
+			return PolynomialResponse{V: v, Err: err}, nil
+		},
+		IntegralEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(PolynomialOpRequest)
+			v, err := svc.Integral(ctx, req.A)
+			return PolynomialResponse{V: v, Err: err}, nil
+		},
+		RootsEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(RootsRequest)
+			v, err := svc.Roots(ctx, req.A, req.Tolerance)
+			return RootsResponse{V: v, Err: err}, nil
+		},
+	}
+}
+
+// compile time assertions for PolynomialSet implementing the service
+// interface.
+var (
+	_ polyservice.Service = PolynomialSet{}
+)
+
+// Evaluate implements the service interface, so PolynomialSet may be used as
+// a service. This is primarily useful in the context of a client library.
+func (s PolynomialSet) Evaluate(ctx context.Context, a []float64, x float64) (float64, error) {
+	resp, err := s.EvaluateEndpoint(ctx, PolynomialEvaluateRequest{A: a, X: x})
+	if err != nil {
+		return 0, err
+	}
+	r := resp.(MathOpResponse)
+	return r.V, r.Err
+}
+
+// Add implements the service interface.
+func (s PolynomialSet) Add(ctx context.Context, a, b []float64) ([]float64, error) {
+	return s.polynomial(ctx, s.AddEndpoint, PolynomialOpRequest{A: a, B: b})
+}
+
+// Multiply implements the service interface.
+func (s PolynomialSet) Multiply(ctx context.Context, a, b []float64) ([]float64, error) {
+	return s.polynomial(ctx, s.MultiplyEndpoint, PolynomialOpRequest{A: a, B: b})
+}
+
+// Derivative implements the service interface.
+func (s PolynomialSet) Derivative(ctx context.Context, a []float64) ([]float64, error) {
+	return s.polynomial(ctx, s.DerivativeEndpoint, PolynomialOpRequest{A: a})
+}
+
+// Integral implements the service interface.
+func (s PolynomialSet) Integral(ctx context.Context, a []float64) ([]float64, error) {
+	return s.polynomial(ctx, s.IntegralEndpoint, PolynomialOpRequest{A: a})
+}
+
+// Roots implements the service interface.
+func (s PolynomialSet) Roots(ctx context.Context, a []float64, tolerance float64) ([]complex128, error) {
+	resp, err := s.RootsEndpoint(ctx, RootsRequest{A: a, Tolerance: tolerance})
+	if err != nil {
+		return nil, err
+	}
+	r := resp.(RootsResponse)
+	return r.V, r.Err
+}
+
+// polynomial calls e, one of the endpoints responding with a
+// PolynomialResponse.
+func (s PolynomialSet) polynomial(ctx context.Context, e endpoint.Endpoint, req PolynomialOpRequest) ([]float64, error) {
+	resp, err := e(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	r := resp.(PolynomialResponse)
+	return r.V, r.Err
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = PolynomialResponse{}
+	_ endpoint.Failer = RootsResponse{}
+)
+
+// PolynomialOpRequest collects the request parameters for the methods of the
+// Polynomial service. Derivative and Integral only use A.
+type PolynomialOpRequest struct {
+	A, B []float64
+}
+
+// PolynomialEvaluateRequest collects the request parameters for the Evaluate
+// method of the Polynomial service.
+type PolynomialEvaluateRequest struct {
+	A []float64
+	X float64
+}
+
+// RootsRequest collects the request parameters for the Roots method.
+type RootsRequest struct {
+	A         []float64
+	Tolerance float64
+}
+
+// PolynomialResponse collects the response values for the methods of the
+// Polynomial service returning a polynomial. Evaluate responds with a
+// MathOpResponse.
+type PolynomialResponse struct {
+	V   []float64
+	Err error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r PolynomialResponse) Failed() error { return r.Err }
+
+// RootsResponse collects the response values for the Roots method.
+type RootsResponse struct {
+	V   []complex128
+	Err error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r RootsResponse) Failed() error { return r.Err }
@@ -0,0 +1,118 @@
+// Package mathendpoint holds the go-kit endpoints of the math services, shared
+// by the grpc_and_http/gokit and grpc_only/gokit variants, whose transports
+// serve them.
+package mathendpoint
+
+import (
+	"context"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/jwenz723/mathserver/pkg/expr"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind endpoint -o set_gen.go
+
+// Set collects all of the endpoints that compose an add service. It's meant to
+// be used as a helper struct, to collect all of the endpoints into a single
+// parameter. The endpoints of the operations are collected by the embedded
+// Operations.
+type Set struct {
+	Operations
+	EvaluateEndpoint endpoint.Endpoint
+	BatchEndpoint    endpoint.Endpoint
+}
+
+// New returns a Set that wraps the provided server, and wires in all of the
+// expected endpoint middlewares via the various parameters.
+func New(svc mathservice2.Service, logger log.Logger) Set {
+	set := Set{
+		Operations:       NewOperations(svc),
+		EvaluateEndpoint: MakeEvaluateEndpoint(svc),
+	}
+	set.BatchEndpoint = MakeBatchEndpoint(set)
+	return set
+}
+
+// Evaluate parses and computes an arithmetic expression. Set doesn't implement
+// it as part of the service interface, it's provided so Set may be used to
+// call the Evaluate endpoint from a client library.
+func (s Set) Evaluate(ctx context.Context, expression string) (float64, error) {
+	resp, err := s.EvaluateEndpoint(ctx, EvaluateRequest{Expression: expression, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeEvaluateEndpoint constructs an Evaluate endpoint wrapping the service.
+// Each operation within the expression is executed by calling the
+// corresponding service method.
+func MakeEvaluateEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(EvaluateRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := expr.Evaluate(ctx, s, req.Expression)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// requestedPrecision returns the precision a client requested by calling
+// precision.NewContext, the zero Precision uses the server's default.
+func requestedPrecision(ctx context.Context) precision.Precision {
+	p, _, _ := precision.FromContext(ctx)
+	return p
+}
+
+// result unwraps response, recording its exact value in the precision.Result
+// carried by ctx, if any.
+func result(ctx context.Context, response MathOpResponse) (float64, error) {
+	if _, res, ok := precision.FromContext(ctx); ok {
+		res.SetString(response.Exact)
+	}
+	return response.V, response.Err
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = MathOpResponse{}
+)
+
+// MathOpRequest collects the request parameters for the math methods. The
+// Division is only used by IntDivide and Remainder.
+type MathOpRequest struct {
+	A, B      float64
+	Precision precision.Precision   `json:"precision"`
+	Division  mathservice2.Division `json:"division"`
+}
+
+// MathListRequest collects the request parameters for the math methods that
+// operate on a list of values.
+type MathListRequest struct {
+	Values    []float64           `json:"values"`
+	Precision precision.Precision `json:"precision"`
+}
+
+// UnaryOpRequest collects the request parameters for the math methods that
+// take a single operand.
+type UnaryOpRequest struct {
+	X         float64             `json:"x"`
+	Precision precision.Precision `json:"precision"`
+}
+
+// EvaluateRequest collects the request parameters for the Evaluate method.
+type EvaluateRequest struct {
+	Expression string              `json:"expression"`
+	Precision  precision.Precision `json:"precision"`
+}
+
+// MathOpResponse collects the response values for the math methods.
+type MathOpResponse struct {
+	V     float64 `json:"v"`
+	Exact string  `json:"exact,omitempty"`
+	Err   error   `json:"-"` // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r MathOpResponse) Failed() error { return r.Err }
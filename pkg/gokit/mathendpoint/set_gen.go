@@ -0,0 +1,1055 @@
+// Code generated by mathsvcgen from mathsvc.proto. DO NOT EDIT.
+
+package mathendpoint
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+)
+
+// Operations collects the endpoints of the operations of the service, the
+// methods taking a pair of operands, a list of values or a single operand.
+// It's embedded in Set, which adds the endpoints of the other methods.
+type Operations struct {
+	DivideEndpoint    endpoint.Endpoint
+	MaxEndpoint       endpoint.Endpoint
+	MinEndpoint       endpoint.Endpoint
+	MultiplyEndpoint  endpoint.Endpoint
+	PowEndpoint       endpoint.Endpoint
+	SubtractEndpoint  endpoint.Endpoint
+	SumEndpoint       endpoint.Endpoint
+	SumAllEndpoint    endpoint.Endpoint
+	ProductEndpoint   endpoint.Endpoint
+	MeanEndpoint      endpoint.Endpoint
+	MedianEndpoint    endpoint.Endpoint
+	VarianceEndpoint  endpoint.Endpoint
+	StdDevEndpoint    endpoint.Endpoint
+	ModEndpoint       endpoint.Endpoint
+	IntDivideEndpoint endpoint.Endpoint
+	RemainderEndpoint endpoint.Endpoint
+	GcdEndpoint       endpoint.Endpoint
+	LcmEndpoint       endpoint.Endpoint
+	SqrtEndpoint      endpoint.Endpoint
+	AbsEndpoint       endpoint.Endpoint
+	NegateEndpoint    endpoint.Endpoint
+	ExpEndpoint       endpoint.Endpoint
+	LnEndpoint        endpoint.Endpoint
+	Log10Endpoint     endpoint.Endpoint
+	Log2Endpoint      endpoint.Endpoint
+	FloorEndpoint     endpoint.Endpoint
+	CeilEndpoint      endpoint.Endpoint
+	RoundEndpoint     endpoint.Endpoint
+	TruncEndpoint     endpoint.Endpoint
+	SinEndpoint       endpoint.Endpoint
+	CosEndpoint       endpoint.Endpoint
+	TanEndpoint       endpoint.Endpoint
+	AsinEndpoint      endpoint.Endpoint
+	AcosEndpoint      endpoint.Endpoint
+	AtanEndpoint      endpoint.Endpoint
+	GammaEndpoint     endpoint.Endpoint
+	LogGammaEndpoint  endpoint.Endpoint
+	BetaEndpoint      endpoint.Endpoint
+	ErfEndpoint       endpoint.Endpoint
+	ErfcEndpoint      endpoint.Endpoint
+	BesselJEndpoint   endpoint.Endpoint
+	BesselYEndpoint   endpoint.Endpoint
+}
+
+// NewOperations returns the Operations wrapping the provided service.
+func NewOperations(svc mathservice2.Service) Operations {
+	return Operations{
+		DivideEndpoint:    MakeDivideEndpoint(svc),
+		MaxEndpoint:       MakeMaxEndpoint(svc),
+		MinEndpoint:       MakeMinEndpoint(svc),
+		MultiplyEndpoint:  MakeMultiplyEndpoint(svc),
+		PowEndpoint:       MakePowEndpoint(svc),
+		SubtractEndpoint:  MakeSubtractEndpoint(svc),
+		SumEndpoint:       MakeSumEndpoint(svc),
+		SumAllEndpoint:    MakeSumAllEndpoint(svc),
+		ProductEndpoint:   MakeProductEndpoint(svc),
+		MeanEndpoint:      MakeMeanEndpoint(svc),
+		MedianEndpoint:    MakeMedianEndpoint(svc),
+		VarianceEndpoint:  MakeVarianceEndpoint(svc),
+		StdDevEndpoint:    MakeStdDevEndpoint(svc),
+		ModEndpoint:       MakeModEndpoint(svc),
+		IntDivideEndpoint: MakeIntDivideEndpoint(svc),
+		RemainderEndpoint: MakeRemainderEndpoint(svc),
+		GcdEndpoint:       MakeGcdEndpoint(svc),
+		LcmEndpoint:       MakeLcmEndpoint(svc),
+		SqrtEndpoint:      MakeSqrtEndpoint(svc),
+		AbsEndpoint:       MakeAbsEndpoint(svc),
+		NegateEndpoint:    MakeNegateEndpoint(svc),
+		ExpEndpoint:       MakeExpEndpoint(svc),
+		LnEndpoint:        MakeLnEndpoint(svc),
+		Log10Endpoint:     MakeLog10Endpoint(svc),
+		Log2Endpoint:      MakeLog2Endpoint(svc),
+		FloorEndpoint:     MakeFloorEndpoint(svc),
+		CeilEndpoint:      MakeCeilEndpoint(svc),
+		RoundEndpoint:     MakeRoundEndpoint(svc),
+		TruncEndpoint:     MakeTruncEndpoint(svc),
+		SinEndpoint:       MakeSinEndpoint(svc),
+		CosEndpoint:       MakeCosEndpoint(svc),
+		TanEndpoint:       MakeTanEndpoint(svc),
+		AsinEndpoint:      MakeAsinEndpoint(svc),
+		AcosEndpoint:      MakeAcosEndpoint(svc),
+		AtanEndpoint:      MakeAtanEndpoint(svc),
+		GammaEndpoint:     MakeGammaEndpoint(svc),
+		LogGammaEndpoint:  MakeLogGammaEndpoint(svc),
+		BetaEndpoint:      MakeBetaEndpoint(svc),
+		ErfEndpoint:       MakeErfEndpoint(svc),
+		ErfcEndpoint:      MakeErfcEndpoint(svc),
+		BesselJEndpoint:   MakeBesselJEndpoint(svc),
+		BesselYEndpoint:   MakeBesselYEndpoint(svc),
+	}
+}
+
+// Divide implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Divide(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.DivideEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeDivideEndpoint constructs a Divide endpoint wrapping the service.
+func MakeDivideEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.Divide(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Max implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Max(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.MaxEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeMaxEndpoint constructs a Max endpoint wrapping the service.
+func MakeMaxEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.Max(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Min implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Min(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.MinEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeMinEndpoint constructs a Min endpoint wrapping the service.
+func MakeMinEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.Min(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Multiply implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Multiply(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.MultiplyEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeMultiplyEndpoint constructs a Multiply endpoint wrapping the service.
+func MakeMultiplyEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.Multiply(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Pow implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Pow(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.PowEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakePowEndpoint constructs a Pow endpoint wrapping the service.
+func MakePowEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.Pow(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Subtract implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Subtract(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.SubtractEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeSubtractEndpoint constructs a Subtract endpoint wrapping the service.
+func MakeSubtractEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.Subtract(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Sum implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Sum(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.SumEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeSumEndpoint constructs a Sum endpoint wrapping the service.
+func MakeSumEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.Sum(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// SumAll implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) SumAll(ctx context.Context, values []float64) (float64, error) {
+	resp, err := o.SumAllEndpoint(ctx, MathListRequest{Values: values, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeSumAllEndpoint constructs a SumAll endpoint wrapping the service.
+func MakeSumAllEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathListRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.SumAll(ctx, req.Values)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Product implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Product(ctx context.Context, values []float64) (float64, error) {
+	resp, err := o.ProductEndpoint(ctx, MathListRequest{Values: values, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeProductEndpoint constructs a Product endpoint wrapping the service.
+func MakeProductEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathListRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Product(ctx, req.Values)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Mean implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Mean(ctx context.Context, values []float64) (float64, error) {
+	resp, err := o.MeanEndpoint(ctx, MathListRequest{Values: values, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeMeanEndpoint constructs a Mean endpoint wrapping the service.
+func MakeMeanEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathListRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Mean(ctx, req.Values)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Median implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Median(ctx context.Context, values []float64) (float64, error) {
+	resp, err := o.MedianEndpoint(ctx, MathListRequest{Values: values, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeMedianEndpoint constructs a Median endpoint wrapping the service.
+func MakeMedianEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathListRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Median(ctx, req.Values)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Variance implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Variance(ctx context.Context, values []float64) (float64, error) {
+	resp, err := o.VarianceEndpoint(ctx, MathListRequest{Values: values, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeVarianceEndpoint constructs a Variance endpoint wrapping the service.
+func MakeVarianceEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathListRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Variance(ctx, req.Values)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// StdDev implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) StdDev(ctx context.Context, values []float64) (float64, error) {
+	resp, err := o.StdDevEndpoint(ctx, MathListRequest{Values: values, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeStdDevEndpoint constructs a StdDev endpoint wrapping the service.
+func MakeStdDevEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathListRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.StdDev(ctx, req.Values)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Mod implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Mod(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.ModEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeModEndpoint constructs a Mod endpoint wrapping the service.
+func MakeModEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.Mod(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// IntDivide implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) IntDivide(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.IntDivideEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeIntDivideEndpoint constructs a IntDivide endpoint wrapping the service.
+func MakeIntDivideEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.IntDivide(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Remainder implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Remainder(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.RemainderEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeRemainderEndpoint constructs a Remainder endpoint wrapping the service.
+func MakeRemainderEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.Remainder(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Gcd implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Gcd(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.GcdEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeGcdEndpoint constructs a Gcd endpoint wrapping the service.
+func MakeGcdEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.Gcd(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Lcm implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Lcm(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.LcmEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeLcmEndpoint constructs a Lcm endpoint wrapping the service.
+func MakeLcmEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.Lcm(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Sqrt implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Sqrt(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.SqrtEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeSqrtEndpoint constructs a Sqrt endpoint wrapping the service.
+func MakeSqrtEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Sqrt(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Abs implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Abs(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.AbsEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeAbsEndpoint constructs a Abs endpoint wrapping the service.
+func MakeAbsEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Abs(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Negate implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Negate(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.NegateEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeNegateEndpoint constructs a Negate endpoint wrapping the service.
+func MakeNegateEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Negate(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Exp implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Exp(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.ExpEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeExpEndpoint constructs a Exp endpoint wrapping the service.
+func MakeExpEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Exp(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Ln implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Ln(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.LnEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeLnEndpoint constructs a Ln endpoint wrapping the service.
+func MakeLnEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Ln(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Log10 implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Log10(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.Log10Endpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeLog10Endpoint constructs a Log10 endpoint wrapping the service.
+func MakeLog10Endpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Log10(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Log2 implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Log2(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.Log2Endpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeLog2Endpoint constructs a Log2 endpoint wrapping the service.
+func MakeLog2Endpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Log2(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Floor implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Floor(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.FloorEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeFloorEndpoint constructs a Floor endpoint wrapping the service.
+func MakeFloorEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Floor(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Ceil implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Ceil(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.CeilEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeCeilEndpoint constructs a Ceil endpoint wrapping the service.
+func MakeCeilEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Ceil(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Round implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Round(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.RoundEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeRoundEndpoint constructs a Round endpoint wrapping the service.
+func MakeRoundEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Round(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Trunc implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Trunc(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.TruncEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeTruncEndpoint constructs a Trunc endpoint wrapping the service.
+func MakeTruncEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Trunc(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Sin implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Sin(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.SinEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeSinEndpoint constructs a Sin endpoint wrapping the service.
+func MakeSinEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Sin(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Cos implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Cos(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.CosEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeCosEndpoint constructs a Cos endpoint wrapping the service.
+func MakeCosEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Cos(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Tan implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Tan(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.TanEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeTanEndpoint constructs a Tan endpoint wrapping the service.
+func MakeTanEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Tan(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Asin implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Asin(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.AsinEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeAsinEndpoint constructs a Asin endpoint wrapping the service.
+func MakeAsinEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Asin(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Acos implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Acos(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.AcosEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeAcosEndpoint constructs a Acos endpoint wrapping the service.
+func MakeAcosEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Acos(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Atan implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Atan(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.AtanEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeAtanEndpoint constructs a Atan endpoint wrapping the service.
+func MakeAtanEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Atan(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Gamma implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Gamma(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.GammaEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeGammaEndpoint constructs a Gamma endpoint wrapping the service.
+func MakeGammaEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Gamma(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// LogGamma implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) LogGamma(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.LogGammaEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeLogGammaEndpoint constructs a LogGamma endpoint wrapping the service.
+func MakeLogGammaEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.LogGamma(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Beta implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Beta(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.BetaEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeBetaEndpoint constructs a Beta endpoint wrapping the service.
+func MakeBetaEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.Beta(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Erf implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Erf(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.ErfEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeErfEndpoint constructs a Erf endpoint wrapping the service.
+func MakeErfEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Erf(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// Erfc implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) Erfc(ctx context.Context, x float64) (float64, error) {
+	resp, err := o.ErfcEndpoint(ctx, UnaryOpRequest{X: x, Precision: requestedPrecision(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeErfcEndpoint constructs a Erfc endpoint wrapping the service.
+func MakeErfcEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(UnaryOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		v, err := s.Erfc(ctx, req.X)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// BesselJ implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) BesselJ(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.BesselJEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeBesselJEndpoint constructs a BesselJ endpoint wrapping the service.
+func MakeBesselJEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.BesselJ(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// BesselY implements the service interface, so Set may be used as a service.
+// This is primarily useful in the context of a client library.
+func (o Operations) BesselY(ctx context.Context, a, b float64) (float64, error) {
+	resp, err := o.BesselYEndpoint(ctx, MathOpRequest{A: a, B: b, Precision: requestedPrecision(ctx), Division: mathservice2.DivisionFromContext(ctx)})
+	if err != nil {
+		return 0, err
+	}
+	return result(ctx, resp.(MathOpResponse))
+}
+
+// MakeBesselYEndpoint constructs a BesselY endpoint wrapping the service.
+func MakeBesselYEndpoint(s mathservice2.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(MathOpRequest)
+		ctx, res := precision.NewContext(ctx, req.Precision)
+		ctx = mathservice2.NewDivisionContext(ctx, req.Division)
+		v, err := s.BesselY(ctx, req.A, req.B)
+		return MathOpResponse{V: v, Exact: res.String(), Err: err}, nil
+	}
+}
+
+// operation returns the endpoint performing item along with its request, or
+// a nil endpoint if item doesn't name one of the Operations.
+func (o Operations) operation(item BatchItem) (endpoint.Endpoint, interface{}) {
+	switch strings.ToLower(item.Op) {
+	case "divide":
+		return o.DivideEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "max":
+		return o.MaxEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "min":
+		return o.MinEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "multiply":
+		return o.MultiplyEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "pow":
+		return o.PowEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "subtract":
+		return o.SubtractEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "sum":
+		return o.SumEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "sumall":
+		return o.SumAllEndpoint, MathListRequest{Values: item.Values, Precision: item.Precision}
+	case "product":
+		return o.ProductEndpoint, MathListRequest{Values: item.Values, Precision: item.Precision}
+	case "mean":
+		return o.MeanEndpoint, MathListRequest{Values: item.Values, Precision: item.Precision}
+	case "median":
+		return o.MedianEndpoint, MathListRequest{Values: item.Values, Precision: item.Precision}
+	case "variance":
+		return o.VarianceEndpoint, MathListRequest{Values: item.Values, Precision: item.Precision}
+	case "stddev":
+		return o.StdDevEndpoint, MathListRequest{Values: item.Values, Precision: item.Precision}
+	case "mod":
+		return o.ModEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "intdivide":
+		return o.IntDivideEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "remainder":
+		return o.RemainderEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "gcd":
+		return o.GcdEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "lcm":
+		return o.LcmEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "sqrt":
+		return o.SqrtEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "abs":
+		return o.AbsEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "negate":
+		return o.NegateEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "exp":
+		return o.ExpEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "ln":
+		return o.LnEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "log10":
+		return o.Log10Endpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "log2":
+		return o.Log2Endpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "floor":
+		return o.FloorEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "ceil":
+		return o.CeilEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "round":
+		return o.RoundEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "trunc":
+		return o.TruncEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "sin":
+		return o.SinEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "cos":
+		return o.CosEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "tan":
+		return o.TanEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "asin":
+		return o.AsinEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "acos":
+		return o.AcosEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "atan":
+		return o.AtanEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "gamma":
+		return o.GammaEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "loggamma":
+		return o.LogGammaEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "beta":
+		return o.BetaEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "erf":
+		return o.ErfEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "erfc":
+		return o.ErfcEndpoint, UnaryOpRequest{X: item.A, Precision: item.Precision}
+	case "besselj":
+		return o.BesselJEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	case "bessely":
+		return o.BesselYEndpoint, MathOpRequest{A: item.A, B: item.B, Precision: item.Precision, Division: item.Division}
+	}
+	return nil, nil
+}
@@ -0,0 +1,94 @@
+package mathendpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jwenz723/mathserver/pkg/statsservice"
+)
+
+// StatisticsSet collects the endpoints of the Statistics service, see Set.
+// The endpoints are called with the whole dataset, once the transport has
+// received every chunk of it.
+type StatisticsSet struct {
+	DescribeEndpoint  endpoint.Endpoint
+	CorrelateEndpoint endpoint.Endpoint
+}
+
+// NewStatistics returns a StatisticsSet that wraps the provided service.
+func NewStatistics(svc statsservice.Service) StatisticsSet {
+	return StatisticsSet{
+		DescribeEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(DescribeRequest)
+			v, err := svc.Describe(ctx, req.Values, req.Options)
+			return DescribeResponse{V: v, Err: err}, nil
+		},
+		CorrelateEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(CorrelateRequest)
+			v, err := svc.Correlate(ctx, req.X, req.Y)
+			return CorrelateResponse{V: v, Err: err}, nil
+		},
+	}
+}
+
+// compile time assertions for StatisticsSet implementing the service
+// interface.
+var (
+	_ statsservice.Service = StatisticsSet{}
+)
+
+// Describe implements the service interface, so StatisticsSet may be used as
+// a service. This is primarily useful in the context of a client library.
+func (s StatisticsSet) Describe(ctx context.Context, values []float64, opts statsservice.Options) (statsservice.Description, error) {
+	resp, err := s.DescribeEndpoint(ctx, DescribeRequest{Values: values, Options: opts})
+	if err != nil {
+		return statsservice.Description{}, err
+	}
+	r := resp.(DescribeResponse)
+	return r.V, r.Err
+}
+
+// Correlate implements the service interface.
+func (s StatisticsSet) Correlate(ctx context.Context, x, y []float64) (statsservice.Correlation, error) {
+	resp, err := s.CorrelateEndpoint(ctx, CorrelateRequest{X: x, Y: y})
+	if err != nil {
+		return statsservice.Correlation{}, err
+	}
+	r := resp.(CorrelateResponse)
+	return r.V, r.Err
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = DescribeResponse{}
+	_ endpoint.Failer = CorrelateResponse{}
+)
+
+// DescribeRequest collects the request parameters for the Describe method.
+type DescribeRequest struct {
+	Values  []float64
+	Options statsservice.Options
+}
+
+// CorrelateRequest collects the request parameters for the Correlate method.
+type CorrelateRequest struct {
+	X, Y []float64
+}
+
+// DescribeResponse collects the response values for the Describe method.
+type DescribeResponse struct {
+	V   statsservice.Description
+	Err error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r DescribeResponse) Failed() error { return r.Err }
+
+// CorrelateResponse collects the response values for the Correlate method.
+type CorrelateResponse struct {
+	V   statsservice.Correlation
+	Err error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r CorrelateResponse) Failed() error { return r.Err }
@@ -0,0 +1,76 @@
+package mathendpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jwenz723/mathserver/pkg/symbolicservice"
+)
+
+// SymbolicSet collects the endpoints of the Symbolic service, see Set. The
+// requests are symbolicservice.Requests.
+type SymbolicSet struct {
+	FormatEndpoint        endpoint.Endpoint
+	SimplifyEndpoint      endpoint.Endpoint
+	DifferentiateEndpoint endpoint.Endpoint
+}
+
+// NewSymbolic returns a SymbolicSet that wraps the provided service.
+func NewSymbolic(svc symbolicservice.Service) SymbolicSet {
+	return SymbolicSet{
+		FormatEndpoint:        makeSymbolicEndpoint(svc.Format),
+		SimplifyEndpoint:      makeSymbolicEndpoint(svc.Simplify),
+		DifferentiateEndpoint: makeSymbolicEndpoint(svc.Differentiate),
+	}
+}
+
+// compile time assertions for SymbolicSet implementing the service interface.
+var (
+	_ symbolicservice.Service = SymbolicSet{}
+)
+
+// Format implements the service interface, so SymbolicSet may be used as a
+// service. This is primarily useful in the context of a client library.
+func (s SymbolicSet) Format(ctx context.Context, r symbolicservice.Request) (symbolicservice.Result, error) {
+	return symbolicResult(s.FormatEndpoint(ctx, r))
+}
+
+// Simplify implements the service interface.
+func (s SymbolicSet) Simplify(ctx context.Context, r symbolicservice.Request) (symbolicservice.Result, error) {
+	return symbolicResult(s.SimplifyEndpoint(ctx, r))
+}
+
+// Differentiate implements the service interface.
+func (s SymbolicSet) Differentiate(ctx context.Context, r symbolicservice.Request) (symbolicservice.Result, error) {
+	return symbolicResult(s.DifferentiateEndpoint(ctx, r))
+}
+
+func makeSymbolicEndpoint(op func(ctx context.Context, r symbolicservice.Request) (symbolicservice.Result, error)) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		r, err := op(ctx, request.(symbolicservice.Request))
+		return SymbolicResponse{Result: r, Err: err}, nil
+	}
+}
+
+func symbolicResult(response interface{}, err error) (symbolicservice.Result, error) {
+	if err != nil {
+		return symbolicservice.Result{}, err
+	}
+	resp := response.(SymbolicResponse)
+	return resp.Result, resp.Err
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = SymbolicResponse{}
+)
+
+// SymbolicResponse collects the response values for the methods of the
+// Symbolic service.
+type SymbolicResponse struct {
+	Result symbolicservice.Result
+	Err    error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r SymbolicResponse) Failed() error { return r.Err }
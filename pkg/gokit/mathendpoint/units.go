@@ -0,0 +1,126 @@
+package mathendpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
+)
+
+// UnitsSet collects the endpoints of the Units service, see Set.
+type UnitsSet struct {
+	SumEndpoint      endpoint.Endpoint
+	SubtractEndpoint endpoint.Endpoint
+	MultiplyEndpoint endpoint.Endpoint
+	DivideEndpoint   endpoint.Endpoint
+	PowEndpoint      endpoint.Endpoint
+	ConvertEndpoint  endpoint.Endpoint
+}
+
+// NewUnits returns a UnitsSet that wraps the provided service.
+func NewUnits(svc unitservice.Service) UnitsSet {
+	return UnitsSet{
+		SumEndpoint:      MakeQuantityOpEndpoint(svc.Sum),
+		SubtractEndpoint: MakeQuantityOpEndpoint(svc.Subtract),
+		MultiplyEndpoint: MakeQuantityOpEndpoint(svc.Multiply),
+		DivideEndpoint:   MakeQuantityOpEndpoint(svc.Divide),
+		PowEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(QuantityPowRequest)
+			v, err := svc.Pow(ctx, req.A, req.B)
+			return QuantityResponse{V: v, Err: err}, nil
+		},
+		ConvertEndpoint: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(ConvertRequest)
+			v, err := svc.Convert(ctx, req.A, req.Unit)
+			return QuantityResponse{V: v, Err: err}, nil
+		},
+	}
+}
+
+// compile time assertions for UnitsSet implementing the service interface.
+var (
+	_ unitservice.Service = UnitsSet{}
+)
+
+// Sum implements the service interface, so UnitsSet may be used as a
+// service. This is primarily useful in the context of a client library.
+func (s UnitsSet) Sum(ctx context.Context, a, b unitservice.Quantity) (unitservice.Quantity, error) {
+	return quantityResult(s.SumEndpoint(ctx, QuantityOpRequest{A: a, B: b}))
+}
+
+// Subtract implements the service interface.
+func (s UnitsSet) Subtract(ctx context.Context, a, b unitservice.Quantity) (unitservice.Quantity, error) {
+	return quantityResult(s.SubtractEndpoint(ctx, QuantityOpRequest{A: a, B: b}))
+}
+
+// Multiply implements the service interface.
+func (s UnitsSet) Multiply(ctx context.Context, a, b unitservice.Quantity) (unitservice.Quantity, error) {
+	return quantityResult(s.MultiplyEndpoint(ctx, QuantityOpRequest{A: a, B: b}))
+}
+
+// Divide implements the service interface.
+func (s UnitsSet) Divide(ctx context.Context, a, b unitservice.Quantity) (unitservice.Quantity, error) {
+	return quantityResult(s.DivideEndpoint(ctx, QuantityOpRequest{A: a, B: b}))
+}
+
+// Pow implements the service interface.
+func (s UnitsSet) Pow(ctx context.Context, a unitservice.Quantity, b float64) (unitservice.Quantity, error) {
+	return quantityResult(s.PowEndpoint(ctx, QuantityPowRequest{A: a, B: b}))
+}
+
+// Convert implements the service interface.
+func (s UnitsSet) Convert(ctx context.Context, a unitservice.Quantity, unit string) (unitservice.Quantity, error) {
+	return quantityResult(s.ConvertEndpoint(ctx, ConvertRequest{A: a, Unit: unit}))
+}
+
+// MakeQuantityOpEndpoint constructs an endpoint calling op, a method of the
+// service taking a pair of quantities.
+func MakeQuantityOpEndpoint(op func(ctx context.Context, a, b unitservice.Quantity) (unitservice.Quantity, error)) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(QuantityOpRequest)
+		v, err := op(ctx, req.A, req.B)
+		return QuantityResponse{V: v, Err: err}, nil
+	}
+}
+
+func quantityResult(response interface{}, err error) (unitservice.Quantity, error) {
+	if err != nil {
+		return unitservice.Quantity{}, err
+	}
+	resp := response.(QuantityResponse)
+	return resp.V, resp.Err
+}
+
+// compile time assertions for our response types implementing endpoint.Failer.
+var (
+	_ endpoint.Failer = QuantityResponse{}
+)
+
+// QuantityOpRequest collects the request parameters for the methods of the
+// Units service taking a pair of quantities.
+type QuantityOpRequest struct {
+	A, B unitservice.Quantity
+}
+
+// QuantityPowRequest collects the request parameters for the Pow method of
+// the Units service.
+type QuantityPowRequest struct {
+	A unitservice.Quantity
+	B float64
+}
+
+// ConvertRequest collects the request parameters for the Convert method.
+type ConvertRequest struct {
+	A    unitservice.Quantity
+	Unit string
+}
+
+// QuantityResponse collects the response values for the methods of the Units
+// service.
+type QuantityResponse struct {
+	V   unitservice.Quantity
+	Err error // should be intercepted by Failed/errorEncoder
+}
+
+// Failed implements endpoint.Failer.
+func (r QuantityResponse) Failed() error { return r.Err }
@@ -0,0 +1,53 @@
+package mathservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/jwenz723/mathserver/pkg/calculusservice"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -service Calculus -type calculusObservabilityMiddleware -o calculus_gen.go
+
+// NewCalculus returns a basic calculusservice.Service with all of the
+// expected middlewares wired in.
+func NewCalculus(duration metrics.Histogram, logger log.Logger) calculusservice.Service {
+	var svc calculusservice.Service
+	{
+		svc = calculusservice.NewBasicService()
+		svc = CalculusObservabilityMiddleware(duration, logger)(svc)
+	}
+	return svc
+}
+
+// CalculusObservabilityMiddleware implements both logging and prometheus
+// metrics for each calculusservice.Service method. The methods are observed
+// as Calculus.<Method>, along with the iterations they used.
+func CalculusObservabilityMiddleware(duration metrics.Histogram, logger log.Logger) calculusservice.Middleware {
+	return func(next calculusservice.Service) calculusservice.Service {
+		return calculusObservabilityMiddleware{duration, logger, next}
+	}
+}
+
+type calculusObservabilityMiddleware struct {
+	duration metrics.Histogram
+	logger   log.Logger
+	next     calculusservice.Service
+}
+
+// observeMethodExecution observes a call of method, logging the given
+// keyvals with the fields of its problem and result.
+func (mw calculusObservabilityMiddleware) observeMethodExecution(ctx context.Context, method string, begin time.Time, err error, keyvals ...interface{}) {
+	var kv []interface{}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		switch v := keyvals[i+1].(type) {
+		case calculusservice.Problem:
+			kv = append(kv, "expression", v.Expression, "a", v.A, "b", v.B, "x", v.X, "algorithm", v.Method)
+		case calculusservice.Result:
+			kv = append(kv, "v", v.V, "iterations", v.Iterations)
+		}
+	}
+	observe(mw.logger, mw.duration, method, begin, err, kv...)
+}
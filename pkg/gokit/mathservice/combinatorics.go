@@ -0,0 +1,55 @@
+package mathservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -service Combinatorics -type combinatoricsObservabilityMiddleware -o combinatorics_gen.go
+
+// NewCombinatorics returns a basic combinatoricsservice.Service with all of
+// the expected middlewares wired in, whose results have at most maxDigits
+// digits.
+func NewCombinatorics(duration metrics.Histogram, logger log.Logger, maxDigits int) combinatoricsservice.Service {
+	var svc combinatoricsservice.Service
+	{
+		svc = combinatoricsservice.NewBasicService(maxDigits)
+		svc = CombinatoricsObservabilityMiddleware(duration, logger)(svc)
+	}
+	return svc
+}
+
+// CombinatoricsObservabilityMiddleware implements both logging and
+// prometheus metrics for each combinatoricsservice.Service method. The
+// methods are observed as Combinatorics.<Method>, and only the number of
+// digits of their results is logged.
+func CombinatoricsObservabilityMiddleware(duration metrics.Histogram, logger log.Logger) combinatoricsservice.Middleware {
+	return func(next combinatoricsservice.Service) combinatoricsservice.Service {
+		return combinatoricsObservabilityMiddleware{duration, logger, next}
+	}
+}
+
+type combinatoricsObservabilityMiddleware struct {
+	duration metrics.Histogram
+	logger   log.Logger
+	next     combinatoricsservice.Service
+}
+
+// observeMethodExecution observes a call of method, logging the given
+// keyvals with the number of digits of its result.
+func (mw combinatoricsObservabilityMiddleware) observeMethodExecution(ctx context.Context, method string, begin time.Time, err error, keyvals ...interface{}) {
+	var kv []interface{}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		switch v := keyvals[i+1].(type) {
+		case combinatoricsservice.Operands:
+			kv = append(kv, "n", v.N, "k", v.K)
+		case string:
+			kv = append(kv, "digits", len(v))
+		}
+	}
+	observe(mw.logger, mw.duration, method, begin, err, kv...)
+}
@@ -0,0 +1,45 @@
+package mathservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/jwenz723/mathserver/pkg/complexservice"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -service Complex -type complexObservabilityMiddleware -o complex_gen.go
+
+// NewComplex returns a basic complexservice.Service with all of the expected
+// middlewares wired in.
+func NewComplex(duration metrics.Histogram, logger log.Logger) complexservice.Service {
+	var svc complexservice.Service
+	{
+		svc = complexservice.NewBasicService()
+		svc = ComplexObservabilityMiddleware(duration, logger)(svc)
+	}
+	return svc
+}
+
+// ComplexObservabilityMiddleware implements both logging and prometheus
+// metrics for each complexservice.Service method. The methods are observed as
+// Complex.<Method> so they aren't mistaken for the Math methods of the same
+// name.
+func ComplexObservabilityMiddleware(duration metrics.Histogram, logger log.Logger) complexservice.Middleware {
+	return func(next complexservice.Service) complexservice.Service {
+		return complexObservabilityMiddleware{duration, logger, next}
+	}
+}
+
+type complexObservabilityMiddleware struct {
+	duration metrics.Histogram
+	logger   log.Logger
+	next     complexservice.Service
+}
+
+// observeMethodExecution observes a call of method, logging the given
+// keyvals, its operands and result.
+func (mw complexObservabilityMiddleware) observeMethodExecution(ctx context.Context, method string, begin time.Time, err error, keyvals ...interface{}) {
+	observe(mw.logger, mw.duration, method, begin, err, keyvals...)
+}
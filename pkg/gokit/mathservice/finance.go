@@ -0,0 +1,67 @@
+package mathservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/jwenz723/mathserver/pkg/financeservice"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -service Finance -type financeObservabilityMiddleware -o finance_gen.go
+
+// NewFinance returns a basic financeservice.Service with all of the expected
+// middlewares wired in.
+func NewFinance(duration metrics.Histogram, logger log.Logger) financeservice.Service {
+	var svc financeservice.Service
+	{
+		svc = financeservice.NewBasicService()
+		svc = FinanceObservabilityMiddleware(duration, logger)(svc)
+	}
+	return svc
+}
+
+// FinanceObservabilityMiddleware implements both logging and prometheus
+// metrics for each financeservice.Service method. The methods are observed
+// as Finance.<Method>.
+func FinanceObservabilityMiddleware(duration metrics.Histogram, logger log.Logger) financeservice.Middleware {
+	return func(next financeservice.Service) financeservice.Service {
+		return financeObservabilityMiddleware{duration, logger, next}
+	}
+}
+
+type financeObservabilityMiddleware struct {
+	duration metrics.Histogram
+	logger   log.Logger
+	next     financeservice.Service
+}
+
+// observeMethodExecution observes a call of method, logging the given
+// keyvals with its request as JSON.
+func (mw financeObservabilityMiddleware) observeMethodExecution(ctx context.Context, method string, begin time.Time, err error, keyvals ...interface{}) {
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		switch v := keyvals[i+1].(type) {
+		case financeservice.Decimal:
+			keyvals[i+1] = string(v)
+		case int:
+			// the number of rows sent by AmortizationSchedule
+			keyvals[i] = "rows"
+		default:
+			keyvals[i], keyvals[i+1] = "request", requestString(v)
+		}
+	}
+	observe(mw.logger, mw.duration, method, begin, err, keyvals...)
+}
+
+// requestString returns the JSON encoding of a request of the Finance
+// service, whose optional scale %+v would print as an address.
+func requestString(request interface{}) string {
+	b, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Sprintf("%+v", request)
+	}
+	return string(b)
+}
@@ -0,0 +1,57 @@
+package mathservice
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/jwenz723/mathserver/pkg/linalgservice"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -service LinearAlgebra -type linalgObservabilityMiddleware -o linalg_gen.go
+
+// NewLinearAlgebra returns a basic linalgservice.Service with all of the
+// expected middlewares wired in.
+func NewLinearAlgebra(duration metrics.Histogram, logger log.Logger) linalgservice.Service {
+	var svc linalgservice.Service
+	{
+		svc = linalgservice.NewBasicService()
+		svc = LinearAlgebraObservabilityMiddleware(duration, logger)(svc)
+	}
+	return svc
+}
+
+// LinearAlgebraObservabilityMiddleware implements both logging and prometheus
+// metrics for each linalgservice.Service method. The methods are observed as
+// LinearAlgebra.<Method>, and the dimensions of their operands are logged
+// rather than their values.
+func LinearAlgebraObservabilityMiddleware(duration metrics.Histogram, logger log.Logger) linalgservice.Middleware {
+	return func(next linalgservice.Service) linalgservice.Service {
+		return linalgObservabilityMiddleware{duration, logger, next}
+	}
+}
+
+type linalgObservabilityMiddleware struct {
+	duration metrics.Histogram
+	logger   log.Logger
+	next     linalgservice.Service
+}
+
+// observeMethodExecution observes a call of method, logging the given
+// keyvals with the dimensions of its vectors and matrices.
+func (mw linalgObservabilityMiddleware) observeMethodExecution(ctx context.Context, method string, begin time.Time, err error, keyvals ...interface{}) {
+	for i := 1; i < len(keyvals); i += 2 {
+		switch v := keyvals[i].(type) {
+		case []float64:
+			keyvals[i] = vector(v)
+		case linalgservice.Matrix:
+			keyvals[i] = v.Dims()
+		}
+	}
+	observe(mw.logger, mw.duration, method, begin, err, keyvals...)
+}
+
+// vector returns the dimension of the vector v, its length.
+func vector(v []float64) string { return strconv.Itoa(len(v)) }
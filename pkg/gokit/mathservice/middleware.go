@@ -0,0 +1,90 @@
+// Package mathservice wires the math services of pkg together with the
+// logging and instrumenting middlewares shared by the grpc_and_http/gokit and
+// grpc_only/gokit variants.
+package mathservice
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	mathservice2 "github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"time"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -o middleware_gen.go
+
+// New returns a basic Service with all of the expected middlewares wired in,
+// applying nonFinite to its results.
+func New(duration metrics.Histogram, logger log.Logger, p precision.Precision, nonFinite mathservice2.NonFinitePolicy) mathservice2.Service {
+	var svc mathservice2.Service
+	{
+		svc = mathservice2.NewBasicService(p)
+		svc = mathservice2.NonFiniteMiddleware(nonFinite)(svc)
+		svc = ObservabilityMiddleware(duration, logger)(svc)
+	}
+	return svc
+}
+
+// ObservabilityMiddleware implements both logging and prometheus metrics for each Service method
+func ObservabilityMiddleware(duration metrics.Histogram, logger log.Logger) mathservice2.Middleware {
+	return func(next mathservice2.Service) mathservice2.Service {
+		return observabilityMiddleware{duration, logger, next}
+	}
+}
+
+type observabilityMiddleware struct {
+	duration metrics.Histogram
+	logger   log.Logger
+	next     mathservice2.Service
+}
+
+func (mw observabilityMiddleware) observeMethodExecution(ctx context.Context, method string, a, b, v float64, begin time.Time, err error) {
+	duration := time.Since(begin)
+
+	mw.logger.Log("msg", "method executed",
+		"method", method,
+		"a", a,
+		"b", b,
+		"v", v,
+		"duration", duration,
+		"err", err)
+	mw.duration.With("method", method, "success", fmt.Sprint(err == nil)).Observe(duration.Seconds())
+}
+
+func (mw observabilityMiddleware) observeListMethodExecution(ctx context.Context, method string, values []float64, v float64, begin time.Time, err error) {
+	duration := time.Since(begin)
+
+	mw.logger.Log("msg", "method executed",
+		"method", method,
+		"n", len(values),
+		"v", v,
+		"duration", duration,
+		"err", err)
+	mw.duration.With("method", method, "success", fmt.Sprint(err == nil)).Observe(duration.Seconds())
+}
+
+func (mw observabilityMiddleware) observeUnaryMethodExecution(ctx context.Context, method string, x, v float64, begin time.Time, err error) {
+	duration := time.Since(begin)
+
+	mw.logger.Log("msg", "method executed",
+		"method", method,
+		"x", x,
+		"v", v,
+		"duration", duration,
+		"err", err)
+	mw.duration.With("method", method, "success", fmt.Sprint(err == nil)).Observe(duration.Seconds())
+}
+
+// observe logs a call of method made at begin, which failed with err, along
+// with keyvals, alternating keys and values, and records its duration.
+func observe(logger log.Logger, duration metrics.Histogram, method string, begin time.Time, err error, keyvals ...interface{}) {
+	d := time.Since(begin)
+
+	keyvals = append([]interface{}{"msg", "method executed", "method", method}, keyvals...)
+	logger.Log(append(keyvals,
+		"duration", d,
+		"err", err)...)
+	duration.With("method", method, "success", fmt.Sprint(err == nil)).Observe(d.Seconds())
+}
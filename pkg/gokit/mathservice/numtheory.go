@@ -0,0 +1,57 @@
+package mathservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/jwenz723/mathserver/pkg/numtheoryservice"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -service NumberTheory -type numberTheoryObservabilityMiddleware -o numtheory_gen.go
+
+// NewNumberTheory returns a basic numtheoryservice.Service with all of the
+// expected middlewares wired in.
+func NewNumberTheory(duration metrics.Histogram, logger log.Logger) numtheoryservice.Service {
+	var svc numtheoryservice.Service
+	{
+		svc = numtheoryservice.NewBasicService()
+		svc = NumberTheoryObservabilityMiddleware(duration, logger)(svc)
+	}
+	return svc
+}
+
+// NumberTheoryObservabilityMiddleware implements both logging and prometheus
+// metrics for each numtheoryservice.Service method. The methods are observed
+// as NumberTheory.<Method>.
+func NumberTheoryObservabilityMiddleware(duration metrics.Histogram, logger log.Logger) numtheoryservice.Middleware {
+	return func(next numtheoryservice.Service) numtheoryservice.Service {
+		return numberTheoryObservabilityMiddleware{duration, logger, next}
+	}
+}
+
+type numberTheoryObservabilityMiddleware struct {
+	duration metrics.Histogram
+	logger   log.Logger
+	next     numtheoryservice.Service
+}
+
+// observeMethodExecution observes a call of method, logging the given
+// keyvals with its request formatted with %+v.
+func (mw numberTheoryObservabilityMiddleware) observeMethodExecution(ctx context.Context, method string, begin time.Time, err error, keyvals ...interface{}) {
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		switch v := keyvals[i+1].(type) {
+		case numtheoryservice.Primality:
+			keyvals[i], keyvals[i+1] = "prime", v.Prime
+		case numtheoryservice.Integer:
+			keyvals[i+1] = string(v)
+		case []numtheoryservice.Factor:
+			keyvals[i+1] = fmt.Sprintf("%+v", v)
+		default:
+			keyvals[i], keyvals[i+1] = "request", fmt.Sprintf("%+v", v)
+		}
+	}
+	observe(mw.logger, mw.duration, method, begin, err, keyvals...)
+}
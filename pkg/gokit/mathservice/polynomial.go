@@ -0,0 +1,53 @@
+package mathservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/jwenz723/mathserver/pkg/polyservice"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -service Polynomial -type polyObservabilityMiddleware -o polynomial_gen.go
+
+// NewPolynomial returns a basic polyservice.Service with all of the expected
+// middlewares wired in.
+func NewPolynomial(duration metrics.Histogram, logger log.Logger) polyservice.Service {
+	var svc polyservice.Service
+	{
+		svc = polyservice.NewBasicService()
+		svc = PolynomialObservabilityMiddleware(duration, logger)(svc)
+	}
+	return svc
+}
+
+// PolynomialObservabilityMiddleware implements both logging and prometheus
+// metrics for each polyservice.Service method. The methods are observed as
+// Polynomial.<Method>, and the number of coefficients of their operands is
+// logged rather than the coefficients.
+func PolynomialObservabilityMiddleware(duration metrics.Histogram, logger log.Logger) polyservice.Middleware {
+	return func(next polyservice.Service) polyservice.Service {
+		return polyObservabilityMiddleware{duration, logger, next}
+	}
+}
+
+type polyObservabilityMiddleware struct {
+	duration metrics.Histogram
+	logger   log.Logger
+	next     polyservice.Service
+}
+
+// observeMethodExecution observes a call of method, logging the given
+// keyvals with the number of coefficients or roots of its polynomials.
+func (mw polyObservabilityMiddleware) observeMethodExecution(ctx context.Context, method string, begin time.Time, err error, keyvals ...interface{}) {
+	for i := 1; i < len(keyvals); i += 2 {
+		switch v := keyvals[i].(type) {
+		case []float64:
+			keyvals[i] = vector(v)
+		case []complex128:
+			keyvals[i] = len(v)
+		}
+	}
+	observe(mw.logger, mw.duration, method, begin, err, keyvals...)
+}
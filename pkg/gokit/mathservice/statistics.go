@@ -0,0 +1,57 @@
+package mathservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/jwenz723/mathserver/pkg/statsservice"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind middleware -service Statistics -type statsObservabilityMiddleware -o statistics_gen.go
+
+// NewStatistics returns a basic statsservice.Service with all of the expected
+// middlewares wired in.
+func NewStatistics(duration metrics.Histogram, logger log.Logger) statsservice.Service {
+	var svc statsservice.Service
+	{
+		svc = statsservice.NewBasicService()
+		svc = StatisticsObservabilityMiddleware(duration, logger)(svc)
+	}
+	return svc
+}
+
+// StatisticsObservabilityMiddleware implements both logging and prometheus
+// metrics for each statsservice.Service method. The methods are observed as
+// Statistics.<Method>, and the number of values of the datasets is logged
+// rather than the values.
+func StatisticsObservabilityMiddleware(duration metrics.Histogram, logger log.Logger) statsservice.Middleware {
+	return func(next statsservice.Service) statsservice.Service {
+		return statsObservabilityMiddleware{duration, logger, next}
+	}
+}
+
+type statsObservabilityMiddleware struct {
+	duration metrics.Histogram
+	logger   log.Logger
+	next     statsservice.Service
+}
+
+// observeMethodExecution observes a call of method, logging the given
+// keyvals with the number of values of its datasets.
+func (mw statsObservabilityMiddleware) observeMethodExecution(ctx context.Context, method string, begin time.Time, err error, keyvals ...interface{}) {
+	var kv []interface{}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		switch v := keyvals[i+1].(type) {
+		case []float64:
+			kv = append(kv, keyvals[i], len(v))
+		case statsservice.Options:
+			kv = append(kv, "quantiles", fmt.Sprint(v.Quantiles), "interpolation", v.Interpolation, "buckets", v.Buckets)
+		case statsservice.Correlation:
+			kv = append(kv, "v", v.Correlation)
+		}
+	}
+	observe(mw.logger, mw.duration, method, begin, err, kv...)
+}
@@ -0,0 +1,140 @@
+// Package jsonfloat encodes float64 values in JSON, which has no
+// representation for NaN and the infinities. They are encoded as the strings
+// "NaN", "Infinity" and "-Infinity", the way JavaScript's Number parses them,
+// so that the HTTP servers can return every result of the service.
+package jsonfloat
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// The strings encoding the non-finite values.
+const (
+	NaN         = "NaN"
+	Infinity    = "Infinity"
+	NegInfinity = "-Infinity"
+)
+
+// Float64 is a float64 that may be non-finite when encoded in JSON.
+type Float64 float64
+
+// String returns the string encoding f if it's non-finite, and f formatted
+// like encoding/json does otherwise.
+func (f Float64) String() string {
+	v := float64(f)
+	switch {
+	case math.IsNaN(v):
+		return NaN
+	case math.IsInf(v, 1):
+		return Infinity
+	case math.IsInf(v, -1):
+		return NegInfinity
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// MarshalJSON implements json.Marshaler, encoding non-finite values as
+// strings and others as numbers.
+func (f Float64) MarshalJSON() ([]byte, error) {
+	v := float64(f)
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return json.Marshal(f.String())
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting a number or one of the
+// strings encoding a non-finite value.
+func (f *Float64) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	if len(b) == 0 || b[0] != '"' {
+		var v float64
+		if err := json.Unmarshal(b, &v); err != nil {
+			return err
+		}
+		*f = Float64(v)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	switch s {
+	case NaN:
+		*f = Float64(math.NaN())
+	case Infinity:
+		*f = Float64(math.Inf(1))
+	case NegInfinity:
+		*f = Float64(math.Inf(-1))
+	default:
+		return fmt.Errorf("jsonfloat: invalid number %q", s)
+	}
+	return nil
+}
+
+// Complex128 is a complex128 encoded in JSON as an object holding its real
+// and imaginary parts, e.g. {"real":1,"imag":-2}, either of which may be
+// non-finite.
+type Complex128 complex128
+
+// complexParts is the JSON encoding of a Complex128.
+type complexParts struct {
+	Real Float64 `json:"real"`
+	Imag Float64 `json:"imag"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Complex128) MarshalJSON() ([]byte, error) {
+	v := complex128(c)
+	return json.Marshal(complexParts{Real: Float64(real(v)), Imag: Float64(imag(v))})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, a missing part is zero.
+func (c *Complex128) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var p complexParts
+	if err := json.Unmarshal(b, &p); err != nil {
+		return err
+	}
+	*c = Complex128(complex(float64(p.Real), float64(p.Imag)))
+	return nil
+}
+
+// Slice is a []float64 whose elements may be non-finite when encoded in JSON.
+type Slice []float64
+
+// MarshalJSON implements json.Marshaler, encoding s as an array of Float64.
+func (s Slice) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("[]"), nil
+	}
+	f := make([]Float64, len(s))
+	for i, v := range s {
+		f[i] = Float64(v)
+	}
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding an array of Float64.
+func (s *Slice) UnmarshalJSON(b []byte) error {
+	var f []Float64
+	if err := json.Unmarshal(b, &f); err != nil {
+		return err
+	}
+	if f == nil {
+		*s = nil
+		return nil
+	}
+	*s = make(Slice, len(f))
+	for i, v := range f {
+		(*s)[i] = float64(v)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package linalgservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+)
+
+// Matrix is a matrix of Rows rows and Cols columns whose Values are stored in
+// row-major order, so the element at row i and column j is
+// Values[i*Cols+j].
+type Matrix struct {
+	Rows, Cols int
+	Values     []float64
+}
+
+// NewMatrix returns a zero matrix of the given dimensions.
+func NewMatrix(rows, cols int) Matrix {
+	return Matrix{Rows: rows, Cols: cols, Values: make([]float64, rows*cols)}
+}
+
+// At returns the element at row i and column j.
+func (m Matrix) At(i, j int) float64 { return m.Values[i*m.Cols+j] }
+
+// Set sets the element at row i and column j to v.
+func (m Matrix) Set(i, j int, v float64) { m.Values[i*m.Cols+j] = v }
+
+// Dims returns the dimensions of m, e.g. 2x3.
+func (m Matrix) Dims() string { return fmt.Sprintf("%dx%d", m.Rows, m.Cols) }
+
+func (m Matrix) clone() Matrix {
+	return Matrix{Rows: m.Rows, Cols: m.Cols, Values: append([]float64(nil), m.Values...)}
+}
+
+func (m Matrix) maxAbs() float64 {
+	var v float64
+	for _, x := range m.Values {
+		v = math.Max(v, math.Abs(x))
+	}
+	return v
+}
+
+// pivot returns the row at or below row k with the greatest absolute value
+// in column k.
+func (m Matrix) pivot(k int) int {
+	p := k
+	for i := k + 1; i < m.Rows; i++ {
+		if math.Abs(m.At(i, k)) > math.Abs(m.At(p, k)) {
+			p = i
+		}
+	}
+	return p
+}
+
+func (m Matrix) swapRows(i, j int) {
+	if i == j {
+		return
+	}
+	for c := 0; c < m.Cols; c++ {
+		a, b := m.At(i, c), m.At(j, c)
+		m.Set(i, c, b)
+		m.Set(j, c, a)
+	}
+}
+
+// eliminate zeroes column k below row k by subtracting multiples of row k,
+// applying the same row operations to rhs if it isn't nil.
+func (m Matrix) eliminate(k int, rhs *Matrix) {
+	for i := k + 1; i < m.Rows; i++ {
+		f := m.At(i, k) / m.At(k, k)
+		if f == 0 {
+			continue
+		}
+		for j := k; j < m.Cols; j++ {
+			m.Set(i, j, m.At(i, j)-f*m.At(k, j))
+		}
+		if rhs != nil {
+			for j := 0; j < rhs.Cols; j++ {
+				rhs.Set(i, j, rhs.At(i, j)-f*rhs.At(k, j))
+			}
+		}
+	}
+}
+
+// matrixJSON is the JSON encoding of a Matrix, e.g.
+// {"rows":2,"cols":2,"values":[1,2,3,4]}, whose values may be non-finite.
+type matrixJSON struct {
+	Rows   int             `json:"rows"`
+	Cols   int             `json:"cols"`
+	Values jsonfloat.Slice `json:"values"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m Matrix) MarshalJSON() ([]byte, error) {
+	return json.Marshal(matrixJSON{Rows: m.Rows, Cols: m.Cols, Values: m.Values})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *Matrix) UnmarshalJSON(b []byte) error {
+	var j matrixJSON
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	*m = Matrix{Rows: j.Rows, Cols: j.Cols, Values: j.Values}
+	return nil
+}
+
+// FromProto converts a gRPC matrix to a Matrix, nil is the empty matrix.
+func FromProto(m *pb.Matrix) Matrix {
+	return Matrix{Rows: int(m.GetRows()), Cols: int(m.GetCols()), Values: m.GetValues()}
+}
+
+// Proto converts m to a gRPC matrix.
+func (m Matrix) Proto() *pb.Matrix {
+	return &pb.Matrix{Rows: uint32(m.Rows), Cols: uint32(m.Cols), Values: m.Values}
+}
@@ -0,0 +1,236 @@
+// Package linalgservice is the core of the LinearAlgebra service, the vector
+// and matrix operations.
+package linalgservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Service describes a service that performs vector and matrix operations.
+// Implementations may be wrapped by a Middleware, e.g. to log and measure
+// each call.
+type Service interface {
+	// Dot returns the dot product of the vectors a and b
+	Dot(ctx context.Context, a, b []float64) (float64, error)
+	// Cross returns the cross product of the 3-dimensional vectors a and b
+	Cross(ctx context.Context, a, b []float64) ([]float64, error)
+	// Norm returns the Euclidean norm of the vector a
+	Norm(ctx context.Context, a []float64) (float64, error)
+	// Add returns the sum of the matrices a and b
+	Add(ctx context.Context, a, b Matrix) (Matrix, error)
+	// Multiply returns the matrix product ab
+	Multiply(ctx context.Context, a, b Matrix) (Matrix, error)
+	// Transpose returns the transpose of the matrix a
+	Transpose(ctx context.Context, a Matrix) (Matrix, error)
+	// Determinant returns the determinant of the square matrix a
+	Determinant(ctx context.Context, a Matrix) (float64, error)
+	// Inverse returns the inverse of the square matrix a
+	Inverse(ctx context.Context, a Matrix) (Matrix, error)
+	// Solve returns the vector x solving ax=b for the square matrix a
+	Solve(ctx context.Context, a Matrix, b []float64) ([]float64, error)
+}
+
+// Middleware describes a service (as opposed to endpoint) middleware.
+type Middleware func(Service) Service
+
+// Errors returned by the basic Service, which transports map to their wire
+// representation, see pb.ErrorCode. Errors about the dimensions of the
+// operands wrap one of them with a message describing the dimensions.
+var (
+	ErrDimensionMismatch = errors.New("dimension mismatch")
+	ErrMalformedMatrix   = errors.New("malformed matrix")
+	ErrNotSquare         = errors.New("matrix isn't square")
+	ErrSingular          = errors.New("matrix is singular")
+)
+
+// NewBasicService returns a naïve, stateless implementation of Service.
+// Determinant, Inverse and Solve use Gaussian elimination with partial
+// pivoting.
+func NewBasicService() Service {
+	return basicService{}
+}
+
+type basicService struct{}
+
+func (basicService) Dot(_ context.Context, a, b []float64) (float64, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("%w: a has %d elements but b has %d", ErrDimensionMismatch, len(a), len(b))
+	}
+	var v float64
+	for i := range a {
+		v += a[i] * b[i]
+	}
+	return v, nil
+}
+
+func (basicService) Cross(_ context.Context, a, b []float64) ([]float64, error) {
+	if len(a) != 3 || len(b) != 3 {
+		return nil, fmt.Errorf("%w: a and b must have 3 elements, they have %d and %d", ErrDimensionMismatch, len(a), len(b))
+	}
+	return []float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}, nil
+}
+
+func (basicService) Norm(_ context.Context, a []float64) (float64, error) {
+	var v float64
+	for _, x := range a {
+		v = math.Hypot(v, x)
+	}
+	return v, nil
+}
+
+func (basicService) Add(_ context.Context, a, b Matrix) (Matrix, error) {
+	if err := validate(a, b); err != nil {
+		return Matrix{}, err
+	}
+	if a.Rows != b.Rows || a.Cols != b.Cols {
+		return Matrix{}, fmt.Errorf("%w: a is %v but b is %v", ErrDimensionMismatch, a.Dims(), b.Dims())
+	}
+	v := NewMatrix(a.Rows, a.Cols)
+	for i := range a.Values {
+		v.Values[i] = a.Values[i] + b.Values[i]
+	}
+	return v, nil
+}
+
+func (basicService) Multiply(_ context.Context, a, b Matrix) (Matrix, error) {
+	if err := validate(a, b); err != nil {
+		return Matrix{}, err
+	}
+	if a.Cols != b.Rows {
+		return Matrix{}, fmt.Errorf("%w: a is %v so b must have %d rows, it is %v", ErrDimensionMismatch, a.Dims(), a.Cols, b.Dims())
+	}
+	v := NewMatrix(a.Rows, b.Cols)
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < b.Cols; j++ {
+			var x float64
+			for k := 0; k < a.Cols; k++ {
+				x += a.At(i, k) * b.At(k, j)
+			}
+			v.Set(i, j, x)
+		}
+	}
+	return v, nil
+}
+
+func (basicService) Transpose(_ context.Context, a Matrix) (Matrix, error) {
+	if err := validate(a); err != nil {
+		return Matrix{}, err
+	}
+	v := NewMatrix(a.Cols, a.Rows)
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			v.Set(j, i, a.At(i, j))
+		}
+	}
+	return v, nil
+}
+
+func (basicService) Determinant(_ context.Context, a Matrix) (float64, error) {
+	if err := validateSquare(a); err != nil {
+		return 0, err
+	}
+	lu := a.clone()
+	det := 1.0
+	for k := 0; k < lu.Rows; k++ {
+		p := lu.pivot(k)
+		if lu.At(p, k) == 0 {
+			return 0, nil
+		}
+		if p != k {
+			lu.swapRows(p, k)
+			det = -det
+		}
+		det *= lu.At(k, k)
+		lu.eliminate(k, nil)
+	}
+	return det, nil
+}
+
+func (basicService) Inverse(_ context.Context, a Matrix) (Matrix, error) {
+	if err := validateSquare(a); err != nil {
+		return Matrix{}, err
+	}
+	n := a.Rows
+	inv := NewMatrix(n, n)
+	for i := 0; i < n; i++ {
+		inv.Set(i, i, 1)
+	}
+	if err := solve(a.clone(), inv); err != nil {
+		return Matrix{}, err
+	}
+	return inv, nil
+}
+
+func (basicService) Solve(_ context.Context, a Matrix, b []float64) ([]float64, error) {
+	if err := validateSquare(a); err != nil {
+		return nil, err
+	}
+	if len(b) != a.Rows {
+		return nil, fmt.Errorf("%w: a is %v so b must have %d elements, it has %d", ErrDimensionMismatch, a.Dims(), a.Rows, len(b))
+	}
+	x := Matrix{Rows: len(b), Cols: 1, Values: append([]float64(nil), b...)}
+	if err := solve(a.clone(), x); err != nil {
+		return nil, err
+	}
+	return x.Values, nil
+}
+
+// solve overwrites rhs with a⁻¹·rhs by reducing the square matrix a to an
+// upper triangular matrix, applying the same row operations to rhs, and back
+// substituting. a is modified. A pivot that is negligible compared to the
+// elements of a makes a singular.
+func solve(a, rhs Matrix) error {
+	tolerance := float64(a.Rows) * epsilon * a.maxAbs()
+	for k := 0; k < a.Rows; k++ {
+		p := a.pivot(k)
+		if math.Abs(a.At(p, k)) <= tolerance {
+			return ErrSingular
+		}
+		a.swapRows(p, k)
+		rhs.swapRows(p, k)
+		a.eliminate(k, &rhs)
+	}
+	// back substitution, a is now upper triangular
+	for k := a.Rows - 1; k >= 0; k-- {
+		d := a.At(k, k)
+		for j := 0; j < rhs.Cols; j++ {
+			rhs.Set(k, j, rhs.At(k, j)/d)
+		}
+		for i := 0; i < k; i++ {
+			f := a.At(i, k)
+			for j := 0; j < rhs.Cols; j++ {
+				rhs.Set(i, j, rhs.At(i, j)-f*rhs.At(k, j))
+			}
+		}
+	}
+	return nil
+}
+
+// epsilon is the difference between 1 and the next float64.
+const epsilon = 0x1p-52
+
+func validate(m ...Matrix) error {
+	for _, m := range m {
+		if m.Rows < 0 || m.Cols < 0 || len(m.Values) != m.Rows*m.Cols {
+			return fmt.Errorf("%w: %v matrix with %d values", ErrMalformedMatrix, m.Dims(), len(m.Values))
+		}
+	}
+	return nil
+}
+
+func validateSquare(a Matrix) error {
+	if err := validate(a); err != nil {
+		return err
+	}
+	if a.Rows != a.Cols {
+		return fmt.Errorf("%w: a is %v", ErrNotSquare, a.Dims())
+	}
+	return nil
+}
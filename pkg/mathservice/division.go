@@ -0,0 +1,109 @@
+package mathservice
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/jwenz723/mathserver/pb"
+)
+
+// Division selects how IntDivide rounds a quotient that isn't a whole number,
+// and so the sign of the Remainder and of Mod. Like a precision it's chosen by
+// each request and carried to the Service by the request context.
+type Division int
+
+const (
+	// Truncated rounds the quotient toward zero, so the remainder has the sign
+	// of a. It's the default, as in Go.
+	Truncated Division = iota
+	// Floored rounds the quotient toward negative infinity, so the remainder
+	// has the sign of b.
+	Floored
+)
+
+var divisionNames = map[Division]string{
+	Truncated: "truncated",
+	Floored:   "floored",
+}
+
+func (d Division) String() string {
+	if s, ok := divisionNames[d]; ok {
+		return s
+	}
+	return fmt.Sprintf("Division(%d)", int(d))
+}
+
+// ParseDivision returns the Division named by s, e.g. "floored".
+func ParseDivision(s string) (Division, error) {
+	for d, name := range divisionNames {
+		if strings.EqualFold(s, name) {
+			return d, nil
+		}
+	}
+	return Truncated, fmt.Errorf("unknown division %q", s)
+}
+
+// MarshalText implements encoding.TextMarshaler so divisions are encoded by
+// name in JSON.
+func (d Division) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Division) UnmarshalText(text []byte) error {
+	v, err := ParseDivision(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// DivisionFromProto converts a gRPC division to a Division. A division this
+// server doesn't know is kept as is, so that the operations using it fail
+// with ErrInvalidDivision rather than picking another one.
+func DivisionFromProto(d pb.Division) Division {
+	return Division(d)
+}
+
+// Proto converts d to a gRPC division.
+func (d Division) Proto() pb.Division {
+	return pb.Division(d)
+}
+
+type divisionKey struct{}
+
+// NewDivisionContext returns a context carrying d.
+func NewDivisionContext(ctx context.Context, d Division) context.Context {
+	return context.WithValue(ctx, divisionKey{}, d)
+}
+
+// DivisionFromContext returns the Division carried by ctx, Truncated if there
+// isn't one.
+func DivisionFromContext(ctx context.Context) Division {
+	d, _ := ctx.Value(divisionKey{}).(Division)
+	return d
+}
+
+// divisionFromContext returns the Division carried by ctx, failing with
+// ErrInvalidDivision if it's none of the known divisions.
+func divisionFromContext(ctx context.Context) (Division, error) {
+	d := DivisionFromContext(ctx)
+	if _, ok := divisionNames[d]; !ok {
+		return d, ErrInvalidDivision
+	}
+	return d, nil
+}
+
+// divide returns the quotient and remainder of x/y, which must not be zero,
+// with the quotient rounded as selected by d.
+func divide(x, y *big.Int, d Division) (q, r *big.Int) {
+	q, r = new(big.Int).QuoRem(x, y, new(big.Int))
+	if d == Floored && r.Sign() != 0 && r.Sign() != y.Sign() {
+		q.Sub(q, big.NewInt(1))
+		r.Add(r, y)
+	}
+	return q, r
+}
@@ -0,0 +1,109 @@
+package mathservice
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+	"github.com/jwenz723/mathserver/pkg/precision"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind nonfinite -o nonfinite_gen.go
+
+// NonFinitePolicy selects what happens to the results of the service that are
+// NaN or infinite, e.g. Pow(-8, 1/3.0) or Pow(10, 400). Unlike a precision
+// it's chosen by the server rather than by each request.
+type NonFinitePolicy int
+
+const (
+	// PassNonFinite returns non-finite results as they are. It's the default.
+	PassNonFinite NonFinitePolicy = iota
+	// RejectNonFinite fails the operations with a non-finite result with
+	// ErrNonFinite.
+	RejectNonFinite
+	// StringNonFinite returns non-finite results as they are, and also as the
+	// exact result "NaN", "Infinity" or "-Infinity" so that clients reading
+	// the exact result see them too.
+	StringNonFinite
+)
+
+var nonFinitePolicyNames = map[NonFinitePolicy]string{
+	PassNonFinite:   "pass",
+	RejectNonFinite: "reject",
+	StringNonFinite: "string",
+}
+
+func (p NonFinitePolicy) String() string {
+	if s, ok := nonFinitePolicyNames[p]; ok {
+		return s
+	}
+	return fmt.Sprintf("NonFinitePolicy(%d)", int(p))
+}
+
+// ParseNonFinitePolicy returns the NonFinitePolicy named by s, e.g. "reject".
+func ParseNonFinitePolicy(s string) (NonFinitePolicy, error) {
+	for p, name := range nonFinitePolicyNames {
+		if strings.EqualFold(s, name) {
+			return p, nil
+		}
+	}
+	return PassNonFinite, fmt.Errorf("unknown non-finite policy %q", s)
+}
+
+// Set implements flag.Value so a NonFinitePolicy can be configured with a
+// command line flag.
+func (p *NonFinitePolicy) Set(s string) error {
+	v, err := ParseNonFinitePolicy(s)
+	if err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
+
+// NonFiniteMiddleware returns a Middleware applying policy to the result of
+// every operation. A result that was computed exactly at an arbitrary
+// precision and only overflows float64 is left alone, its exact result is
+// finite.
+func NonFiniteMiddleware(policy NonFinitePolicy) Middleware {
+	return func(next Service) Service {
+		if policy == PassNonFinite {
+			return next
+		}
+		return nonFiniteMiddleware{policy: policy, next: next}
+	}
+}
+
+type nonFiniteMiddleware struct {
+	policy NonFinitePolicy
+	next   Service
+}
+
+// apply returns the result v of an operation, or err if it failed, after
+// applying the policy of mw.
+func (mw nonFiniteMiddleware) apply(ctx context.Context, v float64, err error) (float64, error) {
+	if err != nil {
+		return 0, err
+	}
+	_, res, ok := precision.FromContext(ctx)
+	if ok && res.Finite() {
+		return v, nil
+	}
+	if !math.IsNaN(v) && !math.IsInf(v, 0) {
+		if ok && mw.policy == StringNonFinite {
+			// an earlier operation of an expression may have set the
+			// exact result of a float64 computation
+			res.SetString("")
+		}
+		return v, nil
+	}
+	if mw.policy == RejectNonFinite {
+		return 0, ErrNonFinite
+	}
+	if ok {
+		res.SetString(jsonfloat.Float64(v).String())
+	}
+	return v, nil
+}
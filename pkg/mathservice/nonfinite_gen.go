@@ -0,0 +1,215 @@
+// Code generated by mathsvcgen from mathsvc.proto. DO NOT EDIT.
+
+package mathservice
+
+import "context"
+
+func (mw nonFiniteMiddleware) Divide(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.Divide(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Max(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.Max(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Min(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.Min(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Multiply(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.Multiply(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Pow(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.Pow(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Subtract(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.Subtract(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Sum(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.Sum(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) SumAll(ctx context.Context, values []float64) (float64, error) {
+	v, err := mw.next.SumAll(ctx, values)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Product(ctx context.Context, values []float64) (float64, error) {
+	v, err := mw.next.Product(ctx, values)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Mean(ctx context.Context, values []float64) (float64, error) {
+	v, err := mw.next.Mean(ctx, values)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Median(ctx context.Context, values []float64) (float64, error) {
+	v, err := mw.next.Median(ctx, values)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Variance(ctx context.Context, values []float64) (float64, error) {
+	v, err := mw.next.Variance(ctx, values)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) StdDev(ctx context.Context, values []float64) (float64, error) {
+	v, err := mw.next.StdDev(ctx, values)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Mod(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.Mod(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) IntDivide(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.IntDivide(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Remainder(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.Remainder(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Gcd(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.Gcd(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Lcm(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.Lcm(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Sqrt(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Sqrt(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Abs(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Abs(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Negate(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Negate(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Exp(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Exp(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Ln(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Ln(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Log10(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Log10(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Log2(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Log2(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Floor(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Floor(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Ceil(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Ceil(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Round(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Round(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Trunc(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Trunc(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Sin(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Sin(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Cos(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Cos(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Tan(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Tan(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Asin(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Asin(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Acos(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Acos(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Atan(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Atan(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Gamma(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Gamma(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) LogGamma(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.LogGamma(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Beta(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.Beta(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Erf(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Erf(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) Erfc(ctx context.Context, x float64) (float64, error) {
+	v, err := mw.next.Erfc(ctx, x)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) BesselJ(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.BesselJ(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
+
+func (mw nonFiniteMiddleware) BesselY(ctx context.Context, a, b float64) (float64, error) {
+	v, err := mw.next.BesselY(ctx, a, b)
+	return mw.apply(ctx, v, err)
+}
@@ -0,0 +1,547 @@
+// Package mathservice is the core of the Math service shared by every server
+// implementation. Each implementation only adds its own middlewares and
+// transports around a Service, so an operation is added or fixed here once.
+package mathservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/jwenz723/mathserver/pkg/field"
+	"github.com/jwenz723/mathserver/pkg/precision"
+)
+
+//go:generate go run github.com/jwenz723/mathserver/cmd/mathsvcgen -kind service -o service_gen.go
+
+// Middleware describes a service (as opposed to endpoint) middleware.
+type Middleware func(Service) Service
+
+// Errors returned by the basic Service, which transports map to their wire
+// representation, see pb.ErrorCode.
+var (
+	ErrDivideByZero        = errors.New("can't divide by zero")
+	ErrNoMax               = errors.New("no maximum value, a and b are the same")
+	ErrNoMin               = errors.New("no minimum value, a and b are the same")
+	ErrNoValues            = errors.New("no values provided")
+	ErrModuloByZero        = errors.New("can't compute modulo zero")
+	ErrNotInteger          = errors.New("a and b must be integers")
+	ErrNegativeSqrt        = errors.New("can't take the square root of a negative number")
+	ErrNonPositiveLog      = errors.New("can't take the logarithm of a non-positive number")
+	ErrOutOfDomain         = errors.New("x must be between -1 and 1")
+	ErrNonFinite           = errors.New("result is not a finite number")
+	ErrPole                = errors.New("the gamma function has a pole at zero and the negative integers")
+	ErrInvalidOrder        = fmt.Errorf("the order a must be an integer between %d and %d", -MaxOrder, MaxOrder)
+	ErrNonPositiveArgument = errors.New("b must be positive")
+	ErrInvalidDivision     = errors.New("division must be truncated or floored")
+)
+
+// ErrUnknownOperation is the error of a batch or stream item naming an
+// operation that doesn't exist.
+var ErrUnknownOperation = errors.New("unknown operation")
+
+// ErrTooManyItems is the error of a batch with more items than the server
+// allows.
+var ErrTooManyItems = errors.New("too many items")
+
+// MaxOrder is the largest order of the Bessel functions, whose cost grows
+// with the order.
+const MaxOrder = 10000
+
+// NewBasicService returns a naïve, stateless implementation of Service. p is
+// the precision used when a request doesn't specify one, the zero value
+// computes with float64.
+func NewBasicService(p precision.Precision) Service {
+	return basicService{precision: p}
+}
+
+type basicService struct {
+	precision precision.Precision
+}
+
+func (s basicService) Divide(ctx context.Context, a, b float64) (float64, error) {
+	if b == 0 {
+		return 0, ErrDivideByZero
+	}
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Divide(a, b)
+	}
+	return a / b, nil
+}
+
+func (s basicService) Max(ctx context.Context, a, b float64) (float64, error) {
+	if a == b {
+		return 0, ErrNoMax
+	}
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Max(a, b)
+	}
+	return math.Max(a, b), nil
+}
+
+func (s basicService) Min(ctx context.Context, a, b float64) (float64, error) {
+	if a == b {
+		return 0, ErrNoMin
+	}
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Min(a, b)
+	}
+	return math.Min(a, b), nil
+}
+
+func (s basicService) Multiply(ctx context.Context, a, b float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Multiply(a, b)
+	}
+	return a * b, nil
+}
+
+func (s basicService) Pow(ctx context.Context, a, b float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Pow(a, b)
+	}
+	return math.Pow(a, b), nil
+}
+
+func (s basicService) Subtract(ctx context.Context, a, b float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Subtract(a, b)
+	}
+	return a - b, nil
+}
+
+func (s basicService) Sum(ctx context.Context, a, b float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Sum(a, b)
+	}
+	return a + b, nil
+}
+
+func (s basicService) SumAll(ctx context.Context, values []float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.SumAll(values)
+	}
+	return sum(values), nil
+}
+
+func (s basicService) Product(ctx context.Context, values []float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Product(values)
+	}
+	v := 1.0
+	for _, x := range values {
+		v *= x
+	}
+	return v, nil
+}
+
+func (s basicService) Mean(ctx context.Context, values []float64) (float64, error) {
+	if len(values) == 0 {
+		return 0, ErrNoValues
+	}
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Mean(values)
+	}
+	return sum(values) / float64(len(values)), nil
+}
+
+func (s basicService) Median(ctx context.Context, values []float64) (float64, error) {
+	if len(values) == 0 {
+		return 0, ErrNoValues
+	}
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Median(values)
+	}
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2, nil
+	}
+	return sorted[mid], nil
+}
+
+func (s basicService) Variance(ctx context.Context, values []float64) (float64, error) {
+	if len(values) == 0 {
+		return 0, ErrNoValues
+	}
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Variance(values)
+	}
+	return variance(values), nil
+}
+
+func (s basicService) StdDev(ctx context.Context, values []float64) (float64, error) {
+	if len(values) == 0 {
+		return 0, ErrNoValues
+	}
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.StdDev(values)
+	}
+	return math.Sqrt(variance(values)), nil
+}
+
+func (s basicService) Mod(ctx context.Context, a, b float64) (float64, error) {
+	// a modulo b is the remainder of the division selected by the request
+	return s.Remainder(ctx, a, b)
+}
+
+func (s basicService) IntDivide(ctx context.Context, a, b float64) (float64, error) {
+	d, err := divisionFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	c, x, y, err := s.integers(ctx, a, b)
+	if err != nil {
+		return 0, err
+	}
+	if y.Sign() == 0 {
+		return 0, ErrDivideByZero
+	}
+	q, _ := divide(x, y, d)
+	return integer(c, q), nil
+}
+
+func (s basicService) Remainder(ctx context.Context, a, b float64) (float64, error) {
+	d, err := divisionFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	c, x, y, err := s.integers(ctx, a, b)
+	if err != nil {
+		return 0, err
+	}
+	if y.Sign() == 0 {
+		return 0, ErrModuloByZero
+	}
+	_, r := divide(x, y, d)
+	return integer(c, r), nil
+}
+
+func (s basicService) Gcd(ctx context.Context, a, b float64) (float64, error) {
+	c, x, y, err := s.integers(ctx, a, b)
+	if err != nil {
+		return 0, err
+	}
+	return integer(c, gcd(x, y)), nil
+}
+
+func (s basicService) Lcm(ctx context.Context, a, b float64) (float64, error) {
+	c, x, y, err := s.integers(ctx, a, b)
+	if err != nil {
+		return 0, err
+	}
+	if x.Sign() == 0 || y.Sign() == 0 {
+		return integer(c, new(big.Int)), nil
+	}
+	z := new(big.Int).Mul(x, y)
+	z.Abs(z).Quo(z, gcd(x, y))
+	return integer(c, z), nil
+}
+
+func (s basicService) Sqrt(ctx context.Context, x float64) (float64, error) {
+	if x < 0 {
+		return 0, ErrNegativeSqrt
+	}
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Sqrt(x)
+	}
+	return math.Sqrt(x), nil
+}
+
+func (s basicService) Abs(ctx context.Context, x float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Abs(x)
+	}
+	return math.Abs(x), nil
+}
+
+func (s basicService) Negate(ctx context.Context, x float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Negate(x)
+	}
+	return -x, nil
+}
+
+func (s basicService) Exp(ctx context.Context, x float64) (float64, error) {
+	return s.transcendental(ctx, math.Exp, x)
+}
+
+func (s basicService) Ln(ctx context.Context, x float64) (float64, error) {
+	if x <= 0 {
+		return 0, ErrNonPositiveLog
+	}
+	return s.transcendental(ctx, math.Log, x)
+}
+
+func (s basicService) Log10(ctx context.Context, x float64) (float64, error) {
+	if x <= 0 {
+		return 0, ErrNonPositiveLog
+	}
+	return s.transcendental(ctx, math.Log10, x)
+}
+
+func (s basicService) Log2(ctx context.Context, x float64) (float64, error) {
+	if x <= 0 {
+		return 0, ErrNonPositiveLog
+	}
+	return s.transcendental(ctx, math.Log2, x)
+}
+
+func (s basicService) Floor(ctx context.Context, x float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Floor(x)
+	}
+	return math.Floor(x), nil
+}
+
+func (s basicService) Ceil(ctx context.Context, x float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Ceil(x)
+	}
+	return math.Ceil(x), nil
+}
+
+func (s basicService) Round(ctx context.Context, x float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Round(x)
+	}
+	return math.Round(x), nil
+}
+
+func (s basicService) Trunc(ctx context.Context, x float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return c.Trunc(x)
+	}
+	return math.Trunc(x), nil
+}
+
+func (s basicService) Sin(ctx context.Context, x float64) (float64, error) {
+	return s.transcendental(ctx, math.Sin, x)
+}
+
+func (s basicService) Cos(ctx context.Context, x float64) (float64, error) {
+	return s.transcendental(ctx, math.Cos, x)
+}
+
+func (s basicService) Tan(ctx context.Context, x float64) (float64, error) {
+	return s.transcendental(ctx, math.Tan, x)
+}
+
+func (s basicService) Asin(ctx context.Context, x float64) (float64, error) {
+	if x < -1 || x > 1 {
+		return 0, ErrOutOfDomain
+	}
+	return s.transcendental(ctx, math.Asin, x)
+}
+
+func (s basicService) Acos(ctx context.Context, x float64) (float64, error) {
+	if x < -1 || x > 1 {
+		return 0, ErrOutOfDomain
+	}
+	return s.transcendental(ctx, math.Acos, x)
+}
+
+func (s basicService) Atan(ctx context.Context, x float64) (float64, error) {
+	return s.transcendental(ctx, math.Atan, x)
+}
+
+func (s basicService) Gamma(ctx context.Context, x float64) (float64, error) {
+	if isPole(x) {
+		return 0, field.Error("x", ErrPole)
+	}
+	return s.transcendental(ctx, math.Gamma, x)
+}
+
+func (s basicService) LogGamma(ctx context.Context, x float64) (float64, error) {
+	if isPole(x) {
+		return 0, field.Error("x", ErrPole)
+	}
+	return s.transcendental(ctx, func(x float64) float64 {
+		v, _ := math.Lgamma(x)
+		return v
+	}, x)
+}
+
+func (s basicService) Beta(ctx context.Context, a, b float64) (float64, error) {
+	if isPole(a) {
+		return 0, field.Error("a", ErrPole)
+	}
+	if isPole(b) {
+		return 0, field.Error("b", ErrPole)
+	}
+	return s.transcendental(ctx, func(float64) float64 { return beta(a, b) }, a)
+}
+
+func (s basicService) Erf(ctx context.Context, x float64) (float64, error) {
+	return s.transcendental(ctx, math.Erf, x)
+}
+
+func (s basicService) Erfc(ctx context.Context, x float64) (float64, error) {
+	return s.transcendental(ctx, math.Erfc, x)
+}
+
+func (s basicService) BesselJ(ctx context.Context, a, b float64) (float64, error) {
+	n, err := order(a)
+	if err != nil {
+		return 0, err
+	}
+	return s.transcendental(ctx, func(x float64) float64 { return math.Jn(n, x) }, b)
+}
+
+func (s basicService) BesselY(ctx context.Context, a, b float64) (float64, error) {
+	n, err := order(a)
+	if err != nil {
+		return 0, err
+	}
+	if !(b > 0) {
+		return 0, ErrNonPositiveArgument
+	}
+	return s.transcendental(ctx, func(x float64) float64 { return math.Yn(n, x) }, b)
+}
+
+// transcendental returns f(x). Transcendental functions are only computed
+// with float64, their results are irrational for almost every rational x and
+// math/big has no arbitrary-precision implementation of them, so they fail
+// with precision.ErrNotRepresentable when an arbitrary precision is requested.
+func (s basicService) transcendental(ctx context.Context, f func(float64) float64, x float64) (float64, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		return 0, precision.ErrNotRepresentable
+	}
+	return f(x), nil
+}
+
+// integers returns a and b as integers, or ErrNotInteger if one of them isn't
+// a whole number. The Calculator returned when an arbitrary precision was
+// requested records the exact result, see integer.
+func (s basicService) integers(ctx context.Context, a, b float64) (*precision.Calculator, *big.Int, *big.Int, error) {
+	if c := precision.CalculatorFromContext(ctx, s.precision); c != nil {
+		ints, ok, err := c.Integers(a, b)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if !ok {
+			return nil, nil, nil, ErrNotInteger
+		}
+		return c, ints[0], ints[1], nil
+	}
+	for _, v := range []float64{a, b} {
+		if math.IsInf(v, 0) || math.Trunc(v) != v {
+			return nil, nil, nil, ErrNotInteger
+		}
+	}
+	x, _ := big.NewFloat(a).Int(nil)
+	y, _ := big.NewFloat(b).Int(nil)
+	return nil, x, y, nil
+}
+
+// integer returns z rounded to a float64, recording it as the exact result in
+// c if it isn't nil.
+func integer(c *precision.Calculator, z *big.Int) float64 {
+	if c != nil {
+		return c.Int(z)
+	}
+	v, _ := new(big.Float).SetInt(z).Float64()
+	return v
+}
+
+// isPole reports whether x is a pole of the gamma function: zero or a
+// negative integer.
+func isPole(x float64) bool {
+	return x <= 0 && math.Trunc(x) == x && !math.IsInf(x, 0)
+}
+
+// beta returns Γ(a)Γ(b)/Γ(a+b). It's computed from the gamma function
+// directly while that doesn't overflow, so that it's exact for small integer
+// operands, and from the logarithms of the gamma function otherwise.
+func beta(a, b float64) float64 {
+	if isPole(a + b) {
+		// Γ(a+b) is infinite while Γ(a) and Γ(b) aren't
+		return 0
+	}
+	if a < b {
+		a, b = b, a
+	}
+	if math.IsInf(a, 1) {
+		// B(a, b) tends to Γ(b)a^-b as a grows
+		return math.Gamma(b) * math.Pow(a, -b)
+	}
+	if a >= 1e4 && a+b > 0 {
+		// the logarithms of Γ(a) and Γ(a+b) are too large to be subtracted
+		// without losing the result, so their difference is taken from
+		// Stirling's series instead
+		lb, sb := math.Lgamma(b)
+		d := -(a-0.5)*math.Log1p(b/a) - b*math.Log(a+b) + b + stirling(a) - stirling(a+b)
+		return float64(sb) * math.Exp(lb+d)
+	}
+	if ga, gb, gab := math.Gamma(a), math.Gamma(b), math.Gamma(a+b); !math.IsInf(ga*gb, 0) && !math.IsInf(gab, 0) {
+		return ga * gb / gab
+	}
+	la, sa := math.Lgamma(a)
+	lb, sb := math.Lgamma(b)
+	lab, sab := math.Lgamma(a + b)
+	return float64(sa*sb*sab) * math.Exp(la+lb-lab)
+}
+
+// stirling returns the first terms of the correction of Stirling's
+// approximation of the logarithm of Γ(x), which are enough for x of 1e4 and
+// more.
+func stirling(x float64) float64 {
+	return 1/(12*x) - 1/(360*x*x*x)
+}
+
+// order returns the order a of a Bessel function as an int, or
+// ErrInvalidOrder if it isn't an integer between -MaxOrder and MaxOrder.
+func order(a float64) (int, error) {
+	if math.Trunc(a) != a || math.Abs(a) > MaxOrder {
+		return 0, ErrInvalidOrder
+	}
+	return int(a), nil
+}
+
+// gcd returns the greatest common divisor of x and y, which is never
+// negative. The gcd of 0 and y is |y|.
+func gcd(x, y *big.Int) *big.Int {
+	x, y = new(big.Int).Abs(x), new(big.Int).Abs(y)
+	if x.Sign() == 0 {
+		return y
+	}
+	if y.Sign() == 0 {
+		return x
+	}
+	return x.GCD(nil, nil, x, y)
+}
+
+// variance returns the population variance of values.
+func variance(values []float64) float64 {
+	mean := sum(values) / float64(len(values))
+	squares := make([]float64, len(values))
+	for i, x := range values {
+		squares[i] = (x - mean) * (x - mean)
+	}
+	return sum(squares) / float64(len(values))
+}
+
+// sum adds up values using Neumaier's variant of Kahan summation, which
+// carries a compensation term so precision isn't lost on long lists.
+func sum(values []float64) float64 {
+	var s, c float64
+	for _, x := range values {
+		t := s + x
+		if math.Abs(s) >= math.Abs(x) {
+			c += (s - t) + x
+		} else {
+			c += (x - t) + s
+		}
+		s = t
+	}
+	if math.IsInf(s, 0) || math.IsNaN(s) {
+		// the compensation term is meaningless once the sum is non-finite
+		return s
+	}
+	return s + c
+}
@@ -0,0 +1,155 @@
+package mathservice_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+)
+
+// twoTo1100 is the exact result of Pow(2, 1100), which overflows float64.
+var twoTo1100 = new(big.Int).Lsh(big.NewInt(1), 1100).String()
+
+func TestListOperations(t *testing.T) {
+	svc := mathservice.NewBasicService(precision.Precision{})
+	tenths := []float64{0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1}
+	for _, tc := range []struct {
+		name   string
+		op     func(context.Context, []float64) (float64, error)
+		values []float64
+		want   float64
+		err    error
+	}{
+		// the naïve sums are 0 and 0.9999999999999999
+		{"sum all cancelling", svc.SumAll, []float64{1e100, 1, -1e100}, 1, nil},
+		{"sum all tenths", svc.SumAll, tenths, 1, nil},
+		{"sum all none", svc.SumAll, nil, 0, nil},
+		{"product", svc.Product, []float64{2, 3, 4}, 24, nil},
+		{"product none", svc.Product, nil, 1, nil},
+		{"mean", svc.Mean, []float64{1, 2, 3, 4}, 2.5, nil},
+		{"mean none", svc.Mean, nil, 0, mathservice.ErrNoValues},
+		{"median odd", svc.Median, []float64{3, 1, 2}, 2, nil},
+		{"median even", svc.Median, []float64{4, 1, 3, 2}, 2.5, nil},
+		{"median none", svc.Median, nil, 0, mathservice.ErrNoValues},
+		{"variance", svc.Variance, []float64{2, 4, 4, 4, 5, 5, 7, 9}, 4, nil},
+		{"variance none", svc.Variance, nil, 0, mathservice.ErrNoValues},
+		{"std dev", svc.StdDev, []float64{2, 4, 4, 4, 5, 5, 7, 9}, 2, nil},
+		{"std dev none", svc.StdDev, nil, 0, mathservice.ErrNoValues},
+	} {
+		v, err := tc.op(context.Background(), tc.values)
+		if v != tc.want || !errors.Is(err, tc.err) || (tc.err == nil && err != nil) {
+			t.Errorf("%s: got %v, %v, want %v, %v", tc.name, v, err, tc.want, tc.err)
+		}
+	}
+}
+
+// TestPrecision checks that the precision requested by the context, or else
+// the default of the service, selects the arithmetic, and that the exact
+// result is recorded in the context.
+func TestPrecision(t *testing.T) {
+	var (
+		float64P = precision.Precision{Mode: precision.Float64}
+		rational = precision.Precision{Mode: precision.Rational}
+		bigFloat = precision.Precision{Mode: precision.BigFloat, Bits: 64}
+	)
+	for _, tc := range []struct {
+		name      string
+		def, p    precision.Precision
+		op        func(mathservice.Service, context.Context) (float64, error)
+		want      float64
+		wantExact string
+	}{
+		{"multiply float64", float64P, precision.Precision{}, multiply, 0.30000000000000004, ""},
+		{"multiply rational", float64P, rational, multiply, 0.3, "0.3"},
+		{"multiply rational default", rational, precision.Precision{}, multiply, 0.3, "0.3"},
+		{"multiply float64 over default", rational, float64P, multiply, 0.30000000000000004, ""},
+		{"multiply bigfloat", float64P, bigFloat, multiply, 0.3, "0.3"},
+		{"divide rational", float64P, rational, func(s mathservice.Service, ctx context.Context) (float64, error) { return s.Divide(ctx, 1, 3) }, 1.0 / 3, "1/3"},
+		{"sum all rational", float64P, rational, func(s mathservice.Service, ctx context.Context) (float64, error) {
+			return s.SumAll(ctx, []float64{0.1, 0.2, -0.3})
+		}, 0, "0"},
+		{"pow overflow rational", float64P, rational, func(s mathservice.Service, ctx context.Context) (float64, error) { return s.Pow(ctx, 2, 1100) }, math.Inf(1), twoTo1100},
+	} {
+		ctx, res := precision.NewContext(context.Background(), tc.p)
+		v, err := tc.op(mathservice.NewBasicService(tc.def), ctx)
+		if err != nil || v != tc.want || res.String() != tc.wantExact {
+			t.Errorf("%s: got %v (exact %q), %v, want %v (exact %q)", tc.name, v, res.String(), err, tc.want, tc.wantExact)
+		}
+	}
+}
+
+func multiply(s mathservice.Service, ctx context.Context) (float64, error) {
+	return s.Multiply(ctx, 0.1, 3)
+}
+
+func TestDivision(t *testing.T) {
+	svc := mathservice.NewBasicService(precision.Precision{})
+	for _, tc := range []struct {
+		a, b    float64
+		d       mathservice.Division
+		q, r, m float64
+	}{
+		{7, 2, mathservice.Truncated, 3, 1, 1},
+		{-7, 2, mathservice.Truncated, -3, -1, -1},
+		{7, -2, mathservice.Truncated, -3, 1, 1},
+		{-7, -2, mathservice.Truncated, 3, -1, -1},
+		{-7, 2, mathservice.Floored, -4, 1, 1},
+		{7, -2, mathservice.Floored, -4, -1, -1},
+		{-7, -2, mathservice.Floored, 3, -1, -1},
+		{-6, 2, mathservice.Floored, -3, 0, 0},
+	} {
+		ctx := mathservice.NewDivisionContext(context.Background(), tc.d)
+		q, err := svc.IntDivide(ctx, tc.a, tc.b)
+		if err != nil || q != tc.q {
+			t.Errorf("IntDivide(%v, %v) %v: got %v, %v, want %v", tc.a, tc.b, tc.d, q, err, tc.q)
+		}
+		r, err := svc.Remainder(ctx, tc.a, tc.b)
+		if err != nil || r != tc.r {
+			t.Errorf("Remainder(%v, %v) %v: got %v, %v, want %v", tc.a, tc.b, tc.d, r, err, tc.r)
+		}
+		m, err := svc.Mod(ctx, tc.a, tc.b)
+		if err != nil || m != tc.m {
+			t.Errorf("Mod(%v, %v) %v: got %v, %v, want %v", tc.a, tc.b, tc.d, m, err, tc.m)
+		}
+	}
+	if _, err := svc.IntDivide(context.Background(), 1, 0); err != mathservice.ErrDivideByZero {
+		t.Errorf("IntDivide by zero: got %v", err)
+	}
+	if _, err := svc.Remainder(context.Background(), 1, 0); err != mathservice.ErrModuloByZero {
+		t.Errorf("Remainder by zero: got %v", err)
+	}
+	if _, err := svc.IntDivide(context.Background(), 1.5, 1); err != mathservice.ErrNotInteger {
+		t.Errorf("IntDivide of 1.5: got %v", err)
+	}
+	unknown := mathservice.NewDivisionContext(context.Background(), mathservice.DivisionFromProto(7))
+	if _, err := svc.Mod(unknown, 7, 2); err != mathservice.ErrInvalidDivision {
+		t.Errorf("Mod with an unknown division: got %v", err)
+	}
+}
+
+func TestNonFiniteMiddleware(t *testing.T) {
+	for _, tc := range []struct {
+		policy    mathservice.NonFinitePolicy
+		p         precision.Precision
+		want      float64
+		wantExact string
+		err       error
+	}{
+		{mathservice.PassNonFinite, precision.Precision{}, math.Inf(1), "", nil},
+		{mathservice.RejectNonFinite, precision.Precision{}, 0, "", mathservice.ErrNonFinite},
+		{mathservice.StringNonFinite, precision.Precision{}, math.Inf(1), "Infinity", nil},
+		// the exact result is finite
+		{mathservice.RejectNonFinite, precision.Precision{Mode: precision.Rational}, math.Inf(1), twoTo1100, nil},
+	} {
+		svc := mathservice.NonFiniteMiddleware(tc.policy)(mathservice.NewBasicService(precision.Precision{}))
+		ctx, res := precision.NewContext(context.Background(), tc.p)
+		v, err := svc.Pow(ctx, 2, 1100)
+		if v != tc.want || err != tc.err || res.String() != tc.wantExact {
+			t.Errorf("%v in %v: got %v (exact %q), %v, want %v (exact %q), %v", tc.policy, tc.p.Mode, v, res.String(), err, tc.want, tc.wantExact, tc.err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package numtheoryservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultBudgetMS is the compute budget of a request that has none, in
+	// milliseconds.
+	DefaultBudgetMS = 1000
+	// MaxBudgetMS is the largest compute budget of a request.
+	MaxBudgetMS = 10000
+)
+
+// tickInterval is the number of steps between two looks at the clock.
+const tickInterval = 64
+
+// meter tracks the time a call spends against its compute budget. The
+// loops of the basic service tick it at every step, and give up when it
+// returns an error.
+type meter struct {
+	ctx      context.Context
+	budget   time.Duration
+	deadline time.Time
+	steps    int
+}
+
+// newMeter starts the budget of budgetMS milliseconds of a call made with
+// ctx, DefaultBudgetMS when it's 0.
+func newMeter(ctx context.Context, budgetMS int64) (*meter, error) {
+	if budgetMS < 0 || budgetMS > MaxBudgetMS {
+		return nil, fmt.Errorf("%w %dms, it must be between 0 and %dms", ErrInvalidBudget, budgetMS, MaxBudgetMS)
+	}
+	if budgetMS == 0 {
+		budgetMS = DefaultBudgetMS
+	}
+	budget := time.Duration(budgetMS) * time.Millisecond
+	return &meter{ctx: ctx, budget: budget, deadline: time.Now().Add(budget)}, nil
+}
+
+// tick counts a step, failing once the budget is spent or the call is
+// canceled.
+func (m *meter) tick() error {
+	m.steps++
+	if m.steps%tickInterval != 0 {
+		return nil
+	}
+	if err := m.ctx.Err(); err != nil {
+		return err
+	}
+	if time.Now().After(m.deadline) {
+		return fmt.Errorf("%w of %v after %d steps", ErrBudgetExceeded, m.budget, m.steps)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package numtheoryservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/jwenz723/mathserver/pkg/field"
+)
+
+// Integer is an integer written in decimal with an optional sign, e.g.
+// "97" or "-12". The empty Integer is 0.
+type Integer string
+
+// MaxBits is the largest number of bits of an operand.
+const MaxBits = 4096
+
+// maxDigits is the number of decimal digits of the largest operand, checked
+// before parsing so that a huge operand isn't converted only to be rejected.
+const maxDigits = MaxBits*30103/100000 + 1
+
+var integerSyntax = regexp.MustCompile(`^[+-]?\d+$`)
+
+// UnmarshalJSON implements json.Unmarshaler. An Integer may be encoded as a
+// JSON string or, since its digits are kept as they're written, as a number.
+func (i *Integer) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*i = Integer(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	*i = Integer(n)
+	return nil
+}
+
+// parse returns the value of i, name is the request field holding i.
+func (i Integer) parse(name string) (*big.Int, error) {
+	s := strings.TrimSpace(string(i))
+	if s == "" {
+		return new(big.Int), nil
+	}
+	if !integerSyntax.MatchString(s) {
+		return nil, field.Error(name, fmt.Errorf("%w %s %q", ErrInvalidInteger, name, string(i)))
+	}
+	digits := strings.TrimLeft(s, "+-0")
+	if len(digits) > maxDigits {
+		return nil, field.Error(name, fmt.Errorf("%w, %s has %d digits", ErrOperandTooLarge, name, len(digits)))
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, field.Error(name, fmt.Errorf("%w %s %q", ErrInvalidInteger, name, string(i)))
+	}
+	if n.BitLen() > MaxBits {
+		return nil, field.Error(name, fmt.Errorf("%w, %s has %d bits", ErrOperandTooLarge, name, n.BitLen()))
+	}
+	return n, nil
+}
+
+// parsePositive is parse for the operands that must be at least 1.
+func (i Integer) parsePositive(name string) (*big.Int, error) {
+	n, err := i.parse(name)
+	if err != nil {
+		return nil, err
+	}
+	if n.Sign() <= 0 {
+		return nil, field.Error(name, fmt.Errorf("%w, %s is %s", ErrNotPositive, name, n))
+	}
+	return n, nil
+}
+
+// integer returns n as an Integer.
+func integer(n *big.Int) Integer {
+	return Integer(n.String())
+}
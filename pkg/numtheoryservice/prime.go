@@ -0,0 +1,162 @@
+package numtheoryservice
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// smallPrimes are the primes below 1000, divided out by trial division
+// before anything cleverer is tried.
+var smallPrimes = func() []uint64 {
+	var primes []uint64
+	composite := make([]bool, 1000)
+	for i := uint64(2); i < 1000; i++ {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for j := i * i; j < 1000; j += i {
+			composite[j] = true
+		}
+	}
+	return primes
+}()
+
+// millerRabinBases are enough witnesses for Miller-Rabin to be deterministic
+// below 2^64.
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// probablePrimeRounds is the number of Miller-Rabin rounds of the test of the
+// integers of 64 bits or more, on top of the Baillie-PSW test big.Int runs.
+const probablePrimeRounds = 20
+
+// primality reports whether n is prime and, if it is, whether that's only
+// probable.
+func primality(n *big.Int) (prime, probable bool) {
+	if n.Sign() <= 0 {
+		return false, false
+	}
+	if n.IsUint64() {
+		return isPrime64(n.Uint64()), false
+	}
+	if n.ProbablyPrime(probablePrimeRounds) {
+		return true, true
+	}
+	return false, false
+}
+
+// isPrime64 is a deterministic Miller-Rabin test.
+func isPrime64(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	for _, p := range millerRabinBases {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+	d, s := n-1, 0
+	for d%2 == 0 {
+		d /= 2
+		s++
+	}
+witnesses:
+	for _, a := range millerRabinBases {
+		x := powMod64(a, d, n)
+		if x == 1 || x == n-1 {
+			continue
+		}
+		for i := 1; i < s; i++ {
+			x = mulMod64(x, x, n)
+			if x == n-1 {
+				continue witnesses
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// mulMod64 returns a*b mod m without overflowing.
+func mulMod64(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, rem := bits.Div64(hi%m, lo, m)
+	return rem
+}
+
+// powMod64 returns a^e mod m.
+func powMod64(a, e, m uint64) uint64 {
+	r := uint64(1)
+	a %= m
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			r = mulMod64(r, a, m)
+		}
+		a = mulMod64(a, a, m)
+	}
+	return r
+}
+
+// rho returns a non-trivial factor of n, an odd composite that isn't a
+// prime power of a small prime, using Brent's variant of Pollard's rho
+// method. It fails with the error of m once the budget is spent.
+func rho(n *big.Int, m *meter) (*big.Int, error) {
+	const batch = 128
+	var (
+		one = big.NewInt(1)
+		x   = new(big.Int)
+		y   = new(big.Int)
+		ys  = new(big.Int)
+		q   = new(big.Int)
+		d   = new(big.Int)
+		t   = new(big.Int)
+	)
+	f := func(z, c *big.Int) {
+		z.Mul(z, z)
+		z.Add(z, c)
+		z.Mod(z, n)
+	}
+	for c := int64(1); ; c++ {
+		cc := big.NewInt(c)
+		y.SetInt64(2)
+		q.SetInt64(1)
+		d.SetInt64(1)
+		for r := 1; d.Cmp(one) == 0; r *= 2 {
+			x.Set(y)
+			for i := 0; i < r; i++ {
+				if err := m.tick(); err != nil {
+					return nil, err
+				}
+				f(y, cc)
+			}
+			for k := 0; k < r && d.Cmp(one) == 0; k += batch {
+				ys.Set(y)
+				for i := 0; i < batch && i < r-k; i++ {
+					if err := m.tick(); err != nil {
+						return nil, err
+					}
+					f(y, cc)
+					q.Mul(q, t.Abs(t.Sub(x, y)))
+					q.Mod(q, n)
+				}
+				d.GCD(nil, nil, q, n)
+			}
+		}
+		if d.Cmp(n) == 0 {
+			// the batch overshot, retrace it one step at a time
+			for {
+				if err := m.tick(); err != nil {
+					return nil, err
+				}
+				f(ys, cc)
+				d.GCD(nil, nil, t.Abs(t.Sub(x, ys)), n)
+				if d.Cmp(one) != 0 {
+					break
+				}
+			}
+		}
+		if d.Cmp(n) != 0 {
+			return d, nil
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package numtheoryservice
+
+import (
+	"github.com/jwenz723/mathserver/pb"
+)
+
+// OperandFromProto converts a gRPC integer request to an Operand.
+func OperandFromProto(r *pb.IntegerRequest) Operand {
+	return Operand{N: Integer(r.GetN()), BudgetMS: int64(r.GetBudgetMs())}
+}
+
+// Proto converts o to a gRPC integer request.
+func (o Operand) Proto() *pb.IntegerRequest {
+	return &pb.IntegerRequest{N: string(o.N), BudgetMs: uint32(o.BudgetMS)}
+}
+
+// ModPowOperandsFromProto converts a gRPC ModPow request to ModPowOperands.
+func ModPowOperandsFromProto(r *pb.ModPowRequest) ModPowOperands {
+	return ModPowOperands{
+		Base:     Integer(r.GetBase()),
+		Exponent: Integer(r.GetExponent()),
+		Modulus:  Integer(r.GetModulus()),
+		BudgetMS: int64(r.GetBudgetMs()),
+	}
+}
+
+// Proto converts o to a gRPC ModPow request.
+func (o ModPowOperands) Proto() *pb.ModPowRequest {
+	return &pb.ModPowRequest{
+		Base:     string(o.Base),
+		Exponent: string(o.Exponent),
+		Modulus:  string(o.Modulus),
+		BudgetMs: uint32(o.BudgetMS),
+	}
+}
+
+// ModInverseOperandsFromProto converts a gRPC ModInverse request to
+// ModInverseOperands.
+func ModInverseOperandsFromProto(r *pb.ModInverseRequest) ModInverseOperands {
+	return ModInverseOperands{A: Integer(r.GetA()), Modulus: Integer(r.GetModulus()), BudgetMS: int64(r.GetBudgetMs())}
+}
+
+// Proto converts o to a gRPC ModInverse request.
+func (o ModInverseOperands) Proto() *pb.ModInverseRequest {
+	return &pb.ModInverseRequest{A: string(o.A), Modulus: string(o.Modulus), BudgetMs: uint32(o.BudgetMS)}
+}
+
+// FactorsFromProto converts the factors of a gRPC factors reply to Factors.
+func FactorsFromProto(factors []*pb.Factor) []Factor {
+	fs := make([]Factor, len(factors))
+	for i, f := range factors {
+		fs[i] = Factor{Prime: Integer(f.GetPrime()), Exponent: int(f.GetExponent())}
+	}
+	return fs
+}
+
+// FactorsProto converts factors to the factors of a gRPC factors reply.
+func FactorsProto(factors []Factor) []*pb.Factor {
+	fs := make([]*pb.Factor, len(factors))
+	for i, f := range factors {
+		fs[i] = &pb.Factor{Prime: string(f.Prime), Exponent: uint32(f.Exponent)}
+	}
+	return fs
+}
@@ -0,0 +1,300 @@
+// Package numtheoryservice is the core of the NumberTheory service, integer
+// arithmetic.
+package numtheoryservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// Service describes a service that computes with integers. Implementations
+// may be wrapped by a Middleware, e.g. to log and measure each call.
+//
+// Operands are Integers of up to MaxBits bits, computed with big.Int. Every
+// request has a compute budget, so that e.g. factorizing the product of two
+// large primes gives up with ErrBudgetExceeded instead of keeping a server
+// goroutine busy for years.
+type Service interface {
+	// IsPrime reports whether the operand is prime
+	IsPrime(ctx context.Context, o Operand) (Primality, error)
+	// Factorize returns the prime factors of the operand
+	Factorize(ctx context.Context, o Operand) ([]Factor, error)
+	// ModPow returns base^exponent mod modulus
+	ModPow(ctx context.Context, o ModPowOperands) (Integer, error)
+	// ModInverse returns the inverse of a mod modulus
+	ModInverse(ctx context.Context, o ModInverseOperands) (Integer, error)
+	// EulerPhi returns the number of integers up to the operand coprime with it
+	EulerPhi(ctx context.Context, o Operand) (Integer, error)
+	// NextPrime returns the smallest prime greater than the operand
+	NextPrime(ctx context.Context, o Operand) (Integer, error)
+}
+
+// Middleware describes a service (as opposed to endpoint) middleware.
+type Middleware func(Service) Service
+
+// Errors returned by the basic Service, which transports map to their wire
+// representation, see pb.ErrorCode.
+var (
+	ErrInvalidInteger  = errors.New("invalid integer")
+	ErrOperandTooLarge = fmt.Errorf("operands may have at most %d bits", MaxBits)
+	ErrNotPositive     = errors.New("operand must be positive")
+	ErrNoInverse       = errors.New("operand has no inverse, it isn't coprime with the modulus")
+	ErrInvalidBudget   = errors.New("invalid compute budget")
+	ErrBudgetExceeded  = errors.New("exceeded the compute budget")
+)
+
+// Operand is the operand N of the methods taking one. Like the operands of
+// every method, it's computed with for at most BudgetMS milliseconds,
+// DefaultBudgetMS when it's 0.
+type Operand struct {
+	N        Integer `json:"n"`
+	BudgetMS int64   `json:"budget_ms"`
+}
+
+// ModPowOperands are the operands of ModPow, see Operand.
+type ModPowOperands struct {
+	Base     Integer `json:"base"`
+	Exponent Integer `json:"exponent"`
+	Modulus  Integer `json:"modulus"`
+	BudgetMS int64   `json:"budget_ms"`
+}
+
+// ModInverseOperands are the operands of ModInverse, see Operand.
+type ModInverseOperands struct {
+	A        Integer `json:"a"`
+	Modulus  Integer `json:"modulus"`
+	BudgetMS int64   `json:"budget_ms"`
+}
+
+// Primality is the result of IsPrime. Probable is set when Prime was
+// established by a probabilistic test, which happens for operands of 64 bits
+// or more.
+type Primality struct {
+	Prime    bool `json:"prime"`
+	Probable bool `json:"probable"`
+}
+
+// Factor is a Prime dividing an integer Exponent times.
+type Factor struct {
+	Prime    Integer `json:"prime"`
+	Exponent int     `json:"exponent"`
+}
+
+// NewBasicService returns a naïve, stateless implementation of Service.
+func NewBasicService() Service {
+	return basicService{}
+}
+
+type basicService struct{}
+
+// IsPrime uses a deterministic Miller-Rabin test below 2^64 and big.Int's
+// ProbablyPrime from there on. Integers below 2 aren't prime.
+func (basicService) IsPrime(ctx context.Context, o Operand) (Primality, error) {
+	if _, err := newMeter(ctx, o.BudgetMS); err != nil {
+		return Primality{}, err
+	}
+	n, err := o.N.parse("n")
+	if err != nil {
+		return Primality{}, err
+	}
+	prime, probable := primality(n)
+	return Primality{Prime: prime, Probable: probable}, nil
+}
+
+// Factorize divides out the primes below 1000, then splits what's left with
+// Pollard's rho method until every factor is prime. 1 has no factors.
+func (basicService) Factorize(ctx context.Context, o Operand) ([]Factor, error) {
+	m, err := newMeter(ctx, o.BudgetMS)
+	if err != nil {
+		return nil, err
+	}
+	n, err := o.N.parsePositive("n")
+	if err != nil {
+		return nil, err
+	}
+	primes, err := factorize(n, m)
+	if err != nil {
+		return nil, err
+	}
+	factors := make([]Factor, 0, len(primes))
+	for _, p := range primes {
+		if len(factors) > 0 && factors[len(factors)-1].Prime == integer(p) {
+			factors[len(factors)-1].Exponent++
+			continue
+		}
+		factors = append(factors, Factor{Prime: integer(p), Exponent: 1})
+	}
+	return factors, nil
+}
+
+// ModPow raises the inverse of base to -exponent when exponent is negative,
+// and fails with ErrNoInverse when there's none. The result is between 0 and
+// modulus.
+func (basicService) ModPow(ctx context.Context, o ModPowOperands) (Integer, error) {
+	if _, err := newMeter(ctx, o.BudgetMS); err != nil {
+		return "", err
+	}
+	base, err := o.Base.parse("base")
+	if err != nil {
+		return "", err
+	}
+	exp, err := o.Exponent.parse("exponent")
+	if err != nil {
+		return "", err
+	}
+	mod, err := o.Modulus.parsePositive("modulus")
+	if err != nil {
+		return "", err
+	}
+	if exp.Sign() < 0 {
+		if base, err = inverse(base, mod); err != nil {
+			return "", err
+		}
+		exp.Neg(exp)
+	}
+	return integer(new(big.Int).Exp(new(big.Int).Mod(base, mod), exp, mod)), nil
+}
+
+// ModInverse uses the extended Euclidean algorithm.
+func (basicService) ModInverse(ctx context.Context, o ModInverseOperands) (Integer, error) {
+	if _, err := newMeter(ctx, o.BudgetMS); err != nil {
+		return "", err
+	}
+	a, err := o.A.parse("a")
+	if err != nil {
+		return "", err
+	}
+	mod, err := o.Modulus.parsePositive("modulus")
+	if err != nil {
+		return "", err
+	}
+	inv, err := inverse(a, mod)
+	if err != nil {
+		return "", err
+	}
+	return integer(inv), nil
+}
+
+// EulerPhi factorizes n, so it may exceed its budget the same way.
+func (basicService) EulerPhi(ctx context.Context, o Operand) (Integer, error) {
+	m, err := newMeter(ctx, o.BudgetMS)
+	if err != nil {
+		return "", err
+	}
+	n, err := o.N.parsePositive("n")
+	if err != nil {
+		return "", err
+	}
+	primes, err := factorize(n, m)
+	if err != nil {
+		return "", err
+	}
+	// phi(n) = n * product of (1 - 1/p) over the distinct primes p
+	phi := new(big.Int).Set(n)
+	for i, p := range primes {
+		if i > 0 && p.Cmp(primes[i-1]) == 0 {
+			continue
+		}
+		phi.Div(phi, p)
+		phi.Mul(phi, new(big.Int).Sub(p, big.NewInt(1)))
+	}
+	return integer(phi), nil
+}
+
+// NextPrime tests the odd integers following n, skipping those with a small
+// prime factor.
+func (basicService) NextPrime(ctx context.Context, o Operand) (Integer, error) {
+	m, err := newMeter(ctx, o.BudgetMS)
+	if err != nil {
+		return "", err
+	}
+	n, err := o.N.parse("n")
+	if err != nil {
+		return "", err
+	}
+	if n.Cmp(big.NewInt(2)) < 0 {
+		return "2", nil
+	}
+	c := new(big.Int).Add(n, big.NewInt(1))
+	if c.Bit(0) == 0 {
+		c.Add(c, big.NewInt(1))
+	}
+	for two := big.NewInt(2); ; c.Add(c, two) {
+		if err := m.tick(); err != nil {
+			return "", err
+		}
+		if p, ok := smallFactor(c); ok && p.Cmp(c) != 0 {
+			continue
+		}
+		if prime, _ := primality(c); prime {
+			return integer(c), nil
+		}
+	}
+}
+
+// inverse returns the inverse of a mod mod, between 0 and mod.
+func inverse(a, mod *big.Int) (*big.Int, error) {
+	a = new(big.Int).Mod(a, mod)
+	if new(big.Int).GCD(nil, nil, a, mod).Cmp(big.NewInt(1)) != 0 {
+		return nil, fmt.Errorf("%w %s", ErrNoInverse, mod)
+	}
+	if mod.Cmp(big.NewInt(1)) == 0 {
+		return new(big.Int), nil
+	}
+	return new(big.Int).ModInverse(a, mod), nil
+}
+
+// smallFactor returns the smallest prime below 1000 dividing n, if any.
+func smallFactor(n *big.Int) (*big.Int, bool) {
+	r := new(big.Int)
+	for _, p := range smallPrimes {
+		bp := new(big.Int).SetUint64(p)
+		if r.Mod(n, bp).Sign() == 0 {
+			return bp, true
+		}
+	}
+	return nil, false
+}
+
+// factorize returns the prime factors of n >= 1 in increasing order, each
+// as many times as it divides n.
+func factorize(n *big.Int, m *meter) ([]*big.Int, error) {
+	var primes []*big.Int
+	n = new(big.Int).Set(n)
+	q, r := new(big.Int), new(big.Int)
+	for _, p := range smallPrimes {
+		bp := new(big.Int).SetUint64(p)
+		for {
+			if q.QuoRem(n, bp, r); r.Sign() != 0 {
+				break
+			}
+			primes = append(primes, bp)
+			n.Set(q)
+		}
+		if err := m.tick(); err != nil {
+			return nil, err
+		}
+	}
+	pending := []*big.Int{n}
+	for len(pending) > 0 {
+		c := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if c.Cmp(big.NewInt(1)) == 0 {
+			continue
+		}
+		if prime, _ := primality(c); prime {
+			primes = append(primes, c)
+			continue
+		}
+		d, err := rho(c, m)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, d, new(big.Int).Quo(c, d))
+	}
+	sort.Slice(primes, func(i, j int) bool { return primes[i].Cmp(primes[j]) < 0 })
+	return primes, nil
+}
@@ -0,0 +1,75 @@
+package numtheoryservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pkg/numtheoryservice"
+)
+
+func TestService(t *testing.T) {
+	svc := numtheoryservice.NewBasicService()
+	n := func(v numtheoryservice.Integer) numtheoryservice.Operand { return numtheoryservice.Operand{N: v} }
+	for _, tc := range []struct {
+		name string
+		call func(ctx context.Context) (interface{}, error)
+		want string
+		err  error
+	}{
+		{"is prime", func(ctx context.Context) (interface{}, error) { return svc.IsPrime(ctx, n("97")) }, "{true false}", nil},
+		{"is prime composite", func(ctx context.Context) (interface{}, error) { return svc.IsPrime(ctx, n("91")) }, "{false false}", nil},
+		{"is prime one", func(ctx context.Context) (interface{}, error) { return svc.IsPrime(ctx, n("1")) }, "{false false}", nil},
+		{"is prime negative", func(ctx context.Context) (interface{}, error) { return svc.IsPrime(ctx, n("-7")) }, "{false false}", nil},
+		{"is prime mersenne", func(ctx context.Context) (interface{}, error) {
+			return svc.IsPrime(ctx, n("170141183460469231731687303715884105727"))
+		}, "{true true}", nil},
+		{"is prime invalid", func(ctx context.Context) (interface{}, error) { return svc.IsPrime(ctx, n("1.5")) }, "", numtheoryservice.ErrInvalidInteger},
+		{"is prime too large", func(ctx context.Context) (interface{}, error) {
+			return svc.IsPrime(ctx, n(numtheoryservice.Integer("1"+strings.Repeat("0", numtheoryservice.MaxBits))))
+		}, "", numtheoryservice.ErrOperandTooLarge},
+		{"factorize", func(ctx context.Context) (interface{}, error) { return svc.Factorize(ctx, n("360")) }, "[{2 3} {3 2} {5 1}]", nil},
+		{"factorize one", func(ctx context.Context) (interface{}, error) { return svc.Factorize(ctx, n("1")) }, "[]", nil},
+		{"factorize semiprime", func(ctx context.Context) (interface{}, error) { return svc.Factorize(ctx, n("10403")) }, "[{101 1} {103 1}]", nil},
+		{"factorize zero", func(ctx context.Context) (interface{}, error) { return svc.Factorize(ctx, n("0")) }, "", numtheoryservice.ErrNotPositive},
+		{"mod pow", func(ctx context.Context) (interface{}, error) {
+			return svc.ModPow(ctx, numtheoryservice.ModPowOperands{Base: "4", Exponent: "13", Modulus: "497"})
+		}, "445", nil},
+		{"mod pow negative exponent", func(ctx context.Context) (interface{}, error) {
+			return svc.ModPow(ctx, numtheoryservice.ModPowOperands{Base: "3", Exponent: "-1", Modulus: "11"})
+		}, "4", nil},
+		{"mod pow no inverse", func(ctx context.Context) (interface{}, error) {
+			return svc.ModPow(ctx, numtheoryservice.ModPowOperands{Base: "2", Exponent: "-1", Modulus: "4"})
+		}, "", numtheoryservice.ErrNoInverse},
+		{"mod pow zero modulus", func(ctx context.Context) (interface{}, error) {
+			return svc.ModPow(ctx, numtheoryservice.ModPowOperands{Base: "2", Exponent: "3", Modulus: "0"})
+		}, "", numtheoryservice.ErrNotPositive},
+		{"mod inverse", func(ctx context.Context) (interface{}, error) {
+			return svc.ModInverse(ctx, numtheoryservice.ModInverseOperands{A: "3", Modulus: "11"})
+		}, "4", nil},
+		{"mod inverse negative", func(ctx context.Context) (interface{}, error) {
+			return svc.ModInverse(ctx, numtheoryservice.ModInverseOperands{A: "-3", Modulus: "11"})
+		}, "7", nil},
+		{"mod inverse none", func(ctx context.Context) (interface{}, error) {
+			return svc.ModInverse(ctx, numtheoryservice.ModInverseOperands{A: "6", Modulus: "9"})
+		}, "", numtheoryservice.ErrNoInverse},
+		{"euler phi", func(ctx context.Context) (interface{}, error) { return svc.EulerPhi(ctx, n("36")) }, "12", nil},
+		{"euler phi one", func(ctx context.Context) (interface{}, error) { return svc.EulerPhi(ctx, n("1")) }, "1", nil},
+		{"euler phi negative", func(ctx context.Context) (interface{}, error) { return svc.EulerPhi(ctx, n("-5")) }, "", numtheoryservice.ErrNotPositive},
+		{"next prime", func(ctx context.Context) (interface{}, error) { return svc.NextPrime(ctx, n("13")) }, "17", nil},
+		{"next prime negative", func(ctx context.Context) (interface{}, error) { return svc.NextPrime(ctx, n("-10")) }, "2", nil},
+		{"invalid budget", func(ctx context.Context) (interface{}, error) {
+			return svc.NextPrime(ctx, numtheoryservice.Operand{N: "13", BudgetMS: -1})
+		}, "", numtheoryservice.ErrInvalidBudget},
+	} {
+		v, err := tc.call(context.Background())
+		switch {
+		case !errors.Is(err, tc.err) || (tc.err == nil && err != nil):
+			t.Errorf("%s: got %v, want %v", tc.name, err, tc.err)
+		case err == nil && fmt.Sprint(v) != tc.want:
+			t.Errorf("%s: got %v, want %s", tc.name, v, tc.want)
+		}
+	}
+}
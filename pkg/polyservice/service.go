@@ -0,0 +1,245 @@
+// Package polyservice is the core of the Polynomial service, the operations on
+// polynomials.
+package polyservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"sort"
+)
+
+// Service describes a service that performs operations on polynomials.
+// Implementations may be wrapped by a Middleware, e.g. to log and measure
+// each call.
+//
+// A polynomial is the slice of its coefficients in increasing order of
+// degree, so []float64{1, 0, 2} is 1+2x². The polynomials returned by the
+// basic Service have no trailing zero coefficients, the zero polynomial is
+// empty.
+type Service interface {
+	// Evaluate returns the value of a at x, computed with Horner's method
+	Evaluate(ctx context.Context, a []float64, x float64) (float64, error)
+	// Add returns a+b
+	Add(ctx context.Context, a, b []float64) ([]float64, error)
+	// Multiply returns a*b
+	Multiply(ctx context.Context, a, b []float64) ([]float64, error)
+	// Derivative returns the derivative of a
+	Derivative(ctx context.Context, a []float64) ([]float64, error)
+	// Integral returns the antiderivative of a whose constant term is 0
+	Integral(ctx context.Context, a []float64) ([]float64, error)
+	// Roots returns the roots of a, each repeated as many times as its
+	// multiplicity and sorted by real then imaginary part. The iteration
+	// stops once no root moves by more than tolerance, relative to its
+	// modulus when that's greater than 1, and a root whose imaginary part is
+	// within the same tolerance is returned as real. A tolerance of 0
+	// selects DefaultTolerance.
+	Roots(ctx context.Context, a []float64, tolerance float64) ([]complex128, error)
+}
+
+// Middleware describes a service (as opposed to endpoint) middleware.
+type Middleware func(Service) Service
+
+// Errors returned by the basic Service, which transports map to their wire
+// representation, see pb.ErrorCode.
+var (
+	ErrZeroPolynomial   = errors.New("every number is a root of the zero polynomial")
+	ErrInvalidTolerance = errors.New("tolerance must be a non-negative number")
+	ErrNoConvergence    = errors.New("roots didn't converge")
+)
+
+// DefaultTolerance is the tolerance Roots uses when it's given 0. The roots
+// converge quadratically once they're close, so a simple root is usually
+// much more accurate than the tolerance, a multiple root only about as
+// accurate.
+const DefaultTolerance = 1e-6
+
+// maxIterations bounds the number of iterations of Roots.
+const maxIterations = 1000
+
+// NewBasicService returns a naïve, stateless implementation of Service.
+// Roots uses the Durand-Kerner method.
+func NewBasicService() Service {
+	return basicService{}
+}
+
+type basicService struct{}
+
+func (basicService) Evaluate(_ context.Context, a []float64, x float64) (float64, error) {
+	a = trim(a)
+	if len(a) == 0 {
+		return 0, nil
+	}
+	// start from the leading coefficient rather than 0, 0*x is NaN when x
+	// is infinite
+	v := a[len(a)-1]
+	for i := len(a) - 2; i >= 0; i-- {
+		v = v*x + a[i]
+	}
+	return v, nil
+}
+
+func (basicService) Add(_ context.Context, a, b []float64) ([]float64, error) {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	v := append([]float64(nil), a...)
+	for i, x := range b {
+		v[i] += x
+	}
+	return trim(v), nil
+}
+
+func (basicService) Multiply(_ context.Context, a, b []float64) ([]float64, error) {
+	a, b = trim(a), trim(b)
+	if len(a) == 0 || len(b) == 0 {
+		return []float64{}, nil
+	}
+	v := make([]float64, len(a)+len(b)-1)
+	for i, x := range a {
+		for j, y := range b {
+			v[i+j] += x * y
+		}
+	}
+	return trim(v), nil
+}
+
+func (basicService) Derivative(_ context.Context, a []float64) ([]float64, error) {
+	a = trim(a)
+	if len(a) == 0 {
+		return []float64{}, nil
+	}
+	v := make([]float64, len(a)-1)
+	for i := range v {
+		v[i] = float64(i+1) * a[i+1]
+	}
+	return trim(v), nil
+}
+
+func (basicService) Integral(_ context.Context, a []float64) ([]float64, error) {
+	a = trim(a)
+	if len(a) == 0 {
+		return []float64{}, nil
+	}
+	v := make([]float64, len(a)+1)
+	for i, x := range a {
+		v[i+1] = x / float64(i+1)
+	}
+	return trim(v), nil
+}
+
+func (basicService) Roots(_ context.Context, a []float64, tolerance float64) ([]complex128, error) {
+	if !(tolerance >= 0) || math.IsInf(tolerance, 1) {
+		return nil, fmt.Errorf("%w, it is %v", ErrInvalidTolerance, tolerance)
+	}
+	if tolerance == 0 {
+		tolerance = DefaultTolerance
+	}
+	a = trim(a)
+	if len(a) == 0 {
+		return nil, ErrZeroPolynomial
+	}
+	for _, x := range a {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return nil, fmt.Errorf("%w: coefficients must be finite", ErrNoConvergence)
+		}
+	}
+
+	// factor out the roots at 0 so they're exact
+	var zeros int
+	for a[zeros] == 0 {
+		zeros++
+	}
+	roots := make([]complex128, zeros)
+	if len(a)-zeros > 1 {
+		r, err := durandKerner(a[zeros:], tolerance)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, r...)
+	}
+	for i, z := range roots {
+		if math.Abs(imag(z)) <= tolerance*math.Max(1, cmplx.Abs(z)) {
+			roots[i] = complex(real(z), 0)
+		}
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		if real(roots[i]) != real(roots[j]) {
+			return real(roots[i]) < real(roots[j])
+		}
+		return imag(roots[i]) < imag(roots[j])
+	})
+	return roots, nil
+}
+
+// durandKerner returns the roots of a, which has no trailing zero
+// coefficients, by refining approximations of every root simultaneously
+// until none moves by more than tolerance.
+func durandKerner(a []float64, tolerance float64) ([]complex128, error) {
+	n := len(a) - 1
+	// monic coefficients, and the Cauchy bound on the modulus of the roots
+	monic := make([]complex128, len(a))
+	bound := 0.0
+	for i, x := range a {
+		monic[i] = complex(x/a[n], 0)
+		if i < n {
+			bound = math.Max(bound, math.Abs(x/a[n]))
+		}
+	}
+	bound++
+
+	// start on a circle of radius bound, away from the real axis so the
+	// iteration can reach complex roots
+	roots := make([]complex128, n)
+	for i := range roots {
+		roots[i] = cmplx.Rect(bound, 2*math.Pi*float64(i)/float64(n)+0.4)
+	}
+
+	for iter := 0; iter < maxIterations; iter++ {
+		converged := true
+		for i, z := range roots {
+			d := complex(1, 0)
+			for j, w := range roots {
+				if j != i {
+					d *= z - w
+				}
+			}
+			if d == 0 {
+				// two approximations met, nudge this one away
+				d = complex(tolerance, tolerance)
+			}
+			delta := horner(monic, z) / d
+			roots[i] = z - delta
+			if cmplx.Abs(delta) > tolerance*math.Max(1, cmplx.Abs(roots[i])) {
+				converged = false
+			}
+		}
+		if converged {
+			return roots, nil
+		}
+	}
+	return nil, fmt.Errorf("%w within %d iterations", ErrNoConvergence, maxIterations)
+}
+
+// horner returns the value of the polynomial a at z.
+func horner(a []complex128, z complex128) complex128 {
+	var v complex128
+	for i := len(a) - 1; i >= 0; i-- {
+		v = v*z + a[i]
+	}
+	return v
+}
+
+// trim returns a without its trailing zero coefficients, never nil.
+func trim(a []float64) []float64 {
+	n := len(a)
+	for n > 0 && a[n-1] == 0 {
+		n--
+	}
+	if n == 0 {
+		return []float64{}
+	}
+	return a[:n]
+}
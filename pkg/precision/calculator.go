@@ -0,0 +1,650 @@
+package precision
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/jwenz723/mathserver/pkg/expr"
+)
+
+// Result records the exact outcome of the operations performed by a
+// Calculator. The same Result may be shared by several operations, as when an
+// expression is evaluated, in which case an operand that was computed by an
+// earlier operation of the expression is replaced by that operation's exact
+// value, see expr.OperationFromContext.
+type Result struct {
+	mu     sync.Mutex
+	last   number
+	set    bool
+	text   string
+	values map[expr.Node]value
+}
+
+// value is the exact value computed by an operation of an expression along
+// with the float64 it was returned as.
+type value struct {
+	v float64
+	n number
+}
+
+// String returns the exact result of the last operation as a decimal string.
+// Rationals that have no finite decimal expansion are formatted as a
+// fraction, e.g. "1/3". It returns "" if no operation was recorded.
+func (r *Result) String() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.set {
+		return r.last.String()
+	}
+	return r.text
+}
+
+// SetString records s as the exact result, it's used by clients to pass
+// along the exact result received from a server.
+func (r *Result) SetString(s string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.set = false
+	r.text = s
+}
+
+// Finite reports whether the exact result of the last operation is finite. It
+// returns false if no operation was recorded.
+func (r *Result) Finite() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.set && (r.last.rat != nil || !r.last.flt.IsInf())
+}
+
+// record records n, returned as v, as the exact result of the last operation,
+// which computes the value of node unless it's nil.
+func (r *Result) record(node expr.Node, v float64, n number) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if node != nil {
+		if r.values == nil {
+			r.values = make(map[expr.Node]value)
+		}
+		r.values[node] = value{v, n}
+	}
+	r.last = n
+	r.set = true
+}
+
+// lookup returns the exact value of node, provided that the value it was
+// returned as is still v: a middleware may have replaced it, such as a
+// non-finite value.
+func (r *Result) lookup(node expr.Node, v float64) (number, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.values[node]
+	if !ok || e.v != v {
+		return number{}, false
+	}
+	return e.n, true
+}
+
+// number is an arbitrary-precision value, exactly one of rat and flt is set.
+type number struct {
+	rat *big.Rat
+	flt *big.Float
+}
+
+func (n number) String() string {
+	if n.rat != nil {
+		return ratString(n.rat)
+	}
+	return n.flt.Text('g', -1)
+}
+
+func (n number) float64() float64 {
+	if n.rat != nil {
+		f, _ := n.rat.Float64()
+		return f
+	}
+	f, _ := n.flt.Float64()
+	return f
+}
+
+// ratString formats r as a decimal if it has a finite decimal expansion and as
+// a fraction otherwise.
+func ratString(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	var (
+		d      = new(big.Int).Set(r.Denom())
+		m      = new(big.Int)
+		two    = big.NewInt(2)
+		five   = big.NewInt(5)
+		digits int
+		twos   int
+		fives  int
+	)
+	for {
+		if q, rem := new(big.Int).QuoRem(d, two, m); rem.Sign() == 0 {
+			d, twos = q, twos+1
+			continue
+		}
+		break
+	}
+	for {
+		if q, rem := new(big.Int).QuoRem(d, five, m); rem.Sign() == 0 {
+			d, fives = q, fives+1
+			continue
+		}
+		break
+	}
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return r.RatString()
+	}
+	digits = twos
+	if fives > digits {
+		digits = fives
+	}
+	return r.FloatString(digits)
+}
+
+// Calculator performs arithmetic at a Precision. Each method returns the
+// result rounded to a float64 and records the exact result in the Result the
+// Calculator was created with.
+//
+// Operands are interpreted as the shortest decimal that round-trips to the
+// given float64, so 0.1 is treated as exactly one tenth, unless they were
+// computed by an earlier operation of the same expression.
+type Calculator struct {
+	p   Precision
+	res *Result
+	op  expr.Operation
+}
+
+// operand returns the first operand x.
+func (c *Calculator) operand(x float64) (number, error) {
+	return c.operandAt(0, x)
+}
+
+// operandAt returns the operand x at index i of the operation.
+func (c *Calculator) operandAt(i int, x float64) (number, error) {
+	if c.p.Mode == BigFloat && c.p.Bits > MaxBits {
+		return number{}, ErrInvalidPrecision
+	}
+	if i < len(c.op.Operands) && c.op.Operands[i] != nil {
+		if n, ok := c.res.lookup(c.op.Operands[i], x); ok {
+			return n, nil
+		}
+	}
+	if math.IsNaN(x) || (c.p.Mode == Rational && math.IsInf(x, 0)) {
+		return number{}, ErrNotRepresentable
+	}
+	if c.p.Mode == Rational {
+		r, _ := new(big.Rat).SetString(strconv.FormatFloat(x, 'g', -1, 64))
+		return number{rat: r}, nil
+	}
+	if math.IsInf(x, 0) {
+		return number{flt: new(big.Float).SetPrec(c.p.Bits).SetInf(x < 0)}, nil
+	}
+	f, _, err := big.ParseFloat(strconv.FormatFloat(x, 'g', -1, 64), 10, c.p.Bits, big.ToNearestEven)
+	if err != nil {
+		return number{}, err
+	}
+	return number{flt: f}, nil
+}
+
+func (c *Calculator) operands(xs ...float64) ([]number, error) {
+	ns := make([]number, len(xs))
+	for i, x := range xs {
+		n, err := c.operandAt(i, x)
+		if err != nil {
+			return nil, err
+		}
+		ns[i] = n
+	}
+	return ns, nil
+}
+
+func (c *Calculator) result(n number) float64 {
+	v := n.float64()
+	c.res.record(c.op.Node, v, n)
+	return v
+}
+
+func (c *Calculator) zero() number {
+	if c.p.Mode == Rational {
+		return number{rat: new(big.Rat)}
+	}
+	return number{flt: new(big.Float).SetPrec(c.p.Bits)}
+}
+
+func (c *Calculator) fromInt(i int64) number {
+	if c.p.Mode == Rational {
+		return number{rat: new(big.Rat).SetInt64(i)}
+	}
+	return number{flt: new(big.Float).SetPrec(c.p.Bits).SetInt64(i)}
+}
+
+// arith applies one of the basic arithmetic operations to x and y. big.Float
+// panics when an operation has no defined result, such as Inf-Inf, so that
+// is recovered and reported as ErrNotRepresentable.
+func (c *Calculator) arith(op byte, x, y number) (n number, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(big.ErrNaN); !ok {
+				panic(r)
+			}
+			err = ErrNotRepresentable
+		}
+	}()
+	z := c.zero()
+	if z.rat != nil {
+		switch op {
+		case '+':
+			z.rat.Add(x.rat, y.rat)
+		case '-':
+			z.rat.Sub(x.rat, y.rat)
+		case '*':
+			z.rat.Mul(x.rat, y.rat)
+		case '/':
+			if y.rat.Sign() == 0 {
+				return number{}, ErrNotRepresentable
+			}
+			z.rat.Quo(x.rat, y.rat)
+		}
+		if bitLen(z.rat) > maxRationalBits {
+			return number{}, ErrNotRepresentable
+		}
+		return z, nil
+	}
+	switch op {
+	case '+':
+		z.flt.Add(x.flt, y.flt)
+	case '-':
+		z.flt.Sub(x.flt, y.flt)
+	case '*':
+		z.flt.Mul(x.flt, y.flt)
+	case '/':
+		z.flt.Quo(x.flt, y.flt)
+	}
+	return z, nil
+}
+
+// bitLen returns the number of bits of the numerator and denominator of r.
+func bitLen(r *big.Rat) int {
+	return r.Num().BitLen() + r.Denom().BitLen()
+}
+
+func cmp(x, y number) int {
+	if x.rat != nil {
+		return x.rat.Cmp(y.rat)
+	}
+	return x.flt.Cmp(y.flt)
+}
+
+func (c *Calculator) binary(op byte, a, b float64) (float64, error) {
+	ns, err := c.operands(a, b)
+	if err != nil {
+		return 0, err
+	}
+	n, err := c.arith(op, ns[0], ns[1])
+	if err != nil {
+		return 0, err
+	}
+	return c.result(n), nil
+}
+
+// Divide returns a/b. The caller is expected to have rejected a zero b.
+func (c *Calculator) Divide(a, b float64) (float64, error) {
+	return c.binary('/', a, b)
+}
+
+// Max returns the greater value of a and b.
+func (c *Calculator) Max(a, b float64) (float64, error) {
+	ns, err := c.operands(a, b)
+	if err != nil {
+		return 0, err
+	}
+	if cmp(ns[0], ns[1]) >= 0 {
+		return c.result(ns[0]), nil
+	}
+	return c.result(ns[1]), nil
+}
+
+// Min returns the lesser value of a and b.
+func (c *Calculator) Min(a, b float64) (float64, error) {
+	ns, err := c.operands(a, b)
+	if err != nil {
+		return 0, err
+	}
+	if cmp(ns[0], ns[1]) <= 0 {
+		return c.result(ns[0]), nil
+	}
+	return c.result(ns[1]), nil
+}
+
+// Multiply returns a*b.
+func (c *Calculator) Multiply(a, b float64) (float64, error) {
+	return c.binary('*', a, b)
+}
+
+// Pow returns a^b. b must be an integer.
+func (c *Calculator) Pow(a, b float64) (float64, error) {
+	if b != math.Trunc(b) || math.IsInf(b, 0) {
+		return 0, ErrNonIntegerExponent
+	}
+	if math.Abs(b) > maxExponent {
+		return 0, ErrExponentTooLarge
+	}
+	x, err := c.operand(a)
+	if err != nil {
+		return 0, err
+	}
+	if x.rat != nil && float64(bitLen(x.rat))*math.Abs(b) > maxRationalBits {
+		// the operand may itself be the result of a pow
+		return 0, ErrExponentTooLarge
+	}
+	e := int64(b)
+	neg := e < 0
+	if neg {
+		e = -e
+	}
+	z := c.fromInt(1)
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			if z, err = c.arith('*', z, x); err != nil {
+				return 0, err
+			}
+		}
+		if e > 1 {
+			if x, err = c.arith('*', x, x); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if neg {
+		if z, err = c.arith('/', c.fromInt(1), z); err != nil {
+			return 0, err
+		}
+	}
+	return c.result(z), nil
+}
+
+// Subtract returns a-b.
+func (c *Calculator) Subtract(a, b float64) (float64, error) {
+	return c.binary('-', a, b)
+}
+
+// Sum returns a+b.
+func (c *Calculator) Sum(a, b float64) (float64, error) {
+	return c.binary('+', a, b)
+}
+
+func (c *Calculator) sum(ns []number) (number, error) {
+	var err error
+	z := c.zero()
+	for _, n := range ns {
+		if z, err = c.arith('+', z, n); err != nil {
+			return number{}, err
+		}
+	}
+	return z, nil
+}
+
+// SumAll returns the sum of values.
+func (c *Calculator) SumAll(values []float64) (float64, error) {
+	ns, err := c.operands(values...)
+	if err != nil {
+		return 0, err
+	}
+	z, err := c.sum(ns)
+	if err != nil {
+		return 0, err
+	}
+	return c.result(z), nil
+}
+
+// Product returns the product of values.
+func (c *Calculator) Product(values []float64) (float64, error) {
+	ns, err := c.operands(values...)
+	if err != nil {
+		return 0, err
+	}
+	z := c.fromInt(1)
+	for _, n := range ns {
+		if z, err = c.arith('*', z, n); err != nil {
+			return 0, err
+		}
+	}
+	return c.result(z), nil
+}
+
+func (c *Calculator) mean(ns []number) (number, error) {
+	s, err := c.sum(ns)
+	if err != nil {
+		return number{}, err
+	}
+	return c.arith('/', s, c.fromInt(int64(len(ns))))
+}
+
+// Mean returns the arithmetic mean of values, which must not be empty.
+func (c *Calculator) Mean(values []float64) (float64, error) {
+	ns, err := c.operands(values...)
+	if err != nil {
+		return 0, err
+	}
+	z, err := c.mean(ns)
+	if err != nil {
+		return 0, err
+	}
+	return c.result(z), nil
+}
+
+// Median returns the median of values, which must not be empty.
+func (c *Calculator) Median(values []float64) (float64, error) {
+	ns, err := c.operands(values...)
+	if err != nil {
+		return 0, err
+	}
+	sort.Slice(ns, func(i, j int) bool { return cmp(ns[i], ns[j]) < 0 })
+	mid := len(ns) / 2
+	if len(ns)%2 == 1 {
+		return c.result(ns[mid]), nil
+	}
+	z, err := c.mean(ns[mid-1 : mid+1])
+	if err != nil {
+		return 0, err
+	}
+	return c.result(z), nil
+}
+
+func (c *Calculator) variance(values []float64) (number, error) {
+	ns, err := c.operands(values...)
+	if err != nil {
+		return number{}, err
+	}
+	mean, err := c.mean(ns)
+	if err != nil {
+		return number{}, err
+	}
+	squares := make([]number, len(ns))
+	for i, n := range ns {
+		d, err := c.arith('-', n, mean)
+		if err != nil {
+			return number{}, err
+		}
+		if squares[i], err = c.arith('*', d, d); err != nil {
+			return number{}, err
+		}
+	}
+	return c.mean(squares)
+}
+
+// Variance returns the population variance of values, which must not be empty.
+func (c *Calculator) Variance(values []float64) (float64, error) {
+	z, err := c.variance(values)
+	if err != nil {
+		return 0, err
+	}
+	return c.result(z), nil
+}
+
+// StdDev returns the population standard deviation of values, which must not
+// be empty. In Rational mode the variance must be the square of a rational.
+func (c *Calculator) StdDev(values []float64) (float64, error) {
+	z, err := c.variance(values)
+	if err != nil {
+		return 0, err
+	}
+	if z, err = c.sqrt(z); err != nil {
+		return 0, err
+	}
+	return c.result(z), nil
+}
+
+// sqrt returns the square root of n, which must not be negative. In Rational
+// mode n must be the square of a rational.
+func (c *Calculator) sqrt(n number) (number, error) {
+	if n.flt != nil {
+		if n.flt.IsInf() {
+			return n, nil
+		}
+		return number{flt: new(big.Float).SetPrec(c.p.Bits).Sqrt(n.flt)}, nil
+	}
+	num, denom := new(big.Int).Sqrt(n.rat.Num()), new(big.Int).Sqrt(n.rat.Denom())
+	root := new(big.Rat).SetFrac(num, denom)
+	if new(big.Rat).Mul(root, root).Cmp(n.rat) != 0 {
+		return number{}, ErrNotRepresentable
+	}
+	return number{rat: root}, nil
+}
+
+// Sqrt returns the square root of x. The caller is expected to have rejected
+// a negative x. In Rational mode x must be the square of a rational.
+func (c *Calculator) Sqrt(x float64) (float64, error) {
+	n, err := c.operand(x)
+	if err != nil {
+		return 0, err
+	}
+	if n, err = c.sqrt(n); err != nil {
+		return 0, err
+	}
+	return c.result(n), nil
+}
+
+// Abs returns the absolute value of x.
+func (c *Calculator) Abs(x float64) (float64, error) {
+	n, err := c.operand(x)
+	if err != nil {
+		return 0, err
+	}
+	z := c.zero()
+	if z.rat != nil {
+		z.rat.Abs(n.rat)
+	} else {
+		z.flt.Abs(n.flt)
+	}
+	return c.result(z), nil
+}
+
+// Negate returns -x.
+func (c *Calculator) Negate(x float64) (float64, error) {
+	n, err := c.operand(x)
+	if err != nil {
+		return 0, err
+	}
+	z := c.zero()
+	if z.rat != nil {
+		z.rat.Neg(n.rat)
+	} else {
+		z.flt.Neg(n.flt)
+	}
+	return c.result(z), nil
+}
+
+// Floor returns the greatest integer less than or equal to x.
+func (c *Calculator) Floor(x float64) (float64, error) {
+	return c.integral(x, func(q, r, denom *big.Int) {
+		if r.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		}
+	})
+}
+
+// Ceil returns the least integer greater than or equal to x.
+func (c *Calculator) Ceil(x float64) (float64, error) {
+	return c.integral(x, func(q, r, denom *big.Int) {
+		if r.Sign() > 0 {
+			q.Add(q, big.NewInt(1))
+		}
+	})
+}
+
+// Round returns the nearest integer to x, rounding half away from zero.
+func (c *Calculator) Round(x float64) (float64, error) {
+	return c.integral(x, func(q, r, denom *big.Int) {
+		if twice := new(big.Int).Lsh(r, 1); twice.CmpAbs(denom) >= 0 {
+			q.Add(q, big.NewInt(int64(r.Sign())))
+		}
+	})
+}
+
+// Trunc returns the integer part of x.
+func (c *Calculator) Trunc(x float64) (float64, error) {
+	return c.integral(x, func(q, r, denom *big.Int) {})
+}
+
+// integral returns x rounded to an integer by adjust, which is given the
+// quotient and remainder of the numerator and denominator of x, the quotient
+// being rounded toward zero. Infinities are returned as they are.
+func (c *Calculator) integral(x float64, adjust func(q, r, denom *big.Int)) (float64, error) {
+	n, err := c.operand(x)
+	if err != nil {
+		return 0, err
+	}
+	r := n.rat
+	if n.flt != nil {
+		if n.flt.IsInf() {
+			return c.result(n), nil
+		}
+		r, _ = n.flt.Rat(nil)
+	}
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	adjust(q, rem, r.Denom())
+	return c.Int(q), nil
+}
+
+// Integers returns xs as integers, ok is false if one of them isn't a whole
+// number. Integer operations compute exactly whatever the precision, they use
+// a Calculator so that their operands are interpreted like those of the other
+// operations and their exact result is recorded.
+func (c *Calculator) Integers(xs ...float64) (ints []*big.Int, ok bool, err error) {
+	ns, err := c.operands(xs...)
+	if err != nil {
+		return nil, false, err
+	}
+	ints = make([]*big.Int, len(ns))
+	for i, n := range ns {
+		if n.rat != nil {
+			if !n.rat.IsInt() {
+				return nil, false, nil
+			}
+			ints[i] = new(big.Int).Set(n.rat.Num())
+			continue
+		}
+		if n.flt.IsInf() || !n.flt.IsInt() {
+			return nil, false, nil
+		}
+		ints[i], _ = n.flt.Int(nil)
+	}
+	return ints, true, nil
+}
+
+// Int records the integer z as the exact result and returns it rounded to a
+// float64.
+func (c *Calculator) Int(z *big.Int) float64 {
+	if c.p.Mode == Rational {
+		return c.result(number{rat: new(big.Rat).SetInt(z)})
+	}
+	return c.result(number{flt: new(big.Float).SetPrec(c.p.Bits).SetInt(z)})
+}
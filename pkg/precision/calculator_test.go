@@ -0,0 +1,89 @@
+package precision_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pkg/expr"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+)
+
+var (
+	rational = precision.Precision{Mode: precision.Rational}
+	bigFloat = precision.Precision{Mode: precision.BigFloat, Bits: 128}
+)
+
+func TestEvaluate(t *testing.T) {
+	for _, tc := range []struct {
+		expression string
+		p          precision.Precision
+		want       string
+		err        error
+	}{
+		{"1/3+1/6", rational, "0.5", nil},
+		{"0.1*3", rational, "0.3", nil},
+		{"1/3 - 0.3333333333333333", rational, "1/30000000000000000", nil},
+		{"0.3333333333333333 - 1/3", rational, "-1/30000000000000000", nil},
+		{"2*0.1 - 0.2", rational, "0", nil},
+		{"-(1/3) + 1/3", rational, "0", nil},
+		{"+(1/3) - 0.3333333333333333", rational, "1/30000000000000000", nil},
+		{"min(1/3, 1) - 0.3333333333333333", rational, "1/30000000000000000", nil},
+		{"abs(-(1/3)) * 3", rational, "1", nil},
+		{"10^400/10^399", rational, "10", nil},
+		{"10^-400*10^400", rational, "1", nil},
+		{"(1/3)^2*9", rational, "1", nil},
+		{"(10^16384)^16384", rational, "", precision.ErrExponentTooLarge},
+		{"(3^16384)^64", rational, "", precision.ErrExponentTooLarge},
+		{strings.Repeat("3^16384*", 50) + "1", rational, "", precision.ErrNotRepresentable},
+		{"2^0.5", rational, "", precision.ErrNonIntegerExponent},
+		{"1/3 - 0.3333333333333333", bigFloat, "3.33333333333333333333338615777910742638e-17", nil},
+		{"1/3", precision.Precision{Mode: precision.BigFloat, Bits: precision.MaxBits}, "", nil},
+		{"1/3", precision.Precision{Mode: precision.BigFloat, Bits: precision.MaxBits + 1}, "", precision.ErrInvalidPrecision},
+	} {
+		ctx, res := precision.NewContext(context.Background(), tc.p)
+		_, err := expr.Evaluate(ctx, mathservice.NewBasicService(precision.Precision{}), tc.expression)
+		switch {
+		case !errors.Is(err, tc.err) || (tc.err == nil && err != nil):
+			t.Errorf("%s in %v: got %v, want %v", tc.expression, tc.p.Mode, err, tc.err)
+		case err == nil && tc.want != "" && res.String() != tc.want:
+			t.Errorf("%s in %v: got %s, want %s", tc.expression, tc.p.Mode, res.String(), tc.want)
+		}
+	}
+}
+
+// replacer is a Calculator replacing every result of Sum with a fixed value,
+// as a middleware might.
+type replacer struct {
+	mathservice.Service
+	v float64
+}
+
+func (r replacer) Sum(ctx context.Context, a, b float64) (float64, error) {
+	if _, err := r.Service.Sum(ctx, a, b); err != nil {
+		return 0, err
+	}
+	return r.v, nil
+}
+
+func TestReplacedOperand(t *testing.T) {
+	ctx, res := precision.NewContext(context.Background(), rational)
+	c := replacer{mathservice.NewBasicService(precision.Precision{}), 2}
+	// the sum is exactly 1/3+1/3 but is replaced by 2, which the product
+	// takes instead
+	v, err := expr.Evaluate(ctx, c, "(1/3+1/3)*3")
+	if err != nil || v != 6 || res.String() != "6" {
+		t.Errorf("got %v (exact %s), %v, want 6", v, res.String(), err)
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	ctx, res := precision.NewContext(context.Background(), rational)
+	v, err := mathservice.NewBasicService(precision.Precision{}).Pow(ctx, 10, 400)
+	if err != nil || !math.IsInf(v, 1) || !res.Finite() || res.String() != "1"+strings.Repeat("0", 400) {
+		t.Errorf("got %v (exact %s), %v, want +Inf (exact 1e400)", v, res.String(), err)
+	}
+}
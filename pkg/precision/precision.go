@@ -0,0 +1,171 @@
+// Package precision implements arbitrary-precision arithmetic for the math
+// services. Operands and results are still exchanged as float64 so that the
+// Service interfaces don't change, the exact result of each operation is
+// recorded in a Result carried by the request context.
+package precision
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/expr"
+)
+
+// DefaultBits is the mantissa precision used for BigFloat computations when
+// neither the request nor the server specify one, and MaxBits the largest
+// one they may specify.
+const (
+	DefaultBits = 256
+	MaxBits     = 1 << 16
+)
+
+var (
+	ErrNonIntegerExponent = errors.New("arbitrary-precision pow requires an integer exponent")
+	ErrExponentTooLarge   = errors.New("exponent is too large for arbitrary-precision pow")
+	ErrNotRepresentable   = errors.New("result can't be represented at the requested precision")
+	ErrInvalidPrecision   = fmt.Errorf("precision bits must be at most %d", MaxBits)
+)
+
+// maxExponent bounds the exponent accepted by Pow and maxRationalBits the size
+// of the numerator and denominator of a Rational result, so a single request
+// can't allocate an unbounded amount of memory, as an expression chaining
+// operations otherwise could.
+const (
+	maxExponent     = 1 << 14
+	maxRationalBits = 1 << 20
+)
+
+// Mode selects the arithmetic used to compute a result.
+type Mode int
+
+const (
+	// Default uses the server's default mode.
+	Default Mode = iota
+	// Float64 computes with float64, the behavior when no precision is requested.
+	Float64
+	// BigFloat computes with big.Float using a caller chosen number of bits.
+	BigFloat
+	// Rational computes exactly with big.Rat.
+	Rational
+)
+
+var modeNames = map[Mode]string{
+	Default:  "default",
+	Float64:  "float64",
+	BigFloat: "bigfloat",
+	Rational: "rational",
+}
+
+func (m Mode) String() string {
+	if s, ok := modeNames[m]; ok {
+		return s
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
+// ParseMode returns the Mode named by s, e.g. "rational".
+func ParseMode(s string) (Mode, error) {
+	for m, name := range modeNames {
+		if strings.EqualFold(s, name) {
+			return m, nil
+		}
+	}
+	return Default, fmt.Errorf("unknown precision mode %q", s)
+}
+
+// Set implements flag.Value so a Mode can be configured with a command line flag.
+func (m *Mode) Set(s string) error {
+	return m.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler so modes are encoded by name in JSON.
+func (m Mode) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *Mode) UnmarshalText(text []byte) error {
+	v, err := ParseMode(string(text))
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// Precision describes how a result should be computed. Bits is only used by
+// BigFloat, a zero value falls back to the server default and a value above
+// MaxBits fails with ErrInvalidPrecision.
+type Precision struct {
+	Mode Mode `json:"mode"`
+	Bits uint `json:"bits,omitempty"`
+}
+
+// Or returns p with any unset fields taken from def.
+func (p Precision) Or(def Precision) Precision {
+	if p.Mode == Default {
+		p.Mode = def.Mode
+	}
+	if p.Bits == 0 {
+		p.Bits = def.Bits
+	}
+	if p.Bits == 0 {
+		p.Bits = DefaultBits
+	}
+	return p
+}
+
+// FromProto converts a gRPC precision to a Precision. A nil p is the zero Precision.
+func FromProto(p *pb.Precision) Precision {
+	if p == nil {
+		return Precision{}
+	}
+	return Precision{Mode: Mode(p.Mode), Bits: uint(p.Bits)}
+}
+
+// Proto converts p to a gRPC precision. The zero Precision is converted to nil.
+func (p Precision) Proto() *pb.Precision {
+	if p == (Precision{}) {
+		return nil
+	}
+	return &pb.Precision{Mode: pb.Precision_Mode(p.Mode), Bits: uint32(p.Bits)}
+}
+
+type contextKey struct{}
+
+type contextValue struct {
+	p   Precision
+	res *Result
+}
+
+// NewContext returns a context carrying p along with the Result that the
+// exact outcome of operations performed with it will be recorded in.
+func NewContext(ctx context.Context, p Precision) (context.Context, *Result) {
+	res := &Result{}
+	return context.WithValue(ctx, contextKey{}, contextValue{p: p, res: res}), res
+}
+
+// FromContext returns the Precision and Result carried by ctx, if any.
+func FromContext(ctx context.Context) (Precision, *Result, bool) {
+	v, ok := ctx.Value(contextKey{}).(contextValue)
+	return v.p, v.res, ok
+}
+
+// CalculatorFromContext returns a Calculator for the precision requested in
+// ctx, with unset fields taken from def. It returns nil when the resolved
+// mode is Float64, in which case the caller should compute with float64.
+func CalculatorFromContext(ctx context.Context, def Precision) *Calculator {
+	p, res, ok := FromContext(ctx)
+	if !ok {
+		res = &Result{}
+	}
+	p = p.Or(def)
+	if p.Mode != BigFloat && p.Mode != Rational {
+		return nil
+	}
+	op, _ := expr.OperationFromContext(ctx)
+	return &Calculator{p: p, res: res, op: op}
+}
@@ -0,0 +1,70 @@
+package precision_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/precision"
+)
+
+func TestParseMode(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want precision.Mode
+		ok   bool
+	}{
+		{"default", precision.Default, true},
+		{"float64", precision.Float64, true},
+		{"BigFloat", precision.BigFloat, true},
+		{"RATIONAL", precision.Rational, true},
+		{"", precision.Default, false},
+		{"decimal", precision.Default, false},
+	} {
+		got, err := precision.ParseMode(tc.s)
+		if got != tc.want || (err == nil) != tc.ok {
+			t.Errorf("ParseMode(%q) = %v, %v, want %v", tc.s, got, err, tc.want)
+		}
+		if tc.ok && got.String() != tc.want.String() {
+			t.Errorf("%v: String got %q", tc.want, got.String())
+		}
+	}
+}
+
+func TestOr(t *testing.T) {
+	def := precision.Precision{Mode: precision.Rational, Bits: 128}
+	for _, tc := range []struct {
+		name string
+		p    precision.Precision
+		def  precision.Precision
+		want precision.Precision
+	}{
+		{"unset", precision.Precision{}, def, def},
+		{"mode set", precision.Precision{Mode: precision.BigFloat}, def, precision.Precision{Mode: precision.BigFloat, Bits: 128}},
+		{"bits set", precision.Precision{Bits: 64}, def, precision.Precision{Mode: precision.Rational, Bits: 64}},
+		{"no default bits", precision.Precision{Mode: precision.BigFloat}, precision.Precision{}, precision.Precision{Mode: precision.BigFloat, Bits: precision.DefaultBits}},
+	} {
+		if got := tc.p.Or(tc.def); got != tc.want {
+			t.Errorf("%s: got %+v, want %+v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestProto(t *testing.T) {
+	for _, tc := range []struct {
+		p    precision.Precision
+		want *pb.Precision
+	}{
+		{precision.Precision{}, nil},
+		{precision.Precision{Mode: precision.Rational}, &pb.Precision{Mode: pb.Precision_RATIONAL}},
+		{precision.Precision{Mode: precision.BigFloat, Bits: 256}, &pb.Precision{Mode: pb.Precision_BIGFLOAT, Bits: 256}},
+	} {
+		got := tc.p.Proto()
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%+v: Proto got %v, want %v", tc.p, got, tc.want)
+		}
+		if back := precision.FromProto(got); back != tc.p {
+			t.Errorf("%+v: FromProto got %+v", tc.p, back)
+		}
+	}
+}
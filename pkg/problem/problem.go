@@ -0,0 +1,106 @@
+// Package problem implements the RFC 7807 problem details used by the HTTP
+// servers to describe failed requests, so that every HTTP implementation
+// reports errors in the same format.
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/jwenz723/mathserver/pb"
+)
+
+// ContentType is the media type of a problem details response body.
+const ContentType = "application/problem+json"
+
+// typePrefix prefixes the type URI of the problems describing service errors.
+const typePrefix = "urn:mathserver:problem:"
+
+// ErrMalformedRequest is wrapped by the errors returned when a request body
+// can't be decoded, which are reported with a 400 status.
+var ErrMalformedRequest = errors.New("malformed request")
+
+// Problem is a problem details object. Code is an extension member carrying
+// the ErrorCode that identifies the error, so that clients can reconstruct it.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code,omitempty"`
+}
+
+// New returns the problem describing err, which is identified on the wire by
+// code, for the request made to instance. Malformed requests have a 400 status,
+// errors with a known code a 422 status and other errors a 500 status.
+func New(code pb.ErrorCode, err error, instance string) *Problem {
+	p := &Problem{
+		Detail:   err.Error(),
+		Instance: instance,
+	}
+	switch {
+	case errors.Is(err, ErrMalformedRequest):
+		p.Type = typePrefix + "malformed-request"
+		p.Title = "Malformed request"
+		p.Status = http.StatusBadRequest
+	case code == pb.ErrorCode_NO_ERROR || code == pb.ErrorCode_UNKNOWN:
+		p.Type = "about:blank"
+		p.Title = http.StatusText(http.StatusInternalServerError)
+		p.Status = http.StatusInternalServerError
+	default:
+		name := strings.ToLower(code.String())
+		p.Type = typePrefix + strings.Replace(name, "_", "-", -1)
+		p.Title = strings.ToUpper(name[:1]) + strings.Replace(name[1:], "_", " ", -1)
+		p.Status = http.StatusUnprocessableEntity
+		p.Code = code.String()
+	}
+	return p
+}
+
+// Malformed wraps err, returned while decoding a request, so that it's
+// reported as a malformed request.
+func Malformed(err error) error {
+	return fmt.Errorf("%w: %v", ErrMalformedRequest, err)
+}
+
+// Error returns the detail of p so that a Problem can be returned as an error
+// by clients.
+func (p *Problem) Error() string {
+	if p.Detail != "" {
+		return p.Detail
+	}
+	return p.Title
+}
+
+// ErrorCode returns the ErrorCode carried by p, or UNKNOWN if there isn't one.
+func (p *Problem) ErrorCode() pb.ErrorCode {
+	if c, ok := pb.ErrorCode_value[p.Code]; ok {
+		return pb.ErrorCode(c)
+	}
+	return pb.ErrorCode_UNKNOWN
+}
+
+// Write writes p to w as the response.
+func (p *Problem) Write(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+// Read decodes the problem described by the body of r. If the body isn't a
+// problem details object, a problem with the status of r is returned.
+func Read(r *http.Response) *Problem {
+	p := &Problem{}
+	mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mt != ContentType || json.NewDecoder(r.Body).Decode(p) != nil || p.Title == "" {
+		p = &Problem{Type: "about:blank", Title: http.StatusText(r.StatusCode), Detail: r.Status}
+	}
+	p.Status = r.StatusCode
+	return p
+}
@@ -0,0 +1,71 @@
+package problem_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/problem"
+)
+
+func TestNew(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		code pb.ErrorCode
+		err  error
+		want problem.Problem
+	}{
+		{"service error", pb.ErrorCode_DIVIDE_BY_ZERO, mathservice.ErrDivideByZero, problem.Problem{
+			Type:     "urn:mathserver:problem:divide-by-zero",
+			Title:    "Divide by zero",
+			Status:   http.StatusUnprocessableEntity,
+			Detail:   mathservice.ErrDivideByZero.Error(),
+			Instance: "/divide",
+			Code:     "DIVIDE_BY_ZERO",
+		}},
+		{"malformed request", pb.ErrorCode_UNKNOWN, problem.Malformed(errors.New("unexpected EOF")), problem.Problem{
+			Type:     "urn:mathserver:problem:malformed-request",
+			Title:    "Malformed request",
+			Status:   http.StatusBadRequest,
+			Detail:   "malformed request: unexpected EOF",
+			Instance: "/divide",
+		}},
+		{"unknown error", pb.ErrorCode_UNKNOWN, errors.New("boom"), problem.Problem{
+			Type:     "about:blank",
+			Title:    "Internal Server Error",
+			Status:   http.StatusInternalServerError,
+			Detail:   "boom",
+			Instance: "/divide",
+		}},
+	} {
+		if got := problem.New(tc.code, tc.err, "/divide"); *got != tc.want {
+			t.Errorf("%s: got %+v, want %+v", tc.name, *got, tc.want)
+		}
+	}
+}
+
+// TestReadWrite checks that a client reads back the problem written by a
+// server, along with its code, and makes do with responses that aren't
+// problem details.
+func TestReadWrite(t *testing.T) {
+	want := problem.New(pb.ErrorCode_NO_MAX, mathservice.ErrNoMax, "/max")
+	w := httptest.NewRecorder()
+	want.Write(w)
+	if ct := w.Header().Get("Content-Type"); ct != problem.ContentType {
+		t.Errorf("got Content-Type %q", ct)
+	}
+	got := problem.Read(w.Result())
+	if *got != *want || got.ErrorCode() != pb.ErrorCode_NO_MAX || got.Error() != mathservice.ErrNoMax.Error() {
+		t.Errorf("got %+v, want %+v", *got, *want)
+	}
+
+	w = httptest.NewRecorder()
+	http.Error(w, "upstream failed", http.StatusBadGateway)
+	got = problem.Read(w.Result())
+	if got.Status != http.StatusBadGateway || got.Title != "Bad Gateway" || got.ErrorCode() != pb.ErrorCode_UNKNOWN {
+		t.Errorf("plain text response: got %+v", *got)
+	}
+}
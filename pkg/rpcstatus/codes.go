@@ -0,0 +1,145 @@
+package rpcstatus
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/calculusservice"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+	"github.com/jwenz723/mathserver/pkg/expr"
+	"github.com/jwenz723/mathserver/pkg/financeservice"
+	"github.com/jwenz723/mathserver/pkg/linalgservice"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/numtheoryservice"
+	"github.com/jwenz723/mathserver/pkg/polyservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/statsservice"
+	"github.com/jwenz723/mathserver/pkg/symbolicservice"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
+)
+
+// errorCodes maps the codes used to identify errors on the wire to the errors
+// returned by the services, so that a client can hand back the same errors
+// the service returned. Code returns the first entry matching an error, so
+// the codes of a single service come before the generic codes they refine,
+// e.g. CALCULUS_NO_CONVERGENCE before NO_CONVERGENCE.
+var errorCodes = []struct {
+	code pb.ErrorCode
+	err  error
+}{
+	{pb.ErrorCode_DIVIDE_BY_ZERO, mathservice.ErrDivideByZero},
+	{pb.ErrorCode_NO_MAX, mathservice.ErrNoMax},
+	{pb.ErrorCode_NO_MIN, mathservice.ErrNoMin},
+	{pb.ErrorCode_NO_VALUES, mathservice.ErrNoValues},
+	{pb.ErrorCode_SYNTAX_ERROR, expr.ErrSyntax},
+	{pb.ErrorCode_NON_INTEGER_EXPONENT, precision.ErrNonIntegerExponent},
+	{pb.ErrorCode_EXPONENT_TOO_LARGE, precision.ErrExponentTooLarge},
+	{pb.ErrorCode_NOT_REPRESENTABLE, precision.ErrNotRepresentable},
+	{pb.ErrorCode_INVALID_PRECISION, precision.ErrInvalidPrecision},
+	{pb.ErrorCode_INVALID_DIVISION, mathservice.ErrInvalidDivision},
+	{pb.ErrorCode_UNKNOWN_OPERATION, mathservice.ErrUnknownOperation},
+	{pb.ErrorCode_TOO_MANY_ITEMS, mathservice.ErrTooManyItems},
+	{pb.ErrorCode_MODULO_BY_ZERO, mathservice.ErrModuloByZero},
+	{pb.ErrorCode_NOT_INTEGER, mathservice.ErrNotInteger},
+	{pb.ErrorCode_NEGATIVE_SQRT, mathservice.ErrNegativeSqrt},
+	{pb.ErrorCode_NON_POSITIVE_LOG, mathservice.ErrNonPositiveLog},
+	{pb.ErrorCode_OUT_OF_DOMAIN, mathservice.ErrOutOfDomain},
+	{pb.ErrorCode_NON_FINITE, mathservice.ErrNonFinite},
+	{pb.ErrorCode_POLE, mathservice.ErrPole},
+	{pb.ErrorCode_INVALID_ORDER, mathservice.ErrInvalidOrder},
+	{pb.ErrorCode_NON_POSITIVE_ARGUMENT, mathservice.ErrNonPositiveArgument},
+	{pb.ErrorCode_DIMENSION_MISMATCH, linalgservice.ErrDimensionMismatch},
+	{pb.ErrorCode_MALFORMED_MATRIX, linalgservice.ErrMalformedMatrix},
+	{pb.ErrorCode_NOT_SQUARE, linalgservice.ErrNotSquare},
+	{pb.ErrorCode_SINGULAR_MATRIX, linalgservice.ErrSingular},
+	{pb.ErrorCode_NON_FINITE_VALUE, statsservice.ErrNonFiniteValue},
+	{pb.ErrorCode_INVALID_QUANTILE, statsservice.ErrInvalidQuantile},
+	{pb.ErrorCode_LENGTH_MISMATCH, statsservice.ErrLengthMismatch},
+	{pb.ErrorCode_TOO_MANY_BUCKETS, statsservice.ErrTooManyBuckets},
+	{pb.ErrorCode_TOO_MANY_VALUES, statsservice.ErrTooManyValues},
+	{pb.ErrorCode_UNKNOWN_UNIT, unitservice.ErrUnknownUnit},
+	{pb.ErrorCode_INCOMPATIBLE_UNITS, unitservice.ErrIncompatibleUnits},
+	{pb.ErrorCode_FRACTIONAL_DIMENSION, unitservice.ErrFractionalDimension},
+	{pb.ErrorCode_INVALID_DECIMAL, financeservice.ErrInvalidDecimal},
+	{pb.ErrorCode_INVALID_RATE, financeservice.ErrInvalidRate},
+	{pb.ErrorCode_INVALID_PERIODS, financeservice.ErrInvalidPeriods},
+	{pb.ErrorCode_INVALID_SCALE, financeservice.ErrInvalidScale},
+	{pb.ErrorCode_NO_SIGN_CHANGE, financeservice.ErrNoSignChange},
+	{pb.ErrorCode_IRR_NO_CONVERGENCE, financeservice.ErrNoConvergence},
+	{pb.ErrorCode_INVALID_INTEGER, numtheoryservice.ErrInvalidInteger},
+	{pb.ErrorCode_OPERAND_TOO_LARGE, numtheoryservice.ErrOperandTooLarge},
+	{pb.ErrorCode_NOT_POSITIVE, numtheoryservice.ErrNotPositive},
+	{pb.ErrorCode_NO_INVERSE, numtheoryservice.ErrNoInverse},
+	{pb.ErrorCode_INVALID_BUDGET, numtheoryservice.ErrInvalidBudget},
+	{pb.ErrorCode_BUDGET_EXCEEDED, numtheoryservice.ErrBudgetExceeded},
+	{pb.ErrorCode_NEGATIVE_OPERAND, combinatoricsservice.ErrNegativeOperand},
+	{pb.ErrorCode_TOO_MANY_DIGITS, combinatoricsservice.ErrTooManyDigits},
+	{pb.ErrorCode_CALCULUS_NO_CONVERGENCE, calculusservice.ErrNoConvergence},
+	{pb.ErrorCode_CALCULUS_INVALID_TOLERANCE, calculusservice.ErrInvalidTolerance},
+	{pb.ErrorCode_INVALID_ITERATIONS, calculusservice.ErrInvalidIterations},
+	{pb.ErrorCode_INVALID_INTERVAL, calculusservice.ErrInvalidInterval},
+	{pb.ErrorCode_NO_BRACKET, calculusservice.ErrNoBracket},
+	{pb.ErrorCode_NON_FINITE_FUNCTION, calculusservice.ErrNonFiniteFunction},
+	{pb.ErrorCode_INVALID_METHOD, calculusservice.ErrInvalidMethod},
+	{pb.ErrorCode_INVALID_VARIABLE, symbolicservice.ErrInvalidVariable},
+	{pb.ErrorCode_NOT_DIFFERENTIABLE, symbolicservice.ErrNotDifferentiable},
+	{pb.ErrorCode_EXPRESSION_TOO_LARGE, symbolicservice.ErrExpressionTooLarge},
+	{pb.ErrorCode_ZERO_POLYNOMIAL, polyservice.ErrZeroPolynomial},
+	{pb.ErrorCode_INVALID_TOLERANCE, polyservice.ErrInvalidTolerance},
+	{pb.ErrorCode_NO_CONVERGENCE, polyservice.ErrNoConvergence},
+}
+
+// Code returns the code identifying err on the wire: NO_ERROR if err is nil
+// and UNKNOWN if err isn't one of the errors returned by the services.
+func Code(err error) pb.ErrorCode {
+	if err == nil {
+		return pb.ErrorCode_NO_ERROR
+	}
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+	return pb.ErrorCode_UNKNOWN
+}
+
+// Err reconstructs the error identified by code, as Code would identify it,
+// from the message sent by a server. When msg differs from the message of the
+// known error, as it does for syntax errors, msg is kept and the known error
+// is wrapped so it still matches using errors.Is. The context errors, such as
+// those of the batch items that were canceled, are identified by their
+// message. Err returns nil if msg is empty.
+func Err(code pb.ErrorCode, msg string) error {
+	if msg == "" {
+		return nil
+	}
+	if code == pb.ErrorCode_UNKNOWN {
+		for _, err := range []error{context.Canceled, context.DeadlineExceeded} {
+			if msg == err.Error() {
+				return err
+			}
+		}
+	}
+	for _, c := range errorCodes {
+		if c.code != code {
+			continue
+		}
+		if msg == c.err.Error() {
+			return c.err
+		}
+		return remoteError{msg: msg, err: c.err}
+	}
+	return errors.New(msg)
+}
+
+// remoteError is an error received from a server that wraps the equivalent
+// local error.
+type remoteError struct {
+	msg string
+	err error
+}
+
+func (e remoteError) Error() string { return e.msg }
+
+func (e remoteError) Unwrap() error { return e.err }
@@ -0,0 +1,55 @@
+package rpcstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pb"
+)
+
+// TestCodes checks that every error code is mapped to an error, and that the
+// error is identified by that code both locally and once received by a
+// client.
+func TestCodes(t *testing.T) {
+	for value, name := range pb.ErrorCode_name {
+		code := pb.ErrorCode(value)
+		if code == pb.ErrorCode_NO_ERROR || code == pb.ErrorCode_UNKNOWN {
+			continue
+		}
+		var target error
+		for _, c := range errorCodes {
+			if c.code == code {
+				target = c.err
+				break
+			}
+		}
+		if target == nil {
+			t.Errorf("%s: no error", name)
+			continue
+		}
+		wrapped := fmt.Errorf("%w, and then some", target)
+		if got := Code(wrapped); got != code {
+			t.Errorf("%s: Code got %v", name, got)
+		}
+		if err := Err(code, target.Error()); err != target {
+			t.Errorf("%s: Err got %v, want %v", name, err, target)
+		}
+		if err := Err(code, wrapped.Error()); !errors.Is(err, target) || err.Error() != wrapped.Error() {
+			t.Errorf("%s: Err got %v, want it to wrap %v", name, err, target)
+		}
+	}
+	if got := Code(nil); got != pb.ErrorCode_NO_ERROR {
+		t.Errorf("nil: Code got %v", got)
+	}
+	if got := Code(errors.New("boom")); got != pb.ErrorCode_UNKNOWN {
+		t.Errorf("unknown error: Code got %v", got)
+	}
+	if err := Err(pb.ErrorCode_UNKNOWN, "boom"); err == nil || err.Error() != "boom" {
+		t.Errorf("unknown code: Err got %v", err)
+	}
+	if err := Err(pb.ErrorCode_UNKNOWN, context.Canceled.Error()); err != context.Canceled {
+		t.Errorf("canceled: Err got %v", err)
+	}
+}
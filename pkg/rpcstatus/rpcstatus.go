@@ -0,0 +1,155 @@
+// Package rpcstatus converts the errors returned by the math services to and
+// from gRPC statuses. Servers that opt in to status errors fail a call with
+// codes.InvalidArgument and attach a google.rpc.BadRequest naming the
+// offending request fields, so that gRPC metrics and dashboards see the
+// failure, instead of returning the error inside a MathOpReply.
+package rpcstatus
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jwenz723/mathserver/pb"
+	fieldpkg "github.com/jwenz723/mathserver/pkg/field"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fields names the request fields that may be at fault for each error, for
+// the errors that don't name the field, see package field.
+var fields = map[pb.ErrorCode][]string{
+	pb.ErrorCode_DIVIDE_BY_ZERO:             {"b"},
+	pb.ErrorCode_NO_MAX:                     {"a", "b"},
+	pb.ErrorCode_NO_MIN:                     {"a", "b"},
+	pb.ErrorCode_NO_VALUES:                  {"values"},
+	pb.ErrorCode_SYNTAX_ERROR:               {"expression"},
+	pb.ErrorCode_NON_INTEGER_EXPONENT:       {"b"},
+	pb.ErrorCode_EXPONENT_TOO_LARGE:         {"b"},
+	pb.ErrorCode_NOT_REPRESENTABLE:          {"precision"},
+	pb.ErrorCode_UNKNOWN_OPERATION:          {"op"},
+	pb.ErrorCode_MODULO_BY_ZERO:             {"b"},
+	pb.ErrorCode_NOT_INTEGER:                {"a", "b"},
+	pb.ErrorCode_NEGATIVE_SQRT:              {"x"},
+	pb.ErrorCode_NON_POSITIVE_LOG:           {"x"},
+	pb.ErrorCode_OUT_OF_DOMAIN:              {"x"},
+	pb.ErrorCode_DIMENSION_MISMATCH:         {"a", "b"},
+	pb.ErrorCode_MALFORMED_MATRIX:           {"a", "b"},
+	pb.ErrorCode_NOT_SQUARE:                 {"a"},
+	pb.ErrorCode_SINGULAR_MATRIX:            {"a"},
+	pb.ErrorCode_ZERO_POLYNOMIAL:            {"a"},
+	pb.ErrorCode_INVALID_TOLERANCE:          {"tolerance"},
+	pb.ErrorCode_NO_CONVERGENCE:             {"a"},
+	pb.ErrorCode_NON_FINITE_VALUE:           {"x", "y"},
+	pb.ErrorCode_INVALID_QUANTILE:           {"options.quantiles"},
+	pb.ErrorCode_LENGTH_MISMATCH:            {"x", "y"},
+	pb.ErrorCode_TOO_MANY_BUCKETS:           {"options.buckets"},
+	pb.ErrorCode_UNKNOWN_UNIT:               {"a.unit", "b.unit"},
+	pb.ErrorCode_INCOMPATIBLE_UNITS:         {"a.unit", "b.unit"},
+	pb.ErrorCode_FRACTIONAL_DIMENSION:       {"b"},
+	pb.ErrorCode_INVALID_DECIMAL:            {"rate", "values", "pv", "fv", "pmt", "principal"},
+	pb.ErrorCode_INVALID_RATE:               {"rate"},
+	pb.ErrorCode_INVALID_PERIODS:            {"periods", "frequency"},
+	pb.ErrorCode_INVALID_SCALE:              {"scale"},
+	pb.ErrorCode_NO_SIGN_CHANGE:             {"values"},
+	pb.ErrorCode_IRR_NO_CONVERGENCE:         {"rate", "values"},
+	pb.ErrorCode_INVALID_INTEGER:            {"n", "base", "exponent", "modulus", "a"},
+	pb.ErrorCode_OPERAND_TOO_LARGE:          {"n", "base", "exponent", "modulus", "a"},
+	pb.ErrorCode_NOT_POSITIVE:               {"n", "modulus"},
+	pb.ErrorCode_NO_INVERSE:                 {"a", "base", "modulus"},
+	pb.ErrorCode_INVALID_BUDGET:             {"budget_ms"},
+	pb.ErrorCode_BUDGET_EXCEEDED:            {"budget_ms"},
+	pb.ErrorCode_POLE:                       {"x", "a", "b"},
+	pb.ErrorCode_INVALID_ORDER:              {"a"},
+	pb.ErrorCode_NON_POSITIVE_ARGUMENT:      {"b"},
+	pb.ErrorCode_NEGATIVE_OPERAND:           {"n", "k"},
+	pb.ErrorCode_TOO_MANY_DIGITS:            {"n", "k"},
+	pb.ErrorCode_CALCULUS_NO_CONVERGENCE:    {"tolerance", "max_iterations"},
+	pb.ErrorCode_CALCULUS_INVALID_TOLERANCE: {"tolerance"},
+	pb.ErrorCode_INVALID_ITERATIONS:         {"max_iterations"},
+	pb.ErrorCode_INVALID_INTERVAL:           {"a", "b", "x"},
+	pb.ErrorCode_NO_BRACKET:                 {"a", "b"},
+	pb.ErrorCode_NON_FINITE_FUNCTION:        {"expression"},
+	pb.ErrorCode_INVALID_METHOD:             {"method"},
+	pb.ErrorCode_INVALID_VARIABLE:           {"variable"},
+	pb.ErrorCode_NOT_DIFFERENTIABLE:         {"expression"},
+	pb.ErrorCode_EXPRESSION_TOO_LARGE:       {"expression"},
+	pb.ErrorCode_INVALID_PRECISION:          {"precision"},
+	pb.ErrorCode_TOO_MANY_VALUES:            {"x", "y"},
+	pb.ErrorCode_INVALID_DIVISION:           {"division"},
+	pb.ErrorCode_TOO_MANY_ITEMS:             {"items"},
+}
+
+// Error returns a status error describing err, which is identified on the
+// wire by code. The BadRequest detail names the given fields, or if none are
+// given the field named by err, see package field, or else the fields usually
+// at fault for code. Errors without a known code are reported as
+// codes.Unknown, unless the context of the call expired or was canceled.
+// Error returns nil if err is nil.
+func Error(code pb.ErrorCode, err error, field ...string) error {
+	if err == nil {
+		return nil
+	}
+	if code == pb.ErrorCode_NO_ERROR || code == pb.ErrorCode_UNKNOWN {
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			return status.Error(codes.DeadlineExceeded, err.Error())
+		case errors.Is(err, context.Canceled):
+			return status.Error(codes.Canceled, err.Error())
+		}
+		return status.Error(codes.Unknown, err.Error())
+	}
+	if len(field) == 0 {
+		if name, ok := fieldpkg.Name(err); ok {
+			field = []string{name}
+		} else {
+			field = fields[code]
+		}
+	}
+	br := &errdetails.BadRequest{}
+	for _, f := range field {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       f,
+			Description: err.Error(),
+		})
+	}
+	st, dErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(br, &pb.ErrorDetail{Code: code})
+	if dErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
+
+// Parse returns the code and message of a status error created by Error. ok
+// is false if err doesn't carry an ErrorDetail, in which case err isn't a
+// domain error and should be returned as is.
+func Parse(err error) (code pb.ErrorCode, msg string, ok bool) {
+	st, isStatus := status.FromError(err)
+	if !isStatus || st.Code() != codes.InvalidArgument {
+		return pb.ErrorCode_NO_ERROR, "", false
+	}
+	for _, d := range st.Details() {
+		if d, isDetail := d.(*pb.ErrorDetail); isDetail {
+			return d.Code, st.Message(), true
+		}
+	}
+	return pb.ErrorCode_NO_ERROR, "", false
+}
+
+// Fields returns the names of the request fields reported as being at fault
+// by the status error err.
+func Fields(err error) []string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	var names []string
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				names = append(names, v.Field)
+			}
+		}
+	}
+	return names
+}
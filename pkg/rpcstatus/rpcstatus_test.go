@@ -0,0 +1,94 @@
+package rpcstatus_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/financeservice"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/numtheoryservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/rpcstatus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestError checks the gRPC code of the status errors, and that only the
+// domain errors can be parsed back.
+func TestError(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  error
+		want codes.Code
+		ok   bool
+	}{
+		{"domain error", mathservice.ErrDivideByZero, codes.InvalidArgument, true},
+		{"wrapped domain error", fmt.Errorf("evaluating: %w", mathservice.ErrNegativeSqrt), codes.InvalidArgument, true},
+		{"deadline exceeded", fmt.Errorf("computing: %w", context.DeadlineExceeded), codes.DeadlineExceeded, false},
+		{"canceled", context.Canceled, codes.Canceled, false},
+		{"unknown error", errors.New("boom"), codes.Unknown, false},
+	} {
+		code := rpcstatus.Code(tc.err)
+		st := rpcstatus.Error(code, tc.err)
+		if got := status.Code(st); got != tc.want {
+			t.Errorf("%s: got status code %v, want %v", tc.name, got, tc.want)
+		}
+		gotCode, msg, ok := rpcstatus.Parse(st)
+		if ok != tc.ok || (ok && (gotCode != code || msg != tc.err.Error())) {
+			t.Errorf("%s: Parse got %v, %q, %v", tc.name, gotCode, msg, ok)
+		}
+	}
+	if err := rpcstatus.Error(pb.ErrorCode_NO_ERROR, nil); err != nil {
+		t.Errorf("nil: got %v", err)
+	}
+}
+
+// TestErrorFields checks that the status errors name the request field that
+// failed rather than every field that may.
+func TestErrorFields(t *testing.T) {
+	ctx := context.Background()
+	math := mathservice.NewBasicService(precision.Precision{Mode: precision.Float64})
+	finance := financeservice.NewBasicService()
+	nt := numtheoryservice.NewBasicService()
+	for _, tc := range []struct {
+		name  string
+		call  func() error
+		field []string
+		want  []string
+	}{
+		{"gamma pole", func() error { _, err := math.Gamma(ctx, -1); return err }, nil, []string{"x"}},
+		{"beta pole in b", func() error { _, err := math.Beta(ctx, 1, -2); return err }, nil, []string{"b"}},
+		{"invalid cash flow", func() error {
+			_, err := finance.NPV(ctx, financeservice.CashFlows{Rate: "0.1", Values: []financeservice.Decimal{"-100", "x"}})
+			return err
+		}, nil, []string{"values[1]"}},
+		{"invalid pmt", func() error {
+			_, err := finance.FV(ctx, financeservice.TimeValue{Rate: "0.1", Periods: 10, PMT: "1.2.3"})
+			return err
+		}, nil, []string{"pmt"}},
+		{"invalid modulus", func() error {
+			_, err := nt.ModPow(ctx, numtheoryservice.ModPowOperands{Base: "2", Exponent: "3", Modulus: "x"})
+			return err
+		}, nil, []string{"modulus"}},
+		{"explicit fields", func() error { _, err := math.Gamma(ctx, -1); return err }, []string{"expression"}, []string{"expression"}},
+		{"unnamed field", func() error { _, err := math.Divide(ctx, 1, 0); return err }, nil, []string{"b"}},
+	} {
+		err := tc.call()
+		if err == nil {
+			t.Errorf("%s: no error", tc.name)
+			continue
+		}
+		st := rpcstatus.Error(rpcstatus.Code(err), err, tc.field...)
+		if got := rpcstatus.Fields(st); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got fields %v, want %v", tc.name, got, tc.want)
+		}
+		code, msg, ok := rpcstatus.Parse(st)
+		if !ok || code == pb.ErrorCode_UNKNOWN || code != rpcstatus.Code(err) || rpcstatus.Code(rpcstatus.Err(code, msg)) != code {
+			t.Errorf("%s: Parse got %v, %q, %v", tc.name, code, msg, ok)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package statsservice
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jwenz723/mathserver/pb"
+)
+
+// DefaultChunkSize is the number of values Send puts in each chunk, 64KiB of
+// doubles, well below the maximum size of a gRPC message.
+const DefaultChunkSize = 8192
+
+// Receive receives the chunks of a Describe or Correlate stream from recv,
+// e.g. the Recv method of the server stream, until the client closes it. It
+// returns a single chunk concatenating the values of every chunk and holding
+// the options of the first one. It fails with ErrTooManyValues as soon as
+// either series has more than maxValues values, DefaultMaxValues when it's 0,
+// without receiving the rest of the stream.
+func Receive(recv func() (*pb.StatisticsChunk, error), maxValues int) (*pb.StatisticsChunk, error) {
+	if maxValues <= 0 {
+		maxValues = DefaultMaxValues
+	}
+	merged := &pb.StatisticsChunk{}
+	for first := true; ; first = false {
+		c, err := recv()
+		if err == io.EOF {
+			return merged, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if first {
+			merged.Options = c.Options
+		}
+		if len(merged.X)+len(c.X) > maxValues || len(merged.Y)+len(c.Y) > maxValues {
+			return nil, fmt.Errorf("%w, at most %d are allowed in each series", ErrTooManyValues, maxValues)
+		}
+		merged.X = append(merged.X, c.X...)
+		merged.Y = append(merged.Y, c.Y...)
+	}
+}
+
+// Send sends x and y with send, e.g. the Send method of the client stream, in
+// chunks of at most chunkSize values of each series, DefaultChunkSize when
+// it's 0. The first chunk carries opts. At least one chunk is sent, so the
+// options reach the server even when there are no values. Send stops without
+// an error when the server closes the stream early, e.g. because the dataset
+// is too large, so that the reply tells why.
+func Send(send func(*pb.StatisticsChunk) error, x, y []float64, opts *pb.StatisticsOptions, chunkSize int) error {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	for first := true; first || len(x) > 0 || len(y) > 0; first = false {
+		c := &pb.StatisticsChunk{X: head(&x, chunkSize), Y: head(&y, chunkSize)}
+		if first {
+			c.Options = opts
+		}
+		err := send(c)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// head removes and returns the first n values of *s, or all of them.
+func head(s *[]float64, n int) []float64 {
+	if n > len(*s) {
+		n = len(*s)
+	}
+	h := (*s)[:n]
+	*s = (*s)[n:]
+	return h
+}
+
+// OptionsFromProto converts gRPC options to Options, nil is the default
+// options.
+func OptionsFromProto(o *pb.StatisticsOptions) Options {
+	return Options{
+		Quantiles:     o.GetQuantiles(),
+		Interpolation: Interpolation(o.GetInterpolation()),
+		Buckets:       int(o.GetBuckets()),
+	}
+}
+
+// Proto converts o to gRPC options.
+func (o Options) Proto() *pb.StatisticsOptions {
+	return &pb.StatisticsOptions{
+		Quantiles:     o.Quantiles,
+		Interpolation: pb.StatisticsOptions_Interpolation(o.Interpolation),
+		Buckets:       uint32(o.Buckets),
+	}
+}
+
+// DescriptionFromProto converts the statistics of a gRPC reply to a
+// Description.
+func DescriptionFromProto(r *pb.DescribeReply) Description {
+	d := Description{
+		Count:     int(r.GetCount()),
+		Min:       r.GetMin(),
+		Max:       r.GetMax(),
+		Mean:      r.GetMean(),
+		Variance:  r.GetVariance(),
+		StdDev:    r.GetStdDev(),
+		Skewness:  r.GetSkewness(),
+		Kurtosis:  r.GetKurtosis(),
+		Mode:      append([]float64{}, r.GetMode()...),
+		Quantiles: make([]Quantile, len(r.GetQuantiles())),
+		Histogram: make([]Bucket, len(r.GetHistogram())),
+	}
+	for i, q := range r.GetQuantiles() {
+		d.Quantiles[i] = Quantile{Q: q.Q, V: q.V}
+	}
+	for i, b := range r.GetHistogram() {
+		d.Histogram[i] = Bucket{Lower: b.Lower, Upper: b.Upper, Count: int(b.Count)}
+	}
+	return d
+}
+
+// Proto converts d to a gRPC reply.
+func (d Description) Proto() *pb.DescribeReply {
+	r := &pb.DescribeReply{
+		Count:     uint64(d.Count),
+		Min:       d.Min,
+		Max:       d.Max,
+		Mean:      d.Mean,
+		Variance:  d.Variance,
+		StdDev:    d.StdDev,
+		Skewness:  d.Skewness,
+		Kurtosis:  d.Kurtosis,
+		Mode:      d.Mode,
+		Quantiles: make([]*pb.Quantile, len(d.Quantiles)),
+		Histogram: make([]*pb.HistogramBucket, len(d.Histogram)),
+	}
+	for i, q := range d.Quantiles {
+		r.Quantiles[i] = &pb.Quantile{Q: q.Q, V: q.V}
+	}
+	for i, b := range d.Histogram {
+		r.Histogram[i] = &pb.HistogramBucket{Lower: b.Lower, Upper: b.Upper, Count: uint64(b.Count)}
+	}
+	return r
+}
+
+// CorrelationFromProto converts the statistics of a gRPC reply to a
+// Correlation.
+func CorrelationFromProto(r *pb.CorrelateReply) Correlation {
+	return Correlation{
+		Count:       int(r.GetCount()),
+		Covariance:  r.GetCovariance(),
+		Correlation: r.GetCorrelation(),
+	}
+}
+
+// Proto converts c to a gRPC reply.
+func (c Correlation) Proto() *pb.CorrelateReply {
+	return &pb.CorrelateReply{
+		Count:       uint64(c.Count),
+		Covariance:  c.Covariance,
+		Correlation: c.Correlation,
+	}
+}
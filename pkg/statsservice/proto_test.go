@@ -0,0 +1,69 @@
+package statsservice_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/statsservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// counter counts the values streamed to Describe, failing as the servers do
+// when there are more than max of them.
+type counter struct {
+	pb.UnimplementedStatisticsServer
+	max int
+}
+
+func (c *counter) Describe(stream pb.Statistics_DescribeServer) error {
+	chunk, err := statsservice.Receive(stream.Recv, c.max)
+	if errors.Is(err, statsservice.ErrTooManyValues) {
+		return stream.SendAndClose(&pb.DescribeReply{Err: err.Error(), Code: pb.ErrorCode_TOO_MANY_VALUES})
+	}
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(&pb.DescribeReply{Count: uint64(len(chunk.X))})
+}
+
+func TestReceiveMaxValues(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	pb.RegisterStatisticsServer(s, &counter{max: 10})
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	for _, tc := range []struct {
+		n    int
+		want *pb.DescribeReply
+	}{
+		{10, &pb.DescribeReply{Count: 10}},
+		// the server closes the stream long before the client is done
+		{100000, &pb.DescribeReply{Code: pb.ErrorCode_TOO_MANY_VALUES}},
+	} {
+		stream, err := pb.NewStatisticsClient(conn).Describe(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := statsservice.Send(stream.Send, make([]float64, tc.n), nil, nil, 3); err != nil {
+			t.Errorf("%d values: Send: %v", tc.n, err)
+			continue
+		}
+		r, err := stream.CloseAndRecv()
+		if err != nil || r.Count != tc.want.Count || r.Code != tc.want.Code {
+			t.Errorf("%d values: got %v, %v, want %v", tc.n, r, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+// Package statsservice is the core of the Statistics service, the descriptive
+// statistics of streamed datasets.
+package statsservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+)
+
+// Service describes a service that computes descriptive statistics.
+// Implementations may be wrapped by a Middleware, e.g. to log and measure
+// each call.
+type Service interface {
+	// Describe returns the descriptive statistics of values
+	Describe(ctx context.Context, values []float64, opts Options) (Description, error)
+	// Correlate returns the covariance and correlation of the series x and y
+	Correlate(ctx context.Context, x, y []float64) (Correlation, error)
+}
+
+// Middleware describes a service (as opposed to endpoint) middleware.
+type Middleware func(Service) Service
+
+// Errors returned by the basic Service, which transports map to their wire
+// representation, see pb.ErrorCode. An empty dataset fails with
+// mathservice.ErrNoValues, like the list operations of the Math service.
+var (
+	ErrNonFiniteValue  = errors.New("values must be finite")
+	ErrInvalidQuantile = errors.New("quantiles must be between 0 and 1")
+	ErrLengthMismatch  = errors.New("x and y must have the same number of values")
+	ErrTooManyBuckets  = errors.New("too many histogram buckets")
+	ErrTooManyValues   = errors.New("too many values")
+)
+
+// MaxBuckets is the largest number of histogram buckets Describe computes.
+const MaxBuckets = 10000
+
+// DefaultMaxValues is the largest number of values of each series Receive
+// buffers by default, 8MiB of doubles.
+const DefaultMaxValues = 1 << 20
+
+// DefaultQuantiles are the quantiles Describe computes when Options has none,
+// p50, p90 and p99.
+var DefaultQuantiles = []float64{0.5, 0.9, 0.99}
+
+// Options configures Describe.
+type Options struct {
+	// Quantiles are the quantiles to compute, DefaultQuantiles when empty.
+	Quantiles []float64
+	// Interpolation selects how a quantile falling between two values is
+	// computed.
+	Interpolation Interpolation
+	// Buckets is the number of buckets of the histogram, none is computed
+	// when it's 0.
+	Buckets int
+}
+
+// Interpolation selects how a quantile whose position h in the sorted values
+// falls between the indices i and j=i+1 is computed from them.
+type Interpolation int
+
+const (
+	// Linear interpolates between the values at i and j. It's the default.
+	Linear Interpolation = iota
+	// Lower picks the value at i.
+	Lower
+	// Higher picks the value at j.
+	Higher
+	// Nearest picks the value at the index closest to h, the even one on a
+	// tie.
+	Nearest
+	// Midpoint averages the values at i and j.
+	Midpoint
+)
+
+var interpolationNames = map[Interpolation]string{
+	Linear:   "linear",
+	Lower:    "lower",
+	Higher:   "higher",
+	Nearest:  "nearest",
+	Midpoint: "midpoint",
+}
+
+func (i Interpolation) String() string {
+	if s, ok := interpolationNames[i]; ok {
+		return s
+	}
+	return fmt.Sprintf("Interpolation(%d)", int(i))
+}
+
+// Quantile is the value V of the quantile Q.
+type Quantile struct {
+	Q, V float64
+}
+
+// Bucket counts the values in [Lower, Upper), the last bucket of a histogram
+// also counts the values equal to its Upper bound.
+type Bucket struct {
+	Lower, Upper float64
+	Count        int
+}
+
+// Description holds the statistics computed by Describe. Variance, Skewness
+// and Kurtosis are those of the population, Kurtosis is the excess kurtosis,
+// and Skewness and Kurtosis are NaN when every value is the same. Mode holds
+// the values occurring most often, sorted, and is empty when no value occurs
+// twice.
+type Description struct {
+	Count              int
+	Min, Max           float64
+	Mean               float64
+	Variance, StdDev   float64
+	Skewness, Kurtosis float64
+	Mode               []float64
+	Quantiles          []Quantile
+	Histogram          []Bucket
+}
+
+// Correlation holds the population covariance and the Pearson correlation
+// coefficient computed by Correlate. The coefficient is NaN when one of the
+// series is constant.
+type Correlation struct {
+	Count       int
+	Covariance  float64
+	Correlation float64
+}
+
+// NewBasicService returns a naïve, stateless implementation of Service. It
+// holds the whole dataset in memory, so quantiles and modes are exact.
+func NewBasicService() Service {
+	return basicService{}
+}
+
+type basicService struct{}
+
+func (basicService) Describe(_ context.Context, values []float64, opts Options) (Description, error) {
+	if err := validate(values); err != nil {
+		return Description{}, err
+	}
+	quantiles := opts.Quantiles
+	if len(quantiles) == 0 {
+		quantiles = DefaultQuantiles
+	}
+	for _, q := range quantiles {
+		if !(q >= 0 && q <= 1) {
+			return Description{}, fmt.Errorf("%w, got %v", ErrInvalidQuantile, q)
+		}
+	}
+	if opts.Buckets < 0 || opts.Buckets > MaxBuckets {
+		return Description{}, fmt.Errorf("%w, got %d and at most %d are allowed", ErrTooManyBuckets, opts.Buckets, MaxBuckets)
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	d := Description{
+		Count:     len(sorted),
+		Min:       sorted[0],
+		Max:       sorted[len(sorted)-1],
+		Mean:      mean(sorted),
+		Mode:      mode(sorted),
+		Quantiles: make([]Quantile, len(quantiles)),
+		Histogram: histogram(sorted, opts.Buckets),
+	}
+	var m2, m3, m4 float64
+	for _, x := range sorted {
+		dev := x - d.Mean
+		m2 += dev * dev
+		m3 += dev * dev * dev
+		m4 += dev * dev * dev * dev
+	}
+	n := float64(d.Count)
+	m2, m3, m4 = m2/n, m3/n, m4/n
+	d.Variance = m2
+	d.StdDev = math.Sqrt(m2)
+	if m2 == 0 {
+		d.Skewness, d.Kurtosis = math.NaN(), math.NaN()
+	} else {
+		d.Skewness = m3 / math.Pow(m2, 1.5)
+		d.Kurtosis = m4/(m2*m2) - 3
+	}
+	for i, q := range quantiles {
+		d.Quantiles[i] = Quantile{Q: q, V: quantile(sorted, q, opts.Interpolation)}
+	}
+	return d, nil
+}
+
+func (basicService) Correlate(_ context.Context, x, y []float64) (Correlation, error) {
+	if len(x) != len(y) {
+		return Correlation{}, fmt.Errorf("%w, got %d and %d", ErrLengthMismatch, len(x), len(y))
+	}
+	if err := validate(x); err != nil {
+		return Correlation{}, err
+	}
+	if err := validate(y); err != nil {
+		return Correlation{}, err
+	}
+	mx, my := mean(x), mean(y)
+	var sxy, sxx, syy float64
+	for i := range x {
+		dx, dy := x[i]-mx, y[i]-my
+		sxy += dx * dy
+		sxx += dx * dx
+		syy += dy * dy
+	}
+	c := Correlation{
+		Count:       len(x),
+		Covariance:  sxy / float64(len(x)),
+		Correlation: math.NaN(),
+	}
+	if sxx != 0 && syy != 0 {
+		// rounding may take the coefficient slightly out of [-1, 1]
+		c.Correlation = math.Max(-1, math.Min(1, sxy/math.Sqrt(sxx*syy)))
+	}
+	return c, nil
+}
+
+// validate returns an error unless values has at least one value and they're
+// all finite.
+func validate(values []float64) error {
+	if len(values) == 0 {
+		return mathservice.ErrNoValues
+	}
+	for i, x := range values {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return fmt.Errorf("%w, value %d is %v", ErrNonFiniteValue, i, x)
+		}
+	}
+	return nil
+}
+
+// mean returns the mean of values, summing them divided by their number
+// when their sum overflows.
+func mean(values []float64) float64 {
+	n := float64(len(values))
+	var sum float64
+	for _, x := range values {
+		sum += x
+	}
+	if !math.IsInf(sum, 0) && !math.IsNaN(sum) {
+		return sum / n
+	}
+	sum = 0
+	for _, x := range values {
+		sum += x / n
+	}
+	return sum
+}
+
+// mode returns the values of sorted occurring most often, none if no value
+// occurs twice.
+func mode(sorted []float64) []float64 {
+	modes := []float64{}
+	best := 1
+	for i := 0; i < len(sorted); {
+		j := i + 1
+		for j < len(sorted) && sorted[j] == sorted[i] {
+			j++
+		}
+		switch n := j - i; {
+		case n > best:
+			best = n
+			modes = append(modes[:0], sorted[i])
+		case n == best && n > 1:
+			modes = append(modes, sorted[i])
+		}
+		i = j
+	}
+	return modes
+}
+
+// quantile returns the quantile q of sorted, whose position h=(n-1)q is
+// interpolated as selected by in.
+func quantile(sorted []float64, q float64, in Interpolation) float64 {
+	h := float64(len(sorted)-1) * q
+	i, j := int(math.Floor(h)), int(math.Ceil(h))
+	lo, hi := sorted[i], sorted[j]
+	switch in {
+	case Lower:
+		return lo
+	case Higher:
+		return hi
+	case Nearest:
+		return sorted[int(math.RoundToEven(h))]
+	case Midpoint:
+		return lo/2 + hi/2
+	default:
+		return lo + (h-float64(i))*(hi-lo)
+	}
+}
+
+// histogram returns the counts of sorted in n buckets of equal width between
+// its minimum and maximum, a single bucket when they're equal.
+func histogram(sorted []float64, n int) []Bucket {
+	if n == 0 {
+		return []Bucket{}
+	}
+	min, max := sorted[0], sorted[len(sorted)-1]
+	if min == max {
+		return []Bucket{{Lower: min, Upper: max, Count: len(sorted)}}
+	}
+	// work with halves so max-min can't overflow
+	half := max/2 - min/2
+	bound := func(i int) float64 {
+		if i == n {
+			return max
+		}
+		return 2 * (min/2 + half*float64(i)/float64(n))
+	}
+	buckets := make([]Bucket, n)
+	for i := range buckets {
+		buckets[i] = Bucket{Lower: bound(i), Upper: bound(i + 1)}
+	}
+	for _, x := range sorted {
+		i := int((x/2 - min/2) / half * float64(n))
+		if i >= n {
+			i = n - 1
+		}
+		// the bounds are rounded, keep each value within those of its bucket
+		for i > 0 && x < buckets[i].Lower {
+			i--
+		}
+		for i < n-1 && x >= buckets[i+1].Lower {
+			i++
+		}
+		buckets[i].Count++
+	}
+	return buckets
+}
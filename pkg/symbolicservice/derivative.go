@@ -0,0 +1,134 @@
+package symbolicservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jwenz723/mathserver/pkg/expr"
+)
+
+// derivative returns the derivative of n with respect to the variable named
+// v, every other variable being a constant. The derivative isn't simplified,
+// and shares nodes with n. max and min, whose derivative depends on which
+// argument is larger, fail with ErrNotDifferentiable unless they're
+// constant. abs is differentiated as abs(u)/u, which is undefined where u is
+// 0 like the derivative itself. Differentiating stops with the error of ctx
+// once it's done.
+func derivative(ctx context.Context, n expr.Node, v string) (expr.Node, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	switch n := n.(type) {
+	case *expr.Number:
+		return number(0), nil
+	case *expr.Variable:
+		if n.Name == v {
+			return number(1), nil
+		}
+		return number(0), nil
+	case *expr.Unary:
+		dx, err := derivative(ctx, n.X, v)
+		if err != nil {
+			return nil, err
+		}
+		return &expr.Unary{Op: n.Op, X: dx}, nil
+	case *expr.Binary:
+		return binaryDerivative(ctx, n, v)
+	case *expr.Call:
+		return callDerivative(ctx, n, v)
+	}
+	return nil, fmt.Errorf("%w, unknown node %v", ErrNotDifferentiable, n)
+}
+
+func binaryDerivative(ctx context.Context, n *expr.Binary, v string) (expr.Node, error) {
+	dx, err := derivative(ctx, n.X, v)
+	if err != nil {
+		return nil, err
+	}
+	dy, err := derivative(ctx, n.Y, v)
+	if err != nil {
+		return nil, err
+	}
+	x, y := n.X, n.Y
+	switch n.Op {
+	case '+', '-':
+		return binary(n.Op, dx, dy), nil
+	case '*':
+		return binary('+', binary('*', dx, y), binary('*', x, dy)), nil
+	case '/':
+		return binary('/', binary('-', binary('*', dx, y), binary('*', x, dy)), binary('^', y, number(2))), nil
+	case '^':
+		switch {
+		case !depends(y, v):
+			// the power rule
+			return binary('*', binary('*', y, binary('^', x, binary('-', y, number(1)))), dx), nil
+		case !depends(x, v):
+			return binary('*', binary('*', n, call("ln", x)), dy), nil
+		}
+		// x^y is exp(y*ln(x))
+		return binary('*', n, binary('+', binary('*', dy, call("ln", x)), binary('/', binary('*', y, dx), x))), nil
+	}
+	return nil, fmt.Errorf("%w, unknown operator %q", ErrNotDifferentiable, n.Op)
+}
+
+func callDerivative(ctx context.Context, n *expr.Call, v string) (expr.Node, error) {
+	if n.Func == "max" || n.Func == "min" {
+		if depends(n, v) {
+			return nil, fmt.Errorf("%w, %s has no derivative with respect to %s", ErrNotDifferentiable, n, v)
+		}
+		return number(0), nil
+	}
+	u := n.Args[0]
+	du, err := derivative(ctx, u, v)
+	if err != nil {
+		return nil, err
+	}
+	var d expr.Node
+	switch n.Func {
+	case "abs":
+		d = binary('/', n, u)
+	case "sqrt":
+		d = binary('/', number(1), binary('*', number(2), n))
+	case "exp":
+		d = n
+	case "ln":
+		d = binary('/', number(1), u)
+	case "sin":
+		d = call("cos", u)
+	case "cos":
+		d = &expr.Unary{Op: '-', X: call("sin", u)}
+	case "tan":
+		d = binary('/', number(1), binary('^', call("cos", u), number(2)))
+	default:
+		return nil, fmt.Errorf("%w, unknown function %q", ErrNotDifferentiable, n.Func)
+	}
+	// the chain rule
+	return binary('*', d, du), nil
+}
+
+// depends reports whether n uses the variable named v.
+func depends(n expr.Node, v string) bool {
+	switch n := n.(type) {
+	case *expr.Variable:
+		return n.Name == v
+	case *expr.Unary:
+		return depends(n.X, v)
+	case *expr.Binary:
+		return depends(n.X, v) || depends(n.Y, v)
+	case *expr.Call:
+		for _, a := range n.Args {
+			if depends(a, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func binary(op byte, x, y expr.Node) *expr.Binary {
+	return &expr.Binary{Op: op, X: x, Y: y}
+}
+
+func call(f string, args ...expr.Node) *expr.Call {
+	return &expr.Call{Func: f, Args: args}
+}
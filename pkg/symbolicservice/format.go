@@ -0,0 +1,310 @@
+package symbolicservice
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jwenz723/mathserver/pkg/expr"
+)
+
+// The precedence of the nodes when printed, from the loosest to the tightest
+// binding. An atom never needs parentheses.
+const (
+	precSum = iota + 1
+	precProduct
+	precUnary
+	precPower
+	precAtom
+)
+
+// precedence returns the precedence of n when printed as infix text, or in
+// the two dimensional layout of LaTeX and MathML when display is set, where a
+// quotient is a fraction and exp(x) a power of e.
+func precedence(n expr.Node, display bool) int {
+	switch n := n.(type) {
+	case *expr.Number:
+		if _, ok := literal(n); ok && math.Signbit(n.Value) {
+			return precUnary
+		}
+		if _, _, ok := scientific(n); ok && display {
+			return precProduct
+		}
+	case *expr.Call:
+		if n.Func == "exp" && display {
+			return precPower
+		}
+	case *expr.Unary:
+		return precUnary
+	case *expr.Binary:
+		switch n.Op {
+		case '+', '-':
+			return precSum
+		case '*':
+			return precProduct
+		case '/':
+			if display {
+				return precAtom
+			}
+			return precProduct
+		case '^':
+			return precPower
+		}
+	}
+	return precAtom
+}
+
+// parens reports whether x, the left or right operand of op, needs
+// parentheses. Sums and products are written without the parentheses their
+// associativity makes unnecessary, so x*(y*z) is x*y*z. An op of 0 stands for
+// a unary minus.
+func parens(op byte, right bool, x expr.Node, display bool) bool {
+	p := precedence(x, display)
+	switch {
+	case op == 0:
+		// -x*y is (-x)*y, which is the same as -(x*y)
+		return p == precSum || p == precUnary
+	case op == '+':
+		return right && p == precUnary
+	case op == '-', op == '*':
+		if right {
+			return p == precSum || p == precUnary
+		}
+		return p < precProduct
+	case op == '/':
+		if right {
+			return p <= precUnary
+		}
+		return p < precProduct
+	case op == '^' && right:
+		// the exponent is raised on its own in the display layout
+		return !display && p <= precUnary
+	case op == '^':
+		q, ok := x.(*expr.Binary)
+		return p < precAtom || (display && ok && q.Op == '/')
+	}
+	return false
+}
+
+// juxtaposed reports whether the product x*y is written without a
+// multiplication sign in the display layout, as in 3x^{2}: x must be a
+// number and y start with a name.
+func juxtaposed(x, y expr.Node) bool {
+	if v, ok := literal(x); !ok || v < 0 {
+		return false
+	}
+	if _, _, ok := scientific(x.(*expr.Number)); ok {
+		return false
+	}
+	return startsWithName(y)
+}
+
+// startsWithName reports whether n printed in the display layout starts with
+// a name, a function or a constant.
+func startsWithName(n expr.Node) bool {
+	switch n := n.(type) {
+	case *expr.Variable, *expr.Call:
+		return true
+	case *expr.Number:
+		return n.Name != ""
+	case *expr.Binary:
+		switch n.Op {
+		case '*':
+			return !parens('*', false, n.X, true) && startsWithName(n.X)
+		case '^':
+			return !parens('^', false, n.X, true) && startsWithName(n.X)
+		}
+	}
+	return false
+}
+
+// scientific returns the mantissa and exponent of a number that formats in
+// scientific notation, such as 1e+21.
+func scientific(n *expr.Number) (mantissa, exponent string, ok bool) {
+	if n.Name != "" {
+		return "", "", false
+	}
+	s := strconv.FormatFloat(math.Abs(n.Value), 'g', -1, 64)
+	i := strings.IndexByte(s, 'e')
+	if i < 0 {
+		return "", "", false
+	}
+	e, err := strconv.Atoi(s[i+1:])
+	if err != nil {
+		return "", "", false
+	}
+	return s[:i], strconv.Itoa(e), true
+}
+
+// infix prints n with the syntax of the requests and as few parentheses as
+// possible, with spaces around the operators of sums.
+func infix(n expr.Node) string {
+	switch n := n.(type) {
+	case *expr.Unary:
+		return string(n.Op) + wrap(infix(n.X), parens(0, false, n.X, false))
+	case *expr.Binary:
+		x := wrap(infix(n.X), parens(n.Op, false, n.X, false))
+		y := wrap(infix(n.Y), parens(n.Op, true, n.Y, false))
+		if n.Op == '+' || n.Op == '-' {
+			return x + " " + string(n.Op) + " " + y
+		}
+		return x + string(n.Op) + y
+	case *expr.Call:
+		args := make([]string, len(n.Args))
+		for i, a := range n.Args {
+			args[i] = infix(a)
+		}
+		return n.Func + "(" + strings.Join(args, ", ") + ")"
+	}
+	return n.String()
+}
+
+func wrap(s string, parens bool) string {
+	if parens {
+		return "(" + s + ")"
+	}
+	return s
+}
+
+// latex prints n as a LaTeX math formula.
+func latex(n expr.Node) string {
+	switch n := n.(type) {
+	case *expr.Number:
+		switch {
+		case n.Name == "pi":
+			return `\pi`
+		case n.Name != "":
+			return n.Name
+		}
+		sign := ""
+		if math.Signbit(n.Value) {
+			sign = "-"
+		}
+		if m, e, ok := scientific(n); ok {
+			return sign + m + `\times 10^{` + e + `}`
+		}
+		return sign + strconv.FormatFloat(math.Abs(n.Value), 'f', -1, 64)
+	case *expr.Variable:
+		name := strings.Replace(n.Name, "_", `\_`, -1)
+		if utf8.RuneCountInString(n.Name) > 1 {
+			return `\mathrm{` + name + `}`
+		}
+		return name
+	case *expr.Unary:
+		return string(n.Op) + latexWrap(latex(n.X), parens(0, false, n.X, true))
+	case *expr.Binary:
+		if n.Op == '/' {
+			return `\frac{` + latex(n.X) + `}{` + latex(n.Y) + `}`
+		}
+		x := latexWrap(latex(n.X), parens(n.Op, false, n.X, true))
+		if n.Op == '^' {
+			return x + `^{` + latex(n.Y) + `}`
+		}
+		y := latexWrap(latex(n.Y), parens(n.Op, true, n.Y, true))
+		switch {
+		case n.Op == '*' && juxtaposed(n.X, n.Y):
+			return x + " " + y
+		case n.Op == '*':
+			return x + ` \cdot ` + y
+		}
+		return x + " " + string(n.Op) + " " + y
+	case *expr.Call:
+		switch n.Func {
+		case "sqrt":
+			return `\sqrt{` + latex(n.Args[0]) + `}`
+		case "abs":
+			return `\left|` + latex(n.Args[0]) + `\right|`
+		case "exp":
+			return `e^{` + latex(n.Args[0]) + `}`
+		}
+		args := make([]string, len(n.Args))
+		for i, a := range n.Args {
+			args[i] = latex(a)
+		}
+		return `\` + n.Func + latexWrap(strings.Join(args, ", "), true)
+	}
+	return n.String()
+}
+
+func latexWrap(s string, parens bool) string {
+	if parens {
+		return `\left(` + s + `\right)`
+	}
+	return s
+}
+
+// mathML prints n as a presentation MathML math element.
+func mathML(n expr.Node) string {
+	return `<math xmlns="http://www.w3.org/1998/Math/MathML">` + mathMLNode(n) + `</math>`
+}
+
+// mathMLNode prints n as a single MathML element.
+func mathMLNode(n expr.Node) string {
+	switch n := n.(type) {
+	case *expr.Number:
+		switch {
+		case n.Name == "pi":
+			return `<mi>&#x3C0;</mi>`
+		case n.Name != "":
+			return `<mi>` + n.Name + `</mi>`
+		}
+		s := `<mn>` + strconv.FormatFloat(math.Abs(n.Value), 'f', -1, 64) + `</mn>`
+		if m, e, ok := scientific(n); ok {
+			exponent := `<mn>` + e + `</mn>`
+			if strings.HasPrefix(e, "-") {
+				exponent = `<mrow><mo>-</mo><mn>` + e[1:] + `</mn></mrow>`
+			}
+			s = `<mrow><mn>` + m + `</mn><mo>&#xD7;</mo><msup><mn>10</mn>` + exponent + `</msup></mrow>`
+		}
+		if math.Signbit(n.Value) {
+			return `<mrow><mo>-</mo>` + s + `</mrow>`
+		}
+		return s
+	case *expr.Variable:
+		return `<mi>` + n.Name + `</mi>`
+	case *expr.Unary:
+		return `<mrow><mo>` + string(n.Op) + `</mo>` + mathMLWrap(mathMLNode(n.X), parens(0, false, n.X, true)) + `</mrow>`
+	case *expr.Binary:
+		switch n.Op {
+		case '/':
+			return `<mfrac>` + mathMLNode(n.X) + mathMLNode(n.Y) + `</mfrac>`
+		case '^':
+			return `<msup>` + mathMLWrap(mathMLNode(n.X), parens('^', false, n.X, true)) + mathMLNode(n.Y) + `</msup>`
+		}
+		op := string(n.Op)
+		switch {
+		case n.Op == '*' && juxtaposed(n.X, n.Y):
+			// invisible times
+			op = `&#x2062;`
+		case n.Op == '*':
+			op = `&#x22C5;`
+		}
+		x := mathMLWrap(mathMLNode(n.X), parens(n.Op, false, n.X, true))
+		y := mathMLWrap(mathMLNode(n.Y), parens(n.Op, true, n.Y, true))
+		return `<mrow>` + x + `<mo>` + op + `</mo>` + y + `</mrow>`
+	case *expr.Call:
+		switch n.Func {
+		case "sqrt":
+			return `<msqrt>` + mathMLNode(n.Args[0]) + `</msqrt>`
+		case "abs":
+			return `<mrow><mo>|</mo>` + mathMLNode(n.Args[0]) + `<mo>|</mo></mrow>`
+		case "exp":
+			return `<msup><mi>e</mi>` + mathMLNode(n.Args[0]) + `</msup>`
+		}
+		var args []string
+		for _, a := range n.Args {
+			args = append(args, mathMLNode(a))
+		}
+		// the function application is an invisible operator too
+		return `<mrow><mi>` + n.Func + `</mi><mo>&#x2061;</mo>` + mathMLWrap(strings.Join(args, `<mo>,</mo>`), true) + `</mrow>`
+	}
+	return `<mi>` + n.String() + `</mi>`
+}
+
+func mathMLWrap(s string, parens bool) string {
+	if parens {
+		return `<mrow><mo>(</mo>` + s + `<mo>)</mo></mrow>`
+	}
+	return s
+}
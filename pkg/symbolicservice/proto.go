@@ -0,0 +1,25 @@
+package symbolicservice
+
+import (
+	"github.com/jwenz723/mathserver/pb"
+)
+
+// RequestFromProto converts a gRPC symbolic request to a Request.
+func RequestFromProto(r *pb.SymbolicRequest) Request {
+	return Request{Expression: r.GetExpression(), Variable: r.GetVariable()}
+}
+
+// Proto converts r to a gRPC symbolic request.
+func (r Request) Proto() *pb.SymbolicRequest {
+	return &pb.SymbolicRequest{Expression: r.Expression, Variable: r.Variable}
+}
+
+// ResultFromProto converts the result of a gRPC symbolic reply to a Result.
+func ResultFromProto(r *pb.SymbolicReply) Result {
+	return Result{Infix: r.GetInfix(), LaTeX: r.GetLatex(), MathML: r.GetMathml()}
+}
+
+// Proto converts r to a gRPC symbolic reply without an error.
+func (r Result) Proto() *pb.SymbolicReply {
+	return &pb.SymbolicReply{Infix: r.Infix, Latex: r.LaTeX, Mathml: r.MathML}
+}
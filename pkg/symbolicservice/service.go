@@ -0,0 +1,175 @@
+// Package symbolicservice is the core of the Symbolic service, which works
+// with expressions as formulas rather than numbers.
+package symbolicservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jwenz723/mathserver/pkg/expr"
+)
+
+// Service describes a service that works with expressions symbolically.
+// Implementations may be wrapped by a Middleware, e.g. to log and measure
+// each call.
+//
+// Expressions are parsed with expr.ParseVars, so that every name that is
+// neither a function nor a constant is a variable. Simplification never
+// rounds: constant parts are only folded when their result is exactly a
+// float64, so 1/4 becomes 0.25 while 1/3 stays as it is.
+type Service interface {
+	// Format returns the expression as it's written
+	Format(ctx context.Context, r Request) (Result, error)
+	// Simplify returns the expression with its constant parts folded and
+	// identities such as x*1 and x+0 eliminated
+	Simplify(ctx context.Context, r Request) (Result, error)
+	// Differentiate returns the simplified derivative of the expression with
+	// respect to the variable
+	Differentiate(ctx context.Context, r Request) (Result, error)
+}
+
+// Middleware describes a service (as opposed to endpoint) middleware.
+type Middleware func(Service) Service
+
+// Errors returned by the basic Service, which transports map to their wire
+// representation, see pb.ErrorCode. An expression that can't be parsed fails
+// with an expr.SyntaxError.
+var (
+	ErrInvalidVariable    = errors.New("invalid variable")
+	ErrNotDifferentiable  = errors.New("not differentiable")
+	ErrExpressionTooLarge = fmt.Errorf("expression too large, it may have at most %d numbers, names, operations and calls", MaxNodes)
+)
+
+// MaxNodes is the most numbers, names, operations and calls an expression may
+// have. It keeps derivatives, which grow with the square of the expression,
+// and the time spent simplifying them in check.
+const MaxNodes = 1000
+
+// maxDerivativeNodes is the most nodes a derivative may have before it's
+// simplified. Derivatives usually have a few times as many nodes as their
+// expression, but the derivative of every level of a power tower such as
+// x^x^x repeats the levels above it.
+const maxDerivativeNodes = 100 * MaxNodes
+
+// Request is the operands of every method. Variable is only used by
+// Differentiate.
+type Request struct {
+	Expression string `json:"expression"`
+	Variable   string `json:"variable"`
+}
+
+// Result is the expression resulting from a method, in every notation. Infix
+// uses the syntax of the requests, LaTeX is a math formula and MathML a
+// presentation MathML math element.
+type Result struct {
+	Infix  string `json:"infix"`
+	LaTeX  string `json:"latex"`
+	MathML string `json:"mathml"`
+}
+
+// NewBasicService returns a naïve, stateless implementation of Service.
+func NewBasicService() Service {
+	return basicService{}
+}
+
+type basicService struct{}
+
+func (s basicService) Format(_ context.Context, r Request) (Result, error) {
+	n, err := parse(r.Expression)
+	if err != nil {
+		return Result{}, err
+	}
+	return result(n), nil
+}
+
+func (s basicService) Simplify(ctx context.Context, r Request) (Result, error) {
+	n, err := parse(r.Expression)
+	if err != nil {
+		return Result{}, err
+	}
+	n, err = simplify(ctx, n)
+	if err != nil {
+		return Result{}, err
+	}
+	return result(n), nil
+}
+
+func (s basicService) Differentiate(ctx context.Context, r Request) (Result, error) {
+	n, err := parse(r.Expression)
+	if err != nil {
+		return Result{}, err
+	}
+	if !isVariable(r.Variable) {
+		return Result{}, fmt.Errorf("%w %q, it must be a name other than those of the functions and constants", ErrInvalidVariable, r.Variable)
+	}
+	d, err := derivative(ctx, n, r.Variable)
+	if err != nil {
+		return Result{}, err
+	}
+	if size := count(d); size > maxDerivativeNodes {
+		return Result{}, fmt.Errorf("%w, its derivative has %d before being simplified, at most %d are allowed", ErrExpressionTooLarge, size, maxDerivativeNodes)
+	}
+	d, err = simplify(ctx, d)
+	if err != nil {
+		return Result{}, err
+	}
+	return result(d), nil
+}
+
+// parse parses an expression, failing with ErrExpressionTooLarge if it has
+// more than MaxNodes nodes.
+func parse(s string) (expr.Node, error) {
+	n, err := expr.ParseVars(s)
+	if err != nil {
+		return nil, err
+	}
+	if size := count(n); size > MaxNodes {
+		return nil, fmt.Errorf("%w, it has %d", ErrExpressionTooLarge, size)
+	}
+	return n, nil
+}
+
+// isVariable reports whether name is the name of a variable.
+func isVariable(name string) bool {
+	n, err := expr.ParseVars(name)
+	_, ok := n.(*expr.Variable)
+	return err == nil && ok
+}
+
+// count returns the number of nodes of n, where a node shared by several
+// others, as in a derivative, counts once for each of them as it's printed
+// as many times. Each node is only visited once, and the count stops growing
+// at math.MaxInt32.
+func count(n expr.Node) int {
+	counts := make(map[expr.Node]int)
+	var visit func(n expr.Node) int
+	visit = func(n expr.Node) int {
+		if c, ok := counts[n]; ok {
+			return c
+		}
+		c := 1
+		switch n := n.(type) {
+		case *expr.Unary:
+			c += visit(n.X)
+		case *expr.Binary:
+			c += visit(n.X) + visit(n.Y)
+		case *expr.Call:
+			for _, a := range n.Args {
+				c += visit(a)
+			}
+		}
+		if c > math.MaxInt32 {
+			c = math.MaxInt32
+		}
+		counts[n] = c
+		return c
+	}
+	return visit(n)
+}
+
+// result prints n in every notation.
+func result(n expr.Node) Result {
+	return Result{Infix: infix(n), LaTeX: latex(n), MathML: mathML(n)}
+}
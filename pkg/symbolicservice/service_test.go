@@ -0,0 +1,92 @@
+package symbolicservice_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jwenz723/mathserver/pkg/expr"
+	"github.com/jwenz723/mathserver/pkg/symbolicservice"
+)
+
+func TestDifferentiate(t *testing.T) {
+	for _, tc := range []struct {
+		expression, want string
+	}{
+		{"x^x^x", "x^x^x*(x^x*(ln(x) + 1)*ln(x) + x^(x - 1))"},
+		{"sin(sin(sin(x)))", "cos(sin(sin(x)))*cos(sin(x))*cos(x)"},
+		{"1/(x+1/(x+x))", "(2/(2*x)^2 - 1)/(x + 1/(2*x))^2"},
+	} {
+		r, err := symbolicservice.NewBasicService().Differentiate(context.Background(), symbolicservice.Request{Expression: tc.expression, Variable: "x"})
+		if err != nil || r.Infix != tc.want {
+			t.Errorf("d/dx %s: got %q, %v, want %q", tc.expression, r.Infix, err, tc.want)
+		}
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	for _, tc := range []struct {
+		expression, infix, latex string
+		err                      error
+	}{
+		{"x+0", "x", "x", nil},
+		{"1*x^1", "x", "x", nil},
+		{"2*3+x-x", "(6 + x) - x", `\left(6 + x\right) - x`, nil},
+		{"x*x/x", "x", "x", nil},
+		{"sqrt(x)^2", "sqrt(x)^2", `\sqrt{x}^{2}`, nil},
+		{"(x+1)/(x+1)", "1", "1", nil},
+		{"y*0+x", "x", "x", nil},
+		{"x+", "", "", expr.ErrSyntax},
+	} {
+		r, err := symbolicservice.NewBasicService().Simplify(context.Background(), symbolicservice.Request{Expression: tc.expression, Variable: "x"})
+		switch {
+		case !errors.Is(err, tc.err) || (tc.err == nil && err != nil):
+			t.Errorf("%s: got %v, want %v", tc.expression, err, tc.err)
+		case err == nil && (r.Infix != tc.infix || r.LaTeX != tc.latex):
+			t.Errorf("%s: got %q, %q, want %q, %q", tc.expression, r.Infix, r.LaTeX, tc.infix, tc.latex)
+		}
+	}
+}
+
+// TestDifferentiateLimits checks that the derivatives of the expressions
+// whose derivatives grow the most, which repeat the levels above each level,
+// are either computed quickly or rejected.
+func TestDifferentiateLimits(t *testing.T) {
+	for _, tc := range []struct {
+		name, expression string
+		want             error
+	}{
+		{"power tower", strings.Repeat("x^", 200) + "x", nil},
+		{"power tower too large", strings.Repeat("x^", 249) + "x", symbolicservice.ErrExpressionTooLarge},
+		{"nested calls", strings.Repeat("sin(", 255) + "x" + strings.Repeat(")", 255), nil},
+		{"nested calls too deep", strings.Repeat("sin(", 257) + "x" + strings.Repeat(")", 257), expr.ErrSyntax},
+		{"nested quotients", strings.Repeat("1/(x+", 128) + "x" + strings.Repeat(")", 128), nil},
+		{"nested products", strings.Repeat("x*(1+", 200) + "x" + strings.Repeat(")", 200), nil},
+		{"powers of sums", strings.Repeat("(x+", 64) + "x" + strings.Repeat(")^x", 64), nil},
+		{"powers of sums too large", strings.Repeat("(x+", 128) + "x" + strings.Repeat(")^x", 128), symbolicservice.ErrExpressionTooLarge},
+	} {
+		start := time.Now()
+		_, err := symbolicservice.NewBasicService().Differentiate(context.Background(), symbolicservice.Request{Expression: tc.expression, Variable: "x"})
+		if !errors.Is(err, tc.want) || (tc.want == nil && err != nil) {
+			t.Errorf("%s: got %v, want %v", tc.name, err, tc.want)
+		}
+		if d := time.Since(start); d > 10*time.Second {
+			t.Errorf("%s: took %v", tc.name, d)
+		}
+	}
+}
+
+func TestCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	svc := symbolicservice.NewBasicService()
+	r := symbolicservice.Request{Expression: "x^2+1", Variable: "x"}
+	if _, err := svc.Differentiate(ctx, r); !errors.Is(err, context.Canceled) {
+		t.Errorf("Differentiate: got %v, want %v", err, context.Canceled)
+	}
+	if _, err := svc.Simplify(ctx, r); !errors.Is(err, context.Canceled) {
+		t.Errorf("Simplify: got %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,506 @@
+package symbolicservice
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/jwenz723/mathserver/pkg/expr"
+)
+
+// simplify returns n simplified from the bottom up: operations on numbers are
+// folded when their result is exact, identities such as x+0, x*1 and x^1 are
+// eliminated, like terms such as 2*x+3*x are added up, and products are
+// written as a number followed by the powers of their factors, whose
+// exponents are added up when they have the same base. n itself is left as it
+// is. Simplifying stops with the error of ctx once it's done.
+func simplify(ctx context.Context, n expr.Node) (expr.Node, error) {
+	s := simplifier{
+		ctx:   ctx,
+		done:  make(map[expr.Node]expr.Node),
+		texts: make(map[expr.Node]string),
+	}
+	return s.simplify(n)
+}
+
+// simplifier simplifies every node once: a derivative shares nodes between
+// its terms, such as x^y in the derivative of x^y, which done maps to their
+// simplified form. texts holds the text of the nodes compared so far, which
+// would otherwise be printed again for every comparison.
+type simplifier struct {
+	ctx   context.Context
+	done  map[expr.Node]expr.Node
+	texts map[expr.Node]string
+}
+
+func (s *simplifier) simplify(n expr.Node) (expr.Node, error) {
+	if r, ok := s.done[n]; ok {
+		return r, nil
+	}
+	if err := s.ctx.Err(); err != nil {
+		return nil, err
+	}
+	r := n
+	switch n := n.(type) {
+	case *expr.Unary:
+		x, err := s.simplify(n.X)
+		if err != nil {
+			return nil, err
+		}
+		r = x
+		if n.Op == '-' {
+			r = s.negate(x)
+		}
+	case *expr.Binary:
+		x, err := s.simplify(n.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := s.simplify(n.Y)
+		if err != nil {
+			return nil, err
+		}
+		r = s.simplifyBinary(n.Op, x, y)
+	case *expr.Call:
+		args := make([]expr.Node, len(n.Args))
+		for i, a := range n.Args {
+			x, err := s.simplify(a)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = x
+		}
+		r = s.simplifyCall(n.Func, args)
+	}
+	s.done[n] = r
+	return r, nil
+}
+
+// simplifyBinary returns the simplified operation op of the simplified
+// operands x and y.
+func (s *simplifier) simplifyBinary(op byte, x, y expr.Node) expr.Node {
+	a, xLiteral := literal(x)
+	b, yLiteral := literal(y)
+	if xLiteral && yLiteral {
+		if v, ok := fold(op, a, b); ok {
+			return number(v)
+		}
+	}
+	switch op {
+	case '+':
+		switch {
+		case is(x, 0):
+			return y
+		case is(y, 0):
+			return x
+		}
+		if n, ok := s.likeTerms('+', x, y); ok {
+			return n
+		}
+		if ny, ok := s.negative(y); ok {
+			return s.simplifyBinary('-', x, ny)
+		}
+		if nx, ok := s.negative(x); ok {
+			return s.simplifyBinary('-', y, nx)
+		}
+	case '-':
+		switch {
+		case is(y, 0):
+			return x
+		case is(x, 0):
+			return s.negate(y)
+		}
+		if n, ok := s.likeTerms('-', x, y); ok {
+			return n
+		}
+		if ny, ok := s.negative(y); ok {
+			return s.simplifyBinary('+', x, ny)
+		}
+	case '*':
+		return s.product(x, y, false)
+	case '/':
+		if is(y, 0) {
+			return &expr.Binary{Op: op, X: x, Y: y}
+		}
+		return s.product(x, y, true)
+	case '^':
+		switch {
+		case is(y, 0):
+			return number(1)
+		case is(y, 1):
+			return x
+		case is(x, 1):
+			return number(1)
+		case is(x, 0) && yLiteral && b > 0:
+			return number(0)
+		}
+		if p, ok := x.(*expr.Binary); ok && p.Op == '^' && yLiteral && b == math.Trunc(b) {
+			// (x^a)^n is x^(a*n) for a whole n, which isn't true of any n:
+			// (x^2)^0.5 is |x|
+			return s.simplifyBinary('^', p.X, s.simplifyBinary('*', p.Y, y))
+		}
+	}
+	return &expr.Binary{Op: op, X: x, Y: y}
+}
+
+// simplifyCall returns the simplified call of f with the simplified
+// arguments args.
+func (s *simplifier) simplifyCall(f string, args []expr.Node) expr.Node {
+	if len(args) == 2 {
+		a, aLiteral := literal(args[0])
+		b, bLiteral := literal(args[1])
+		switch {
+		case aLiteral && bLiteral && f == "max":
+			return number(math.Max(a, b))
+		case aLiteral && bLiteral && f == "min":
+			return number(math.Min(a, b))
+		}
+		return &expr.Call{Func: f, Args: args}
+	}
+	x, ok := literal(args[0])
+	switch {
+	case ok && f == "abs":
+		return number(math.Abs(x))
+	case ok && f == "sqrt" && x >= 0:
+		if r := math.Sqrt(x); exactProduct(r, r) && r*r == x {
+			return number(r)
+		}
+	case ok && x == 0 && (f == "sin" || f == "tan"):
+		return number(0)
+	case ok && x == 0 && (f == "exp" || f == "cos"):
+		return number(1)
+	case ok && x == 1 && f == "ln":
+		return number(0)
+	case f == "abs" && isNegation(args[0]):
+		return s.simplifyCall(f, []expr.Node{args[0].(*expr.Unary).X})
+	case f == "ln" && isConstant(args[0], "e"):
+		return number(1)
+	}
+	return &expr.Call{Func: f, Args: args}
+}
+
+// factor is a base raised to an exponent within a product.
+type factor struct {
+	base, exponent expr.Node
+}
+
+// product returns the simplified product of the simplified nodes x and y, or
+// their quotient when divide is set. The product is flattened into a
+// coefficient, computed exactly, and factors, whose exponents are added up
+// when they have the same base, so that x*y*2*x is 2*x^2*y and x*y/x is y.
+// The factors with a negative exponent make up the denominator.
+func (s *simplifier) product(x, y expr.Node, divide bool) expr.Node {
+	var (
+		c       = big.NewRat(1, 1)
+		factors []factor
+		collect func(n expr.Node, inverse bool)
+	)
+	collect = func(n expr.Node, inverse bool) {
+		switch m := n.(type) {
+		case *expr.Number:
+			if v, ok := literal(m); ok && !(v == 0 && inverse) {
+				if inverse {
+					c.Quo(c, new(big.Rat).SetFloat64(v))
+				} else {
+					c.Mul(c, new(big.Rat).SetFloat64(v))
+				}
+				return
+			}
+		case *expr.Unary:
+			if m.Op == '-' {
+				c.Neg(c)
+				collect(m.X, inverse)
+				return
+			}
+		case *expr.Binary:
+			switch {
+			case m.Op == '*':
+				collect(m.X, inverse)
+				collect(m.Y, inverse)
+				return
+			case m.Op == '/' && !is(m.Y, 0):
+				collect(m.X, inverse)
+				collect(m.Y, !inverse)
+				return
+			}
+		}
+		base, exponent := power(n)
+		if inverse {
+			exponent = s.negate(exponent)
+		}
+		for i := range factors {
+			if s.equal(factors[i].base, base) {
+				factors[i].exponent = s.simplifyBinary('+', factors[i].exponent, exponent)
+				return
+			}
+		}
+		factors = append(factors, factor{base, exponent})
+	}
+	collect(x, false)
+	collect(y, divide)
+	if c.Sign() == 0 {
+		return number(0)
+	}
+	num, numExact := new(big.Float).SetInt(new(big.Int).Abs(c.Num())).Float64()
+	den, denExact := new(big.Float).SetInt(c.Denom()).Float64()
+	if numExact != big.Exact || denExact != big.Exact {
+		// the coefficient can't be written, such as 1e300*1e300
+		if divide {
+			return &expr.Binary{Op: '/', X: x, Y: y}
+		}
+		return &expr.Binary{Op: '*', X: x, Y: y}
+	}
+
+	sort.SliceStable(factors, func(i, j int) bool {
+		a, b := factors[i].base, factors[j].base
+		if rank(a) != rank(b) {
+			return rank(a) < rank(b)
+		}
+		return s.text(a) < s.text(b)
+	})
+	var numerator, denominator expr.Node
+	for _, f := range factors {
+		chain := &numerator
+		if e, ok := s.negative(f.exponent); ok {
+			f.exponent, chain = e, &denominator
+		}
+		p := s.simplifyBinary('^', f.base, f.exponent)
+		if is(p, 1) {
+			continue
+		}
+		if *chain == nil {
+			*chain = p
+		} else {
+			*chain = &expr.Binary{Op: '*', X: *chain, Y: p}
+		}
+	}
+	sign := float64(c.Sign())
+	hidden := numerator != nil && num == 1
+	switch {
+	case numerator == nil:
+		numerator = number(sign * num)
+	case num != 1:
+		numerator = &expr.Binary{Op: '*', X: number(sign * num), Y: numerator}
+	}
+	if den != 1 {
+		if denominator == nil {
+			denominator = number(den)
+		} else {
+			denominator = &expr.Binary{Op: '*', X: number(den), Y: denominator}
+		}
+	}
+	n := numerator
+	if denominator != nil {
+		n = &expr.Binary{Op: '/', X: numerator, Y: denominator}
+	}
+	if hidden && sign < 0 {
+		return &expr.Unary{Op: '-', X: n}
+	}
+	return n
+}
+
+// rank orders the factors of a product: numbers, constants, variables, and
+// then the others, such as sin(x) in x*sin(x). The factors of a rank are in
+// alphabetical order, so that x*y and y*x are the same product.
+func rank(base expr.Node) int {
+	switch b := base.(type) {
+	case *expr.Number:
+		if b.Name == "" {
+			return 0
+		}
+		return 1
+	case *expr.Variable:
+		return 2
+	}
+	return 3
+}
+
+// likeTerms returns the sum or difference op of x and y when they're
+// multiples of the same term, such as 2*x+3*x which is 5*x.
+func (s *simplifier) likeTerms(op byte, x, y expr.Node) (expr.Node, bool) {
+	a, u := term(x)
+	b, v := term(y)
+	if !s.equal(u, v) {
+		return nil, false
+	}
+	c, ok := fold(op, a, b)
+	if !ok {
+		return nil, false
+	}
+	return s.simplifyBinary('*', number(c), u), true
+}
+
+// term returns n as a number times a term, which is 1*n unless n has a
+// number in front or is a negation.
+func term(n expr.Node) (float64, expr.Node) {
+	if isNegation(n) {
+		c, u := term(n.(*expr.Unary).X)
+		return -c, u
+	}
+	if p, ok := n.(*expr.Binary); ok && p.Op == '*' {
+		if c, ok := literal(p.X); ok {
+			return c, p.Y
+		}
+	}
+	return 1, n
+}
+
+// negate returns the simplified negation of the simplified node x.
+func (s *simplifier) negate(x expr.Node) expr.Node {
+	if v, ok := literal(x); ok {
+		return number(-v)
+	}
+	if isNegation(x) {
+		return x.(*expr.Unary).X
+	}
+	if d, ok := x.(*expr.Binary); ok && d.Op == '-' {
+		return &expr.Binary{Op: '-', X: d.Y, Y: d.X}
+	}
+	if d, ok := x.(*expr.Binary); ok && (d.Op == '*' || d.Op == '/') {
+		// the sign goes to the number in front, -(3*x) is -3*x
+		if c, ok := literal(d.X); ok {
+			return s.simplifyBinary(d.Op, number(-c), d.Y)
+		}
+	}
+	return &expr.Unary{Op: '-', X: x}
+}
+
+// negative returns the simplified negation of the simplified node n if n is
+// visibly negative: a negative number, a negation, or a product or quotient
+// with a negative number in front.
+func (s *simplifier) negative(n expr.Node) (expr.Node, bool) {
+	if v, ok := literal(n); ok && v < 0 {
+		return number(-v), true
+	}
+	if isNegation(n) {
+		return n.(*expr.Unary).X, true
+	}
+	if d, ok := n.(*expr.Binary); ok && (d.Op == '*' || d.Op == '/') {
+		if v, ok := literal(d.X); ok && v < 0 {
+			return s.negate(n), true
+		}
+	}
+	return nil, false
+}
+
+// fold returns a op b if it's exactly a float64.
+func fold(op byte, a, b float64) (float64, bool) {
+	var (
+		x = new(big.Rat).SetFloat64(a)
+		y = new(big.Rat).SetFloat64(b)
+		r = new(big.Rat)
+	)
+	switch op {
+	case '+':
+		r.Add(x, y)
+	case '-':
+		r.Sub(x, y)
+	case '*':
+		r.Mul(x, y)
+	case '/':
+		if y.Sign() == 0 {
+			return 0, false
+		}
+		r.Quo(x, y)
+	case '^':
+		// only whole exponents small enough to be computed exactly, the
+		// others are rarely exact
+		if b != math.Trunc(b) || math.Abs(b) > 64 || (a == 0 && b < 0) {
+			return 0, false
+		}
+		r.SetInt64(1)
+		for i := 0; i < int(math.Abs(b)); i++ {
+			r.Mul(r, x)
+		}
+		if b < 0 {
+			r.Inv(r)
+		}
+	default:
+		return 0, false
+	}
+	v, exact := r.Float64()
+	return v, exact
+}
+
+// exactProduct reports whether a*b is exactly a float64.
+func exactProduct(a, b float64) bool {
+	_, ok := fold('*', a, b)
+	return ok
+}
+
+// number returns a Number of value v, never -0.
+func number(v float64) *expr.Number {
+	if v == 0 {
+		v = 0
+	}
+	return &expr.Number{Value: v}
+}
+
+// literal returns the value of n if it's a number other than a constant.
+func literal(n expr.Node) (float64, bool) {
+	if c, ok := n.(*expr.Number); ok && c.Name == "" {
+		return c.Value, true
+	}
+	return 0, false
+}
+
+// is reports whether n is the number v.
+func is(n expr.Node, v float64) bool {
+	c, ok := literal(n)
+	return ok && c == v
+}
+
+// isConstant reports whether n is the constant named name.
+func isConstant(n expr.Node, name string) bool {
+	c, ok := n.(*expr.Number)
+	return ok && c.Name == name
+}
+
+// isNegation reports whether n is a unary minus.
+func isNegation(n expr.Node) bool {
+	u, ok := n.(*expr.Unary)
+	return ok && u.Op == '-'
+}
+
+// power returns the base and exponent of n, which are n and 1 unless n is a
+// power.
+func power(n expr.Node) (base, exponent expr.Node) {
+	if p, ok := n.(*expr.Binary); ok && p.Op == '^' {
+		return p.X, p.Y
+	}
+	return n, number(1)
+}
+
+// equal reports whether a and b are the same expression.
+func (s *simplifier) equal(a, b expr.Node) bool {
+	return a == b || s.text(a) == s.text(b)
+}
+
+// text returns n.String(), printing each node only once however many
+// expressions it's part of.
+func (s *simplifier) text(n expr.Node) string {
+	if t, ok := s.texts[n]; ok {
+		return t
+	}
+	var t string
+	switch n := n.(type) {
+	case *expr.Unary:
+		t = "(" + string(n.Op) + s.text(n.X) + ")"
+	case *expr.Binary:
+		t = "(" + s.text(n.X) + " " + string(n.Op) + " " + s.text(n.Y) + ")"
+	case *expr.Call:
+		t = n.Func + "("
+		for i, a := range n.Args {
+			if i > 0 {
+				t += ", "
+			}
+			t += s.text(a)
+		}
+		t += ")"
+	default:
+		t = n.String()
+	}
+	s.texts[n] = t
+	return t
+}
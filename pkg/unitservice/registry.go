@@ -0,0 +1,281 @@
+package unitservice
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Dimension holds the exponents of the SI base quantities of a unit, in the
+// order of baseUnits.
+type Dimension [7]int
+
+// baseUnits are the SI base units every unit is defined in terms of.
+var baseUnits = [len(Dimension{})]string{"m", "kg", "s", "A", "K", "mol", "cd"}
+
+// definition is a unit known to a Registry, factor times its dimension in
+// base units.
+type definition struct {
+	factor float64
+	dim    Dimension
+}
+
+// builtins are the units every Registry starts with besides the base units,
+// defined in order, so each may use those before it.
+var builtins = []struct {
+	name   string
+	factor float64
+	unit   string
+}{
+	// length
+	{"km", 1000, "m"},
+	{"cm", 0.01, "m"},
+	{"mm", 0.001, "m"},
+	{"um", 1e-6, "m"},
+	{"µm", 1e-6, "m"},
+	{"nm", 1e-9, "m"},
+	{"in", 0.0254, "m"},
+	{"ft", 0.3048, "m"},
+	{"yd", 0.9144, "m"},
+	{"mi", 1609.344, "m"},
+	{"nmi", 1852, "m"},
+	// mass
+	{"g", 0.001, "kg"},
+	{"mg", 1e-6, "kg"},
+	{"t", 1000, "kg"},
+	{"lb", 0.45359237, "kg"},
+	{"oz", 0.028349523125, "kg"},
+	// time
+	{"ms", 0.001, "s"},
+	{"us", 1e-6, "s"},
+	{"µs", 1e-6, "s"},
+	{"ns", 1e-9, "s"},
+	{"min", 60, "s"},
+	{"h", 3600, "s"},
+	{"d", 86400, "s"},
+	// current
+	{"mA", 0.001, "A"},
+	// dimensionless
+	{"rad", 1, ""},
+	{"deg", math.Pi / 180, ""},
+	{"%", 0.01, ""},
+	// derived
+	{"Hz", 1, "1/s"},
+	{"N", 1, "kg*m/s^2"},
+	{"lbf", 4.4482216152605, "N"},
+	{"Pa", 1, "N/m^2"},
+	{"kPa", 1000, "Pa"},
+	{"bar", 1e5, "Pa"},
+	{"atm", 101325, "Pa"},
+	{"J", 1, "N*m"},
+	{"kJ", 1000, "J"},
+	{"cal", 4.184, "J"},
+	{"kcal", 4184, "J"},
+	{"W", 1, "J/s"},
+	{"kW", 1000, "W"},
+	{"Wh", 3600, "J"},
+	{"kWh", 3.6e6, "J"},
+	{"C", 1, "A*s"},
+	{"V", 1, "W/A"},
+	{"ohm", 1, "V/A"},
+	{"Ω", 1, "V/A"},
+	{"L", 0.001, "m^3"},
+	{"mL", 1e-6, "m^3"},
+	{"ha", 1e4, "m^2"},
+}
+
+// Registry holds the units that can be used in the units of quantities. A
+// Registry must not be modified once the Service using it serves requests.
+type Registry struct {
+	units map[string]definition
+}
+
+// NewRegistry returns a Registry holding the SI base units m, kg, s, A, K,
+// mol and cd, and common units derived from them such as km, h, N or kWh.
+func NewRegistry() *Registry {
+	r := &Registry{units: make(map[string]definition)}
+	for i, name := range baseUnits {
+		var dim Dimension
+		dim[i] = 1
+		r.units[name] = definition{factor: 1, dim: dim}
+	}
+	for _, b := range builtins {
+		if err := r.Define(b.name, b.factor, b.unit); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// Define adds the unit name, equal to factor times unit, e.g.
+// Define("furlong", 201.168, "m"). name must not already be defined.
+func (r *Registry) Define(name string, factor float64, unit string) error {
+	if !validSymbol(name) {
+		return fmt.Errorf("invalid unit name %q", name)
+	}
+	if _, ok := r.units[name]; ok {
+		return fmt.Errorf("unit %q is already defined", name)
+	}
+	if !(factor > 0) || math.IsInf(factor, 1) {
+		return fmt.Errorf("the factor of unit %q must be a positive number, it is %v", name, factor)
+	}
+	u, err := r.Parse(unit)
+	if err != nil {
+		return err
+	}
+	r.units[name] = definition{factor: factor * u.factor, dim: u.dim}
+	return nil
+}
+
+// Load defines the units read from rd, one per line as its name, an equals
+// sign, its factor and the unit the factor is in:
+//
+//	# nautical units
+//	kn = 1 nmi/h
+//	fathom = 6 ft
+//
+// Blank lines and lines starting with # are ignored.
+func (r *Registry) Load(rd io.Reader) error {
+	s := bufio.NewScanner(rd)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		eq := strings.Index(text, "=")
+		if eq < 0 {
+			return fmt.Errorf("line %d: missing = in %q", line, text)
+		}
+		name := strings.TrimSpace(text[:eq])
+		def := strings.Fields(text[eq+1:])
+		if len(def) == 0 {
+			return fmt.Errorf("line %d: missing factor of unit %q", line, name)
+		}
+		factor, err := strconv.ParseFloat(def[0], 64)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid factor of unit %q: %v", line, name, err)
+		}
+		if err := r.Define(name, factor, strings.Join(def[1:], "")); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	return s.Err()
+}
+
+// LoadFile is Load reading the file at path.
+func (r *Registry) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := r.Load(f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// Parse parses unit, a product of units known to r separated by * or /, each
+// optionally raised to an integer power with ^, e.g. "kg*m/s^2". A / divides
+// by the unit that follows it only, "J/kg/K" is J/(kg*K). The empty unit and
+// "1" are dimensionless. It fails with ErrUnknownUnit.
+func (r *Registry) Parse(unit string) (Unit, error) {
+	u := Unit{factor: 1}
+	rest := strings.TrimSpace(unit)
+	if rest == "" {
+		return u, nil
+	}
+	sign := 1
+	for first := true; ; first = false {
+		end := strings.IndexAny(rest, "*/")
+		if end < 0 {
+			end = len(rest)
+		}
+		t, err := parseTerm(strings.TrimSpace(rest[:end]))
+		if err != nil {
+			return Unit{}, fmt.Errorf("%w %q: %v", ErrUnknownUnit, unit, err)
+		}
+		switch {
+		case t.symbol == "1" && first && t.exp == 1:
+			// the numerator of e.g. 1/s
+		case t.symbol == "1":
+			return Unit{}, fmt.Errorf("%w %q: 1 may only start a unit", ErrUnknownUnit, unit)
+		default:
+			d, ok := r.units[t.symbol]
+			if !ok {
+				return Unit{}, fmt.Errorf("%w %q", ErrUnknownUnit, t.symbol)
+			}
+			t.exp *= sign
+			u = u.mul(Unit{terms: []term{t}, factor: math.Pow(d.factor, float64(t.exp)), dim: d.dim.scale(t.exp)})
+		}
+		if end == len(rest) {
+			return u, nil
+		}
+		sign = 1
+		if rest[end] == '/' {
+			sign = -1
+		}
+		rest = rest[end+1:]
+	}
+}
+
+// parseTerm parses a single unit symbol optionally raised to a power.
+func parseTerm(s string) (term, error) {
+	symbol, exp := s, 1
+	if i := strings.Index(s, "^"); i >= 0 {
+		symbol = strings.TrimSpace(s[:i])
+		e, err := strconv.Atoi(strings.TrimSpace(s[i+1:]))
+		if err != nil {
+			return term{}, fmt.Errorf("the exponent of %q must be an integer", symbol)
+		}
+		exp = e
+	}
+	if symbol != "1" && !validSymbol(symbol) {
+		return term{}, fmt.Errorf("invalid unit symbol %q", symbol)
+	}
+	return term{symbol: symbol, exp: exp}, nil
+}
+
+// validSymbol reports whether s may name a unit: letters, underscores and
+// the signs ° and %.
+func validSymbol(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !unicode.IsLetter(c) && c != '_' && c != '°' && c != '%' {
+			return false
+		}
+	}
+	return true
+}
+
+func (d Dimension) scale(n int) Dimension {
+	for i := range d {
+		d[i] *= n
+	}
+	return d
+}
+
+func (d Dimension) add(other Dimension) Dimension {
+	for i := range d {
+		d[i] += other[i]
+	}
+	return d
+}
+
+// String formats d in base units, e.g. "kg*m/s^2".
+func (d Dimension) String() string {
+	u := Unit{}
+	for i, exp := range d {
+		if exp != 0 {
+			u.terms = append(u.terms, term{symbol: baseUnits[i], exp: exp})
+		}
+	}
+	return u.String()
+}
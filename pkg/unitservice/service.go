@@ -0,0 +1,208 @@
+// Package unitservice is the core of the Units service, the arithmetic on
+// quantities carrying a unit.
+package unitservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/jsonfloat"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+)
+
+// Service describes a service that performs arithmetic on quantities.
+// Implementations may be wrapped by a Middleware, e.g. to log and measure
+// each call.
+//
+// The units a Service knows are held by a Registry, which starts with the SI
+// base units and common units derived from them and may be extended with a
+// units file, see Registry.Load.
+type Service interface {
+	// Sum returns a+b in the unit of a
+	Sum(ctx context.Context, a, b Quantity) (Quantity, error)
+	// Subtract returns a-b in the unit of a
+	Subtract(ctx context.Context, a, b Quantity) (Quantity, error)
+	// Multiply returns a*b in the product of their units
+	Multiply(ctx context.Context, a, b Quantity) (Quantity, error)
+	// Divide returns a/b in the quotient of their units
+	Divide(ctx context.Context, a, b Quantity) (Quantity, error)
+	// Pow returns a^b
+	Pow(ctx context.Context, a Quantity, b float64) (Quantity, error)
+	// Convert returns a in unit
+	Convert(ctx context.Context, a Quantity, unit string) (Quantity, error)
+}
+
+// Middleware describes a service (as opposed to endpoint) middleware.
+type Middleware func(Service) Service
+
+// Errors returned by the basic Service, which transports map to their wire
+// representation, see pb.ErrorCode. Dividing by zero fails with
+// mathservice.ErrDivideByZero, like the Divide of the Math service.
+var (
+	ErrUnknownUnit         = errors.New("unknown unit")
+	ErrIncompatibleUnits   = errors.New("incompatible units")
+	ErrFractionalDimension = errors.New("unit would have a fractional exponent")
+)
+
+// Quantity is a Value in a Unit, see Registry.Parse for the syntax of units.
+type Quantity struct {
+	Value float64
+	Unit  string
+}
+
+func (q Quantity) String() string {
+	if q.Unit == "" {
+		return fmt.Sprint(q.Value)
+	}
+	return fmt.Sprintf("%v %s", q.Value, q.Unit)
+}
+
+type quantityJSON struct {
+	Value jsonfloat.Float64 `json:"value"`
+	Unit  string            `json:"unit"`
+}
+
+// MarshalJSON implements json.Marshaler, e.g. {"value":9.81,"unit":"m/s^2"}.
+func (q Quantity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(quantityJSON{Value: jsonfloat.Float64(q.Value), Unit: q.Unit})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (q *Quantity) UnmarshalJSON(b []byte) error {
+	var j quantityJSON
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	*q = Quantity{Value: float64(j.Value), Unit: j.Unit}
+	return nil
+}
+
+// FromProto converts a gRPC quantity to a Quantity, nil is the dimensionless
+// 0.
+func FromProto(q *pb.Quantity) Quantity {
+	return Quantity{Value: q.GetValue(), Unit: q.GetUnit()}
+}
+
+// Proto converts q to a gRPC quantity.
+func (q Quantity) Proto() *pb.Quantity {
+	return &pb.Quantity{Value: q.Value, Unit: q.Unit}
+}
+
+// NewBasicService returns a naïve, stateless implementation of Service
+// knowing the units of r, which must not be modified afterwards. The units of
+// its results are formatted by Unit.String.
+func NewBasicService(r *Registry) Service {
+	return basicService{r}
+}
+
+type basicService struct {
+	units *Registry
+}
+
+func (s basicService) Sum(_ context.Context, a, b Quantity) (Quantity, error) {
+	ua, vb, err := s.compatible(a, b)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Value: a.Value + vb, Unit: ua.String()}, nil
+}
+
+func (s basicService) Subtract(_ context.Context, a, b Quantity) (Quantity, error) {
+	ua, vb, err := s.compatible(a, b)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Value: a.Value - vb, Unit: ua.String()}, nil
+}
+
+func (s basicService) Multiply(_ context.Context, a, b Quantity) (Quantity, error) {
+	ua, ub, err := s.parse(a.Unit, b.Unit)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Value: a.Value * b.Value, Unit: ua.mul(ub).String()}, nil
+}
+
+func (s basicService) Divide(_ context.Context, a, b Quantity) (Quantity, error) {
+	ua, ub, err := s.parse(a.Unit, b.Unit)
+	if err != nil {
+		return Quantity{}, err
+	}
+	if b.Value == 0 {
+		return Quantity{}, mathservice.ErrDivideByZero
+	}
+	return Quantity{Value: a.Value / b.Value, Unit: ua.mul(ub.inverse()).String()}, nil
+}
+
+func (s basicService) Pow(_ context.Context, a Quantity, b float64) (Quantity, error) {
+	ua, err := s.units.Parse(a.Unit)
+	if err != nil {
+		return Quantity{}, err
+	}
+	u, err := ua.pow(b)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Value: math.Pow(a.Value, b), Unit: u.String()}, nil
+}
+
+func (s basicService) Convert(_ context.Context, a Quantity, unit string) (Quantity, error) {
+	ua, u, err := s.parse(a.Unit, unit)
+	if err != nil {
+		return Quantity{}, err
+	}
+	if ua.dim != u.dim {
+		return Quantity{}, incompatible(ua, u)
+	}
+	return Quantity{Value: a.Value * (ua.factor / u.factor), Unit: u.String()}, nil
+}
+
+// parse parses the units a and b.
+func (s basicService) parse(a, b string) (Unit, Unit, error) {
+	ua, err := s.units.Parse(a)
+	if err != nil {
+		return Unit{}, Unit{}, err
+	}
+	ub, err := s.units.Parse(b)
+	if err != nil {
+		return Unit{}, Unit{}, err
+	}
+	return ua, ub, nil
+}
+
+// compatible returns the unit of a and the value of b in that unit, it fails
+// unless a and b have the same dimension.
+func (s basicService) compatible(a, b Quantity) (Unit, float64, error) {
+	ua, ub, err := s.parse(a.Unit, b.Unit)
+	if err != nil {
+		return Unit{}, 0, err
+	}
+	if ua.dim != ub.dim {
+		return Unit{}, 0, incompatible(ua, ub)
+	}
+	return ua, b.Value * (ub.factor / ua.factor), nil
+}
+
+// incompatible returns the error describing units of different dimensions.
+func incompatible(a, b Unit) error {
+	return fmt.Errorf("%w %s and %s", ErrIncompatibleUnits, describe(a), describe(b))
+}
+
+// describe returns u in error messages, along with its dimension in base
+// units unless it's expressed in them.
+func describe(u Unit) string {
+	switch s, dim := u.String(), u.dim.String(); {
+	case s == "" && dim == "":
+		return "a dimensionless number"
+	case dim == "":
+		return s + " (dimensionless)"
+	case s != dim:
+		return s + " (" + dim + ")"
+	default:
+		return s
+	}
+}
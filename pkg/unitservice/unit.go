@@ -0,0 +1,133 @@
+package unitservice
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Unit is a unit parsed by a Registry, a product of the units it knows each
+// raised to an integer power.
+type Unit struct {
+	terms  []term
+	factor float64
+	dim    Dimension
+}
+
+// term is a unit of a Registry raised to the power exp.
+type term struct {
+	symbol string
+	exp    int
+}
+
+// Dimension returns the dimension of u.
+func (u Unit) Dimension() Dimension { return u.dim }
+
+// Factor returns the value of u in the base units of its dimension, e.g.
+// 1000 for km.
+func (u Unit) Factor() float64 { return u.factor }
+
+// String formats u the way Parse accepts it, each unit once, in the order
+// they first appear, with those raised to a negative power after a /, e.g.
+// "kg*m/s^2". A dimensionless unit without terms is the empty string.
+func (u Unit) String() string {
+	var num, den []string
+	for _, t := range u.terms {
+		switch {
+		case t.exp == 1:
+			num = append(num, t.symbol)
+		case t.exp > 1:
+			num = append(num, fmt.Sprintf("%s^%d", t.symbol, t.exp))
+		case t.exp == -1:
+			den = append(den, t.symbol)
+		default:
+			den = append(den, fmt.Sprintf("%s^%d", t.symbol, -t.exp))
+		}
+	}
+	if len(num) == 0 && len(den) == 0 {
+		return ""
+	}
+	s := strings.Join(num, "*")
+	if s == "" {
+		s = "1"
+	}
+	for _, d := range den {
+		s += "/" + d
+	}
+	return s
+}
+
+// mul returns the product of u and v, merging the powers of the units they
+// share.
+func (u Unit) mul(v Unit) Unit {
+	p := Unit{
+		terms:  make([]term, 0, len(u.terms)+len(v.terms)),
+		factor: u.factor * v.factor,
+		dim:    u.dim.add(v.dim),
+	}
+	p.terms = append(p.terms, u.terms...)
+	for _, t := range v.terms {
+		merged := false
+		for i := range p.terms {
+			if p.terms[i].symbol == t.symbol {
+				p.terms[i].exp += t.exp
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			p.terms = append(p.terms, t)
+		}
+	}
+	return p.compact()
+}
+
+// compact drops the units of u raised to the power 0, e.g. those that
+// cancelled out in a product.
+func (u Unit) compact() Unit {
+	terms := u.terms[:0]
+	for _, t := range u.terms {
+		if t.exp != 0 {
+			terms = append(terms, t)
+		}
+	}
+	u.terms = terms
+	return u
+}
+
+// inverse returns 1/u.
+func (u Unit) inverse() Unit {
+	inv := Unit{terms: make([]term, len(u.terms)), factor: 1 / u.factor, dim: u.dim.scale(-1)}
+	for i, t := range u.terms {
+		inv.terms[i] = term{symbol: t.symbol, exp: -t.exp}
+	}
+	return inv
+}
+
+// pow returns u^b, it fails with ErrFractionalDimension unless the power of
+// each unit of u remains an integer.
+func (u Unit) pow(b float64) (Unit, error) {
+	p := Unit{terms: make([]term, len(u.terms)), factor: math.Pow(u.factor, b)}
+	for i, t := range u.terms {
+		exp, ok := integer(float64(t.exp) * b)
+		if !ok {
+			return Unit{}, fmt.Errorf("%w: %s^%v", ErrFractionalDimension, t.symbol, b)
+		}
+		p.terms[i] = term{symbol: t.symbol, exp: exp}
+	}
+	for i, exp := range u.dim {
+		// the dimension follows from the terms, this can't fail when they
+		// didn't
+		p.dim[i], _ = integer(float64(exp) * b)
+	}
+	return p.compact(), nil
+}
+
+// integer returns x as an int when it's within rounding of one, e.g. 3*(1/3).
+func integer(x float64) (int, bool) {
+	r := math.Round(x)
+	if math.IsNaN(r) || math.IsInf(r, 0) || math.Abs(x-r) > 1e-9 || math.Abs(r) > math.MaxInt32 {
+		return 0, false
+	}
+	return int(r), true
+}
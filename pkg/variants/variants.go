@@ -0,0 +1,390 @@
+// Package variants constructs every server implementation in the repository
+// in-process, wired up the same way as its cmd/mathsvc but with logs
+// discarded and metrics left unregistered, so that the implementations can
+// be tested and benchmarked side by side.
+package variants
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics/discard"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	httpgokittransport "github.com/jwenz723/mathserver/grpc_and_http/gokit/pkg/mathtransport"
+	httpstdservice "github.com/jwenz723/mathserver/grpc_and_http/std/pkg/mathservice"
+	httpstdserver "github.com/jwenz723/mathserver/grpc_and_http/std/pkg/server"
+	gokittransport "github.com/jwenz723/mathserver/grpc_only/gokit/pkg/mathtransport"
+	grpcnativeserver "github.com/jwenz723/mathserver/grpc_only/grpcnative/pkg/server"
+	stdservice "github.com/jwenz723/mathserver/grpc_only/std/pkg/mathservice"
+	stdserver "github.com/jwenz723/mathserver/grpc_only/std/pkg/server"
+	"github.com/jwenz723/mathserver/pb"
+	"github.com/jwenz723/mathserver/pkg/calculusservice"
+	"github.com/jwenz723/mathserver/pkg/combinatoricsservice"
+	"github.com/jwenz723/mathserver/pkg/complexservice"
+	"github.com/jwenz723/mathserver/pkg/financeservice"
+	gokitendpoint "github.com/jwenz723/mathserver/pkg/gokit/mathendpoint"
+	gokitservice "github.com/jwenz723/mathserver/pkg/gokit/mathservice"
+	"github.com/jwenz723/mathserver/pkg/linalgservice"
+	"github.com/jwenz723/mathserver/pkg/mathservice"
+	"github.com/jwenz723/mathserver/pkg/numtheoryservice"
+	"github.com/jwenz723/mathserver/pkg/precision"
+	"github.com/jwenz723/mathserver/pkg/statsservice"
+	"github.com/jwenz723/mathserver/pkg/unitservice"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Variant is one of the server implementations.
+type Variant struct {
+	// Name is the directory of the implementation, e.g. "grpc_only/std".
+	Name string
+	// NewGRPCServer returns the gRPC server of the implementation. When
+	// statusErrors is set errors are reported as gRPC status codes.
+	NewGRPCServer func(statusErrors bool) pb.MathServer
+	// GRPCOptions are the options the gRPC server must be created with, such
+	// as the interceptors grpcnative relies on for logging and metrics.
+	GRPCOptions []grpc.ServerOption
+	// NewComplexGRPCServer returns the gRPC server of the Complex service of
+	// the implementation, it's nil for the implementations that don't serve
+	// it.
+	NewComplexGRPCServer func(statusErrors bool) pb.ComplexServer
+	// NewLinearAlgebraGRPCServer is NewComplexGRPCServer for the
+	// LinearAlgebra service.
+	NewLinearAlgebraGRPCServer func(statusErrors bool) pb.LinearAlgebraServer
+	// NewPolynomialGRPCServer is NewComplexGRPCServer for the Polynomial
+	// service, which only grpc_and_http/gokit serves so far.
+	NewPolynomialGRPCServer func(statusErrors bool) pb.PolynomialServer
+	// NewStatisticsGRPCServer is NewComplexGRPCServer for the Statistics
+	// service, which is only served over gRPC.
+	NewStatisticsGRPCServer func(statusErrors bool) pb.StatisticsServer
+	// NewUnitsGRPCServer is NewComplexGRPCServer for the Units service, it
+	// knows the built-in units only.
+	NewUnitsGRPCServer func(statusErrors bool) pb.UnitsServer
+	// NewFinanceGRPCServer is NewComplexGRPCServer for the Finance service.
+	NewFinanceGRPCServer func(statusErrors bool) pb.FinanceServer
+	// NewNumberTheoryGRPCServer is NewComplexGRPCServer for the NumberTheory
+	// service.
+	NewNumberTheoryGRPCServer func(statusErrors bool) pb.NumberTheoryServer
+	// NewCombinatoricsGRPCServer is NewComplexGRPCServer for the
+	// Combinatorics service, it allows the default number of digits.
+	NewCombinatoricsGRPCServer func(statusErrors bool) pb.CombinatoricsServer
+	// NewCalculusGRPCServer is NewComplexGRPCServer for the Calculus service.
+	NewCalculusGRPCServer func(statusErrors bool) pb.CalculusServer
+	// NewSymbolicGRPCServer is NewComplexGRPCServer for the Symbolic service,
+	// which only the grpc_and_http variants serve.
+	NewSymbolicGRPCServer func(statusErrors bool) pb.SymbolicServer
+	// HTTPHandler serves the HTTP API of the implementation, it's nil for the
+	// gRPC only implementations. It also serves the Complex service under
+	// /complex/ when NewComplexGRPCServer is set, the LinearAlgebra service
+	// under /linearalgebra/ when NewLinearAlgebraGRPCServer is set, the
+	// Polynomial service under /polynomial/ when NewPolynomialGRPCServer is
+	// set, the Units service under /units/ when NewUnitsGRPCServer is set, the
+	// Finance service under /finance/ when NewFinanceGRPCServer is set, the
+	// NumberTheory service under /numbertheory/ when NewNumberTheoryGRPCServer
+	// is set, the Combinatorics service under /combinatorics/ when
+	// NewCombinatoricsGRPCServer is set, the Calculus service under
+	// /calculus/ when NewCalculusGRPCServer is set and the Symbolic service
+	// under /symbolic/ when NewSymbolicGRPCServer is set.
+	HTTPHandler http.Handler
+	// HTTPBatch reports whether HTTPHandler serves POST /batch.
+	HTTPBatch bool
+}
+
+// All returns every implementation, computing with p unless a request asks
+// for another precision and applying nonFinite to the results.
+func All(p precision.Precision, nonFinite mathservice.NonFinitePolicy) []Variant {
+	var (
+		logger  = log.NewNopLogger()
+		zlogger = zap.NewNop()
+		units   = unitservice.NewRegistry()
+
+		httpGokitEndpoints = gokitendpoint.New(gokitservice.New(discard.NewHistogram(), logger, p, nonFinite), logger)
+		httpGokitComplex   = gokitendpoint.NewComplex(gokitservice.NewComplex(discard.NewHistogram(), logger))
+		httpGokitLinalg    = gokitendpoint.NewLinearAlgebra(gokitservice.NewLinearAlgebra(discard.NewHistogram(), logger))
+		httpGokitPoly      = gokitendpoint.NewPolynomial(gokitservice.NewPolynomial(discard.NewHistogram(), logger))
+		httpGokitStats     = gokitendpoint.NewStatistics(gokitservice.NewStatistics(discard.NewHistogram(), logger))
+		httpGokitUnits     = gokitendpoint.NewUnits(gokitservice.NewUnits(discard.NewHistogram(), logger, units))
+		httpGokitFinance   = gokitendpoint.NewFinance(gokitservice.NewFinance(discard.NewHistogram(), logger))
+		httpGokitNT        = gokitendpoint.NewNumberTheory(gokitservice.NewNumberTheory(discard.NewHistogram(), logger))
+		httpGokitComb      = gokitendpoint.NewCombinatorics(gokitservice.NewCombinatorics(discard.NewHistogram(), logger, 0))
+		httpGokitCalc      = gokitendpoint.NewCalculus(gokitservice.NewCalculus(discard.NewHistogram(), logger))
+		httpGokitSym       = gokitendpoint.NewSymbolic(gokitservice.NewSymbolic(discard.NewHistogram(), logger))
+		httpStdService     = httpstdservice.New(duration(), zlogger, p, nonFinite)
+		httpStdComplex     = httpstdservice.NewComplex(duration(), zlogger)
+		httpStdLinalg      = httpstdservice.NewLinearAlgebra(duration(), zlogger)
+		httpStdUnits       = httpstdservice.NewUnits(duration(), zlogger, units)
+		httpStdFinance     = httpstdservice.NewFinance(duration(), zlogger)
+		httpStdNT          = httpstdservice.NewNumberTheory(duration(), zlogger)
+		httpStdComb        = httpstdservice.NewCombinatorics(duration(), zlogger, 0)
+		httpStdCalc        = httpstdservice.NewCalculus(duration(), zlogger)
+		httpStdSym         = httpstdservice.NewSymbolic(duration(), zlogger)
+		gokitEndpoints     = gokitendpoint.New(gokitservice.New(discard.NewHistogram(), logger, p, nonFinite), logger)
+		gokitStats         = gokitendpoint.NewStatistics(gokitservice.NewStatistics(discard.NewHistogram(), logger))
+		gokitUnits         = gokitendpoint.NewUnits(gokitservice.NewUnits(discard.NewHistogram(), logger, units))
+		gokitFinance       = gokitendpoint.NewFinance(gokitservice.NewFinance(discard.NewHistogram(), logger))
+		gokitNT            = gokitendpoint.NewNumberTheory(gokitservice.NewNumberTheory(discard.NewHistogram(), logger))
+		gokitComb          = gokitendpoint.NewCombinatorics(gokitservice.NewCombinatorics(discard.NewHistogram(), logger, 0))
+		gokitCalc          = gokitendpoint.NewCalculus(gokitservice.NewCalculus(discard.NewHistogram(), logger))
+		gokitComplex       = gokitendpoint.NewComplex(gokitservice.NewComplex(discard.NewHistogram(), logger))
+		gokitLinalg        = gokitendpoint.NewLinearAlgebra(gokitservice.NewLinearAlgebra(discard.NewHistogram(), logger))
+		stdService         = stdservice.New(duration(), zlogger, p, nonFinite)
+		stdUnits           = stdservice.NewUnits(duration(), zlogger, units)
+		stdFinance         = stdservice.NewFinance(duration(), zlogger)
+		stdNT              = stdservice.NewNumberTheory(duration(), zlogger)
+		stdComb            = stdservice.NewCombinatorics(duration(), zlogger, 0)
+		stdCalc            = stdservice.NewCalculus(duration(), zlogger)
+		stdComplex         = stdservice.NewComplex(duration(), zlogger)
+		stdLinalg          = stdservice.NewLinearAlgebra(duration(), zlogger)
+		grpcnativeService  = mathservice.NonFiniteMiddleware(nonFinite)(mathservice.NewBasicService(p))
+		grpcnativeDecider  = grpcnativeserver.NewGrpcServer(grpcnativeService, false)
+		grpcnativeUnary    = grpc_middleware.ChainUnaryServer(
+			grpc_prometheus.UnaryServerInterceptor,
+			grpc_zap.UnaryServerInterceptor(zlogger),
+			grpc_zap.PayloadUnaryServerInterceptor(zlogger, grpcnativeDecider.GrpcLoggingDecider()),
+		)
+	)
+
+	return []Variant{
+		{
+			Name: "grpc_and_http/gokit",
+			NewGRPCServer: func(statusErrors bool) pb.MathServer {
+				return httpgokittransport.NewGRPCServer(httpGokitEndpoints, logger, statusErrors)
+			},
+			NewComplexGRPCServer: func(statusErrors bool) pb.ComplexServer {
+				return httpgokittransport.NewComplexGRPCServer(httpGokitComplex, logger, statusErrors)
+			},
+			NewLinearAlgebraGRPCServer: func(statusErrors bool) pb.LinearAlgebraServer {
+				return httpgokittransport.NewLinearAlgebraGRPCServer(httpGokitLinalg, logger, statusErrors)
+			},
+			NewPolynomialGRPCServer: func(statusErrors bool) pb.PolynomialServer {
+				return httpgokittransport.NewPolynomialGRPCServer(httpGokitPoly, logger, statusErrors)
+			},
+			NewStatisticsGRPCServer: func(statusErrors bool) pb.StatisticsServer {
+				return httpgokittransport.NewStatisticsGRPCServer(httpGokitStats, logger, statusErrors, 0)
+			},
+			NewUnitsGRPCServer: func(statusErrors bool) pb.UnitsServer {
+				return httpgokittransport.NewUnitsGRPCServer(httpGokitUnits, logger, statusErrors)
+			},
+			NewFinanceGRPCServer: func(statusErrors bool) pb.FinanceServer {
+				return httpgokittransport.NewFinanceGRPCServer(httpGokitFinance, logger, statusErrors)
+			},
+			NewNumberTheoryGRPCServer: func(statusErrors bool) pb.NumberTheoryServer {
+				return httpgokittransport.NewNumberTheoryGRPCServer(httpGokitNT, logger, statusErrors)
+			},
+			NewCombinatoricsGRPCServer: func(statusErrors bool) pb.CombinatoricsServer {
+				return httpgokittransport.NewCombinatoricsGRPCServer(httpGokitComb, logger, statusErrors)
+			},
+			NewCalculusGRPCServer: func(statusErrors bool) pb.CalculusServer {
+				return httpgokittransport.NewCalculusGRPCServer(httpGokitCalc, logger, statusErrors)
+			},
+			NewSymbolicGRPCServer: func(statusErrors bool) pb.SymbolicServer {
+				return httpgokittransport.NewSymbolicGRPCServer(httpGokitSym, logger, statusErrors)
+			},
+			HTTPHandler: withServices(
+				httpgokittransport.NewHTTPHandler(httpGokitEndpoints, logger),
+				map[string]http.Handler{
+					"/complex/":       httpgokittransport.NewComplexHTTPHandler(httpGokitComplex, logger),
+					"/linearalgebra/": httpgokittransport.NewLinearAlgebraHTTPHandler(httpGokitLinalg, logger),
+					"/polynomial/":    httpgokittransport.NewPolynomialHTTPHandler(httpGokitPoly, logger),
+					"/units/":         httpgokittransport.NewUnitsHTTPHandler(httpGokitUnits, logger),
+					"/finance/":       httpgokittransport.NewFinanceHTTPHandler(httpGokitFinance, logger),
+					"/numbertheory/":  httpgokittransport.NewNumberTheoryHTTPHandler(httpGokitNT, logger),
+					"/combinatorics/": httpgokittransport.NewCombinatoricsHTTPHandler(httpGokitComb, logger),
+					"/calculus/":      httpgokittransport.NewCalculusHTTPHandler(httpGokitCalc, logger),
+					"/symbolic/":      httpgokittransport.NewSymbolicHTTPHandler(httpGokitSym, logger),
+				},
+			),
+			HTTPBatch: true,
+		},
+		{
+			Name: "grpc_and_http/std",
+			NewGRPCServer: func(statusErrors bool) pb.MathServer {
+				s := httpstdserver.NewGrpcServer(httpStdService, statusErrors)
+				return &s
+			},
+			NewComplexGRPCServer: func(statusErrors bool) pb.ComplexServer {
+				s := httpstdserver.NewComplexGrpcServer(httpStdComplex, statusErrors)
+				return &s
+			},
+			NewLinearAlgebraGRPCServer: func(statusErrors bool) pb.LinearAlgebraServer {
+				s := httpstdserver.NewLinearAlgebraGrpcServer(httpStdLinalg, statusErrors)
+				return &s
+			},
+			NewUnitsGRPCServer: func(statusErrors bool) pb.UnitsServer {
+				s := httpstdserver.NewUnitsGrpcServer(httpStdUnits, statusErrors)
+				return &s
+			},
+			NewFinanceGRPCServer: func(statusErrors bool) pb.FinanceServer {
+				s := httpstdserver.NewFinanceGrpcServer(httpStdFinance, statusErrors)
+				return &s
+			},
+			NewNumberTheoryGRPCServer: func(statusErrors bool) pb.NumberTheoryServer {
+				s := httpstdserver.NewNumberTheoryGrpcServer(httpStdNT, statusErrors)
+				return &s
+			},
+			NewCombinatoricsGRPCServer: func(statusErrors bool) pb.CombinatoricsServer {
+				s := httpstdserver.NewCombinatoricsGrpcServer(httpStdComb, statusErrors)
+				return &s
+			},
+			NewCalculusGRPCServer: func(statusErrors bool) pb.CalculusServer {
+				s := httpstdserver.NewCalculusGrpcServer(httpStdCalc, statusErrors)
+				return &s
+			},
+			NewSymbolicGRPCServer: func(statusErrors bool) pb.SymbolicServer {
+				s := httpstdserver.NewSymbolicGrpcServer(httpStdSym, statusErrors)
+				return &s
+			},
+			HTTPHandler: withServices(
+				httpstdserver.NewHttpRouter(httpStdService, zlogger),
+				map[string]http.Handler{
+					"/complex/":       httpstdserver.NewComplexHttpRouter(httpStdComplex, zlogger),
+					"/linearalgebra/": httpstdserver.NewLinearAlgebraHttpRouter(httpStdLinalg, zlogger),
+					"/units/":         httpstdserver.NewUnitsHttpRouter(httpStdUnits, zlogger),
+					"/finance/":       httpstdserver.NewFinanceHttpRouter(httpStdFinance, zlogger),
+					"/numbertheory/":  httpstdserver.NewNumberTheoryHttpRouter(httpStdNT, zlogger),
+					"/combinatorics/": httpstdserver.NewCombinatoricsHttpRouter(httpStdComb, zlogger),
+					"/calculus/":      httpstdserver.NewCalculusHttpRouter(httpStdCalc, zlogger),
+					"/symbolic/":      httpstdserver.NewSymbolicHttpRouter(httpStdSym, zlogger),
+				},
+			),
+			HTTPBatch: true,
+		},
+		{
+			Name: "grpc_only/gokit",
+			NewGRPCServer: func(statusErrors bool) pb.MathServer {
+				return gokittransport.NewGRPCServer(gokitEndpoints, logger, statusErrors)
+			},
+			NewStatisticsGRPCServer: func(statusErrors bool) pb.StatisticsServer {
+				return gokittransport.NewStatisticsGRPCServer(gokitStats, logger, statusErrors, 0)
+			},
+			NewUnitsGRPCServer: func(statusErrors bool) pb.UnitsServer {
+				return gokittransport.NewUnitsGRPCServer(gokitUnits, logger, statusErrors)
+			},
+			NewFinanceGRPCServer: func(statusErrors bool) pb.FinanceServer {
+				return gokittransport.NewFinanceGRPCServer(gokitFinance, logger, statusErrors)
+			},
+			NewNumberTheoryGRPCServer: func(statusErrors bool) pb.NumberTheoryServer {
+				return gokittransport.NewNumberTheoryGRPCServer(gokitNT, logger, statusErrors)
+			},
+			NewCombinatoricsGRPCServer: func(statusErrors bool) pb.CombinatoricsServer {
+				return gokittransport.NewCombinatoricsGRPCServer(gokitComb, logger, statusErrors)
+			},
+			NewCalculusGRPCServer: func(statusErrors bool) pb.CalculusServer {
+				return gokittransport.NewCalculusGRPCServer(gokitCalc, logger, statusErrors)
+			},
+			NewComplexGRPCServer: func(statusErrors bool) pb.ComplexServer {
+				return gokittransport.NewComplexGRPCServer(gokitComplex, logger, statusErrors)
+			},
+			NewLinearAlgebraGRPCServer: func(statusErrors bool) pb.LinearAlgebraServer {
+				return gokittransport.NewLinearAlgebraGRPCServer(gokitLinalg, logger, statusErrors)
+			},
+		},
+		{
+			Name: "grpc_only/grpcnative",
+			NewGRPCServer: func(statusErrors bool) pb.MathServer {
+				s := grpcnativeserver.NewGrpcServer(grpcnativeService, statusErrors)
+				s.Interceptor = grpcnativeUnary
+				return &s
+			},
+			NewStatisticsGRPCServer: func(statusErrors bool) pb.StatisticsServer {
+				s := grpcnativeserver.NewStatisticsGrpcServer(statsservice.NewBasicService(), statusErrors, 0)
+				return &s
+			},
+			NewUnitsGRPCServer: func(statusErrors bool) pb.UnitsServer {
+				s := grpcnativeserver.NewUnitsGrpcServer(unitservice.NewBasicService(units), statusErrors)
+				return &s
+			},
+			NewFinanceGRPCServer: func(statusErrors bool) pb.FinanceServer {
+				s := grpcnativeserver.NewFinanceGrpcServer(financeservice.NewBasicService(), statusErrors)
+				return &s
+			},
+			NewNumberTheoryGRPCServer: func(statusErrors bool) pb.NumberTheoryServer {
+				s := grpcnativeserver.NewNumberTheoryGrpcServer(numtheoryservice.NewBasicService(), statusErrors)
+				return &s
+			},
+			NewCombinatoricsGRPCServer: func(statusErrors bool) pb.CombinatoricsServer {
+				s := grpcnativeserver.NewCombinatoricsGrpcServer(combinatoricsservice.NewBasicService(0), statusErrors)
+				return &s
+			},
+			NewCalculusGRPCServer: func(statusErrors bool) pb.CalculusServer {
+				s := grpcnativeserver.NewCalculusGrpcServer(calculusservice.NewBasicService(), statusErrors)
+				return &s
+			},
+			NewComplexGRPCServer: func(statusErrors bool) pb.ComplexServer {
+				s := grpcnativeserver.NewComplexGrpcServer(complexservice.NewBasicService(), statusErrors)
+				return &s
+			},
+			NewLinearAlgebraGRPCServer: func(statusErrors bool) pb.LinearAlgebraServer {
+				s := grpcnativeserver.NewLinearAlgebraGrpcServer(linalgservice.NewBasicService(), statusErrors)
+				return &s
+			},
+			GRPCOptions: []grpc.ServerOption{
+				grpc.UnaryInterceptor(grpcnativeUnary),
+				grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+					grpc_prometheus.StreamServerInterceptor,
+					grpc_zap.StreamServerInterceptor(zlogger),
+				)),
+			},
+		},
+		{
+			Name: "grpc_only/std",
+			NewGRPCServer: func(statusErrors bool) pb.MathServer {
+				s := stdserver.NewGrpcServer(stdService, statusErrors)
+				return &s
+			},
+			NewUnitsGRPCServer: func(statusErrors bool) pb.UnitsServer {
+				s := stdserver.NewUnitsGrpcServer(stdUnits, statusErrors)
+				return &s
+			},
+			NewFinanceGRPCServer: func(statusErrors bool) pb.FinanceServer {
+				s := stdserver.NewFinanceGrpcServer(stdFinance, statusErrors)
+				return &s
+			},
+			NewNumberTheoryGRPCServer: func(statusErrors bool) pb.NumberTheoryServer {
+				s := stdserver.NewNumberTheoryGrpcServer(stdNT, statusErrors)
+				return &s
+			},
+			NewCombinatoricsGRPCServer: func(statusErrors bool) pb.CombinatoricsServer {
+				s := stdserver.NewCombinatoricsGrpcServer(stdComb, statusErrors)
+				return &s
+			},
+			NewCalculusGRPCServer: func(statusErrors bool) pb.CalculusServer {
+				s := stdserver.NewCalculusGrpcServer(stdCalc, statusErrors)
+				return &s
+			},
+			NewComplexGRPCServer: func(statusErrors bool) pb.ComplexServer {
+				s := stdserver.NewComplexGrpcServer(stdComplex, statusErrors)
+				return &s
+			},
+			NewLinearAlgebraGRPCServer: func(statusErrors bool) pb.LinearAlgebraServer {
+				s := stdserver.NewLinearAlgebraGrpcServer(stdLinalg, statusErrors)
+				return &s
+			},
+		},
+	}
+}
+
+// withServices serves the requests under each path prefix of services with
+// its handler and every other request with mathHandler, the way the
+// grpc_and_http mains do.
+func withServices(mathHandler http.Handler, services map[string]http.Handler) http.Handler {
+	m := http.NewServeMux()
+	for prefix, h := range services {
+		m.Handle(prefix, h)
+	}
+	m.Handle("/", mathHandler)
+	return m
+}
+
+// duration returns an unregistered summary for the std observability
+// middleware, each implementation gets its own so they don't collide.
+func duration() *prometheus.SummaryVec {
+	return prometheus.NewSummaryVec(prometheus.SummaryOpts{
+		Subsystem: "mathsvc",
+		Name:      "request_duration_seconds",
+		Help:      "Request duration in seconds.",
+	}, []string{"method", "success"})
+}